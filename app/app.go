@@ -12,6 +12,7 @@ import (
 	appparams "github.com/ChainSafe/chainlink-cosmos/app/params"
 	"github.com/ChainSafe/chainlink-cosmos/docs"
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink"
+	chainlinkclient "github.com/ChainSafe/chainlink-cosmos/x/chainlink/client"
 	chainlinkkeeper "github.com/ChainSafe/chainlink-cosmos/x/chainlink/keeper"
 	chainlinktypes "github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -104,6 +105,7 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distrclient.ProposalHandler, upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler,
+			chainlinkclient.AddFeedProposalHandler, chainlinkclient.AddModuleOwnerProposalHandler, chainlinkclient.RemoveModuleOwnerProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -311,12 +313,26 @@ func New(
 		),
 	)
 
+	// Create Chainlink keepers, the chainlink proposal handler of the gov router needs it
+	app.ChainLinkKeeper = *chainlinkkeeper.NewKeeper(
+		appCodec,
+		app.BankKeeper,
+		app.GetSubspace(chainlinktypes.ModuleName),
+		keys[chainlinktypes.FeedDataStoreKey],
+		keys[chainlinktypes.RoundStoreKey],
+		keys[chainlinktypes.ModuleOwnerStoreKey],
+		keys[chainlinktypes.FeedInfoStoreKey],
+		keys[chainlinktypes.AccountStoreKey],
+		keys[chainlinktypes.MemStoreKey],
+	)
+
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, chainlink.NewParamChangeProposalHandler(app.ChainLinkKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(chainlinktypes.RouterKey, chainlink.NewProposalHandler(app.ChainLinkKeeper))
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
 		keys[govtypes.StoreKey],
@@ -336,22 +352,25 @@ func New(
 	)
 	app.EvidenceKeeper = *evidenceKeeper
 
-	// Create Chainlink keepers
-	app.ChainLinkKeeper = *chainlinkkeeper.NewKeeper(
-		appCodec,
-		app.BankKeeper,
-		keys[chainlinktypes.FeedDataStoreKey],
-		keys[chainlinktypes.RoundStoreKey],
-		keys[chainlinktypes.ModuleOwnerStoreKey],
-		keys[chainlinktypes.FeedInfoStoreKey],
-		keys[chainlinktypes.AccountStoreKey],
-		keys[chainlinktypes.MemStoreKey],
-	)
+	// migrate the chainlink feed data store to the length-prefixed, big endian key layout
+	app.UpgradeKeeper.SetUpgradeHandler(chainlinktypes.FeedDataStoreLayoutUpgradeName, func(ctx sdk.Context, _ upgradetypes.Plan) {
+		if err := app.ChainLinkKeeper.MigrateFeedDataStoreLayout(ctx); err != nil {
+			panic(err)
+		}
+	})
+	// index the chainlink keys of the accounts registered before the chainlink key index
+	app.UpgradeKeeper.SetUpgradeHandler(chainlinktypes.ChainlinkKeyIndexUpgradeName, func(ctx sdk.Context, _ upgradetypes.Plan) {
+		if err := app.ChainLinkKeeper.MigrateChainlinkKeyIndex(ctx); err != nil {
+			panic(err)
+		}
+	})
 
 	// Register feed reward payout strategy functions
 	// nil means no strategy registered when chain launching, empty string must be passed in as `feedRewardStrategy` when
 	// sending adding a new feed or set FeedReward txs.
-	chainlinktypes.NewFeedRewardStrategyRegister(nil)
+	// The built-in strategies are registered by default, app specific strategies can be added to the map.
+	chainlinktypes.NewFeedRewardStrategyRegister(chainlinktypes.BuiltinFeedRewardStrategies())
+	chainlinktypes.NewFeedRewardStrategyDescriptionRegister(chainlinktypes.BuiltinFeedRewardStrategyDescriptions())
 
 	/****  Module Options ****/
 
@@ -394,7 +413,7 @@ func New(
 		stakingtypes.ModuleName,
 	)
 
-	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName)
+	app.mm.SetOrderEndBlockers(crisistypes.ModuleName, govtypes.ModuleName, stakingtypes.ModuleName, chainlinktypes.ModuleName)
 
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
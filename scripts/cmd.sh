@@ -10,6 +10,9 @@ bobPK=$(chainlinkd keys show bob -p)
 cerloAddr=$(chainlinkd keys show cerlo -a)
 cerloPK=$(chainlinkd keys show cerlo -p)
 
+# ABI encoded OCR report with a single observation (100) from oracle 0, in epoch 1 round 1
+report=0x00000000000000000000000000000000000000000000000000000000000001010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000064
+
 # ======
 # Module
 # ======
@@ -17,12 +20,51 @@ cerloPK=$(chainlinkd keys show cerlo -p)
 # List all module owner
 chainlinkd query chainlink get-module-owner-list --chain-id testchain -o json
 
+# Query the module params
+chainlinkd query chainlink get-params --chain-id testchain -o json
+
+# Propose to keep the latest 1000 rounds of every feed
+cat > round-retention.json <<EOF
+{
+  "title": "Chainlink round retention",
+  "description": "Keep the latest 1000 rounds of every feed",
+  "changes": [{"subspace": "chainlink", "key": "RoundRetention", "value": "\\"1000\\""}],
+  "deposit": "10000000stake"
+}
+EOF
+chainlinkd tx gov submit-proposal param-change round-retention.json --from alice --keyring-backend test --chain-id testchain --fees 3link
+
+# Propose to add bob as module owner through x/gov
+chainlinkd tx gov submit-proposal add-module-owner "$bobAddr" "$bobPK" --title "Add bob" --description "Add bob as chainlink module owner" --deposit 10000000stake --from alice --keyring-backend test --chain-id testchain --fees 3link
+
+# Propose to remove bob as module owner through x/gov
+chainlinkd tx gov submit-proposal remove-module-owner "$bobAddr" --title "Remove bob" --description "Remove bob as chainlink module owner" --deposit 10000000stake --from alice --keyring-backend test --chain-id testchain --fees 3link
+
 # Add new module owner by alice
 chainlinkd tx chainlink add-module-owner "$bobAddr" "$bobPK" --from alice --keyring-backend test --chain-id testchain --fees 3link
 
+# Remove module owner bob by alice, the last module owner can not be removed, and add bob back
+chainlinkd tx chainlink remove-module-owner "$bobAddr" --from alice --keyring-backend test --chain-id testchain --fees 3link
+chainlinkd tx chainlink add-module-owner "$bobAddr" "$bobPK" --from alice --keyring-backend test --chain-id testchain --fees 3link
+
+# List the owner proposals pending the approval of the module owners
+chainlinkd query chainlink list-owner-proposals --chain-id testchain -o json
+
+# Approve the owner proposal 1 by bob, it executes once ownerApprovalThreshold module owners approved it
+chainlinkd tx chainlink approve-owner-proposal 1 --from bob --keyring-backend test --chain-id testchain --fees 3link
+
+# Cancel the owner proposal 2 by its proposer alice
+chainlinkd tx chainlink cancel-owner-proposal 2 --from alice --keyring-backend test --chain-id testchain --fees 3link
+
 # Module ownership transfer by bob to alice
 chainlinkd tx chainlink module-ownership-transfer "$aliceAddr" "$alicePK" --from bob --keyring-backend test --chain-id testchain --fees 3link
 
+# List the module ownership transfers pending the acceptance of the new module owners
+chainlinkd query chainlink list-module-ownership-transfers --chain-id testchain -o json
+
+# Accept the module ownership transfer of bob by alice
+chainlinkd tx chainlink accept-module-ownership "$bobAddr" --from alice --keyring-backend test --chain-id testchain --fees 3link
+
 # ====
 # Feed
 # ====
@@ -48,9 +90,21 @@ chainlinkd tx chainlink remove-data-provider feedid1 "$cerloAddr" --from cerlo -
 # Query feed info by feedId
 chainlinkd query chainlink get-feed-info feedid1 --chain-id testchain
 
+# Add feed transmitter
+chainlinkd tx chainlink add-transmitter feedid1 "$bobAddr" --from cerlo --keyring-backend test --chain-id testchain --fees 3link
+
+# Remove feed transmitter
+chainlinkd tx chainlink remove-transmitter feedid1 "$bobAddr" --from cerlo --keyring-backend test --chain-id testchain --fees 3link
+
 # Feed ownership transfer by cerlo to bob
 chainlinkd tx chainlink feed-ownership-transfer feedid1 "$bobAddr" --from cerlo --keyring-backend test --chain-id testchain --fees 3link
 
+# Query the pending ownership transfer of the feed
+chainlinkd query chainlink get-feed-ownership-transfer feedid1 --chain-id testchain
+
+# Accept the feed ownership transfer by bob
+chainlinkd tx chainlink accept-feed-ownership feedid1 --from bob --keyring-backend test --chain-id testchain --fees 3link
+
 # Query feed info by feedId
 chainlinkd query chainlink get-feed-info feedid1 --chain-id testchain
 
@@ -66,12 +120,45 @@ chainlinkd tx chainlink set-deviation-threshold-trigger feedid1 300 --from bob -
 # Update feed reward parameter
 chainlinkd tx chainlink set-feed-reward feedid1 1000 "" --from cerlo --keyring-backend test --chain-id testchain --fees 3link
 
+# Query the available reward strategies and pick a built-in one
+chainlinkd query chainlink get-feed-reward-avail-strategy --chain-id testchain -o json
+chainlinkd tx chainlink set-feed-reward feedid1 1000 accuracyWeighted --from bob --keyring-backend test --chain-id testchain --fees 3link
+
+# Update feed metadata
+chainlinkd tx chainlink set-feed-metadata feedid1 --decimals 8 --feed-version 1 --base-asset ATOM --quote-asset USD --from bob --keyring-backend test --chain-id testchain --fees 3link
+
+# Update feed answer bounds
+chainlinkd tx chainlink set-answer-bounds feedid1 --min-answer 1 --max-answer 100000000000 --answer-bounds-mode reject --from bob --keyring-backend test --chain-id testchain --fees 3link
+
+# Query feed metadata
+chainlinkd query chainlink get-feed-metadata feedid1 --chain-id testchain -o json
+
+# Query the latest OCR config details
+chainlinkd query chainlink latest-config-details feedid1 --chain-id testchain -o json
+
+# Fund the reward escrow of a feed, withdraw part of it and query the balance
+chainlinkd tx chainlink fund-feed feedid1 1000 --from bob --keyring-backend test --chain-id testchain --fees 3link
+chainlinkd tx chainlink withdraw-feed-funds feedid1 200 --from bob --keyring-backend test --chain-id testchain --fees 3link
+chainlinkd query chainlink get-feed-escrow-balance feedid1 --chain-id testchain -o json
+
+# Pause and unpause a feed
+chainlinkd tx chainlink pause-feed feedid1 --from bob --keyring-backend test --chain-id testchain --fees 3link
+chainlinkd tx chainlink unpause-feed feedid1 --from bob --keyring-backend test --chain-id testchain --fees 3link
+
+# Deprecate a feed, then delete it by module owner
+chainlinkd tx chainlink deprecate-feed feedid2 --from bob --keyring-backend test --chain-id testchain --fees 3link
+chainlinkd tx chainlink delete-feed feedid2 --from alice --keyring-backend test --chain-id testchain --fees 3link
+
 # ==================
 # Feed Data (Report)
 # ==================
 
 # Submit feed data by bob
-chainlinkd tx chainlink submit-feed-data feedid1 "feed 1 test data" "signatures_bob,signatures_cerlo" "$bobPK,$cerloPK" --from cerlo --keyring-backend test --chain-id testchain --fees 3link
+chainlinkd tx chainlink submit-feed-data feedid1 "$report" "feed 1 test data" "signatures_bob,signatures_cerlo" "$bobPK,$cerloPK" --from cerlo --keyring-backend test --chain-id testchain --fees 3link
+
+# Query the payments owed to bob, then withdraw them to his piggy address
+chainlinkd query chainlink list-owed-payments --oracle "$bobAddr" --chain-id testchain -o json
+chainlinkd tx chainlink withdraw-payment feedid1 --from bob --keyring-backend test --chain-id testchain --fees 3link
 
 # Query feed data by txHash
 chainlinkd query tx C350CAD4673DB75005C6215262633375ECE318BAEDC794820EE43FA958FB8174 --chain-id testchain -o json
@@ -87,3 +174,9 @@ chainlinkd query chainlink get-lates-feed-data feedid2 --chain-id testchain -o j
 
 # Query the latest round of feed data
 chainlinkd query chainlink get-latest-feed-data --chain-id testchain -o json
+
+# Query the round history of a feed, newest round first, 10 rounds per page
+chainlinkd query chainlink get-round-history feedid1 --from-round 1 --to-round 100 --reverse --limit 10 --chain-id testchain -o json
+
+# List the feeds owned by alice
+chainlinkd query chainlink list-feeds --feed-owner $(chainlinkd keys show alice -a) --chain-id testchain -o json
@@ -21,6 +21,11 @@ bobPK=$(chainlinkd keys show bob -p)
 cerloAddr=$(chainlinkd keys show cerlo -a)
 cerloPK=$(chainlinkd keys show cerlo -p)
 
+# ABI encoded OCR report with a single observation (100) from oracle 0, in epoch 1 round 1
+report=0x00000000000000000000000000000000000000000000000000000000000001010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000064
+# the same report in epoch 1 round 2, a report can not be submitted twice
+report2=0x00000000000000000000000000000000000000000000000000000000000001020000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000064
+
 ### ~~~ BEGIN FEED ADD TESTS ~~~ ###
 
 # aDd NeW fEeD bY aLiCe
@@ -60,7 +65,7 @@ echo "added alice account successfully..."
 
 # sUbMiT fEeD dAtA bY aLiCe
 echo "submitting feed data by alice"
-submitFeedTx1=$($chainlinkCMD submit-feed-data feedid1 "feed 1 test data" "signatures_alice" "$alicePK" --from alice --keyring-backend test --chain-id testchain --fees 3link <<< 'y\n')
+submitFeedTx1=$($chainlinkCMD submit-feed-data feedid1 "$report" "feed 1 test data" "signatures_alice" "$alicePK" --from alice --keyring-backend test --chain-id testchain --fees 3link <<< 'y\n')
 submitFeedTx1Resp=$(echo "$submitFeedTx1" | jq '.height')
 if [ "$submitFeedTx1Resp" == "\"0\"" ]
 then
@@ -85,7 +90,7 @@ fi
 
 # sUbMiT fEeD dAtA bY cErLo (nOn-AuThOrIzEd DaTa PrOvIdEr)...
 echo "submitting feed data by unauthorized data provider"
-badSubmitFeedTx=$($chainlinkCMD submit-feed-data feedid1 "feed 1 test data" "signatures_bob" "$bobPK" --from bob --keyring-backend test --chain-id testchain --fees 3link <<< 'y\n')
+badSubmitFeedTx=$($chainlinkCMD submit-feed-data feedid1 "$report" "feed 1 test data" "signatures_bob" "$bobPK" --from bob --keyring-backend test --chain-id testchain --fees 3link <<< 'y\n')
 badSubmitFeedTxResp=$(echo "$badSubmitFeedTx" | jq '.raw_log')
 if [ "$badSubmitFeedTxResp" != "\"submitter is not a valid data provider: unauthorized\"" ]
 then
@@ -126,7 +131,7 @@ fi
 
 # sUbMiT fEeD dAtA bY bOb
 echo "submitting feed data by bob"
-submitFeedTx2=$($chainlinkCMD submit-feed-data feedid1 "feed 1 test data" "signatures_bob" "$bobPK" --from bob --keyring-backend test --chain-id testchain --fees 3link <<< 'y\n')
+submitFeedTx2=$($chainlinkCMD submit-feed-data feedid1 "$report2" "feed 1 test data" "signatures_bob" "$bobPK" --from bob --keyring-backend test --chain-id testchain --fees 3link <<< 'y\n')
 submitFeedTx2Resp=$(echo "$submitFeedTx2" | jq '.height')
 if [ "$submitFeedTx2Resp" == "\"0\"" ]
 then
@@ -328,10 +328,20 @@ func (s *GRPCTestSuite) TestIntegration() {
 		NewModuleOwnerPubKey:  []byte(alice.Cosmos),
 		AssignerAddress:       bob.Addr,
 	}
-	s.Require().NoError(addModuleOwnerTx.ValidateBasic())
+	s.Require().NoError(moduleOwnershipTransferTx.ValidateBasic())
 	moduleOwnershipTransferResponse := s.BroadcastTx(ctx, bob, moduleOwnershipTransferTx)
 	s.Require().EqualValues(0, moduleOwnershipTransferResponse.TxResponse.Code)
 
+	listModuleOwnershipTransfersResponse, err := queryClient.ListModuleOwnershipTransfers(ctx, &types.ListModuleOwnershipTransfersRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(listModuleOwnershipTransfersResponse.GetTransfers()))
+	s.Require().EqualValues(alice.Addr, listModuleOwnershipTransfersResponse.GetTransfers()[0].GetNewOwner())
+
+	acceptModuleOwnershipTx := types.NewMsgAcceptModuleOwnership(alice.Addr, bob.Addr)
+	s.Require().NoError(acceptModuleOwnershipTx.ValidateBasic())
+	acceptModuleOwnershipResponse := s.BroadcastTx(ctx, alice, acceptModuleOwnershipTx)
+	s.Require().EqualValues(0, acceptModuleOwnershipResponse.TxResponse.Code)
+
 	s.T().Log("4 - Add new feed by alice")
 
 	feedId := "testfeed1"
@@ -420,6 +430,16 @@ func (s *GRPCTestSuite) TestIntegration() {
 	feedOwnershipTransferResponse := s.BroadcastTx(ctx, cerlo, feedOwnershipTransferTx)
 	s.Require().EqualValues(0, feedOwnershipTransferResponse.TxResponse.Code)
 
+	// the feed ownership only changes once bob accepts it
+	getFeedOwnershipTransferResponse, err := queryClient.GetFeedOwnershipTransfer(ctx, &types.GetFeedOwnershipTransferRequest{FeedId: feedId})
+	s.Require().NoError(err)
+	s.Require().EqualValues(bob.Addr, getFeedOwnershipTransferResponse.GetTransfer().GetNewOwner())
+
+	acceptFeedOwnershipTx := types.NewMsgAcceptFeedOwnership(bob.Addr, feedId)
+	s.Require().NoError(acceptFeedOwnershipTx.ValidateBasic())
+	acceptFeedOwnershipResponse := s.BroadcastTx(ctx, bob, acceptFeedOwnershipTx)
+	s.Require().EqualValues(0, acceptFeedOwnershipResponse.TxResponse.Code)
+
 	getFeedByFeedIdResponse, err = queryClient.GetFeedByFeedId(ctx, &types.GetFeedByIdRequest{FeedId: feedId})
 	s.Require().NoError(err)
 	feed = getFeedByFeedIdResponse.GetFeed()
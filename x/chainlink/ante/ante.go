@@ -17,12 +17,33 @@ import (
 )
 
 const (
-	ErrFeedDoesNotExist         = "feed does not exist"
-	ErrSignerIsNotFeedOwner     = "account %s (%s) is not a feed owner"
-	ErrAccountAlreadyExists     = "there is already a chainlink account associated with this cosmos address"
-	ErrUnregisteredDataProvider = "linked account not found in account store"
-	ErrDoesNotExist             = "no chainlink account associated with this cosmos address"
-	ErrSubmitterDoesNotMatch    = "submitter address does not match"
+	ErrFeedDoesNotExist             = "feed does not exist"
+	ErrSignerIsNotFeedOwner         = "account %s (%s) is not a feed owner"
+	ErrSignerIsNotFeedOrModuleOwner = "account %s (%s) is neither the feed owner nor a module owner"
+	ErrFeedAlreadyPaused            = "feed already paused"
+	ErrFeedNotPaused                = "feed is not paused"
+	ErrFeedAlreadyDeprecated        = "feed already deprecated"
+	ErrFeedNotDeprecated            = "feed must be deprecated before being deleted"
+	ErrTooManyDataProviders         = "a feed can not have more than %d data providers"
+	ErrGovernanceOnly               = "%T requires a governance proposal in governance only mode"
+	ErrStaleReportEpochAndRound     = "report epoch %d round %d is not newer than the latest accepted one of feed %s"
+	ErrReportConfigDigest           = "report config digest %x, active config digest %x"
+	ErrNotConfigSigner              = "chainlink key of data provider %s is not the signing key of the observer %d in the active OCR config"
+	ErrAnswerBoundsNotNumeric       = "answer bounds do not apply to %s feeds"
+	ErrAccountAlreadyExists         = "there is already a chainlink account associated with this cosmos address"
+	ErrUnregisteredDataProvider     = "linked account not found in account store"
+	ErrDoesNotExist                 = "no chainlink account associated with this cosmos address"
+	ErrSubmitterDoesNotMatch        = "submitter address does not match"
+
+	ErrChainlinkKeyAlreadyRegistered = "chainlink key already registered under %s"
+
+	ErrInvalidChainlinkPubKey      = "invalid chainlink pubKey in account store"
+	ErrInvalidObservationSignature = "invalid observation signature"
+	ErrObservationSignerMismatch   = "observation signed by %s, expected chainlink signer %s"
+	ErrDuplicateObservationSigner  = "data provider %s signed more than one observation"
+	ErrDuplicateChainlinkSigner    = "chainlink signer %s signed more than one observation"
+	ErrObservationDataMismatch     = "observation data %d does not match the report observation"
+	ErrNotReportObserver           = "data provider %s is not the observer %d of the report"
 )
 
 func NewAnteHandler(
@@ -75,6 +96,18 @@ func (mod ModuleOwnerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid Msg: empty Msg: %T", tx)
 	}
 
+	// in governance only mode, feeds and module owners are added or removed by x/gov proposals only, a module ownership
+	// transfer adds and removes a module owner so it can neither be proposed nor accepted, a pending one can be cancelled
+	if mod.chainLinkKeeper.GetParams(ctx).GovernanceOnly {
+		for _, msg := range tx.GetMsgs() {
+			switch msg.(type) {
+			case *types.MsgModuleOwner, *types.MsgFeed, *types.MsgRemoveModuleOwner, *types.MsgApproveOwnerProposal,
+				*types.MsgModuleOwnershipTransfer, *types.MsgAcceptModuleOwnership:
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrGovernanceOnly, msg)
+			}
+		}
+	}
+
 	existingModuleOwnerList, err := mod.chainLinkKeeper.GetAllModuleOwner(sdk.WrapSDKContext(ctx), nil)
 	if err != nil {
 		return ctx, sdkerrors.Wrapf(sdkerrors.ErrLogic, "module owner check failed at anteHandler[ModuleOwnerDecorator]")
@@ -100,11 +133,31 @@ func (mod ModuleOwnerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid Tx: empty signer: %T", t)
 			}
 			signers = append(signers, t.GetSigners()[0])
+		case *types.MsgRemoveModuleOwner:
+			if len(t.GetSigners()) == 0 {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid Tx: empty signer: %T", t)
+			}
+			signers = append(signers, t.GetSigners()[0])
+		case *types.MsgApproveOwnerProposal:
+			if len(t.GetSigners()) == 0 {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid Tx: empty signer: %T", t)
+			}
+			signers = append(signers, t.GetSigners()[0])
+		case *types.MsgCancelOwnerProposal:
+			if len(t.GetSigners()) == 0 {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid Tx: empty signer: %T", t)
+			}
+			signers = append(signers, t.GetSigners()[0])
 		case *types.MsgFeed:
 			if len(t.GetSigners()) == 0 {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid Tx: empty signer: %T", t)
 			}
 			signers = append(signers, t.GetSigners()[0])
+		case *types.MsgDeleteFeed:
+			if len(t.GetSigners()) == 0 {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "invalid Tx: empty signer: %T", t)
+			}
+			signers = append(signers, t.GetSigners()[0])
 		default:
 			continue
 		}
@@ -137,13 +190,7 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	for _, msg := range tx.GetMsgs() {
 		switch t := msg.(type) {
 		case *types.MsgFeed:
-			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
-			if !feed.Feed.Empty() {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "feed already exists")
-			}
-			// check reward schema strategy
-			err := feedRewardSchemaStrategyChecker(t.GetFeedReward().GetStrategy())
-			if err != nil {
+			if err := fd.chainLinkKeeper.ValidateNewFeed(ctx, t); err != nil {
 				return ctx, err
 			}
 		case *types.MsgAddDataProvider:
@@ -151,9 +198,15 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
 			if (types.DataProviders)(feed.GetFeed().GetDataProviders()).Contains(t.GetDataProvider().GetAddress()) {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "data provider already registered")
 			}
+			if maxDataProviders := fd.chainLinkKeeper.GetParams(ctx).MaxDataProviders; len(feed.GetFeed().GetDataProviders()) >= int(maxDataProviders) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrTooManyDataProviders, maxDataProviders)
+			}
 			signer := t.GetSigners()[0]
 			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
@@ -163,6 +216,9 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
 			if !(types.DataProviders)(feed.GetFeed().GetDataProviders()).Contains(t.GetAddress()) {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "data provider not present")
 			}
@@ -170,11 +226,44 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
 			}
+		case *types.MsgAddTransmitter:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			if (types.Transmitters)(feed.GetFeed().GetTransmitters()).Contains(t.GetAddress()) {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "transmitter already registered")
+			}
+			signer := t.GetSigners()[0]
+			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
+			}
+		case *types.MsgRemoveTransmitter:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			if !(types.Transmitters)(feed.GetFeed().GetTransmitters()).Contains(t.GetAddress()) {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "transmitter not present")
+			}
+			signer := t.GetSigners()[0]
+			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
+			}
 		case *types.MsgSetSubmissionCount:
 			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
 			signer := t.GetSigners()[0]
 			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
@@ -184,6 +273,9 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
 			signer := t.GetSigners()[0]
 			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
@@ -193,6 +285,9 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
 			signer := t.GetSigners()[0]
 			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
@@ -202,6 +297,9 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
 			signer := t.GetSigners()[0]
 			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
@@ -211,7 +309,133 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if err != nil {
 				return ctx, err
 			}
+		case *types.MsgSetAnswerBounds:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			if t.GetAnswerBounds() != nil && !feed.GetFeed().GetValueSchema().IsNumeric() {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, ErrAnswerBoundsNotNumeric, feed.GetFeed().GetValueSchema().ValueType())
+			}
+			signer := t.GetSigners()[0]
+			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
+			}
+		case *types.MsgSetOCRConfig:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			signer := t.GetSigners()[0]
+			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
+			}
+		case *types.MsgSetFeedMetadata:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			signer := t.GetSigners()[0]
+			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
+			}
 		case *types.MsgFeedOwnershipTransfer:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			signer := t.GetSigners()[0]
+			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
+			}
+		case *types.MsgAcceptFeedOwnership:
+			// only the new feed owner of the pending transfer can accept it, which the keeper checks
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+		case *types.MsgCancelFeedOwnershipTransfer:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+		case *types.MsgPauseFeed:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			if feed.GetFeed().GetPaused() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrFeedAlreadyPaused)
+			}
+			if err := fd.feedOrModuleOwnerCheck(ctx, feed.GetFeed(), t.GetSigners()[0]); err != nil {
+				return ctx, err
+			}
+		case *types.MsgUnpauseFeed:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			if !feed.GetFeed().GetPaused() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrFeedNotPaused)
+			}
+			if err := fd.feedOrModuleOwnerCheck(ctx, feed.GetFeed(), t.GetSigners()[0]); err != nil {
+				return ctx, err
+			}
+		case *types.MsgDeprecateFeed:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrFeedAlreadyDeprecated)
+			}
+			if err := fd.feedOrModuleOwnerCheck(ctx, feed.GetFeed(), t.GetSigners()[0]); err != nil {
+				return ctx, err
+			}
+		case *types.MsgDeleteFeed:
+			// the signer is checked to be a module owner by ModuleOwnerDecorator
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if !feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, ErrFeedNotDeprecated)
+			}
+		case *types.MsgFundFeed:
+			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
+			if feed.Feed.Empty() {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
+			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			signer := t.GetSigners()[0]
+			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
+			}
+		case *types.MsgWithdrawFeedFunds:
+			// the escrow of a deprecated feed can still be withdrawn
 			feed := fd.chainLinkKeeper.GetFeed(ctx, t.GetFeedId())
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
@@ -225,6 +449,12 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, ErrFeedDoesNotExist)
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			if feed.GetFeed().GetPaused() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedPaused, t.GetFeedId())
+			}
 			signer := t.GetSigners()[0]
 			if !feed.GetFeed().GetFeedOwner().Equals(signer) {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOwner, common.BytesToAddress(signer.Bytes()), signer)
@@ -237,6 +467,18 @@ func (fd FeedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	return next(ctx, tx, simulate)
 }
 
+// feedOrModuleOwnerCheck checks the signer is either the owner of the feed or a module owner
+func (fd FeedDecorator) feedOrModuleOwnerCheck(ctx sdk.Context, feed *types.MsgFeed, signer sdk.AccAddress) error {
+	if feed.GetFeedOwner().Equals(signer) {
+		return nil
+	}
+	moduleOwners := fd.chainLinkKeeper.GetModuleOwnerList(ctx).GetModuleOwner()
+	if (types.MsgModuleOwners)(moduleOwners).Contains(signer) {
+		return nil
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrSignerIsNotFeedOrModuleOwner, common.BytesToAddress(signer.Bytes()), signer)
+}
+
 type FeedDataDecorator struct {
 	chainLinkKeeper chainlinkkeeper.Keeper
 }
@@ -264,10 +506,11 @@ func (fd FeedDataDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 			if len(txFee) == 0 {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "empty tx fee coin slices")
 			}
-			// get the first coin from txFee as the tx fee charged for MsgFeedData tx
+			// only the part of the tx fee paid in the reward denom can be reimbursed out of the feed escrow
+			rewardDenom := fd.chainLinkKeeper.GetParams(ctx).RewardDenom
 			t.TxFee = &types.Coin{
-				Denom:  txFee[0].Denom,
-				Amount: txFee[0].Amount.Uint64(),
+				Denom:  rewardDenom,
+				Amount: txFee.AmountOf(rewardDenom).Uint64(),
 			}
 
 			// get feed by feedId
@@ -275,33 +518,80 @@ func (fd FeedDataDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 			if feed.Feed.Empty() {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "feed not exist")
 			}
+			if feed.GetFeed().GetDeprecated() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedDeprecated, t.GetFeedId())
+			}
+			if feed.GetFeed().GetPaused() {
+				return ctx, sdkerrors.Wrap(types.ErrFeedPaused, t.GetFeedId())
+			}
 
-			// basic checking
-			if !(types.DataProviders)(feed.GetFeed().GetDataProviders()).Contains(t.GetSubmitter()) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "submitter is not a valid data provider")
+			report, err := types.DecodeFeedReport(t.GetReport(), feed.GetFeed().GetValueSchema())
+			if err != nil {
+				return ctx, err
 			}
-			if uint32(len(t.GetObservationFeedDataSignatures())) < feed.GetFeed().GetSubmissionCount() {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not enough signatures")
+
+			// once the feed got an OCR config, the report must be made under the active config
+			config := fd.chainLinkKeeper.GetOCRConfig(ctx, t.GetFeedId())
+			if config != nil && !bytes.Equal(report.ConfigDigest(), config.GetConfigDigest()) {
+				return ctx, sdkerrors.Wrapf(types.ErrConfigDigestMismatch, ErrReportConfigDigest, report.ConfigDigest(), config.GetConfigDigest())
 			}
 
-			// observation signatures VS original observation data
-			if len(t.GetObservationFeedData()) != len(t.GetObservationFeedDataSignatures()) {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of observation signatures and observation data does not match")
+			// replayed or older reports are rejected, the report epoch and round must move forward
+			if latest := fd.chainLinkKeeper.GetLatestEpochAndRound(ctx, t.GetFeedId()); report.EpochAndRound() <= latest {
+				return ctx, sdkerrors.Wrapf(types.ErrStaleReport, ErrStaleReportEpochAndRound, report.Epoch(), report.Round(), t.GetFeedId())
 			}
-			feedDataValidationFlag := len(t.GetObservationFeedData())
-			for _, observation := range t.GetObservationFeedData() {
-				for _, observationSignature := range t.GetObservationFeedDataSignatures() {
-					if signaturePlainDataValidate(observationSignature, observation) {
-						feedDataValidationFlag--
-						break
+
+			// out of bounds answers are rejected unless the feed flags them
+			if answerBounds := feed.GetFeed().GetAnswerBounds(); answerBounds != nil && !answerBounds.FlagsOutOfBounds() {
+				if err := answerBounds.Check(report.Median()); err != nil {
+					return ctx, err
+				}
+			}
+
+			// basic checking, the active OCR config replaces the data providers and the submission count of the feed
+			if config != nil {
+				if !config.HasTransmitter(t.GetSubmitter()) {
+					return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "submitter is not a transmitter of the feed")
+				}
+				if uint32(len(t.GetObservationFeedDataSignatures())) <= config.GetF() {
+					return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not enough signatures")
+				}
+				for _, oracle := range report.GetOracles() {
+					if _, err := config.SignerAddress(oracle); err != nil {
+						return ctx, err
+					}
+				}
+			} else {
+				if !feed.GetFeed().IsTransmitter(t.GetSubmitter()) {
+					return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "submitter is not a transmitter of the feed")
+				}
+				if uint32(len(t.GetObservationFeedDataSignatures())) < feed.GetFeed().GetSubmissionCount() {
+					return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "not enough signatures")
+				}
+				// without OCR config, the observer index is the index of the data provider in the feed
+				for _, oracle := range report.GetOracles() {
+					if int(oracle) >= len(feed.GetFeed().GetDataProviders()) {
+						return ctx, sdkerrors.Wrapf(types.ErrInvalidOCRReport, "observer index %d out of the feed data providers", oracle)
 					}
 				}
 			}
-			if feedDataValidationFlag != 0 {
-				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "observation signatures validation against observation data failed")
+
+			// every observer of the report signs it: signature i is the signature of the report by the observer of
+			// observation i, data provider i, and observation data i is observation i of the report
+			if len(t.GetObservationFeedDataSignatures()) != len(report.GetObservations()) {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of observation signatures and report observations does not match")
+			}
+			if len(t.GetObservationFeedData()) != len(t.GetObservationFeedDataSignatures()) {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of observation signatures and observation data does not match")
+			}
+			if len(t.GetObservationFeedData()) != len(t.GetCosmosPubKeys()) {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of data provider pubKeys and observation data does not match")
 			}
 
-			for _, pubKey := range t.GetCosmosPubKeys() {
+			signingHash := types.ReportSigningHash(t.GetFeedId(), t.GetReport())
+			signers := make(map[string]bool, len(t.GetCosmosPubKeys()))
+			chainlinkSigners := make(map[common.Address]bool, len(t.GetCosmosPubKeys()))
+			for i, pubKey := range t.GetCosmosPubKeys() {
 				cosmosAddr, err := types.DeriveCosmosAddrFromPubKey(string(pubKey))
 				if err != nil {
 					return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid data provider: invalid cosmos pubkey")
@@ -312,26 +602,49 @@ func (fd FeedDataDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 					return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid data provider: failed to derive cosmos address, invalid cosmos pubkey")
 				}
 
-				// valid data provider checking
-				if !(types.DataProviders)(feed.GetFeed().GetDataProviders()).Contains(dataProviderAddr) {
-					return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "invalid data provider: data provider not in the list")
+				if !bytes.Equal(t.GetObservationFeedData()[i], report.GetObservations()[i].GetData()) {
+					return ctx, sdkerrors.Wrapf(types.ErrInvalidOCRReport, ErrObservationDataMismatch, i)
+				}
+
+				// valid data provider checking, the signers of the active OCR config are checked against their chainlink key below
+				observer := report.GetOracles()[i]
+				if config == nil && !feed.GetFeed().GetDataProviders()[observer].GetAddress().Equals(dataProviderAddr) {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrNotReportObserver, dataProviderAddr, observer)
 				}
 
+				// one data provider can only sign one observation per report
+				if signers[dataProviderAddr.String()] {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrDuplicateObservationSigner, dataProviderAddr)
+				}
+				signers[dataProviderAddr.String()] = true
+
 				resp := fd.chainLinkKeeper.GetAccount(ctx, &types.GetAccountRequest{AccountAddress: dataProviderAddr})
 				if resp.GetAccount().GetSubmitter().String() == "" {
 					return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, ErrUnregisteredDataProvider)
 				}
+				chainlinkSigner, err := types.ChainlinkPubKeyToAddress(resp.GetAccount().GetChainlinkPublicKey())
+				if err != nil {
+					return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, ErrInvalidChainlinkPubKey)
+				}
 
-				// chainlink pubKey VS observation signature validation
-				chainlinkPubKeyValidationFlag := false
-				for _, signature := range t.GetObservationFeedDataSignatures() {
-					if pubKeySignatureValidate(resp.GetAccount().GetChainlinkPublicKey(), signature) {
-						chainlinkPubKeyValidationFlag = true
-						break
+				// with an OCR config, the chainlink key of data provider i must be the signing key of the observer of observation i
+				if config != nil {
+					configSigner, err := config.SignerAddress(observer)
+					if err != nil || configSigner != chainlinkSigner {
+						return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrNotConfigSigner, dataProviderAddr, observer)
 					}
 				}
-				if !chainlinkPubKeyValidationFlag {
-					return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "observation signature validation against chainlink pubKey failed")
+
+				// the same chainlink key registered in several encodings can not sign for several observers
+				if chainlinkSigners[chainlinkSigner] {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrDuplicateChainlinkSigner, chainlinkSigner)
+				}
+				chainlinkSigners[chainlinkSigner] = true
+
+				// chainlink pubKey VS report signature validation
+				err = reportSignatureValidate(chainlinkSigner, t.GetObservationFeedDataSignatures()[i], signingHash)
+				if err != nil {
+					return ctx, sdkerrors.Wrapf(err, "observation %d of data provider %s", i, dataProviderAddr)
 				}
 			}
 
@@ -394,6 +707,12 @@ func (fd AccountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 			if resp.Account.Submitter.String() != "" {
 				return ctx, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, ErrAccountAlreadyExists)
 			}
+			// a chainlink key can only be registered under a single cosmos address
+			for _, chainlinkKey := range [][]byte{t.GetChainlinkPublicKey(), t.GetChainlinkSigningKey()} {
+				if owner := fd.chainLinkKeeper.GetAccountAddressByChainlinkKey(ctx, chainlinkKey); owner != nil {
+					return ctx, sdkerrors.Wrapf(types.ErrChainlinkKeyRegistered, ErrChainlinkKeyAlreadyRegistered, owner)
+				}
+			}
 		// case to edit an existing chainlink account in the Account Store
 		case *types.MsgEditAccount:
 			req := &types.GetAccountRequest{AccountAddress: t.Submitter}
@@ -0,0 +1,192 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package ante
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModuleOwnerDecorator_GovernanceOnly(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	decorator := NewModuleOwnerDecorator(k)
+
+	moduleOwner, newModuleOwner := GenerateAccount(), GenerateAccount()
+	k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: moduleOwner})
+
+	transfer := types.NewMsgModuleOwnershipTransfer(moduleOwner, newModuleOwner, nil)
+	accept := types.NewMsgAcceptModuleOwnership(newModuleOwner, moduleOwner)
+	cancel := types.NewMsgCancelModuleOwnershipTransfer(moduleOwner, moduleOwner)
+
+	for _, msg := range []sdk.Msg{transfer, accept, cancel} {
+		_, err := decorator.AnteHandle(ctx, newTestTx(nil, msg), false, nextAnteHandler)
+		require.NoError(t, err, "%T", msg)
+	}
+
+	params := k.GetParams(ctx)
+	params.GovernanceOnly = true
+	k.SetParams(ctx, params)
+
+	// a module ownership transfer adds and removes a module owner, it is left to the governance proposals
+	for _, msg := range []sdk.Msg{transfer, accept} {
+		_, err := decorator.AnteHandle(ctx, newTestTx(nil, msg), false, nextAnteHandler)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized, "%T", msg)
+	}
+
+	// a pending module ownership transfer can still be cancelled
+	_, err := decorator.AnteHandle(ctx, newTestTx(nil, cancel), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func TestFeedDataDecorator_TxFee(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	decorator := NewFeedDataDecorator(k)
+
+	// only the part of the fee paid in the reward denom is recorded for the reimbursement
+	msg := &types.MsgFeedData{FeedId: "feed1"}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), types.NewLinkCoinInt64(3))
+	_, err := decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.Equal(t, &types.Coin{Denom: types.LinkDenom, Amount: 3}, msg.GetTxFee())
+
+	msg = &types.MsgFeedData{FeedId: "feed1"}
+	_, err = decorator.AnteHandle(ctx, newTestTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.Equal(t, &types.Coin{Denom: types.LinkDenom, Amount: 0}, msg.GetTxFee())
+}
+
+func TestFeedDataDecorator_ReportSignatures(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	decorator := NewFeedDataDecorator(k)
+	fee := sdk.NewCoins(types.NewLinkCoinInt64(3))
+
+	oracles := []testOracle{newTestOracle(t, k, ctx), newTestOracle(t, k, ctx), newTestOracle(t, k, ctx)}
+	feed := &types.MsgFeed{FeedId: "feed1", FeedOwner: GenerateAccount(), SubmissionCount: 2}
+	for _, o := range oracles {
+		feed.DataProviders = append(feed.DataProviders, &types.DataProvider{Address: o.address, PubKey: o.cosmosPubKey})
+	}
+	k.SetFeed(ctx, feed)
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed2", FeedOwner: GenerateAccount(), DataProviders: feed.GetDataProviders(), SubmissionCount: 2})
+
+	// observation 0 is made by the data provider 2 of the feed, observation 1 by the data provider 0
+	transmitter := oracles[0].address
+	report := generateReport(t, nil, 1, []byte{2, 0}, 10, 20)
+
+	msg := signedFeedData(t, "feed1", transmitter, report, oracles[2], oracles[0])
+	_, err := decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// the signature of a data provider is bound to its observer index
+	msg = signedFeedData(t, "feed1", transmitter, report, oracles[0], oracles[2])
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the observation data must be the observations of the report
+	msg = signedFeedData(t, "feed1", transmitter, report, oracles[2], oracles[0])
+	msg.ObservationFeedData[1] = []byte("feedData")
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, types.ErrInvalidOCRReport)
+
+	// every observer of the report signs it
+	msg = signedFeedData(t, "feed1", transmitter, report, oracles[2], oracles[0])
+	msg.ObservationFeedData = msg.ObservationFeedData[:1]
+	msg.ObservationFeedDataSignatures = msg.ObservationFeedDataSignatures[:1]
+	msg.CosmosPubKeys = msg.CosmosPubKeys[:1]
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// an observer index out of the feed data providers is rejected
+	msg = signedFeedData(t, "feed1", transmitter, generateReport(t, nil, 1, []byte{0, 3}, 10, 20), oracles[0], oracles[1])
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, types.ErrInvalidOCRReport)
+
+	// the signatures of a report can not be attached to other report values
+	signatures := [][]byte{oracles[2].sign(t, "feed1", report), oracles[0].sign(t, "feed1", report)}
+	msg = signedFeedData(t, "feed1", transmitter, generateReport(t, nil, 1, []byte{2, 0}, 10, 1000), oracles[2], oracles[0])
+	msg.ObservationFeedDataSignatures = signatures
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// nor replayed in another round
+	msg = signedFeedData(t, "feed1", transmitter, generateReport(t, nil, 2, []byte{2, 0}, 10, 20), oracles[2], oracles[0])
+	msg.ObservationFeedDataSignatures = signatures
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// nor on another feed
+	msg = signedFeedData(t, "feed2", transmitter, report, oracles[2], oracles[0])
+	msg.ObservationFeedDataSignatures = signatures
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestFeedDataDecorator_OCRConfigSigners(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	decorator := NewFeedDataDecorator(k)
+	fee := sdk.NewCoins(types.NewLinkCoinInt64(3))
+
+	oracles := []testOracle{newTestOracle(t, k, ctx), newTestOracle(t, k, ctx), newTestOracle(t, k, ctx), newTestOracle(t, k, ctx)}
+	outsider := newTestOracle(t, k, ctx)
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: GenerateAccount()})
+
+	// the signing keys of the config are not encoded like the chainlink keys of the account store
+	transmitter := GenerateAccount()
+	setConfig := &types.MsgSetOCRConfig{FeedId: "feed1", F: 1}
+	for _, o := range oracles {
+		setConfig.SigningKeys = append(setConfig.SigningKeys, []byte("0x"+hex.EncodeToString(crypto.FromECDSAPub(&o.signingKey.PublicKey))))
+		setConfig.Transmitters = append(setConfig.Transmitters, GenerateAccount())
+	}
+	setConfig.Transmitters[0] = transmitter
+	_, _, err := k.SetOCRConfig(ctx, setConfig)
+	require.NoError(t, err)
+	digest := k.GetOCRConfig(ctx, "feed1").GetConfigDigest()
+
+	// observation 0 is made by the oracle 3 of the config, observation 1 by the oracle 1
+	report := generateReport(t, digest, 1, []byte{3, 1}, 10, 20)
+	msg := signedFeedData(t, "feed1", transmitter, report, oracles[3], oracles[1])
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// a signer of the config can not sign for the observer index of another signer
+	msg = signedFeedData(t, "feed1", transmitter, report, oracles[1], oracles[3])
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = signedFeedData(t, "feed1", transmitter, report, oracles[3], oracles[0])
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a data provider out of the config can not sign
+	msg = signedFeedData(t, "feed1", transmitter, report, oracles[3], outsider)
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// an observer index out of the config signers is rejected
+	msg = signedFeedData(t, "feed1", transmitter, generateReport(t, digest, 1, []byte{3, 4}, 10, 20), oracles[3], outsider)
+	_, err = decorator.AnteHandle(ctx, newTestTx(fee, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, types.ErrInvalidOCRReport)
+}
+
+func TestFeedDecorator_AcceptFeedOwnership_Deprecated(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	decorator := NewFeedDecorator(k)
+
+	feedOwner, newFeedOwner := GenerateAccount(), GenerateAccount()
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner})
+
+	msg := types.NewMsgAcceptFeedOwnership(newFeedOwner, "feed1")
+	_, err := decorator.AnteHandle(ctx, newTestTx(nil, msg), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	// the ownership of a deprecated feed can not change hands
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner, Deprecated: true})
+	_, err = decorator.AnteHandle(ctx, newTestTx(nil, msg), false, nextAnteHandler)
+	require.ErrorIs(t, err, types.ErrFeedDeprecated)
+}
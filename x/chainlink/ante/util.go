@@ -4,8 +4,12 @@
 package ante
 
 import (
+	"bytes"
+
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 func feedRewardSchemaStrategyChecker(strategy string) error {
@@ -19,12 +23,37 @@ func feedRewardSchemaStrategyChecker(strategy string) error {
 	return nil
 }
 
-// TODO: chainlink pubKey against observation signature, replace with validation logic here with chainlink key algo
-func pubKeySignatureValidate(chainlinkPubKey, signature []byte) bool {
-	return true
+// recoverReportSigner recovers the address of the secp256k1 key that signed the report signing hash.
+// The signature is expected in the [R || S || V] format, V being either 0/1 or 27/28.
+func recoverReportSigner(signature, signingHash []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid signature length %d, expected %d", len(signature), crypto.SignatureLength)
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(signingHash, sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pubKey), nil
 }
 
-// TODO: observation data against observation signature, replace with validation logic here with chainlink key algo
-func signaturePlainDataValidate(signature, data []byte) bool {
-	return true
+// reportSignatureValidate checks that signature is the signature of the report signing hash made by the key of the expected address
+func reportSignatureValidate(expected common.Address, signature, signingHash []byte) error {
+	signer, err := recoverReportSigner(signature, signingHash)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, ErrInvalidObservationSignature)
+	}
+
+	if !bytes.Equal(expected.Bytes(), signer.Bytes()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, ErrObservationSignerMismatch, signer, expected)
+	}
+
+	return nil
 }
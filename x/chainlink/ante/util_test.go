@@ -0,0 +1,144 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package ante
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	chainlinkkeeper "github.com/ChainSafe/chainlink-cosmos/x/chainlink/keeper"
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+func setupKeeper(t testing.TB) (chainlinkkeeper.Keeper, sdk.Context) {
+	feedDataStoreKey := sdk.NewKVStoreKey(types.FeedDataStoreKey)
+	roundStoreKey := sdk.NewKVStoreKey(types.RoundStoreKey)
+	moduleOwnerStoreKey := sdk.NewKVStoreKey(types.ModuleOwnerStoreKey)
+	feedInfoStoreKey := sdk.NewKVStoreKey(types.FeedInfoStoreKey)
+	accountStoreKey := sdk.NewKVStoreKey(types.AccountStoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(feedDataStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(roundStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(moduleOwnerStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(feedInfoStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(accountStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	paramSpace := paramtypes.NewSubspace(codec.NewProtoCodec(registry), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName)
+	keeper := chainlinkkeeper.NewKeeper(codec.NewProtoCodec(registry), nil, paramSpace, feedDataStoreKey, roundStoreKey, moduleOwnerStoreKey, feedInfoStoreKey, accountStoreKey, memStoreKey)
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{Height: 1}, false, log.NewNopLogger())
+	return *keeper, ctx
+}
+
+func GenerateAccount() sdk.AccAddress {
+	_, _, addr := testdata.KeyTestPubAddr()
+	return addr
+}
+
+// testTx is the minimal sdk.FeeTx the decorators are run against
+type testTx struct {
+	msgs []sdk.Msg
+	fee  sdk.Coins
+}
+
+func newTestTx(fee sdk.Coins, msgs ...sdk.Msg) testTx {
+	return testTx{msgs: msgs, fee: fee}
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg         { return tx.msgs }
+func (tx testTx) ValidateBasic() error       { return nil }
+func (tx testTx) GetGas() uint64             { return 0 }
+func (tx testTx) GetFee() sdk.Coins          { return tx.fee }
+func (tx testTx) FeePayer() sdk.AccAddress   { return nil }
+func (tx testTx) FeeGranter() sdk.AccAddress { return nil }
+
+// nextAnteHandler is the end of the decorator chain under test
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+// testOracle is a data provider with a chainlink account registered in the account store
+type testOracle struct {
+	address      sdk.AccAddress
+	cosmosPubKey []byte
+	signingKey   *ecdsa.PrivateKey
+}
+
+// newTestOracle registers the chainlink account of a new data provider, its chainlink public key is the hex encoded
+// compressed public key of its signing key
+func newTestOracle(t testing.TB, k chainlinkkeeper.Keeper, ctx sdk.Context) testOracle {
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	cosmosPubKey, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey)
+	require.NoError(t, err)
+
+	signingKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	_, _, err = k.AddAccount(ctx, &types.MsgAccount{
+		Submitter:           addr,
+		ChainlinkPublicKey:  []byte(hex.EncodeToString(crypto.CompressPubkey(&signingKey.PublicKey))),
+		ChainlinkSigningKey: []byte(hex.EncodeToString(crypto.FromECDSA(signingKey))),
+	})
+	require.NoError(t, err)
+
+	return testOracle{address: addr, cosmosPubKey: []byte(cosmosPubKey), signingKey: signingKey}
+}
+
+// sign returns the signature of the report of the feed by the oracle
+func (o testOracle) sign(t testing.TB, feedId string, report []byte) []byte {
+	signature, err := crypto.Sign(types.ReportSigningHash(feedId, report), o.signingKey)
+	require.NoError(t, err)
+	return signature
+}
+
+// signedFeedData returns the MsgFeedData of the report of the feed signed by the oracles, oracle i being the observer
+// of observation i of the report
+func signedFeedData(t testing.TB, feedId string, submitter sdk.AccAddress, report []byte, oracles ...testOracle) *types.MsgFeedData {
+	decoded, err := types.DecodeOCRReport(report)
+	require.NoError(t, err)
+
+	msg := &types.MsgFeedData{FeedId: feedId, Submitter: submitter, Report: report}
+	for i, o := range oracles {
+		msg.ObservationFeedData = append(msg.ObservationFeedData, decoded.GetObservations()[i].GetData())
+		msg.ObservationFeedDataSignatures = append(msg.ObservationFeedDataSignatures, o.sign(t, feedId, report))
+		msg.CosmosPubKeys = append(msg.CosmosPubKeys, o.cosmosPubKey)
+	}
+	return msg
+}
+
+// generateReport ABI encodes an OCR report of the given sorted observations made by the given observers
+func generateReport(t testing.TB, configDigest []byte, epoch uint32, observers []byte, observations ...int64) []byte {
+	values := make([]*big.Int, 0, len(observations))
+	for _, o := range observations {
+		values = append(values, big.NewInt(o))
+	}
+
+	report, err := types.EncodeOCRReport(types.NewOCRReportContext(configDigest, epoch, 1), observers, values)
+	require.NoError(t, err)
+	return report
+}
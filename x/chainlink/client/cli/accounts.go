@@ -133,3 +133,65 @@ func CmdGetAccountInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-accounts",
+		Short: "Lists the registered Chainlink accounts.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.ListAccountsRequest{Pagination: pageReq}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ListAccounts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accounts")
+
+	return cmd
+}
+
+func CmdGetAccountByChainlinkKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-account-by-chainlink-key <chainlink_oracle_public_or_signing_key>",
+		Short: "Gets the Chainlink account a Chainlink public or signing key is registered under.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.GetAccountByChainlinkKeyRequest{ChainlinkKey: []byte(args[0])}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetAccountByChainlinkKey(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
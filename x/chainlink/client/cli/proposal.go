@@ -0,0 +1,152 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+// CmdSubmitAddFeedProposal is the `tx gov submit-proposal add-feed` command
+func CmdSubmitAddFeedProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-feed [feed-file]",
+		Short: "Submit a proposal adding a chainlink feed",
+		Long: "Submit a proposal adding the feed of the JSON file, encoded like the MsgFeed of the add-feed tx, along with an initial deposit. " +
+			"The gov module account is the module owner of the feed.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var feed types.MsgFeed
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(bz, &feed); err != nil {
+				return err
+			}
+
+			title, description, err := proposalTitleAndDescription(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, types.NewAddFeedProposal(title, description, &feed))
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// CmdSubmitAddModuleOwnerProposal is the `tx gov submit-proposal add-module-owner` command
+func CmdSubmitAddModuleOwnerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-module-owner [address] [publicKey]",
+		Short: "Submit a proposal adding a chainlink module owner",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, description, err := proposalTitleAndDescription(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, types.NewAddModuleOwnerProposal(title, description, addr, []byte(args[1])))
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+// CmdSubmitRemoveModuleOwnerProposal is the `tx gov submit-proposal remove-module-owner` command
+func CmdSubmitRemoveModuleOwnerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-module-owner [address]",
+		Short: "Submit a proposal removing a chainlink module owner, the last module owner can not be removed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			title, description, err := proposalTitleAndDescription(cmd)
+			if err != nil {
+				return err
+			}
+
+			return submitProposal(cmd, clientCtx, types.NewRemoveModuleOwnerProposal(title, description, addr))
+		},
+	}
+
+	addProposalFlags(cmd)
+	return cmd
+}
+
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+}
+
+func proposalTitleAndDescription(cmd *cobra.Command) (string, string, error) {
+	title, err := cmd.Flags().GetString(govcli.FlagTitle)
+	if err != nil {
+		return "", "", err
+	}
+	description, err := cmd.Flags().GetString(govcli.FlagDescription)
+	if err != nil {
+		return "", "", err
+	}
+	return title, description, nil
+}
+
+// submitProposal broadcasts the proposal with the deposit of the deposit flag
+func submitProposal(cmd *cobra.Command, clientCtx client.Context, content govtypes.Content) error {
+	depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+	if err != nil {
+		return err
+	}
+	deposit, err := sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		return err
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
@@ -22,11 +22,23 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdGetFeedDataByRound())
+	cmd.AddCommand(CmdGetRoundHistory())
 	cmd.AddCommand(CmdGetLatestFeedData())
 	cmd.AddCommand(CmdGetModuleOwnerList())
+	cmd.AddCommand(CmdListOwnerProposals())
+	cmd.AddCommand(CmdListModuleOwnershipTransfers())
 	cmd.AddCommand(CmdGetFeedInfo())
+	cmd.AddCommand(CmdGetFeedMetadata())
+	cmd.AddCommand(CmdGetFeedOwnershipTransfer())
+	cmd.AddCommand(CmdLatestConfigDetails())
+	cmd.AddCommand(CmdGetFeedEscrowBalance())
+	cmd.AddCommand(CmdListOwedPayments())
+	cmd.AddCommand(CmdListFeeds())
 	cmd.AddCommand(CmdGetAccountInfo())
+	cmd.AddCommand(CmdListAccounts())
+	cmd.AddCommand(CmdGetAccountByChainlinkKey())
 	cmd.AddCommand(CmdGetFeedRewardAvailStrategy())
+	cmd.AddCommand(CmdGetParams())
 
 	return cmd
 }
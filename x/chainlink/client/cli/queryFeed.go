@@ -11,9 +11,25 @@ import (
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagFromRound = "from-round"
+	FlagToRound   = "to-round"
+	FlagStartTime = "start-time"
+	FlagEndTime   = "end-time"
+	FlagReverse   = "reverse"
+
+	FlagFeedOwner          = "feed-owner"
+	FlagDataProvider       = "data-provider"
+	FlagFeedRewardStrategy = "feed-reward-strategy"
+
+	FlagOracle = "oracle"
+	FlagFeedId = "feed-id"
+)
+
 func CmdGetFeedDataByRound() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get-round-feed-data [roundId] [feedId]",
@@ -66,6 +82,64 @@ func CmdGetFeedDataByRound() *cobra.Command {
 	return cmd
 }
 
+func CmdGetRoundHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-round-history [feedId]",
+		Short: "List the rounds of a feed within a round range and an optional time window.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.GetRoundHistoryRequest{
+				FeedId:     args[0],
+				Pagination: pageReq,
+			}
+			if params.FromRound, err = cmd.Flags().GetUint64(FlagFromRound); err != nil {
+				return err
+			}
+			if params.ToRound, err = cmd.Flags().GetUint64(FlagToRound); err != nil {
+				return err
+			}
+			if params.StartTime, err = cmd.Flags().GetUint64(FlagStartTime); err != nil {
+				return err
+			}
+			if params.EndTime, err = cmd.Flags().GetUint64(FlagEndTime); err != nil {
+				return err
+			}
+			if params.Reverse, err = cmd.Flags().GetBool(FlagReverse); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetRoundHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromRound, 0, "first round of the range, defaults to the first round of the feed")
+	cmd.Flags().Uint64(FlagToRound, 0, "last round of the range, defaults to the latest round of the feed")
+	cmd.Flags().Uint64(FlagStartTime, 0, "only list the rounds updated at or after this unix timestamp (seconds)")
+	cmd.Flags().Uint64(FlagEndTime, 0, "only list the rounds updated at or before this unix timestamp (seconds)")
+	cmd.Flags().Bool(FlagReverse, false, "list the rounds from the newest to the oldest")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "round history")
+	return cmd
+}
+
 func CmdGetLatestFeedData() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get-latest-feed-data [feedId]",
@@ -133,6 +207,223 @@ func CmdGetFeedInfo() *cobra.Command {
 	return cmd
 }
 
+func CmdGetFeedMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-feed-metadata [feedId]",
+		Short: "Get the decimals, description, version and metadata of a feed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.GetFeedMetadataRequest{FeedId: args[0]}
+
+			res, err := queryClient.GetFeedMetadata(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetFeedOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-feed-ownership-transfer [feedId]",
+		Short: "Get the ownership transfer of a feed pending the acceptance of the new feed owner",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.GetFeedOwnershipTransferRequest{FeedId: args[0]}
+
+			res, err := queryClient.GetFeedOwnershipTransfer(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdLatestConfigDetails() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-config-details [feedId]",
+		Short: "Get the config count, block number and config digest of the active OCR config of a feed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.LatestConfigDetailsRequest{FeedId: args[0]}
+
+			res, err := queryClient.LatestConfigDetails(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetFeedEscrowBalance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-feed-escrow-balance [feedId]",
+		Short: "Get the amount of link escrowed to reward the rounds of a feed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.GetFeedEscrowBalanceRequest{FeedId: args[0]}
+
+			res, err := queryClient.GetFeedEscrowBalance(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdListOwedPayments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-owed-payments",
+		Short: "List the rewards and fee reimbursements owed to the oracles, optionally filtered by oracle and feed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.ListOwedPaymentsRequest{Pagination: pageReq}
+			if params.FeedId, err = cmd.Flags().GetString(FlagFeedId); err != nil {
+				return err
+			}
+			oracle, err := cmd.Flags().GetString(FlagOracle)
+			if err != nil {
+				return err
+			}
+			if oracle != "" {
+				if params.Oracle, err = sdk.AccAddressFromBech32(oracle); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ListOwedPayments(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOracle, "", "only list the payments owed to this address")
+	cmd.Flags().String(FlagFeedId, "", "only list the payments owed by this feed")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "owed payments")
+	return cmd
+}
+
+func CmdListFeeds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-feeds",
+		Short: "List the feeds, optionally filtered by feed owner, data provider and reward strategy",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.ListFeedsRequest{Pagination: pageReq}
+			if params.FeedRewardStrategy, err = cmd.Flags().GetString(FlagFeedRewardStrategy); err != nil {
+				return err
+			}
+			for flag, addr := range map[string]*sdk.AccAddress{
+				FlagFeedOwner:    &params.FeedOwner,
+				FlagDataProvider: &params.DataProvider,
+			} {
+				bech32Addr, err := cmd.Flags().GetString(flag)
+				if err != nil {
+					return err
+				}
+				if bech32Addr == "" {
+					continue
+				}
+				if *addr, err = sdk.AccAddressFromBech32(bech32Addr); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ListFeeds(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagFeedOwner, "", "only list the feeds owned by this address")
+	cmd.Flags().String(FlagDataProvider, "", "only list the feeds this address is a data provider of")
+	cmd.Flags().String(FlagFeedRewardStrategy, "", "only list the feeds rewarded with this strategy")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "feeds")
+	return cmd
+}
+
 func CmdGetFeedRewardAvailStrategy() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get-feed-reward-avail-strategy",
@@ -160,3 +451,31 @@ func CmdGetFeedRewardAvailStrategy() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdGetParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get-params",
+		Short: "Get the chainlink module params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			clientCtx, err := client.ReadPersistentCommandFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.GetModuleParamsRequest{}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GetModuleParams(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -26,14 +26,33 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddModuleOwner())
 	cmd.AddCommand(CmdGenesisModuleOwner())
 	cmd.AddCommand(CmdTransferModuleOwnership())
+	cmd.AddCommand(CmdAcceptModuleOwnership())
+	cmd.AddCommand(CmdCancelModuleOwnershipTransfer())
+	cmd.AddCommand(CmdRemoveModuleOwner())
+	cmd.AddCommand(CmdApproveOwnerProposal())
+	cmd.AddCommand(CmdCancelOwnerProposal())
 	cmd.AddCommand(CmdAddFeed())
 	cmd.AddCommand(CmdAddDataProvider())
 	cmd.AddCommand(CmdRemoveDataProvider())
+	cmd.AddCommand(CmdAddTransmitter())
+	cmd.AddCommand(CmdRemoveTransmitter())
 	cmd.AddCommand(CmdSetSubmissionCount())
 	cmd.AddCommand(CmdSetHeartbeatTrigger())
 	cmd.AddCommand(CmdSetDeviationThreshold())
 	cmd.AddCommand(CmdSetFeedReward())
+	cmd.AddCommand(CmdSetFeedMetadata())
+	cmd.AddCommand(CmdSetAnswerBounds())
+	cmd.AddCommand(CmdSetOCRConfig())
 	cmd.AddCommand(CmdTransferFeedOwnership())
+	cmd.AddCommand(CmdAcceptFeedOwnership())
+	cmd.AddCommand(CmdCancelFeedOwnershipTransfer())
+	cmd.AddCommand(CmdPauseFeed())
+	cmd.AddCommand(CmdUnpauseFeed())
+	cmd.AddCommand(CmdDeprecateFeed())
+	cmd.AddCommand(CmdDeleteFeed())
+	cmd.AddCommand(CmdFundFeed())
+	cmd.AddCommand(CmdWithdrawFeedFunds())
+	cmd.AddCommand(CmdWithdrawPayment())
 	cmd.AddCommand(CmdRequestNewRound())
 	cmd.AddCommand(CmdAddChainlinkAccount())
 	cmd.AddCommand(CmdEditPiggyAddress())
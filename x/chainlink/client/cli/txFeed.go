@@ -4,7 +4,9 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -17,6 +19,115 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagDeviationThresholdPolicy = "deviation-threshold-policy"
+
+	FlagDecimals    = "decimals"
+	FlagFeedVersion = "feed-version"
+	FlagBaseAsset   = "base-asset"
+	FlagQuoteAsset  = "quote-asset"
+	FlagUnit        = "unit"
+
+	FlagMinAnswer        = "min-answer"
+	FlagMaxAnswer        = "max-answer"
+	FlagAnswerBoundsMode = "answer-bounds-mode"
+
+	FlagTransmitters = "transmitters"
+
+	FlagValueType = "value-type"
+	FlagTupleSize = "tuple-size"
+
+	FlagMintRewards             = "mint-rewards"
+	FlagInsufficientFundsPolicy = "insufficient-funds-policy"
+
+	FlagOnchainConfig         = "onchain-config"
+	FlagOffchainConfigVersion = "offchain-config-version"
+	FlagOffchainConfig        = "offchain-config"
+)
+
+// addFeedMetadataFlags adds the flags describing the feed metadata
+func addFeedMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagDecimals, 0, "number of decimals of the feed answers")
+	cmd.Flags().Uint64(FlagFeedVersion, 0, "version of the feed")
+	cmd.Flags().String(FlagBaseAsset, "", "asset being priced, ATOM in ATOM/USD")
+	cmd.Flags().String(FlagQuoteAsset, "", "asset the price is expressed in, USD in ATOM/USD")
+	cmd.Flags().String(FlagUnit, "", "unit of the feed answers when the feed is not a price pair")
+}
+
+// readFeedMetadataFlags reads the feed metadata from the flags added by addFeedMetadataFlags
+func readFeedMetadataFlags(cmd *cobra.Command) (*types.FeedMetadata, error) {
+	metadata := &types.FeedMetadata{}
+	var err error
+	if metadata.Decimals, err = cmd.Flags().GetUint32(FlagDecimals); err != nil {
+		return nil, err
+	}
+	if metadata.Version, err = cmd.Flags().GetUint64(FlagFeedVersion); err != nil {
+		return nil, err
+	}
+	if metadata.BaseAsset, err = cmd.Flags().GetString(FlagBaseAsset); err != nil {
+		return nil, err
+	}
+	if metadata.QuoteAsset, err = cmd.Flags().GetString(FlagQuoteAsset); err != nil {
+		return nil, err
+	}
+	if metadata.Unit, err = cmd.Flags().GetString(FlagUnit); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// addAnswerBoundsFlags adds the flags describing the feed answer bounds
+func addAnswerBoundsFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMinAnswer, "", "minimum answer of the feed, requires --max-answer")
+	cmd.Flags().String(FlagMaxAnswer, "", "maximum answer of the feed, requires --min-answer")
+	cmd.Flags().String(FlagAnswerBoundsMode, types.AnswerBoundsModeReject, "mode for rounds whose answer is out of bounds (reject|flag)")
+}
+
+// readValueSchemaFlags reads the feed value schema from the value type flags, nil for int192 feeds
+func readValueSchemaFlags(cmd *cobra.Command) (*types.FeedValueSchema, error) {
+	valueType, err := cmd.Flags().GetString(FlagValueType)
+	if err != nil {
+		return nil, err
+	}
+	tupleSize, err := cmd.Flags().GetUint32(FlagTupleSize)
+	if err != nil {
+		return nil, err
+	}
+	if (valueType == "" || valueType == types.FeedValueTypeInt192) && tupleSize == 0 {
+		return nil, nil
+	}
+	return &types.FeedValueSchema{Type: valueType, TupleSize: tupleSize}, nil
+}
+
+// readAnswerBoundsFlags reads the feed answer bounds from the flags added by addAnswerBoundsFlags,
+// no bounds are returned when neither --min-answer nor --max-answer is given
+func readAnswerBoundsFlags(cmd *cobra.Command) (*types.AnswerBounds, error) {
+	minAnswer, err := cmd.Flags().GetString(FlagMinAnswer)
+	if err != nil {
+		return nil, err
+	}
+	maxAnswer, err := cmd.Flags().GetString(FlagMaxAnswer)
+	if err != nil {
+		return nil, err
+	}
+	if minAnswer == "" && maxAnswer == "" {
+		return nil, nil
+	}
+
+	answerBounds := &types.AnswerBounds{}
+	var ok bool
+	if answerBounds.MinAnswer, ok = sdk.NewIntFromString(minAnswer); !ok {
+		return nil, fmt.Errorf("invalid %s: %s", FlagMinAnswer, minAnswer)
+	}
+	if answerBounds.MaxAnswer, ok = sdk.NewIntFromString(maxAnswer); !ok {
+		return nil, fmt.Errorf("invalid %s: %s", FlagMaxAnswer, maxAnswer)
+	}
+	if answerBounds.Mode, err = cmd.Flags().GetString(FlagAnswerBoundsMode); err != nil {
+		return nil, err
+	}
+	return answerBounds, nil
+}
+
 func CmdAddFeed() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "add-feed [feedId] [feedDescription] [feedOwnerAddress] [submissionCount] [heartbeatTrigger]" +
@@ -86,6 +197,41 @@ func CmdAddFeed() *cobra.Command {
 			msg := types.NewMsgFeed(argsFeedId, argsFeedDesc, feedOwnerAddr, clientCtx.GetFromAddress(),
 				initDataProviderList, uint32(submissionCount), uint32(heartbeatTrigger), uint32(deviationThresholdTrigger),
 				feedRewardBaseAmount, argsFeedRewardSchemaStrategy)
+			msg.DeviationThresholdPolicy, err = cmd.Flags().GetString(FlagDeviationThresholdPolicy)
+			if err != nil {
+				return err
+			}
+			msg.Metadata, err = readFeedMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg.AnswerBounds, err = readAnswerBoundsFlags(cmd)
+			if err != nil {
+				return err
+			}
+			transmitters, err := cmd.Flags().GetStringSlice(FlagTransmitters)
+			if err != nil {
+				return err
+			}
+			for _, transmitter := range transmitters {
+				addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(transmitter))
+				if err != nil {
+					return sdkerrors.Wrapf(err, "invalid transmitter address: %s", transmitter)
+				}
+				msg.Transmitters = append(msg.Transmitters, addr)
+			}
+			msg.ValueSchema, err = readValueSchemaFlags(cmd)
+			if err != nil {
+				return err
+			}
+			msg.MintRewards, err = cmd.Flags().GetBool(FlagMintRewards)
+			if err != nil {
+				return err
+			}
+			msg.InsufficientFundsPolicy, err = cmd.Flags().GetString(FlagInsufficientFundsPolicy)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -93,6 +239,14 @@ func CmdAddFeed() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDeviationThresholdPolicy, types.DeviationThresholdPolicyReject, "policy for rounds below the deviation threshold before the heartbeat elapsed (reject|nonRewardable)")
+	cmd.Flags().StringSlice(FlagTransmitters, nil, "comma separated addresses allowed to submit the reports, the data providers when empty")
+	cmd.Flags().String(FlagValueType, types.FeedValueTypeInt192, "type of the feed values (int192|bytes|string|tuple)")
+	cmd.Flags().Uint32(FlagTupleSize, 0, "number of int192 components of the values of a tuple feed")
+	cmd.Flags().Bool(FlagMintRewards, false, "mint the rewards the feed escrow can not cover")
+	cmd.Flags().String(FlagInsufficientFundsPolicy, types.InsufficientFundsPolicyNonRewardable, "policy for rounds the feed escrow can not reward (nonRewardable|reject)")
+	addFeedMetadataFlags(cmd)
+	addAnswerBoundsFlags(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -166,6 +320,70 @@ func CmdRemoveDataProvider() *cobra.Command {
 	return cmd
 }
 
+func CmdAddTransmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-transmitter [feedId] [address]",
+		Short: "Add new transmitter to the feed. Signer must be the feed owner.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+			argsAddress := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(argsAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddTransmitter(clientCtx.GetFromAddress(), argsFeedId, addr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveTransmitter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-transmitter [feedId] [address]",
+		Short: "Remove transmitter from the feed. Signer must be the feed owner.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+			argsAddress := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(argsAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveTransmitter(clientCtx.GetFromAddress(), argsFeedId, addr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdSetSubmissionCount() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "set-submission-count [feedId] [count]",
@@ -253,6 +471,10 @@ func CmdSetDeviationThreshold() *cobra.Command {
 			}
 
 			msg := types.NewMsgSetDeviationThreshold(clientCtx.GetFromAddress(), argsFeedId, uint32(deviationThresholdTrigger))
+			msg.DeviationThresholdPolicy, err = cmd.Flags().GetString(FlagDeviationThresholdPolicy)
+			if err != nil {
+				return err
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -260,6 +482,7 @@ func CmdSetDeviationThreshold() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDeviationThresholdPolicy, types.DeviationThresholdPolicyReject, "policy for rounds below the deviation threshold before the heartbeat elapsed (reject|nonRewardable)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -299,10 +522,157 @@ func CmdSetFeedReward() *cobra.Command {
 	return cmd
 }
 
+func CmdSetFeedMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-feed-metadata [feedId]",
+		Short: "Sets the metadata of a given feed",
+		Long:  "Replace the decimals, version, base/quote assets and unit of the feed with the given flags. Signer must be the feed owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := readFeedMetadataFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeedMetadata(clientCtx.GetFromAddress(), argsFeedId, metadata)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addFeedMetadataFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetAnswerBounds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-answer-bounds [feedId]",
+		Short: "Sets the answer bounds of a given feed",
+		Long: "Replace the minimum and maximum answers of the feed with the given flags, the bounds are removed when neither " +
+			"--min-answer nor --max-answer is given. Signer must be the feed owner.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			answerBounds, err := readAnswerBoundsFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAnswerBounds(clientCtx.GetFromAddress(), argsFeedId, answerBounds)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addAnswerBoundsFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSetOCRConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-ocr-config [feedId] [signingKeys] [transmitters] [f]",
+		Short: "Sets the OCR config of a given feed",
+		Long: "Replace the OCR config of the feed. signingKeys are the comma separated chainlink public keys of the oracles, " +
+			"as registered with add-chainlink-account, transmitters are the comma separated addresses allowed to submit the reports, " +
+			"one per oracle, and f is the maximum number of faulty oracles. Signer must be the feed owner.",
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+			argsSigningKeys := strings.Split(args[1], ",")
+			argsTransmitters := strings.Split(args[2], ",")
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signingKeys := make([][]byte, 0, len(argsSigningKeys))
+			for _, key := range argsSigningKeys {
+				signingKeys = append(signingKeys, []byte(strings.TrimSpace(key)))
+			}
+
+			transmitters := make([]sdk.AccAddress, 0, len(argsTransmitters))
+			for _, transmitter := range argsTransmitters {
+				addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(transmitter))
+				if err != nil {
+					return err
+				}
+				transmitters = append(transmitters, addr)
+			}
+
+			f, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			onchainConfig, err := readHexFlag(cmd, FlagOnchainConfig)
+			if err != nil {
+				return err
+			}
+			offchainConfigVersion, err := cmd.Flags().GetUint64(FlagOffchainConfigVersion)
+			if err != nil {
+				return err
+			}
+			offchainConfig, err := readHexFlag(cmd, FlagOffchainConfig)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetOCRConfig(clientCtx.GetFromAddress(), argsFeedId, signingKeys, transmitters, uint32(f),
+				onchainConfig, offchainConfigVersion, offchainConfig)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagOnchainConfig, "", "hex encoded onchain config")
+	cmd.Flags().Uint64(FlagOffchainConfigVersion, 0, "version of the offchain config encoding")
+	cmd.Flags().String(FlagOffchainConfig, "", "hex encoded offchain config")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readHexFlag reads the hex encoded, optionally 0x prefixed, value of a string flag
+func readHexFlag(cmd *cobra.Command, flag string) ([]byte, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+	return decoded, nil
+}
+
 func CmdTransferFeedOwnership() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "feed-ownership-transfer [feedId] [newFeedOwnerAddress]",
-		Short: "Transfer chainLink feed ownership from an existing feed owner account to another account. Signer must be an existing feed owner.",
+		Short: "Propose to transfer chainLink feed ownership from an existing feed owner account to another account, the new feed owner accepts it with accept-feed-ownership. Signer must be an existing feed owner.",
 		Args:  cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argsFeedId := args[0]
@@ -331,34 +701,294 @@ func CmdTransferFeedOwnership() *cobra.Command {
 	return cmd
 }
 
+func CmdAcceptFeedOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-feed-ownership [feedId]",
+		Short: "Accept the pending ownership transfer of a feed. Signer must be the new feed owner of the transfer.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptFeedOwnership(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelFeedOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-feed-ownership-transfer [feedId]",
+		Short: "Cancel the pending ownership transfer of a feed. Signer must be the feed owner or the new feed owner of the transfer.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelFeedOwnershipTransfer(clientCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPauseFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-feed [feedId]",
+		Short: "Pause a feed, a paused feed rejects new rounds. Signer must be the feed owner or a module owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseFeed(clientCtx.GetFromAddress(), argsFeedId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUnpauseFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause-feed [feedId]",
+		Short: "Unpause a paused feed. Signer must be the feed owner or a module owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnpauseFeed(clientCtx.GetFromAddress(), argsFeedId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeprecateFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-feed [feedId]",
+		Short: "Deprecate a feed, a deprecated feed is read-only and its data providers are no longer rewarded. Signer must be the feed owner or a module owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeprecateFeed(clientCtx.GetFromAddress(), argsFeedId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeleteFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-feed [feedId]",
+		Short: "Delete a deprecated feed along with its rounds, the feedId can not be re-used afterwards. Signer must be a module owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteFeed(clientCtx.GetFromAddress(), argsFeedId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFundFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-feed [feedId] [amount]",
+		Short: "Move link from the signer to the escrow rewarding the rounds of a feed. Signer must be the feed owner.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+			argsAmount := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := strconv.ParseUint(argsAmount, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundFeed(clientCtx.GetFromAddress(), argsFeedId, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawFeedFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-feed-funds [feedId] [amount]",
+		Short: "Move link from the escrow of a feed back to the signer. Signer must be the feed owner.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := args[0]
+			argsAmount := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := strconv.ParseUint(argsAmount, 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawFeedFunds(clientCtx.GetFromAddress(), argsFeedId, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawPayment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-payment [feedId]",
+		Short: "Withdraw the rewards and fee reimbursements owed to the signer by a feed, by every feed when no feedId is given. The payments are sent to the piggy address of the signer.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			argsFeedId := ""
+			if len(args) > 0 {
+				argsFeedId = args[0]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawPayment(clientCtx.GetFromAddress(), argsFeedId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdSubmitFeedData() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "submit-feed-data [feedId] [feedData] [signatures] [cosmosPubKeys]",
+		Use:   "submit-feed-data [feedId] [report] [feedData] [signatures] [cosmosPubKeys]",
 		Short: "Submit feed data",
-		Long:  "Submit feed data, called by an OCR round leader to submit an off-chain report of data signed by a number of oracles.",
-		Args:  cobra.MinimumNArgs(4),
+		Long:  "Submit feed data, called by an OCR round leader to submit an off-chain report of data signed by a number of oracles.\n\tThe report is the hex encoded OCR report ABI encoded as (bytes32 rawReportContext, bytes32 rawObservers, int192[] observations).\n\tFeed data, signatures and cosmos pubKeys are comma separated lists where the i-th feed data is the hex encoded i-th observation of the report and the i-th signature is the hex encoded signature of the report by the i-th data provider, the observer of the i-th observation.",
+		Args:  cobra.MinimumNArgs(5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argsFeedId := args[0]
-			argsFeedData := args[1]
-			argsSignatures := args[2]
-			argsCosmosPubKeys := args[3]
+			argsReport := args[1]
+			argsFeedData := args[2]
+			argsSignatures := args[3]
+			argsCosmosPubKeys := args[4]
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			report, err := hex.DecodeString(strings.TrimPrefix(argsReport, "0x"))
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid hex encoded report")
+			}
+
+			// feed data i is the hex encoded observation i of the report
 			observationData := strings.Split(argsFeedData, ",")
 			dataList := make([][]byte, 0)
 			for _, data := range observationData {
-				dataList = append(dataList, []byte(data))
+				observation, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid hex encoded feed data")
+				}
+				dataList = append(dataList, observation)
 			}
 
-			// TODO: this is dummy data to simulate the data providers signature set
+			// signature i is the hex encoded secp256k1 signature of the report by data provider i
 			signatures := strings.Split(argsSignatures, ",")
 			signatureList := make([][]byte, 0)
 			for _, sign := range signatures {
-				signatureList = append(signatureList, []byte(sign))
+				signature, err := hex.DecodeString(strings.TrimPrefix(sign, "0x"))
+				if err != nil {
+					return sdkerrors.Wrap(err, "invalid hex encoded signature")
+				}
+				signatureList = append(signatureList, signature)
 			}
 
 			pubKeys := strings.Split(argsCosmosPubKeys, ",")
@@ -367,7 +997,7 @@ func CmdSubmitFeedData() *cobra.Command {
 				pubKeyList = append(pubKeyList, []byte(key))
 			}
 
-			msg := types.NewMsgFeedData(clientCtx.GetFromAddress(), argsFeedId, dataList, signatureList, pubKeyList)
+			msg := types.NewMsgFeedData(clientCtx.GetFromAddress(), argsFeedId, report, dataList, signatureList, pubKeyList)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -4,6 +4,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -47,7 +49,7 @@ func CmdAddModuleOwner() *cobra.Command {
 func CmdTransferModuleOwnership() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "module-ownership-transfer [newModuleOwnerAddress] [newModuleOwnerPublicKey]",
-		Short: "Transfer chainLink module ownership from an existing module owner account to another account. Signer must be an existing module owner.",
+		Short: "Propose to transfer chainLink module ownership from an existing module owner account to another account, the new module owner accepts it with accept-module-ownership. Signer must be an existing module owner.",
 		Args:  cobra.MinimumNArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			argsAddress := args[0]
@@ -75,3 +77,148 @@ func CmdTransferModuleOwnership() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAcceptModuleOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-module-ownership [assignerAddress]",
+		Short: "Accept the module ownership transfer proposed by a module owner. Signer must be the new module owner of the transfer.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			assigner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptModuleOwnership(clientCtx.GetFromAddress(), assigner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelModuleOwnershipTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-module-ownership-transfer [assignerAddress]",
+		Short: "Cancel the module ownership transfer proposed by a module owner. Signer must be the module owner who proposed it or the new module owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			assigner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelModuleOwnershipTransfer(clientCtx.GetFromAddress(), assigner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveModuleOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-module-owner [address]",
+		Short: "Remove a chainLink module owner, the last module owner can not be removed. Signer must be an existing module owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveModuleOwner(clientCtx.GetFromAddress(), addr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdApproveOwnerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-owner-proposal [proposalId]",
+		Short: "Approve a pending owner proposal, it executes once enough module owners approved it, approving it again executes it once the threshold is reached. Signer must be an existing module owner.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveOwnerProposal(clientCtx.GetFromAddress(), proposalId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelOwnerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-owner-proposal [proposalId]",
+		Short: "Cancel a pending owner proposal. Signer must be the module owner who submitted it.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelOwnerProposal(clientCtx.GetFromAddress(), proposalId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,17 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package client
+
+import (
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/client/cli"
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// the chainlink proposal handlers of the gov module CLI and REST
+var (
+	AddFeedProposalHandler           = govclient.NewProposalHandler(cli.CmdSubmitAddFeedProposal, rest.AddFeedProposalRESTHandler)
+	AddModuleOwnerProposalHandler    = govclient.NewProposalHandler(cli.CmdSubmitAddModuleOwnerProposal, rest.AddModuleOwnerProposalRESTHandler)
+	RemoveModuleOwnerProposalHandler = govclient.NewProposalHandler(cli.CmdSubmitRemoveModuleOwnerProposal, rest.RemoveModuleOwnerProposalRESTHandler)
+)
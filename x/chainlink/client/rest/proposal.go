@@ -0,0 +1,106 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type AddFeedProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"baseReq"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+	Feed        *types.MsgFeed `json:"feed"`
+}
+
+type AddModuleOwnerProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"baseReq"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+	Address     sdk.AccAddress `json:"address"`
+	PubKey      string         `json:"pubKey"`
+}
+
+type RemoveModuleOwnerProposalReq struct {
+	BaseReq     rest.BaseReq   `json:"baseReq"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+	Address     sdk.AccAddress `json:"address"`
+}
+
+// AddFeedProposalRESTHandler is the REST handler of the AddFeedProposal submission
+func AddFeedProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "chainlink_add_feed",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req AddFeedProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewAddFeedProposal(req.Title, req.Description, req.Feed)
+			writeProposalTxResponse(w, clientCtx, req.BaseReq, req.Proposer, req.Deposit, content)
+		},
+	}
+}
+
+// AddModuleOwnerProposalRESTHandler is the REST handler of the AddModuleOwnerProposal submission
+func AddModuleOwnerProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "chainlink_add_module_owner",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req AddModuleOwnerProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewAddModuleOwnerProposal(req.Title, req.Description, req.Address, []byte(req.PubKey))
+			writeProposalTxResponse(w, clientCtx, req.BaseReq, req.Proposer, req.Deposit, content)
+		},
+	}
+}
+
+// RemoveModuleOwnerProposalRESTHandler is the REST handler of the RemoveModuleOwnerProposal submission
+func RemoveModuleOwnerProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "chainlink_remove_module_owner",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			var req RemoveModuleOwnerProposalReq
+			if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+				return
+			}
+			content := types.NewRemoveModuleOwnerProposal(req.Title, req.Description, req.Address)
+			writeProposalTxResponse(w, clientCtx, req.BaseReq, req.Proposer, req.Deposit, content)
+		},
+	}
+}
+
+func writeProposalTxResponse(w http.ResponseWriter, clientCtx client.Context, baseReq rest.BaseReq, proposer sdk.AccAddress, deposit sdk.Coins, content govtypes.Content) {
+	baseReq = baseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return
+	}
+
+	msg, err := govtypes.NewMsgSubmitProposal(content, deposit, proposer)
+	if rest.CheckBadRequestError(w, err) {
+		return
+	}
+	if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+		return
+	}
+
+	tx.WriteGeneratedTxResponse(clientCtx, w, baseReq, msg)
+}
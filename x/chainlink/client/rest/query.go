@@ -4,6 +4,7 @@
 package rest
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,17 +12,31 @@ import (
 
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 )
 
 func registerQueryRoutes(clientCtx client.Context, r *mux.Router) {
-	r.HandleFunc("/chainlink/legacy/feed/data/round/{roundId}/{feedId}", listRoundFeedDataHandler(clientCtx)).Methods(MethodGet) // query feed data by roundId and feedId
-	r.HandleFunc("/chainlink/legacy/feed/data/latest/{feedId}", listLatestFeedDataHandler(clientCtx)).Methods(MethodGet)         // query the latest feed data by feedId
-	r.HandleFunc("/chainlink/legacy/module/owner", getModuleOwner(clientCtx)).Methods(MethodGet)                                 // query the module owners
-	r.HandleFunc("/chainlink/legacy/module/feed/{feedId}", getFeedInfo(clientCtx)).Methods(MethodGet)                            // query the feed info by feedId
-	r.HandleFunc("/chainlink/legacy/module/account/{accountAddress}", getAccountInfo(clientCtx)).Methods(MethodGet)              // query the chainlink account
-	r.HandleFunc("/chainlink/legacy/module/feed/reward/strategy", getFeedRewardAvailStrategy(clientCtx)).Methods(MethodGet)      // query the available feed reward strategies
+	r.HandleFunc("/chainlink/legacy/feed/data/round/{roundId}/{feedId}", listRoundFeedDataHandler(clientCtx)).Methods(MethodGet)          // query feed data by roundId and feedId
+	r.HandleFunc("/chainlink/legacy/feed/data/history/{feedId}", listRoundHistoryHandler(clientCtx)).Methods(MethodGet)                   // query the round history of a feed
+	r.HandleFunc("/chainlink/legacy/feed/data/latest/{feedId}", listLatestFeedDataHandler(clientCtx)).Methods(MethodGet)                  // query the latest feed data by feedId
+	r.HandleFunc("/chainlink/legacy/module/owner", getModuleOwner(clientCtx)).Methods(MethodGet)                                          // query the module owners
+	r.HandleFunc("/chainlink/legacy/module/owner/proposals", listOwnerProposalsHandler(clientCtx)).Methods(MethodGet)                     // query the pending owner proposals
+	r.HandleFunc("/chainlink/legacy/module/owner/transfers", listModuleOwnershipTransfersHandler(clientCtx)).Methods(MethodGet)           // query the pending module ownership transfers
+	r.HandleFunc("/chainlink/legacy/module/feed/{feedId}", getFeedInfo(clientCtx)).Methods(MethodGet)                                     // query the feed info by feedId
+	r.HandleFunc("/chainlink/legacy/module/feed/{feedId}/metadata", getFeedMetadata(clientCtx)).Methods(MethodGet)                        // query the feed metadata by feedId
+	r.HandleFunc("/chainlink/legacy/module/feed/{feedId}/ownership-transfer", getFeedOwnershipTransfer(clientCtx)).Methods(MethodGet)     // query the pending ownership transfer by feedId
+	r.HandleFunc("/chainlink/legacy/module/feed/{feedId}/config", getLatestConfigDetails(clientCtx)).Methods(MethodGet)                   // query the latest OCR config details by feedId
+	r.HandleFunc("/chainlink/legacy/module/feed/{feedId}/escrow", getFeedEscrowBalance(clientCtx)).Methods(MethodGet)                     // query the escrow balance by feedId
+	r.HandleFunc("/chainlink/legacy/module/feeds", listFeedsHandler(clientCtx)).Methods(MethodGet)                                        // query the feeds matching the filters
+	r.HandleFunc("/chainlink/legacy/module/payments", listOwedPaymentsHandler(clientCtx)).Methods(MethodGet)                              // query the payments owed matching the filters
+	r.HandleFunc("/chainlink/legacy/module/account/{accountAddress}", getAccountInfo(clientCtx)).Methods(MethodGet)                       // query the chainlink account
+	r.HandleFunc("/chainlink/legacy/module/accounts", listAccountsHandler(clientCtx)).Methods(MethodGet)                                  // query the chainlink accounts
+	r.HandleFunc("/chainlink/legacy/module/chainlink-key/{chainlinkKey}/account", getAccountByChainlinkKey(clientCtx)).Methods(MethodGet) // query the chainlink account by chainlink key
+	r.HandleFunc("/chainlink/legacy/module/feed/reward/strategy", getFeedRewardAvailStrategy(clientCtx)).Methods(MethodGet)               // query the available feed reward strategies
+	r.HandleFunc("/chainlink/legacy/module/params", getParams(clientCtx)).Methods(MethodGet)                                              // query the module params
 }
 
 func listRoundFeedDataHandler(clientCtx client.Context) http.HandlerFunc {
@@ -47,6 +62,55 @@ func listRoundFeedDataHandler(clientCtx client.Context) http.HandlerFunc {
 	}
 }
 
+// listRoundHistoryHandler accepts the fromRound, toRound, startTime, endTime and reverse query parameters
+// along with the pagination query parameters
+func listRoundHistoryHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.GetRoundHistoryRequest{
+			FeedId:     vars["feedId"],
+			Pagination: pageReq,
+		}
+		for name, value := range map[string]*uint64{
+			"fromRound": &params.FromRound,
+			"toRound":   &params.ToRound,
+			"startTime": &params.StartTime,
+			"endTime":   &params.EndTime,
+		} {
+			if *value, err = parseUintQueryParam(r, name); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		if params.Reverse, err = parseBoolQueryParam(r, "reverse"); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRoundHistory), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
 func listLatestFeedDataHandler(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -92,6 +156,151 @@ func getFeedInfo(clientCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func getFeedMetadata(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		feedId := vars["feedId"]
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryFeedMetadata, feedId), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getFeedOwnershipTransfer(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		feedId := vars["feedId"]
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryFeedOwnershipTransfer, feedId), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getLatestConfigDetails(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		feedId := vars["feedId"]
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryLatestConfig, feedId), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getFeedEscrowBalance(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		feedId := vars["feedId"]
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryFeedEscrow, feedId), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// listFeedsHandler accepts the feedOwner, dataProvider (bech32 addresses) and feedRewardStrategy filters
+// along with the pagination query parameters
+func listFeedsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.ListFeedsRequest{
+			FeedRewardStrategy: r.URL.Query().Get("feedRewardStrategy"),
+			Pagination:         pageReq,
+		}
+		for name, value := range map[string]*sdk.AccAddress{
+			"feedOwner":    &params.FeedOwner,
+			"dataProvider": &params.DataProvider,
+		} {
+			if r.URL.Query().Get(name) == "" {
+				continue
+			}
+			if *value, err = sdk.AccAddressFromBech32(r.URL.Query().Get(name)); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("%s is invalid", name))
+				return
+			}
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeedList), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// listOwedPaymentsHandler accepts the oracle (bech32 address) and feedId filters along with the pagination query parameters
+func listOwedPaymentsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.ListOwedPaymentsRequest{
+			FeedId:     r.URL.Query().Get("feedId"),
+			Pagination: pageReq,
+		}
+		if oracle := r.URL.Query().Get("oracle"); oracle != "" {
+			if params.Oracle, err = sdk.AccAddressFromBech32(oracle); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "oracle is invalid")
+				return
+			}
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryOwedPayments), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
 func getAccountInfo(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -108,6 +317,97 @@ func getAccountInfo(clientCtx client.Context) http.HandlerFunc {
 	}
 }
 
+func listOwnerProposalsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(types.ListOwnerProposalsRequest{Pagination: pageReq})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryOwnerProposals), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func listModuleOwnershipTransfersHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(types.ListModuleOwnershipTransfersRequest{Pagination: pageReq})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryModuleOwnershipTransfers), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func listAccountsHandler(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pageReq, err := parsePageRequest(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := clientCtx.LegacyAmino.MarshalJSON(types.ListAccountsRequest{Pagination: pageReq})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAccountList), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+func getAccountByChainlinkKey(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		chainlinkKey := vars["chainlinkKey"]
+
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryAccountByKey, chainlinkKey), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
 func getFeedRewardAvailStrategy(clientCtx client.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		availStrategies := make([]string, 0, len(types.FeedRewardStrategyConvertor))
@@ -125,3 +425,63 @@ func getFeedRewardAvailStrategy(clientCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, clientCtx, availStrategies)
 	}
 }
+
+func getParams(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, height, err := clientCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		clientCtx = clientCtx.WithHeight(height)
+		rest.PostProcessResponse(w, clientCtx, res)
+	}
+}
+
+// parsePageRequest reads the key (base64), offset, limit and countTotal pagination query parameters
+func parsePageRequest(r *http.Request) (*sdkquery.PageRequest, error) {
+	pageReq := &sdkquery.PageRequest{}
+
+	if key := r.URL.Query().Get("key"); key != "" {
+		var err error
+		if pageReq.Key, err = base64.StdEncoding.DecodeString(key); err != nil {
+			return nil, errors.New("key is invalid")
+		}
+	}
+
+	var err error
+	if pageReq.Offset, err = parseUintQueryParam(r, "offset"); err != nil {
+		return nil, err
+	}
+	if pageReq.Limit, err = parseUintQueryParam(r, "limit"); err != nil {
+		return nil, err
+	}
+	if pageReq.CountTotal, err = parseBoolQueryParam(r, "countTotal"); err != nil {
+		return nil, err
+	}
+
+	return pageReq, nil
+}
+
+func parseUintQueryParam(r *http.Request, name string) (uint64, error) {
+	if r.URL.Query().Get(name) == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseUint(r.URL.Query().Get(name), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s is invalid", name)
+	}
+	return value, nil
+}
+
+func parseBoolQueryParam(r *http.Request, name string) (bool, error) {
+	if r.URL.Query().Get(name) == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(r.URL.Query().Get(name))
+	if err != nil {
+		return false, fmt.Errorf("%s is invalid", name)
+	}
+	return value, nil
+}
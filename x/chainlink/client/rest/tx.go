@@ -25,6 +25,7 @@ func registerTxHandlers(clientCtx client.Context, r *mux.Router) {
 type FeedDataRequest struct {
 	BaseReq       rest.BaseReq `json:"baseReq"`
 	FeedId        string       `json:"feedId"`
+	Report        []byte       `json:"report"`
 	FeedData      [][]byte     `json:"feedData"`
 	Signatures    [][]byte     `json:"signature"`
 	CosmosPubKeys [][]byte     `json:"cosmosPubKeys"`
@@ -48,7 +49,7 @@ func NewFeedDataRequestHandler(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgFeedData(submitter, req.FeedId, req.FeedData, req.Signatures, req.CosmosPubKeys)
+		msg := types.NewMsgFeedData(submitter, req.FeedId, req.Report, req.FeedData, req.Signatures, req.CosmosPubKeys)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -4,29 +4,49 @@
 package exported
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 )
 
-// RoundDataI TODO
+// RoundDataI is an answered round of a feed
 type RoundDataI interface {
 	proto.Message
 
 	GetFeedId() string
-	GetFeedData() OCRAbiEncodedI
+	GetRoundId() uint64
+	Report() ReportI
+	AnswerValue() FeedValueI
 }
 
-// ObservationI TODO
+// ObservationI is the observation of an oracle, typed after the value schema of its feed
 type ObservationI interface {
 	proto.Message
 
 	GetData() []byte
+	ObservedValue() FeedValueI
 }
 
-// OCRAbiEncodedI TODO
-type OCRAbiEncodedI interface {
+// ReportI is a report of a feed carrying the observations of its oracles, whatever the value schema of the feed
+type ReportI interface {
 	proto.Message
 
 	GetContext() []byte
 	GetOracles() []byte
-	GetObservations() []ObservationI
+	ValueType() string
+	ObservationList() []ObservationI
+	AnswerValue() FeedValueI
+}
+
+// OCRAbiEncodedI is the former name of ReportI, when every report carried int192 observations
+type OCRAbiEncodedI = ReportI
+
+// FeedValueI is a value typed after the value schema of a feed, only the getter of its type returns a value
+type FeedValueI interface {
+	proto.Message
+
+	GetType() string
+	GetNumeric() sdk.Int
+	GetBytes() []byte
+	GetText() string
+	GetTuple() []sdk.Int
 }
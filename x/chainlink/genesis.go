@@ -18,6 +18,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 		k.SetModuleOwner(ctx, &m)
 	}
+
+	params := types.DefaultParams()
+	if genState.GetParams() != nil {
+		params = *genState.GetParams()
+	}
+	k.SetParams(ctx, params)
 }
 
 // ExportGenesis returns the chainlink module's exported genesis.
@@ -25,6 +31,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	moduleOwners := k.GetModuleOwnerList(ctx)
 	genesis.ModuleOwners = moduleOwners.GetModuleOwner()
+	params := k.GetParams(ctx)
+	genesis.Params = &params
 
 	return genesis
 }
@@ -10,6 +10,8 @@ import (
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
 func NewHandler(k keeper.Keeper) sdk.Handler {
@@ -28,6 +30,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgModuleOwnershipTransfer:
 			res, err := msgServer.ModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptModuleOwnership:
+			res, err := msgServer.AcceptModuleOwnershipTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelModuleOwnershipTransfer:
+			res, err := msgServer.CancelModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveModuleOwner:
+			res, err := msgServer.RemoveModuleOwnerTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgApproveOwnerProposal:
+			res, err := msgServer.ApproveOwnerProposalTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelOwnerProposal:
+			res, err := msgServer.CancelOwnerProposalTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgFeed:
 			res, err := msgServer.AddFeedTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -37,6 +54,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgRemoveDataProvider:
 			res, err := msgServer.RemoveDataProviderTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAddTransmitter:
+			res, err := msgServer.AddTransmitterTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRemoveTransmitter:
+			res, err := msgServer.RemoveTransmitterTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgSetSubmissionCount:
 			res, err := msgServer.SetSubmissionCountTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -49,9 +72,45 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgSetFeedReward:
 			res, err := msgServer.SetFeedRewardTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetAnswerBounds:
+			res, err := msgServer.SetAnswerBoundsTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetOCRConfig:
+			res, err := msgServer.SetOCRConfigTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetFeedMetadata:
+			res, err := msgServer.SetFeedMetadataTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgFeedOwnershipTransfer:
 			res, err := msgServer.FeedOwnershipTransferTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptFeedOwnership:
+			res, err := msgServer.AcceptFeedOwnershipTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelFeedOwnershipTransfer:
+			res, err := msgServer.CancelFeedOwnershipTransferTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPauseFeed:
+			res, err := msgServer.PauseFeedTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnpauseFeed:
+			res, err := msgServer.UnpauseFeedTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeprecateFeed:
+			res, err := msgServer.DeprecateFeedTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeleteFeed:
+			res, err := msgServer.DeleteFeedTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgFundFeed:
+			res, err := msgServer.FundFeedTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawFeedFunds:
+			res, err := msgServer.WithdrawFeedFundsTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgWithdrawPayment:
+			res, err := msgServer.WithdrawPaymentTx(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgRequestNewRound:
 			res, err := msgServer.RequestNewRoundTx(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
@@ -67,3 +126,32 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+// NewProposalHandler returns the handler of the chainlink x/gov proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddFeedProposal:
+			return keeper.HandleAddFeedProposal(ctx, k, c)
+		case *types.AddModuleOwnerProposal:
+			return keeper.HandleAddModuleOwnerProposal(ctx, k, c)
+		case *types.RemoveModuleOwnerProposal:
+			return keeper.HandleRemoveModuleOwnerProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+// NewParamChangeProposalHandler wraps the x/params proposal handler, the changes of the chainlink params are
+// checked against the module state before the params handler applies them
+func NewParamChangeProposalHandler(k keeper.Keeper, paramsHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if c, ok := content.(*paramproposal.ParameterChangeProposal); ok {
+			if err := keeper.ValidateParamChangeProposal(ctx, k, c); err != nil {
+				return err
+			}
+		}
+		return paramsHandler(ctx, content)
+	}
+}
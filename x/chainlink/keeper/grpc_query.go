@@ -18,6 +18,12 @@ func (k Keeper) GetRoundData(c context.Context, req *types.GetRoundDataRequest)
 	return k.GetRoundFeedDataByFilter(ctx, req)
 }
 
+// GetRoundHistory implements the Query/GetRoundHistory gRPC method
+func (k Keeper) GetRoundHistory(c context.Context, req *types.GetRoundHistoryRequest) (*types.GetRoundHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetRoundFeedDataHistory(ctx, req)
+}
+
 // LatestRoundData implements the Query/LatestRoundData gRPC method
 func (k Keeper) LatestRoundData(c context.Context, req *types.GetLatestRoundDataRequest) (*types.GetLatestRoundDataResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -32,7 +38,41 @@ func (k Keeper) GetAllModuleOwner(c context.Context, _ *types.GetModuleOwnerRequ
 
 func (k Keeper) GetFeedByFeedId(c context.Context, req *types.GetFeedByIdRequest) (*types.GetFeedByIdResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	return k.GetFeed(ctx, req.FeedId), nil
+	resp := k.GetFeed(ctx, req.FeedId)
+	if resp.GetFeed() == nil {
+		resp.Tombstone = k.GetFeedTombstone(ctx, req.FeedId)
+	}
+	return resp, nil
+}
+
+// GetFeedMetadata implements the Query/GetFeedMetadata gRPC method
+func (k Keeper) GetFeedMetadata(c context.Context, req *types.GetFeedMetadataRequest) (*types.GetFeedMetadataResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetFeedMetadataByFeedId(ctx, req)
+}
+
+// LatestConfigDetails implements the Query/LatestConfigDetails gRPC method
+func (k Keeper) LatestConfigDetails(c context.Context, req *types.LatestConfigDetailsRequest) (*types.LatestConfigDetailsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetLatestConfigDetails(ctx, req)
+}
+
+// GetFeedEscrowBalance implements the Query/GetFeedEscrowBalance gRPC method
+func (k Keeper) GetFeedEscrowBalance(c context.Context, req *types.GetFeedEscrowBalanceRequest) (*types.GetFeedEscrowBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetFeedEscrowByFeedId(ctx, req)
+}
+
+// ListOwedPayments implements the Query/ListOwedPayments gRPC method
+func (k Keeper) ListOwedPayments(c context.Context, req *types.ListOwedPaymentsRequest) (*types.ListOwedPaymentsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetOwedPaymentList(ctx, req)
+}
+
+// ListFeeds implements the Query/ListFeeds gRPC method
+func (k Keeper) ListFeeds(c context.Context, req *types.ListFeedsRequest) (*types.ListFeedsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetFeedsByFilter(ctx, req)
 }
 
 func (k Keeper) GetAccountInfo(c context.Context, req *types.GetAccountRequest) (*types.GetAccountResponse, error) {
@@ -40,7 +80,43 @@ func (k Keeper) GetAccountInfo(c context.Context, req *types.GetAccountRequest)
 	return k.GetAccount(ctx, req), nil
 }
 
+// ListOwnerProposals implements the Query/ListOwnerProposals gRPC method
+func (k Keeper) ListOwnerProposals(c context.Context, req *types.ListOwnerProposalsRequest) (*types.ListOwnerProposalsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetOwnerProposalList(ctx, req)
+}
+
+// ListModuleOwnershipTransfers implements the Query/ListModuleOwnershipTransfers gRPC method
+func (k Keeper) ListModuleOwnershipTransfers(c context.Context, req *types.ListModuleOwnershipTransfersRequest) (*types.ListModuleOwnershipTransfersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetModuleOwnershipTransferList(ctx, req)
+}
+
+// GetFeedOwnershipTransfer implements the Query/GetFeedOwnershipTransfer gRPC method
+func (k Keeper) GetFeedOwnershipTransfer(c context.Context, req *types.GetFeedOwnershipTransferRequest) (*types.GetFeedOwnershipTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.GetFeedOwnershipTransferResponse{Transfer: k.GetPendingFeedOwnershipTransfer(ctx, req.GetFeedId())}, nil
+}
+
+// ListAccounts implements the Query/ListAccounts gRPC method
+func (k Keeper) ListAccounts(c context.Context, req *types.ListAccountsRequest) (*types.ListAccountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetAccountList(ctx, req)
+}
+
+// GetAccountByChainlinkKey implements the Query/GetAccountByChainlinkKey gRPC method
+func (k Keeper) GetAccountByChainlinkKey(c context.Context, req *types.GetAccountByChainlinkKeyRequest) (*types.GetAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.LookupAccountByChainlinkKey(ctx, req)
+}
+
 func (k Keeper) GetFeedRewardAvailStrategy(c context.Context, _ *types.GetFeedRewardAvailStrategiesRequest) (*types.GetFeedRewardAvailStrategiesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 	return k.GetRegisteredFeedRewardStrategies(ctx), nil
 }
+
+// GetModuleParams implements the Query/GetModuleParams gRPC method
+func (k Keeper) GetModuleParams(c context.Context, _ *types.GetModuleParamsRequest) (*types.GetModuleParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return k.GetModuleParamsResponse(ctx), nil
+}
@@ -4,8 +4,10 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
-	"strconv"
+	"math"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
@@ -15,13 +17,17 @@ import (
 
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 type (
 	Keeper struct {
 		cdc                 codec.Marshaler
 		bankKeeper          types.BankKeeper
+		paramSpace          paramtypes.Subspace
 		feedDataStoreKey    sdk.StoreKey
 		roundStoreKey       sdk.StoreKey
 		moduleOwnerStoreKey sdk.StoreKey
@@ -34,6 +40,7 @@ type (
 func NewKeeper(
 	cdc codec.Marshaler,
 	bk types.BankKeeper,
+	paramSpace paramtypes.Subspace,
 	feedDataStoreKey,
 	roundStoreKey,
 	moduleOwnerStoreKey,
@@ -41,9 +48,15 @@ func NewKeeper(
 	accountStoreKey,
 	memKey sdk.StoreKey,
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:                 cdc,
 		bankKeeper:          bk,
+		paramSpace:          paramSpace,
 		feedDataStoreKey:    feedDataStoreKey,
 		roundStoreKey:       roundStoreKey,
 		moduleOwnerStoreKey: moduleOwnerStoreKey,
@@ -75,47 +88,109 @@ func (k Keeper) SetFeedData(ctx sdk.Context, feedData *types.MsgFeedData) (int64
 		}
 	}
 
-	roundStore := ctx.KVStore(k.roundStoreKey)
+	// deserialize the report after the feed value schema before touching the store, malformed reports never get a round
+	feed := k.GetFeed(ctx, feedData.GetFeedId()).GetFeed()
+	deserializedOCRReport, err := types.DecodeFeedReport(feedData.GetReport(), feed.GetValueSchema())
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// once the feed got an OCR config, only the reports of the active config are accepted
+	if config := k.GetOCRConfig(ctx, feedData.GetFeedId()); config != nil && !bytes.Equal(deserializedOCRReport.ConfigDigest(), config.GetConfigDigest()) {
+		return 0, nil, sdkerrors.Wrapf(types.ErrConfigDigestMismatch, "report config digest %x, active config digest %x",
+			deserializedOCRReport.ConfigDigest(), config.GetConfigDigest())
+	}
+
+	// reports must be transmitted in increasing OCR epoch and round order, a replayed or older report never gets a round
+	latestEpochAndRound := k.GetLatestEpochAndRound(ctx, feedData.GetFeedId())
+	if deserializedOCRReport.EpochAndRound() <= latestEpochAndRound {
+		return 0, nil, sdkerrors.Wrapf(types.ErrStaleReport, "report epoch %d round %d is not newer than the latest accepted one of feed %s",
+			deserializedOCRReport.Epoch(), deserializedOCRReport.Round(), feedData.GetFeedId())
+	}
+
 	currentLatestRoundId := k.GetLatestRoundId(ctx, feedData.FeedId)
 	roundId := currentLatestRoundId + 1
+	answer := deserializedOCRReport.Median()
+	value := deserializedOCRReport.Aggregate()
+
+	// the answer must lie within the answer bounds of the feed, out of bounds answers are either rejected
+	// or accepted as non-rewardable, depending on the feed answer bounds mode
+	boundsErr := feed.GetAnswerBounds().Check(answer)
+	if boundsErr != nil && !feed.GetAnswerBounds().FlagsOutOfBounds() {
+		return 0, nil, boundsErr
+	}
+
+	// the new answer must deviate enough from the previous one unless the heartbeat elapsed
+	deviationEvent, err := k.evaluateDeviation(ctx, feedData.GetFeedId(), feed, currentLatestRoundId, answer)
+	if err != nil {
+		return 0, nil, err
+	}
+	deviationEvent.RoundId = roundId
 
-	// update the latest roundId of the current feedId
-	roundStore.Set(types.GetRoundIdKey(feedData.GetFeedId()), i64tob(roundId))
+	// update the latest roundId and OCR epoch and round of the current feedId
+	k.setLatestRoundId(ctx, feedData.GetFeedId(), roundId)
+	k.setLatestEpochAndRound(ctx, feedData.GetFeedId(), deserializedOCRReport.EpochAndRound())
 
 	// TODO: add more complex feed validation here such as verify against other modules
 
-	// TODO: deserialize the feedData.FeedData if it's an OCR report, assume all the feedData is OCR report for now.
-	// this is simulating the OCR report deserialization lib
-	/****************/
-	observations := make([]*types.Observation, 0, len(feedData.GetObservationFeedData()))
-	for _, b := range feedData.GetObservationFeedData() {
-		o := &types.Observation{Data: []byte(string(b))}
-		observations = append(observations, o)
-	}
-	deserializedOCRReport := types.OCRAbiEncoded{
-		Context:      []byte(fmt.Sprintf("%d", roundId)),
-		Oracles:      feedData.Submitter.Bytes(),
-		Observations: observations,
-	}
-	/****************/
-	// TODO: verify deserializedOCRReport here
+	// the answer of the round is aggregated on-chain, OCR rounds start and get answered in the same transmission
+	blockTime := uint64(ctx.BlockTime().Unix())
 	finalFeedDataInStore := types.OCRFeedDataInStore{
 		FeedData:              feedData,
-		DeserializedOCRReport: &deserializedOCRReport,
+		DeserializedOCRReport: deserializedOCRReport,
 		RoundId:               roundId,
+		Answer:                answer,
+		StartedAt:             blockTime,
+		UpdatedAt:             blockTime,
+		AnsweredInRound:       roundId,
+		BlockHeight:           ctx.BlockHeight(),
+		Deviation:             deviationEvent.GetDeviation(),
+		Rewardable:            deviationEvent.GetRewardable() && boundsErr == nil,
+		Value:                 value,
 	}
 
 	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
 
 	f := k.cdc.MustMarshalBinaryBare(&finalFeedDataInStore)
 
-	feedDataStore.Set(types.GetFeedDataKey(feedData.GetFeedId(), strconv.FormatUint(roundId, 10)), f)
+	feedDataStore.Set(types.GetFeedDataKey(feedData.GetFeedId(), roundId), f)
+
+	// drop the rounds older than the round retention param
+	k.pruneRounds(ctx, feedData.GetFeedId(), roundId)
+
+	// a new round resets the heartbeat of the feed
+	k.SetLastUpdateTime(ctx, feedData.GetFeedId(), blockTimeMillis(ctx))
+	k.ScheduleHeartbeat(ctx, feedData.GetFeedId(), feed.GetHeartbeatTrigger(), blockTimeMillis(ctx))
+
+	// flag the out of bounds round
+	if boundsErr != nil {
+		err = types.EmitEvent(&types.MsgFeedDataValidationFailedEvent{
+			FeedId:        feedData.GetFeedId(),
+			DataProviders: feed.GetDataProviders(),
+			FeedOwner:     feed.GetFeedOwner(),
+			Submitter:     feedData.GetSubmitter(),
+			FeedData:      feedData.GetObservationFeedData(),
+			Signatures:    feedData.GetObservationFeedDataSignatures(),
+			Reason:        boundsErr.Error(),
+		}, ctx.EventManager())
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	// emit RoundDeviation event
+	err = types.EmitEvent(deviationEvent, ctx.EventManager())
+	if err != nil {
+		return 0, nil, err
+	}
 
 	// emit NewRoundData event
-	err := types.EmitEvent(&types.MsgNewRoundDataEvent{
+	err = types.EmitEvent(&types.MsgNewRoundDataEvent{
 		FeedId:   feedData.FeedId,
 		RoundId:  roundId,
 		FeedData: feedData.ObservationFeedData,
+		Answer:   finalFeedDataInStore.Answer,
+		Value:    value,
 	}, ctx.EventManager())
 	if err != nil {
 		return 0, nil, err
@@ -124,6 +199,98 @@ func (k Keeper) SetFeedData(ctx sdk.Context, feedData *types.MsgFeedData) (int64
 	return ctx.BlockHeight(), ctx.TxBytes(), nil
 }
 
+// maxPrunedRounds is the maximum number of rounds deleted by a new round of a feed, so that the rounds kept by a
+// larger retention before it got lowered are pruned over the next rounds instead of in a single tx
+const maxPrunedRounds = 10
+
+// pruneRounds deletes the oldest rounds of a feed that are not among the latest rounds kept by the round retention param,
+// at most maxPrunedRounds of them per call
+func (k Keeper) pruneRounds(ctx sdk.Context, feedId string, latestRoundId uint64) {
+	retention := k.GetParams(ctx).RoundRetention
+	if retention == 0 || latestRoundId <= retention {
+		return
+	}
+
+	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
+	iterator := feedDataStore.Iterator(types.GetFeedDataPrefix(feedId), types.GetFeedDataKey(feedId, latestRoundId-retention+1))
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < maxPrunedRounds; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		feedDataStore.Delete(key)
+	}
+}
+
+// evaluateDeviation compares the answer of a new round of the feed against the answer of the previous round.
+// A round that does not meet the deviation threshold trigger before the heartbeat elapsed is either rejected
+// or accepted as non-rewardable, depending on the feed deviation threshold policy.
+func (k Keeper) evaluateDeviation(ctx sdk.Context, feedId string, feed *types.MsgFeed, previousRoundId uint64, answer sdk.Int) (*types.MsgRoundDeviationEvent, error) {
+	event := &types.MsgRoundDeviationEvent{
+		FeedId:                    feedId,
+		PreviousAnswer:            sdk.ZeroInt(),
+		Answer:                    answer,
+		DeviationThresholdTrigger: feed.GetDeviationThresholdTrigger(),
+		Rewardable:                true,
+	}
+
+	// the first round of a feed always gets accepted, so do the rounds of the feeds without a numeric answer
+	previousRound := k.GetFeedDataInStore(ctx, feedId, previousRoundId)
+	if previousRound == nil || !feed.GetValueSchema().IsNumeric() {
+		return event, nil
+	}
+
+	event.PreviousAnswer = previousRound.Answer
+	if event.PreviousAnswer.IsNil() {
+		event.PreviousAnswer = previousRound.GetDeserializedOCRReport().Median()
+	}
+	event.Deviation = types.ComputeDeviation(event.PreviousAnswer, answer)
+
+	lastUpdate := k.GetLastUpdateTime(ctx, feedId)
+	event.HeartbeatElapsed = feed.GetHeartbeatTrigger() > 0 && blockTimeMillis(ctx) >= lastUpdate+uint64(feed.GetHeartbeatTrigger())
+
+	if feed.GetDeviationThresholdTrigger() == 0 || event.GetHeartbeatElapsed() || event.GetDeviation() >= uint64(feed.GetDeviationThresholdTrigger()) {
+		return event, nil
+	}
+
+	if feed.GetDeviationThresholdPolicy() == types.DeviationThresholdPolicyNonRewardable {
+		event.Rewardable = false
+		return event, nil
+	}
+
+	return nil, sdkerrors.Wrapf(types.ErrDeviationThresholdNotMet, "deviation %d is below the threshold %d and the heartbeat has not elapsed",
+		event.GetDeviation(), feed.GetDeviationThresholdTrigger())
+}
+
+// GetFeedDataInStore returns the persisted round of a feed, nil if the round does not exist
+func (k Keeper) GetFeedDataInStore(ctx sdk.Context, feedId string, roundId uint64) *types.OCRFeedDataInStore {
+	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
+	value := feedDataStore.Get(types.GetFeedDataKey(feedId, roundId))
+	if value == nil {
+		return nil
+	}
+
+	var feedData types.OCRFeedDataInStore
+	k.cdc.MustUnmarshalBinaryBare(value, &feedData)
+	return &feedData
+}
+
+// setRoundNonRewardable flags an accepted round as not rewarded
+func (k Keeper) setRoundNonRewardable(ctx sdk.Context, feedId string, roundId uint64) {
+	round := k.GetFeedDataInStore(ctx, feedId, roundId)
+	if round == nil {
+		return
+	}
+	round.Rewardable = false
+
+	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
+	feedDataStore.Set(types.GetFeedDataKey(feedId, roundId), k.cdc.MustMarshalBinaryBare(round))
+}
+
+// GetRoundFeedDataByFilter returns the given round of the given feed,
+// or the given round of every feed paginated by feed if feedId is not given.
 func (k Keeper) GetRoundFeedDataByFilter(ctx sdk.Context, req *types.GetRoundDataRequest) (*types.GetRoundDataResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -131,18 +298,26 @@ func (k Keeper) GetRoundFeedDataByFilter(ctx sdk.Context, req *types.GetRoundDat
 
 	var feedRoundData []*types.RoundData
 
-	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
+	if req.GetFeedId() != "" {
+		if feedData := k.GetFeedDataInStore(ctx, req.GetFeedId(), req.GetRoundId()); feedData != nil {
+			feedRoundData = append(feedRoundData, toRoundData(*feedData))
+		}
+		return &types.GetRoundDataResponse{
+			RoundData:  feedRoundData,
+			Pagination: &query.PageResponse{},
+		}, nil
+	}
 
-	pageRes, err := query.Paginate(feedDataStore, req.Pagination, func(key []byte, value []byte) error {
-		var feedData types.OCRFeedDataInStore
+	latestRoundStore := prefix.NewStore(ctx.KVStore(k.roundStoreKey), types.LatestRoundIdKeyPrefix)
 
-		if err := k.cdc.UnmarshalBinaryBare(value, &feedData); err != nil {
-			return err
+	pageRes, err := query.Paginate(latestRoundStore, req.Pagination, func(key []byte, value []byte) error {
+		if sdk.BigEndianToUint64(value) < req.GetRoundId() {
+			return nil
 		}
 
-		data := feedDataFilter(req.GetFeedId(), req.GetRoundId(), feedData)
-		if data != nil {
-			feedRoundData = append(feedRoundData, data)
+		feedData := k.GetFeedDataInStore(ctx, types.FeedIdFromLengthPrefixed(key), req.GetRoundId())
+		if feedData != nil {
+			feedRoundData = append(feedRoundData, toRoundData(*feedData))
 		}
 
 		return nil
@@ -158,6 +333,123 @@ func (k Keeper) GetRoundFeedDataByFilter(ctx sdk.Context, req *types.GetRoundDat
 	}, nil
 }
 
+// GetRoundFeedDataHistory returns the rounds of a feed within the requested round range and time window.
+// Rounds are keyed by their big endian roundId, the page cursor is the key of the next round to return.
+func (k Keeper) GetRoundFeedDataHistory(ctx sdk.Context, req *types.GetRoundHistoryRequest) (*types.GetRoundHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.GetFeedId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "feedId is required")
+	}
+
+	fromRound := req.GetFromRound()
+	if fromRound == 0 {
+		fromRound = 1
+	}
+	toRound := req.GetToRound()
+	if toRound == 0 {
+		toRound = k.GetLatestRoundId(ctx, req.GetFeedId())
+	}
+	if req.GetToRound() != 0 && toRound < fromRound {
+		return nil, status.Error(codes.InvalidArgument, "toRound must not be lower than fromRound")
+	}
+	if req.GetEndTime() != 0 && req.GetEndTime() < req.GetStartTime() {
+		return nil, status.Error(codes.InvalidArgument, "endTime must not be lower than startTime")
+	}
+
+	pageReq := req.GetPagination()
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.GetKey()) != 0 && pageReq.GetOffset() > 0 {
+		return nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+	limit := pageReq.GetLimit()
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	// the cursor moves the lower bound of the round range forward, or its upper bound backward when reversed
+	if key := pageReq.GetKey(); len(key) != 0 {
+		if len(key) != 8 {
+			return nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+		cursor := sdk.BigEndianToUint64(key)
+		if req.GetReverse() && cursor < toRound {
+			toRound = cursor
+		} else if !req.GetReverse() && cursor > fromRound {
+			fromRound = cursor
+		}
+	}
+
+	var (
+		feedRoundData []*types.RoundData
+		matched       uint64
+		nextKey       []byte
+	)
+
+	if fromRound <= toRound {
+		start, end := sdk.Uint64ToBigEndian(fromRound), sdk.Uint64ToBigEndian(toRound+1)
+		if toRound == math.MaxUint64 {
+			end = nil
+		}
+
+		feedHistoryStore := prefix.NewStore(ctx.KVStore(k.feedDataStoreKey), types.GetFeedDataPrefix(req.GetFeedId()))
+
+		var iterator sdk.Iterator
+		if req.GetReverse() {
+			iterator = feedHistoryStore.ReverseIterator(start, end)
+		} else {
+			iterator = feedHistoryStore.Iterator(start, end)
+		}
+		defer iterator.Close()
+
+		// rounds outside of the time window are skipped without counting towards the page
+		// so that pages are only short once the history is exhausted
+		for ; iterator.Valid(); iterator.Next() {
+			var feedData types.OCRFeedDataInStore
+			if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &feedData); err != nil {
+				return nil, err
+			}
+
+			updatedAt := feedData.GetUpdatedAt()
+			if updatedAt < req.GetStartTime() || (req.GetEndTime() != 0 && updatedAt > req.GetEndTime()) {
+				continue
+			}
+
+			matched++
+			if matched <= pageReq.GetOffset() {
+				continue
+			}
+
+			if uint64(len(feedRoundData)) == limit {
+				if nextKey == nil {
+					nextKey = iterator.Key()
+				}
+				if !pageReq.GetCountTotal() {
+					break
+				}
+				continue
+			}
+
+			feedRoundData = append(feedRoundData, toRoundData(feedData))
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if pageReq.GetCountTotal() {
+		pageRes.Total = matched
+	}
+
+	return &types.GetRoundHistoryResponse{
+		RoundData:  feedRoundData,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GetLatestRoundFeedDataByFilter returns the latest round of the given feed,
+// or the rounds of every feed that reached the global latest roundId if feedId is not given.
 func (k Keeper) GetLatestRoundFeedDataByFilter(ctx sdk.Context, req *types.GetLatestRoundDataRequest) (*types.GetLatestRoundDataResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -168,18 +460,26 @@ func (k Keeper) GetLatestRoundFeedDataByFilter(ctx sdk.Context, req *types.GetLa
 	// get the roundId based on given feedId
 	latestRoundId := k.GetLatestRoundId(ctx, req.GetFeedId())
 
-	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
-	iterator := sdk.KVStorePrefixIterator(feedDataStore, types.GetFeedDataKey("", ""))
+	if req.GetFeedId() != "" {
+		if feedData := k.GetFeedDataInStore(ctx, req.GetFeedId(), latestRoundId); feedData != nil {
+			feedRoundData = append(feedRoundData, toRoundData(*feedData))
+		}
+		return &types.GetLatestRoundDataResponse{
+			RoundData: feedRoundData,
+		}, nil
+	}
 
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.roundStoreKey), types.LatestRoundIdKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var feedData types.OCRFeedDataInStore
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &feedData)
+		if sdk.BigEndianToUint64(iterator.Value()) != latestRoundId {
+			continue
+		}
 
-		data := feedDataFilter(req.GetFeedId(), latestRoundId, feedData)
-		if data != nil {
-			feedRoundData = append(feedRoundData, data)
+		feedId := types.FeedIdFromLengthPrefixed(iterator.Key()[len(types.LatestRoundIdKeyPrefix):])
+		if feedData := k.GetFeedDataInStore(ctx, feedId, latestRoundId); feedData != nil {
+			feedRoundData = append(feedRoundData, toRoundData(*feedData))
 		}
 	}
 
@@ -199,15 +499,15 @@ func (k Keeper) GetLatestRoundId(ctx sdk.Context, feedId string) uint64 {
 		if len(roundIdBytes) == 0 {
 			return 0
 		}
-		return btoi64(roundIdBytes)
+		return sdk.BigEndianToUint64(roundIdBytes)
 	}
 
 	var latestRoundId uint64
-	roundIdIterator := sdk.KVStorePrefixIterator(roundStore, types.GetRoundIdKey(""))
+	roundIdIterator := sdk.KVStorePrefixIterator(roundStore, types.LatestRoundIdKeyPrefix)
 	defer roundIdIterator.Close()
 
 	for ; roundIdIterator.Valid(); roundIdIterator.Next() {
-		roundId := btoi64(roundIdIterator.Value())
+		roundId := sdk.BigEndianToUint64(roundIdIterator.Value())
 		if roundId > latestRoundId {
 			latestRoundId = roundId
 		}
@@ -216,6 +516,12 @@ func (k Keeper) GetLatestRoundId(ctx sdk.Context, feedId string) uint64 {
 	return latestRoundId
 }
 
+// setLatestRoundId moves the latest round pointer of a feed
+func (k Keeper) setLatestRoundId(ctx sdk.Context, feedId string, roundId uint64) {
+	roundStore := ctx.KVStore(k.roundStoreKey)
+	roundStore.Set(types.GetRoundIdKey(feedId), sdk.Uint64ToBigEndian(roundId))
+}
+
 func (k Keeper) SetModuleOwner(ctx sdk.Context, moduleOwner *types.MsgModuleOwner) (int64, []byte) {
 	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
 
@@ -234,6 +540,30 @@ func (k Keeper) RemoveModuleOwner(ctx sdk.Context, transfer *types.MsgModuleOwne
 	return ctx.BlockHeight(), ctx.TxBytes()
 }
 
+// ValidateModuleOwnerRemoval checks the address is a module owner who is not the last one
+func (k Keeper) ValidateModuleOwnerRemoval(ctx sdk.Context, address sdk.AccAddress) error {
+	moduleOwners := types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner())
+	if !moduleOwners.Contains(address) {
+		return sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "%s is not a module owner", address)
+	}
+	if len(moduleOwners) == 1 {
+		return sdkerrors.Wrap(types.ErrLastModuleOwner, address.String())
+	}
+	return nil
+}
+
+// DeleteModuleOwner removes a module owner along with its pending module ownership transfer, the last module owner
+// can not be removed
+func (k Keeper) DeleteModuleOwner(ctx sdk.Context, address sdk.AccAddress) error {
+	if err := k.ValidateModuleOwnerRemoval(ctx, address); err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.moduleOwnerStoreKey).Delete(types.GetModuleOwnerKey(address.String()))
+	k.DeleteModuleOwnershipTransfer(ctx, address)
+	return nil
+}
+
 func (k Keeper) GetModuleOwnerList(ctx sdk.Context) *types.GetModuleOwnerResponse {
 	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
 	iterator := sdk.KVStorePrefixIterator(moduleStore, types.GetModuleOwnerKey(""))
@@ -254,6 +584,34 @@ func (k Keeper) GetModuleOwnerList(ctx sdk.Context) *types.GetModuleOwnerRespons
 	}
 }
 
+// ValidateNewFeed checks a feed can be added, whether by a module owner or by a x/gov proposal: the feedId must be
+// neither used nor deleted, the feed must be within the limits of the module params and its reward strategy registered
+func (k Keeper) ValidateNewFeed(ctx sdk.Context, feed *types.MsgFeed) error {
+	if !k.GetFeed(ctx, feed.GetFeedId()).GetFeed().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "feed already exists")
+	}
+	// the feedId of a deleted feed can not be re-used
+	if k.GetFeedTombstone(ctx, feed.GetFeedId()) != nil {
+		return sdkerrors.Wrapf(types.ErrFeedDeleted, "feed %s has been deleted, its feedId can not be re-used", feed.GetFeedId())
+	}
+
+	params := k.GetParams(ctx)
+	if len(feed.GetFeedId()) > int(params.MaxFeedIdLength) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "feedId can not be longer than %d", params.MaxFeedIdLength)
+	}
+	if len(feed.GetDataProviders()) > int(params.MaxDataProviders) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "a feed can not have more than %d data providers", params.MaxDataProviders)
+	}
+
+	if strategy := feed.GetFeedReward().GetStrategy(); strategy != "" {
+		if _, ok := types.FeedRewardStrategyConvertor[strategy]; !ok {
+			return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid feed reward strategy")
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) SetFeed(ctx sdk.Context, feed *types.MsgFeed) (int64, []byte) {
 	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
 
@@ -282,6 +640,43 @@ func (k Keeper) GetFeed(ctx sdk.Context, feedId string) *types.GetFeedByIdRespon
 	}
 }
 
+// GetFeedsByFilter returns the feeds matching every filter of the request, paginated over the matching feeds
+func (k Keeper) GetFeedsByFilter(ctx sdk.Context, req *types.ListFeedsRequest) (*types.ListFeedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var feeds []*types.MsgFeed
+
+	feedStore := prefix.NewStore(ctx.KVStore(k.feedInfoStoreKey), types.GetFeedInfoKey(""))
+
+	pageRes, err := query.FilteredPaginate(feedStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var feed types.MsgFeed
+		if err := k.cdc.UnmarshalBinaryBare(value, &feed); err != nil {
+			return false, err
+		}
+
+		if !feedMatchesFilter(&feed, req) {
+			return false, nil
+		}
+
+		if accumulate {
+			feeds = append(feeds, &feed)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ListFeedsResponse{
+		Feeds:      feeds,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) AddDataProvider(ctx sdk.Context, addDataProvider *types.MsgAddDataProvider) (int64, []byte, error) {
 	// retrieve feed from store
 	resp := k.GetFeed(ctx, addDataProvider.GetFeedId())
@@ -316,6 +711,40 @@ func (k Keeper) RemoveDataProvider(ctx sdk.Context, removeDataProvider *types.Ms
 	return ctx.BlockHeight(), ctx.TxBytes(), nil
 }
 
+func (k Keeper) AddTransmitter(ctx sdk.Context, addTransmitter *types.MsgAddTransmitter) (int64, []byte, error) {
+	// retrieve feed from store
+	resp := k.GetFeed(ctx, addTransmitter.GetFeedId())
+	feed := resp.GetFeed()
+	if feed == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", addTransmitter.GetFeedId())
+	}
+
+	// add new transmitter
+	feed.Transmitters = append(feed.Transmitters, addTransmitter.GetAddress())
+
+	// put back feed in the store
+	k.SetFeed(ctx, feed)
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+func (k Keeper) RemoveTransmitter(ctx sdk.Context, removeTransmitter *types.MsgRemoveTransmitter) (int64, []byte, error) {
+	// retrieve feed from store
+	resp := k.GetFeed(ctx, removeTransmitter.GetFeedId())
+	feed := resp.GetFeed()
+	if feed == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", removeTransmitter.GetFeedId())
+	}
+
+	// remove transmitter from the list
+	feed.Transmitters = (types.Transmitters)(feed.Transmitters).Remove(removeTransmitter.GetAddress())
+
+	// put back feed in the store
+	k.SetFeed(ctx, feed)
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
 func (k Keeper) SetSubmissionCount(ctx sdk.Context, setSubmissionCount *types.MsgSetSubmissionCount) (int64, []byte, error) {
 	// retrieve feed from store
 	resp := k.GetFeed(ctx, setSubmissionCount.GetFeedId())
@@ -347,6 +776,13 @@ func (k Keeper) SetHeartbeatTrigger(ctx sdk.Context, setHeartbeatTrigger *types.
 	// put back feed in the store
 	k.SetFeed(ctx, feed)
 
+	// reschedule the heartbeat from the latest round, or from now if the feed never got updated
+	from := k.GetLastUpdateTime(ctx, feed.GetFeedId())
+	if from == 0 {
+		from = blockTimeMillis(ctx)
+	}
+	k.ScheduleHeartbeat(ctx, feed.GetFeedId(), feed.GetHeartbeatTrigger(), from)
+
 	return ctx.BlockHeight(), ctx.TxBytes(), nil
 }
 
@@ -358,8 +794,9 @@ func (k Keeper) SetDeviationThresholdTrigger(ctx sdk.Context, setDeviationThresh
 		return 0, nil, fmt.Errorf("feed '%s' not found", setDeviationThresholdTrigger.GetFeedId())
 	}
 
-	// update deviation threshold trigger
+	// update deviation threshold trigger and policy
 	feed.DeviationThresholdTrigger = setDeviationThresholdTrigger.GetDeviationThresholdTrigger()
+	feed.DeviationThresholdPolicy = setDeviationThresholdTrigger.GetDeviationThresholdPolicy()
 
 	// put back feed in the store
 	k.SetFeed(ctx, feed)
@@ -384,42 +821,190 @@ func (k Keeper) SetFeedReward(ctx sdk.Context, setFeedReward *types.MsgSetFeedRe
 	return ctx.BlockHeight(), ctx.TxBytes(), nil
 }
 
-// DistributeReward will mint the reward from the module
-// then transfer the reward to the receiver (data provider)
+// SetAnswerBounds replaces the answer bounds of the feed
+func (k Keeper) SetAnswerBounds(ctx sdk.Context, setAnswerBounds *types.MsgSetAnswerBounds) (int64, []byte, error) {
+	// retrieve feed from store
+	resp := k.GetFeed(ctx, setAnswerBounds.GetFeedId())
+	feed := resp.GetFeed()
+	if feed == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", setAnswerBounds.GetFeedId())
+	}
+
+	// replace feed answer bounds
+	feed.AnswerBounds = setAnswerBounds.GetAnswerBounds()
+
+	// put back feed in the store
+	k.SetFeed(ctx, feed)
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// SetOCRConfig replaces the OCR config of a feed, bumping its config count and computing its config digest.
+// The reports of the previous config are no longer accepted and the epoch and round tracking of the feed restarts.
+func (k Keeper) SetOCRConfig(ctx sdk.Context, setOCRConfig *types.MsgSetOCRConfig) (int64, []byte, error) {
+	feedId := setOCRConfig.GetFeedId()
+	if k.GetFeed(ctx, feedId).GetFeed() == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", feedId)
+	}
+
+	config := types.OCRConfig{
+		SigningKeys:           setOCRConfig.GetSigningKeys(),
+		Transmitters:          setOCRConfig.GetTransmitters(),
+		F:                     setOCRConfig.GetF(),
+		OnchainConfig:         setOCRConfig.GetOnchainConfig(),
+		OffchainConfigVersion: setOCRConfig.GetOffchainConfigVersion(),
+		OffchainConfig:        setOCRConfig.GetOffchainConfig(),
+		ConfigCount:           k.GetOCRConfig(ctx, feedId).GetConfigCount() + 1,
+		BlockNumber:           ctx.BlockHeight(),
+	}
+	config.ConfigDigest = types.ComputeConfigDigest(ctx.ChainID(), feedId, config.GetConfigCount(), &config)
+
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	feedInfoStore.Set(types.GetOCRConfigKey(feedId), k.cdc.MustMarshalBinaryBare(&config))
+	feedInfoStore.Delete(types.GetLatestEpochAndRoundKey(feedId))
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// GetOCRConfig returns the active OCR config of a feed, nil if the feed never got configured
+func (k Keeper) GetOCRConfig(ctx sdk.Context, feedId string) *types.OCRConfig {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	bz := feedInfoStore.Get(types.GetOCRConfigKey(feedId))
+	if bz == nil {
+		return nil
+	}
+
+	var config types.OCRConfig
+	k.cdc.MustUnmarshalBinaryBare(bz, &config)
+
+	return &config
+}
+
+func (k Keeper) GetLatestConfigDetails(ctx sdk.Context, req *types.LatestConfigDetailsRequest) (*types.LatestConfigDetailsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if k.GetFeed(ctx, req.GetFeedId()).GetFeed() == nil {
+		return nil, status.Errorf(codes.NotFound, "feed '%s' not found", req.GetFeedId())
+	}
+
+	config := k.GetOCRConfig(ctx, req.GetFeedId())
+
+	return &types.LatestConfigDetailsResponse{
+		ConfigCount:  config.GetConfigCount(),
+		BlockNumber:  config.GetBlockNumber(),
+		ConfigDigest: config.GetConfigDigest(),
+		Config:       config,
+	}, nil
+}
+
+func (k Keeper) SetFeedMetadata(ctx sdk.Context, setFeedMetadata *types.MsgSetFeedMetadata) (int64, []byte, error) {
+	// retrieve feed from store
+	resp := k.GetFeed(ctx, setFeedMetadata.GetFeedId())
+	feed := resp.GetFeed()
+	if feed == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", setFeedMetadata.GetFeedId())
+	}
+
+	// replace feed metadata
+	feed.Metadata = setFeedMetadata.GetMetadata()
+
+	// put back feed in the store
+	k.SetFeed(ctx, feed)
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// GetFeedMetadataByFeedId returns the AggregatorV3 decimals, description and version of a feed along with its metadata
+func (k Keeper) GetFeedMetadataByFeedId(ctx sdk.Context, req *types.GetFeedMetadataRequest) (*types.GetFeedMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	feed := k.GetFeed(ctx, req.GetFeedId()).GetFeed()
+	if feed == nil {
+		return nil, status.Errorf(codes.NotFound, "feed '%s' not found", req.GetFeedId())
+	}
+
+	metadata := feed.GetMetadata()
+	if metadata == nil {
+		metadata = &types.FeedMetadata{}
+	}
+
+	return &types.GetFeedMetadataResponse{
+		FeedId:      feed.GetFeedId(),
+		Decimals:    metadata.GetDecimals(),
+		Description: metadata.Description(feed.GetDesc()),
+		Version:     metadata.GetVersion(),
+		Metadata:    metadata,
+	}, nil
+}
+
+// DistributeReward credits the rewards of a round and the tx fee reimbursement, if the fee reimbursement policy
+// param reimburses it, to the payment ledger of the oracles, out of the feed escrow. When the escrow can not cover
+// them, the shortfall is minted if the feed opted in, otherwise ErrInsufficientFeedFunds is returned before anything
+// is credited. The coins stay in the module account until the oracles withdraw them.
 func (k Keeper) DistributeReward(ctx sdk.Context, msg *types.MsgFeedData, feedRewardDecision []types.RewardPayout, totalRewardVal uint64) error {
-	tokensToMint := types.NewLinkCoinInt64(int64(totalRewardVal) + int64(msg.GetTxFee().GetAmount()))
+	feedId := msg.GetFeedId()
+	txFee := k.txFeeReimbursement(ctx, msg)
+	required := totalRewardVal + txFee
+	balance := k.GetFeedEscrow(ctx, feedId)
+
+	if balance < required {
+		if !k.GetFeed(ctx, feedId).GetFeed().GetMintRewards() {
+			return sdkerrors.Wrapf(types.ErrInsufficientFeedFunds, "feed '%s' escrow holds %d, %d required", feedId, balance, required)
+		}
 
-	// mint new tokens if the source of the transfer is the same chain
-	if err := k.bankKeeper.MintCoins(
-		ctx, types.ModuleName, sdk.NewCoins(tokensToMint),
-	); err != nil {
-		return err
+		// the feed opted in to minted rewards, the module mints what the escrow lacks and drains the escrow
+		shortfall := required - balance
+		if err := k.bankKeeper.MintCoins(
+			ctx, types.ModuleName, k.GetParams(ctx).RewardCoins(shortfall),
+		); err != nil {
+			return err
+		}
+
+		err := types.EmitEvent(&types.MsgFeedUnderfundedEvent{
+			FeedId:   feedId,
+			RoundId:  k.GetLatestRoundId(ctx, feedId),
+			Balance:  balance,
+			Required: required,
+			Minted:   shortfall,
+		}, ctx.EventManager())
+		if err != nil {
+			k.Logger(ctx).Error(err.Error())
+		}
+		balance = required
 	}
 
+	k.setFeedEscrowBalance(ctx, feedId, balance-required)
+
 	OraclePaidEvent := types.MsgOraclePaidEvent{
-		FeedId: msg.FeedId,
+		FeedId: feedId,
 	}
 
-	// distribute reward to each data provider in the current round including submitter
+	// credit reward to each data provider in the current round, the transmitter gets the tx fee reimbursed.
+	// The payouts are withdrawn to the piggy address of the chainlink account of the data provider when it is set.
 	for _, payout := range feedRewardDecision {
 		event := OraclePaidEvent
 
 		dataProvider := payout.DataProvider
 		payoutAmount := payout.Amount
 
-		if dataProvider.GetAddress().String() == msg.Submitter.String() {
-			payoutAmount += msg.GetTxFee().GetAmount()
+		if payout.Role == types.RewardRoleTransmitter {
+			payoutAmount += txFee
 		}
-
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
-			ctx, types.ModuleName, dataProvider.GetAddress(), sdk.NewCoins(types.NewLinkCoinInt64(int64(payoutAmount))),
-		); err != nil {
-			return err
+		if payoutAmount == 0 {
+			continue
 		}
 
+		k.creditPayment(ctx, dataProvider.GetAddress(), feedId, payoutAmount)
+
 		// emit OraclePaid event for valid data providers
 		event.Account = dataProvider.GetAddress()
+		event.Payee = k.GetPayeeAddress(ctx, dataProvider.GetAddress())
 		event.Value = payoutAmount
+		event.Role = payout.Role
 
 		err := types.EmitEvent(&event, ctx.EventManager())
 		if err != nil {
@@ -430,6 +1015,217 @@ func (k Keeper) DistributeReward(ctx sdk.Context, msg *types.MsgFeedData, feedRe
 	return nil
 }
 
+// txFeeReimbursement returns the tx fee reimbursed to the transmitter of a round out of the feed escrow, only the fee
+// paid in the reward denom is reimbursed, up to the maxFeeReimbursement param
+func (k Keeper) txFeeReimbursement(ctx sdk.Context, msg *types.MsgFeedData) uint64 {
+	return k.GetParams(ctx).TxFeeReimbursement(msg.GetTxFee())
+}
+
+// creditPayment adds amount to the payment a feed owes to an oracle
+func (k Keeper) creditPayment(ctx sdk.Context, oracle sdk.AccAddress, feedId string, amount uint64) {
+	accStore := ctx.KVStore(k.accountStoreKey)
+	key := types.GetPaymentKey(oracle, feedId)
+
+	payment := types.OwedPayment{
+		FeedId: feedId,
+		Oracle: oracle,
+	}
+	if bz := accStore.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &payment)
+	}
+	payment.Amount += amount
+
+	accStore.Set(key, k.cdc.MustMarshalBinaryBare(&payment))
+}
+
+// getOwedPayments returns the payments owed to an oracle by a feed, by every feed when feedId is empty
+func (k Keeper) getOwedPayments(ctx sdk.Context, oracle sdk.AccAddress, feedId string) []types.OwedPayment {
+	if oracle.Empty() {
+		return nil
+	}
+	accStore := ctx.KVStore(k.accountStoreKey)
+
+	if feedId != "" {
+		bz := accStore.Get(types.GetPaymentKey(oracle, feedId))
+		if bz == nil {
+			return nil
+		}
+		var payment types.OwedPayment
+		k.cdc.MustUnmarshalBinaryBare(bz, &payment)
+		return []types.OwedPayment{payment}
+	}
+
+	var payments []types.OwedPayment
+	iterator := sdk.KVStorePrefixIterator(accStore, types.GetPaymentPrefix(oracle))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var payment types.OwedPayment
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &payment)
+		payments = append(payments, payment)
+	}
+	return payments
+}
+
+// GetOwedAmount returns the amount of link owed to an oracle by a feed, by every feed when feedId is empty
+func (k Keeper) GetOwedAmount(ctx sdk.Context, oracle sdk.AccAddress, feedId string) uint64 {
+	amount := uint64(0)
+	for _, payment := range k.getOwedPayments(ctx, oracle, feedId) {
+		amount += payment.GetAmount()
+	}
+	return amount
+}
+
+// WithdrawPayment pays the payments owed to the signer by the feed, by every feed when no feed is given,
+// to the payee of the signer and clears them from the ledger
+func (k Keeper) WithdrawPayment(ctx sdk.Context, withdrawPayment *types.MsgWithdrawPayment) (int64, []byte, error) {
+	oracle := withdrawPayment.GetSigner()
+
+	payments := k.getOwedPayments(ctx, oracle, withdrawPayment.GetFeedId())
+	amount := uint64(0)
+	for _, payment := range payments {
+		amount += payment.GetAmount()
+	}
+	if amount == 0 {
+		return 0, nil, sdkerrors.Wrapf(types.ErrNoPaymentOwed, "to %s", oracle)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, k.GetPayeeAddress(ctx, oracle), k.GetParams(ctx).RewardCoins(amount),
+	); err != nil {
+		return 0, nil, err
+	}
+
+	accStore := ctx.KVStore(k.accountStoreKey)
+	for _, payment := range payments {
+		accStore.Delete(types.GetPaymentKey(oracle, payment.GetFeedId()))
+	}
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// GetOwedPaymentList returns the payments owed matching every filter of the request, paginated
+func (k Keeper) GetOwedPaymentList(ctx sdk.Context, req *types.ListOwedPaymentsRequest) (*types.ListOwedPaymentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var payments []*types.OwedPayment
+
+	paymentStore := prefix.NewStore(ctx.KVStore(k.accountStoreKey), types.GetPaymentPrefix(req.GetOracle()))
+
+	pageRes, err := query.FilteredPaginate(paymentStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var payment types.OwedPayment
+		if err := k.cdc.UnmarshalBinaryBare(value, &payment); err != nil {
+			return false, err
+		}
+
+		if req.GetFeedId() != "" && payment.GetFeedId() != req.GetFeedId() {
+			return false, nil
+		}
+
+		if accumulate {
+			payments = append(payments, &payment)
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ListOwedPaymentsResponse{
+		Payments:   payments,
+		Pagination: pageRes,
+	}, nil
+}
+
+// FundFeed moves link from the feed owner to the feed escrow held by the module account
+func (k Keeper) FundFeed(ctx sdk.Context, fundFeed *types.MsgFundFeed) (int64, []byte, error) {
+	feedId := fundFeed.GetFeedId()
+	if k.GetFeed(ctx, feedId).GetFeed() == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", feedId)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx, fundFeed.GetSigner(), types.ModuleName, k.GetParams(ctx).RewardCoins(fundFeed.GetAmount()),
+	); err != nil {
+		return 0, nil, err
+	}
+
+	k.setFeedEscrowBalance(ctx, feedId, k.GetFeedEscrow(ctx, feedId)+fundFeed.GetAmount())
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// WithdrawFeedFunds moves link from the feed escrow back to the feed owner
+func (k Keeper) WithdrawFeedFunds(ctx sdk.Context, withdrawFeedFunds *types.MsgWithdrawFeedFunds) (int64, []byte, error) {
+	feedId := withdrawFeedFunds.GetFeedId()
+	if k.GetFeed(ctx, feedId).GetFeed() == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", feedId)
+	}
+
+	balance := k.GetFeedEscrow(ctx, feedId)
+	if balance < withdrawFeedFunds.GetAmount() {
+		return 0, nil, sdkerrors.Wrapf(types.ErrInsufficientFeedFunds, "feed '%s' escrow holds %d", feedId, balance)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx, types.ModuleName, withdrawFeedFunds.GetSigner(), k.GetParams(ctx).RewardCoins(withdrawFeedFunds.GetAmount()),
+	); err != nil {
+		return 0, nil, err
+	}
+
+	k.setFeedEscrowBalance(ctx, feedId, balance-withdrawFeedFunds.GetAmount())
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// GetFeedEscrow returns the amount of link escrowed to reward the rounds of a feed
+func (k Keeper) GetFeedEscrow(ctx sdk.Context, feedId string) uint64 {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	balance := feedInfoStore.Get(types.GetFeedEscrowKey(feedId))
+	if len(balance) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(balance)
+}
+
+func (k Keeper) setFeedEscrowBalance(ctx sdk.Context, feedId string, balance uint64) {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	feedInfoStore.Set(types.GetFeedEscrowKey(feedId), sdk.Uint64ToBigEndian(balance))
+}
+
+// refundFeedEscrow pays the remaining escrow of a feed back to its owner and drops the escrow,
+// it returns the refunded amount
+func (k Keeper) refundFeedEscrow(ctx sdk.Context, feedId string, feedOwner sdk.AccAddress) (uint64, error) {
+	balance := k.GetFeedEscrow(ctx, feedId)
+	if balance > 0 {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, feedOwner, k.GetParams(ctx).RewardCoins(balance),
+		); err != nil {
+			return 0, err
+		}
+	}
+	ctx.KVStore(k.feedInfoStoreKey).Delete(types.GetFeedEscrowKey(feedId))
+	return balance, nil
+}
+
+func (k Keeper) GetFeedEscrowByFeedId(ctx sdk.Context, req *types.GetFeedEscrowBalanceRequest) (*types.GetFeedEscrowBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if k.GetFeed(ctx, req.GetFeedId()).GetFeed() == nil {
+		return nil, status.Errorf(codes.NotFound, "feed '%s' not found", req.GetFeedId())
+	}
+
+	return &types.GetFeedEscrowBalanceResponse{
+		FeedId:  req.GetFeedId(),
+		Balance: &types.Coin{Denom: k.GetParams(ctx).RewardDenom, Amount: k.GetFeedEscrow(ctx, req.GetFeedId())},
+	}, nil
+}
+
 func (k Keeper) FeedOwnershipTransfer(ctx sdk.Context, feedOwnershipTransfer *types.MsgFeedOwnershipTransfer) (int64, []byte, error) {
 	// retrieve feed from store
 	resp := k.GetFeed(ctx, feedOwnershipTransfer.GetFeedId())
@@ -447,6 +1243,137 @@ func (k Keeper) FeedOwnershipTransfer(ctx sdk.Context, feedOwnershipTransfer *ty
 	return ctx.BlockHeight(), ctx.TxBytes(), nil
 }
 
+// PauseFeed pauses a feed, a paused feed rejects new rounds while its rounds history stays available
+func (k Keeper) PauseFeed(ctx sdk.Context, pauseFeed *types.MsgPauseFeed) (int64, []byte, error) {
+	return k.setFeedPaused(ctx, pauseFeed.GetFeedId(), true)
+}
+
+// UnpauseFeed resumes a paused feed
+func (k Keeper) UnpauseFeed(ctx sdk.Context, unpauseFeed *types.MsgUnpauseFeed) (int64, []byte, error) {
+	return k.setFeedPaused(ctx, unpauseFeed.GetFeedId(), false)
+}
+
+func (k Keeper) setFeedPaused(ctx sdk.Context, feedId string, paused bool) (int64, []byte, error) {
+	// retrieve feed from store
+	resp := k.GetFeed(ctx, feedId)
+	feed := resp.GetFeed()
+	if feed == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", feedId)
+	}
+
+	feed.Paused = paused
+
+	// put back feed in the store
+	k.SetFeed(ctx, feed)
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// DeprecateFeed marks a feed read-only, a deprecated feed neither accepts new rounds nor parameter changes
+// and its heartbeat is unscheduled. Its rounds stay available until the feed gets deleted.
+func (k Keeper) DeprecateFeed(ctx sdk.Context, deprecateFeed *types.MsgDeprecateFeed) (int64, []byte, error) {
+	// retrieve feed from store
+	resp := k.GetFeed(ctx, deprecateFeed.GetFeedId())
+	feed := resp.GetFeed()
+	if feed == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", deprecateFeed.GetFeedId())
+	}
+
+	feed.Deprecated = true
+
+	// put back feed in the store
+	k.SetFeed(ctx, feed)
+
+	k.UnscheduleHeartbeat(ctx, feed.GetFeedId())
+
+	// a deprecated feed is read-only, the ownership transfer pending for it can no longer be accepted
+	k.DeleteFeedOwnershipTransfer(ctx, feed.GetFeedId())
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// DeleteFeed prunes a deprecated feed along with its rounds and leaves a tombstone in its place,
+// so that the feedId can not be re-used by a new feed.
+func (k Keeper) DeleteFeed(ctx sdk.Context, deleteFeed *types.MsgDeleteFeed) (int64, []byte, error) {
+	feedId := deleteFeed.GetFeedId()
+
+	// retrieve feed from store
+	resp := k.GetFeed(ctx, feedId)
+	feed := resp.GetFeed()
+	if feed == nil {
+		return 0, nil, fmt.Errorf("feed '%s' not found", feedId)
+	}
+	if !feed.GetDeprecated() {
+		return 0, nil, fmt.Errorf("feed '%s' must be deprecated before being deleted", feedId)
+	}
+
+	tombstone := types.FeedTombstone{
+		FeedId:          feedId,
+		FeedOwner:       feed.GetFeedOwner(),
+		Desc:            feed.GetDesc(),
+		Metadata:        feed.GetMetadata(),
+		LastRoundId:     k.GetLatestRoundId(ctx, feedId),
+		DeletedAtHeight: ctx.BlockHeight(),
+		DeletedBy:       deleteFeed.GetSigner(),
+	}
+
+	// prune the rounds of the feed
+	feedDataStore := prefix.NewStore(ctx.KVStore(k.feedDataStoreKey), types.GetFeedDataPrefix(feedId))
+	roundKeys := make([][]byte, 0)
+	iterator := feedDataStore.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		roundKeys = append(roundKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range roundKeys {
+		feedDataStore.Delete(key)
+	}
+	ctx.KVStore(k.roundStoreKey).Delete(types.GetRoundIdKey(feedId))
+
+	// the escrow left is paid back to the feed owner
+	refund, err := k.refundFeedEscrow(ctx, feedId, feed.GetFeedOwner())
+	if err != nil {
+		return 0, nil, err
+	}
+	if refund > 0 {
+		err = types.EmitEvent(&types.MsgFeedEscrowChangeEvent{
+			FeedId:     feedId,
+			ChangeType: FeedEscrowChangeTypeRefund,
+			Amount:     refund,
+			Signer:     deleteFeed.GetSigner(),
+		}, ctx.EventManager())
+		if err != nil {
+			k.Logger(ctx).Error(err.Error())
+		}
+	}
+
+	// replace the feed by its tombstone
+	k.UnscheduleHeartbeat(ctx, feedId)
+	k.DeleteFeedOwnershipTransfer(ctx, feedId)
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	feedInfoStore.Delete(types.GetLastUpdateKey(feedId))
+	feedInfoStore.Delete(types.GetLatestEpochAndRoundKey(feedId))
+	feedInfoStore.Delete(types.GetOCRConfigKey(feedId))
+	feedInfoStore.Delete(types.GetFeedInfoKey(feedId))
+	feedInfoStore.Set(types.GetFeedTombstoneKey(feedId), k.cdc.MustMarshalBinaryBare(&tombstone))
+
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// GetFeedTombstone returns the tombstone of a deleted feed, nil if the feed never got deleted
+func (k Keeper) GetFeedTombstone(ctx sdk.Context, feedId string) *types.FeedTombstone {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	bz := feedInfoStore.Get(types.GetFeedTombstoneKey(feedId))
+	if bz == nil {
+		return nil
+	}
+
+	var tombstone types.FeedTombstone
+	k.cdc.MustUnmarshalBinaryBare(bz, &tombstone)
+
+	return &tombstone
+}
+
 // RequestNewRound will be a transaction sent by the FeedOwner to request a new report to the chainlink network
 // The event emitted will expect a data provider to submit a new report.
 func (k Keeper) RequestNewRound(ctx sdk.Context, requestNewRound *types.MsgRequestNewRound) (int64, []byte, error) {
@@ -461,14 +1388,128 @@ func (k Keeper) RequestNewRound(ctx sdk.Context, requestNewRound *types.MsgReque
 	return ctx.BlockHeight(), ctx.TxBytes(), nil
 }
 
-func (k Keeper) AddAccount(ctx sdk.Context, acc *types.MsgAccount) (int64, []byte) {
+// SetLastUpdateTime stores the time (unix milliseconds) of the latest round of a feed
+func (k Keeper) SetLastUpdateTime(ctx sdk.Context, feedId string, updateTime uint64) {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	feedInfoStore.Set(types.GetLastUpdateKey(feedId), i64tob(updateTime))
+}
+
+// GetLastUpdateTime returns the time (unix milliseconds) of the latest round of a feed, 0 if the feed never got updated
+func (k Keeper) GetLastUpdateTime(ctx sdk.Context, feedId string) uint64 {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	lastUpdate := feedInfoStore.Get(types.GetLastUpdateKey(feedId))
+	if len(lastUpdate) == 0 {
+		return 0
+	}
+	return btoi64(lastUpdate)
+}
+
+// GetLatestEpochAndRound returns the packed OCR epoch and round of the latest accepted report of a feed,
+// 0 if the feed never got a report
+func (k Keeper) GetLatestEpochAndRound(ctx sdk.Context, feedId string) uint64 {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	latest := feedInfoStore.Get(types.GetLatestEpochAndRoundKey(feedId))
+	if len(latest) == 0 {
+		return 0
+	}
+	return btoi64(latest)
+}
+
+func (k Keeper) setLatestEpochAndRound(ctx sdk.Context, feedId string, epochAndRound uint64) {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	feedInfoStore.Set(types.GetLatestEpochAndRoundKey(feedId), i64tob(epochAndRound))
+}
+
+// ScheduleHeartbeat (re)schedules the next heartbeat of a feed heartbeatTrigger milliseconds after from.
+// Any previously scheduled heartbeat of the feed is dropped, a zero heartbeatTrigger disables the heartbeat.
+func (k Keeper) ScheduleHeartbeat(ctx sdk.Context, feedId string, heartbeatTrigger uint32, from uint64) {
+	k.UnscheduleHeartbeat(ctx, feedId)
+	if heartbeatTrigger == 0 {
+		return
+	}
+
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	dueTime := from + uint64(heartbeatTrigger)
+	feedInfoStore.Set(types.GetHeartbeatScheduleKey(dueTime, feedId), []byte(feedId))
+	feedInfoStore.Set(types.GetHeartbeatDueKey(feedId), i64tob(dueTime))
+}
+
+// UnscheduleHeartbeat removes the scheduled heartbeat of a feed if any
+func (k Keeper) UnscheduleHeartbeat(ctx sdk.Context, feedId string) {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	dueTime := feedInfoStore.Get(types.GetHeartbeatDueKey(feedId))
+	if len(dueTime) == 0 {
+		return
+	}
+
+	feedInfoStore.Delete(types.GetHeartbeatScheduleKey(btoi64(dueTime), feedId))
+	feedInfoStore.Delete(types.GetHeartbeatDueKey(feedId))
+}
+
+// ProcessHeartbeats emits a MsgNewRoundRequestEvent for every unpaused feed whose heartbeat is due at the current block
+// time, then schedules their next heartbeat. Only the due part of the scheduler index is iterated.
+func (k Keeper) ProcessHeartbeats(ctx sdk.Context) {
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	now := blockTimeMillis(ctx)
+
+	iterator := feedInfoStore.Iterator(types.GetHeartbeatScheduleKey(0, ""), types.GetHeartbeatScheduleKey(now+1, ""))
+	dueFeedIds := make([]string, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		dueFeedIds = append(dueFeedIds, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, feedId := range dueFeedIds {
+		feed := k.GetFeed(ctx, feedId).GetFeed()
+		if feed == nil {
+			k.UnscheduleHeartbeat(ctx, feedId)
+			continue
+		}
+
+		// a paused feed keeps its heartbeat schedule without requesting new rounds
+		if !feed.GetPaused() {
+			err := types.EmitEvent(&types.MsgNewRoundRequestEvent{
+				FeedId: feedId,
+			}, ctx.EventManager())
+			if err != nil {
+				k.Logger(ctx).Error("failed to emit heartbeat MsgNewRoundRequestEvent: ", err.Error())
+			}
+		}
+
+		k.ScheduleHeartbeat(ctx, feedId, feed.GetHeartbeatTrigger(), now)
+	}
+}
+
+// AddAccount stores the chainlink account and indexes its chainlink public and signing keys.
+// A chainlink key can only be registered under a single cosmos address, whatever its encoding.
+func (k Keeper) AddAccount(ctx sdk.Context, acc *types.MsgAccount) (int64, []byte, error) {
 	accStore := ctx.KVStore(k.accountStoreKey)
 
+	chainlinkKeys := [][]byte{acc.GetChainlinkPublicKey(), acc.GetChainlinkSigningKey()}
+	for _, chainlinkKey := range chainlinkKeys {
+		if owner := k.GetAccountAddressByChainlinkKey(ctx, chainlinkKey); owner != nil && !owner.Equals(acc.GetSubmitter()) {
+			return 0, nil, sdkerrors.Wrapf(types.ErrChainlinkKeyRegistered, "chainlink key %s is registered under %s", chainlinkKey, owner)
+		}
+	}
+
 	a := k.cdc.MustMarshalBinaryBare(acc)
 
 	accStore.Set(types.GetAccountKey(acc.GetSubmitter().String()), a)
+	for _, chainlinkKey := range chainlinkKeys {
+		accStore.Set(types.GetChainlinkKeyIndexKey(chainlinkKey), acc.GetSubmitter())
+	}
 
-	return ctx.BlockHeight(), ctx.TxBytes()
+	return ctx.BlockHeight(), ctx.TxBytes(), nil
+}
+
+// GetAccountAddressByChainlinkKey returns the cosmos address the chainlink public or signing key is registered under,
+// nil if the key is not registered
+func (k Keeper) GetAccountAddressByChainlinkKey(ctx sdk.Context, chainlinkKey []byte) sdk.AccAddress {
+	if len(chainlinkKey) == 0 {
+		return nil
+	}
+	accStore := ctx.KVStore(k.accountStoreKey)
+	return accStore.Get(types.GetChainlinkKeyIndexKey(chainlinkKey))
 }
 
 func (k Keeper) EditAccount(ctx sdk.Context, acc *types.MsgEditAccount) (int64, []byte, error) {
@@ -488,6 +1529,24 @@ func (k Keeper) EditAccount(ctx sdk.Context, acc *types.MsgEditAccount) (int64,
 	return ctx.BlockHeight(), ctx.TxBytes(), nil
 }
 
+// GetPayeeAddress returns the address the rewards and fee reimbursements of an oracle are paid to: the piggy address
+// of the chainlink account registered under the oracle address, the oracle address itself when it has none
+func (k Keeper) GetPayeeAddress(ctx sdk.Context, oracle sdk.AccAddress) sdk.AccAddress {
+	accStore := ctx.KVStore(k.accountStoreKey)
+	accountBytes := accStore.Get(types.GetAccountKey(oracle.String()))
+	if accountBytes == nil {
+		return oracle
+	}
+
+	var account types.MsgAccount
+	k.cdc.MustUnmarshalBinaryBare(accountBytes, &account)
+	if account.GetPiggyAddress().Empty() {
+		return oracle
+	}
+
+	return account.GetPiggyAddress()
+}
+
 func (k Keeper) GetAccount(ctx sdk.Context, accReq *types.GetAccountRequest) *types.GetAccountResponse {
 	acc := accReq.AccountAddress.String()
 	accStore := ctx.KVStore(k.accountStoreKey)
@@ -501,13 +1560,70 @@ func (k Keeper) GetAccount(ctx sdk.Context, accReq *types.GetAccountRequest) *ty
 	}
 }
 
+// LookupAccountByChainlinkKey returns the chainlink account the chainlink public or signing key is registered under
+func (k Keeper) LookupAccountByChainlinkKey(ctx sdk.Context, req *types.GetAccountByChainlinkKeyRequest) (*types.GetAccountResponse, error) {
+	if req == nil || len(req.GetChainlinkKey()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	accAddr := k.GetAccountAddressByChainlinkKey(ctx, req.GetChainlinkKey())
+	if accAddr == nil {
+		return &types.GetAccountResponse{
+			Account: &types.MsgAccount{},
+		}, nil
+	}
+
+	return k.GetAccount(ctx, &types.GetAccountRequest{AccountAddress: accAddr}), nil
+}
+
+// GetAccountList returns the registered chainlink accounts, paginated
+func (k Keeper) GetAccountList(ctx sdk.Context, req *types.ListAccountsRequest) (*types.ListAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var accounts []*types.MsgAccount
+
+	accountStore := prefix.NewStore(ctx.KVStore(k.accountStoreKey), types.GetAccountKey(""))
+
+	pageRes, err := query.Paginate(accountStore, req.Pagination, func(key []byte, value []byte) error {
+		var account types.MsgAccount
+		if err := k.cdc.UnmarshalBinaryBare(value, &account); err != nil {
+			return err
+		}
+
+		accounts = append(accounts, &account)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ListAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GetRegisteredFeedRewardStrategies returns the registered reward strategies along with their description, in name order
 func (k Keeper) GetRegisteredFeedRewardStrategies(_ sdk.Context) *types.GetFeedRewardAvailStrategiesResponse {
 	availStrategies := make([]string, 0, len(types.FeedRewardStrategyConvertor))
 	for name := range types.FeedRewardStrategyConvertor {
 		availStrategies = append(availStrategies, name)
 	}
+	sort.Strings(availStrategies)
+
+	strategies := make([]*types.FeedRewardStrategyInfo, 0, len(availStrategies))
+	for _, name := range availStrategies {
+		strategies = append(strategies, &types.FeedRewardStrategyInfo{
+			Name:        name,
+			Description: types.FeedRewardStrategyDescriptions[name],
+		})
+	}
 
 	return &types.GetFeedRewardAvailStrategiesResponse{
 		AvailStrategies: availStrategies,
+		Strategies:      strategies,
 	}
 }
@@ -4,9 +4,12 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -15,6 +18,11 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -29,6 +37,8 @@ func setupKeeper(t testing.TB) (*Keeper, sdk.Context) {
 	feedInfoStoreKey := sdk.NewKVStoreKey(types.FeedInfoStoreKey)
 	accountStoreKey := sdk.NewKVStoreKey(types.AccountStoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTStoreKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
@@ -38,19 +48,21 @@ func setupKeeper(t testing.TB) (*Keeper, sdk.Context) {
 	stateStore.MountStoreWithDB(feedInfoStoreKey, sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(accountStoreKey, sdk.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, sdk.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(paramsStoreKey, sdk.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTStoreKey, sdk.StoreTypeTransient, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
+	paramSpace := paramtypes.NewSubspace(codec.NewProtoCodec(registry), codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName)
 	// TODO: do i need to replace nil -> bankKeeper? not quite sure if that can be exposed from this level
-	keeper := NewKeeper(codec.NewProtoCodec(registry), nil, feedDataStoreKey, roundStoreKey, moduleOwnerStoreKey, feedInfoStoreKey, accountStoreKey, memStoreKey)
+	keeper := NewKeeper(codec.NewProtoCodec(registry), nil, paramSpace, feedDataStoreKey, roundStoreKey, moduleOwnerStoreKey, feedInfoStoreKey, accountStoreKey, memStoreKey)
 
-	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{Height: testBlockHeight, Time: testBlockTime}, false, log.NewNopLogger())
 	return keeper, ctx
 }
 
 func TestFeedKeyStructure(t *testing.T) {
 	k, ctx := setupKeeper(t)
-	roundStore := ctx.KVStore(k.roundStoreKey)
 	feedStore := ctx.KVStore(k.feedDataStoreKey)
 
 	testCases := []struct {
@@ -67,11 +79,12 @@ func TestFeedKeyStructure(t *testing.T) {
 	for _, tc := range testCases {
 		for _, roundId := range tc.roundIds {
 			// force set roundId-1 for SetFeedData
-			roundStore.Set(types.GetRoundIdKey(tc.feedId), i64tob(roundId-1))
+			k.setLatestRoundId(ctx, tc.feedId, roundId-1)
 
 			feedData := types.MsgFeedData{
 				FeedId:    tc.feedId,
 				Submitter: []byte(fmt.Sprintf("%s/%d", tc.feedId, roundId)),
+				Report:    GenerateReport(t, int64(roundId)),
 			}
 
 			_, _, err := k.SetFeedData(ctx, &feedData)
@@ -83,7 +96,7 @@ func TestFeedKeyStructure(t *testing.T) {
 	for _, tc := range testCases {
 		testName := fmt.Sprintf("feed:%s,round:%v", tc.feedId, tc.roundIds)
 		t.Run(testName, func(t *testing.T) {
-			prefixKey := types.GetFeedDataKey(tc.feedId, "")
+			prefixKey := types.GetFeedDataPrefix(tc.feedId)
 			//fmt.Println("[DEBUG] search for key", string(prefixKey))
 
 			iterator := sdk.KVStorePrefixIterator(feedStore, prefixKey)
@@ -123,20 +136,21 @@ func TestKeeper_SetFeedData(t *testing.T) {
 		testName := fmt.Sprintf("feed:%s,round:%d", tc.feedId, tc.roundId)
 		t.Run(testName, func(t *testing.T) {
 			// force set roundId-1 for SetFeedData
-			roundStore.Set(types.GetRoundIdKey(tc.feedId), i64tob(tc.roundId-1))
+			k.setLatestRoundId(ctx, tc.feedId, tc.roundId-1)
 
 			msgFeedData := types.MsgFeedData{
 				FeedId: tc.feedId,
+				Report: GenerateReport(t, int64(tc.roundId)),
 			}
 
 			_, _, err := k.SetFeedData(ctx, &msgFeedData)
 			require.NoError(t, err)
 
 			roundId := roundStore.Get(types.GetRoundIdKey(tc.feedId))
-			require.Equal(t, i64tob(tc.roundId), roundId)
+			require.Equal(t, sdk.Uint64ToBigEndian(tc.roundId), roundId)
 
 			var feedData types.OCRFeedDataInStore
-			value := feedDateStore.Get(types.GetFeedDataKey(tc.feedId, strconv.FormatUint(tc.roundId, 10)))
+			value := feedDateStore.Get(types.GetFeedDataKey(tc.feedId, tc.roundId))
 			err = k.cdc.UnmarshalBinaryBare(value, &feedData)
 			require.NoError(t, err)
 			require.Equal(t, tc.feedId, feedData.GetFeedData().GetFeedId())
@@ -144,9 +158,47 @@ func TestKeeper_SetFeedData(t *testing.T) {
 	}
 }
 
+func TestKeeper_SetFeedData_ValueSchema(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	feed := &types.MsgFeed{
+		FeedId:                    "status",
+		DeviationThresholdTrigger: 1000,
+		ValueSchema:               &types.FeedValueSchema{Type: types.FeedValueTypeString},
+	}
+	k.SetFeed(ctx, feed)
+
+	submit := func(epoch uint32, observations ...string) error {
+		values := make([]*types.FeedValue, 0, len(observations))
+		observers := make([]byte, 0, len(observations))
+		for i, o := range observations {
+			values = append(values, types.NewStringFeedValue(o))
+			observers = append(observers, byte(i))
+		}
+		report, err := types.EncodeFeedReport(types.NewOCRReportContext(nil, epoch, 1), observers, feed.GetValueSchema(), values)
+		require.NoError(t, err)
+		_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "status", Report: report})
+		return err
+	}
+
+	// the round is answered with the most observed value, the deviation threshold does not apply to string feeds
+	require.NoError(t, submit(1, "open", "closed", "open"))
+	require.NoError(t, submit(2, "open", "open", "open"))
+
+	latest, err := k.GetLatestRoundFeedDataByFilter(ctx, &types.GetLatestRoundDataRequest{FeedId: "status"})
+	require.NoError(t, err)
+	require.Len(t, latest.GetRoundData(), 1)
+	require.Equal(t, types.NewStringFeedValue("open"), latest.GetRoundData()[0].GetValue())
+	require.Equal(t, "open", latest.GetRoundData()[0].AnswerValue().GetText())
+	require.True(t, latest.GetRoundData()[0].Answer.IsZero())
+
+	// a report of another shape than the feed schema never gets a round
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "status", Report: GenerateReport(t, 1, 2, 3)})
+	require.ErrorIs(t, err, types.ErrInvalidOCRReport)
+}
+
 func TestKeeper_GetRoundFeedDataByFilter(t *testing.T) {
 	k, ctx := setupKeeper(t)
-	roundStore := ctx.KVStore(k.roundStoreKey)
 
 	testCases := []struct {
 		feedId    string
@@ -167,12 +219,13 @@ func TestKeeper_GetRoundFeedDataByFilter(t *testing.T) {
 			continue
 		}
 		// force set roundId-1 for SetFeedData
-		roundStore.Set(types.GetRoundIdKey(tc.feedId), i64tob(tc.roundId-1))
+		k.setLatestRoundId(ctx, tc.feedId, tc.roundId-1)
 
 		msgFeedData := types.MsgFeedData{
 			FeedId:              tc.feedId,
 			ObservationFeedData: tc.feedData,
 			Submitter:           tc.submitter,
+			Report:              GenerateReport(t, int64(tc.roundId), int64(tc.roundId)+1, int64(tc.roundId)+2),
 		}
 
 		_, _, err := k.SetFeedData(ctx, &msgFeedData)
@@ -194,13 +247,20 @@ func TestKeeper_GetRoundFeedDataByFilter(t *testing.T) {
 
 			if tc.insert {
 				require.Equal(t, 1, len(roundData))
-				require.Equal(t, strconv.FormatUint(tc.roundId, 10), string(roundData[0].GetFeedData().Context))
 				require.Equal(t, tc.feedId, roundData[0].FeedId)
-				require.Equal(t, tc.submitter, roundData[0].FeedData.Oracles)
+				require.Equal(t, tc.roundId, roundData[0].GetRoundId())
+				require.Equal(t, sdk.NewIntFromUint64(tc.roundId+1), roundData[0].Answer)
+				require.Equal(t, uint64(testBlockTime.Unix()), roundData[0].GetStartedAt())
+				require.Equal(t, uint64(testBlockTime.Unix()), roundData[0].GetUpdatedAt())
+				require.Equal(t, tc.roundId, roundData[0].GetAnsweredInRound())
+				require.Equal(t, testBlockHeight, roundData[0].GetBlockHeight())
+				require.Equal(t, types.OCRReportContextLength, len(roundData[0].GetFeedData().GetContext()))
+				require.Equal(t, []byte{0, 1, 2}, roundData[0].GetFeedData().GetOracles())
 
 				observations := roundData[0].GetFeedData().GetObservations()
-				for i := 0; i < len(tc.feedData); i++ {
-					require.Equal(t, string(tc.feedData[i]), string(observations[i].Data[0]))
+				require.Equal(t, 3, len(observations))
+				for i, o := range observations {
+					require.Equal(t, sdk.NewIntFromUint64(tc.roundId+uint64(i)), o.Value)
 				}
 			} else {
 				require.Equal(t, 0, len(roundData))
@@ -209,10 +269,99 @@ func TestKeeper_GetRoundFeedDataByFilter(t *testing.T) {
 	}
 }
 
-func TestKeeper_GetLatestRoundFeedDataByFilter(t *testing.T) {
+func TestKeeper_GetRoundFeedDataHistory(t *testing.T) {
 	k, ctx := setupKeeper(t)
 
-	roundStore := ctx.KVStore(k.roundStoreKey)
+	// feed1 gets rounds 1..10 a minute apart, feed11 shares its key prefix but must never show up
+	for i := int64(1); i <= 10; i++ {
+		roundCtx := ctx.WithBlockTime(testBlockTime.Add(time.Duration(i) * time.Minute))
+		_, _, err := k.SetFeedData(roundCtx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReport(t, i)})
+		require.NoError(t, err)
+		_, _, err = k.SetFeedData(roundCtx, &types.MsgFeedData{FeedId: "feed11", Report: GenerateReport(t, i)})
+		require.NoError(t, err)
+	}
+	roundTime := func(roundId uint64) uint64 {
+		return uint64(testBlockTime.Add(time.Duration(roundId) * time.Minute).Unix())
+	}
+
+	testCases := []struct {
+		name     string
+		req      *types.GetRoundHistoryRequest
+		expected []uint64
+		nextKey  []byte
+		total    uint64
+	}{
+		{name: "whole history", req: &types.GetRoundHistoryRequest{FeedId: "feed1"}, expected: []uint64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+		{name: "round range", req: &types.GetRoundHistoryRequest{FeedId: "feed1", FromRound: 3, ToRound: 5}, expected: []uint64{3, 4, 5}},
+		{name: "round range beyond latest round", req: &types.GetRoundHistoryRequest{FeedId: "feed1", FromRound: 9, ToRound: 20}, expected: []uint64{9, 10}},
+		{name: "time window", req: &types.GetRoundHistoryRequest{FeedId: "feed1", StartTime: roundTime(4), EndTime: roundTime(6)}, expected: []uint64{4, 5, 6}},
+		{name: "reverse", req: &types.GetRoundHistoryRequest{FeedId: "feed1", FromRound: 2, ToRound: 4, Reverse: true}, expected: []uint64{4, 3, 2}},
+		{
+			name:     "first page",
+			req:      &types.GetRoundHistoryRequest{FeedId: "feed1", Pagination: &query.PageRequest{Limit: 4, CountTotal: true}},
+			expected: []uint64{1, 2, 3, 4},
+			nextKey:  sdk.Uint64ToBigEndian(5),
+			total:    10,
+		},
+		{
+			name:     "page by key",
+			req:      &types.GetRoundHistoryRequest{FeedId: "feed1", Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(5), Limit: 4}},
+			expected: []uint64{5, 6, 7, 8},
+			nextKey:  sdk.Uint64ToBigEndian(9),
+		},
+		{
+			name:     "last page",
+			req:      &types.GetRoundHistoryRequest{FeedId: "feed1", Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(9), Limit: 4}},
+			expected: []uint64{9, 10},
+		},
+		{
+			name:     "page by offset within the time window",
+			req:      &types.GetRoundHistoryRequest{FeedId: "feed1", StartTime: roundTime(3), Pagination: &query.PageRequest{Offset: 2, Limit: 2}},
+			expected: []uint64{5, 6},
+			nextKey:  sdk.Uint64ToBigEndian(7),
+		},
+		{
+			name:     "reverse page by key",
+			req:      &types.GetRoundHistoryRequest{FeedId: "feed1", Reverse: true, Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(6), Limit: 3}},
+			expected: []uint64{6, 5, 4},
+			nextKey:  sdk.Uint64ToBigEndian(3),
+		},
+		{name: "unknown feed", req: &types.GetRoundHistoryRequest{FeedId: "feed2"}, expected: []uint64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := k.GetRoundFeedDataHistory(ctx, tc.req)
+			require.NoError(t, err)
+
+			roundIds := make([]uint64, 0, len(resp.GetRoundData()))
+			for _, roundData := range resp.GetRoundData() {
+				require.Equal(t, "feed1", roundData.GetFeedId())
+				require.Equal(t, roundTime(roundData.GetRoundId()), roundData.GetUpdatedAt())
+				roundIds = append(roundIds, roundData.GetRoundId())
+			}
+			require.Equal(t, tc.expected, roundIds)
+			require.Equal(t, tc.nextKey, resp.GetPagination().GetNextKey())
+			require.Equal(t, tc.total, resp.GetPagination().GetTotal())
+		})
+	}
+
+	invalidRequests := []*types.GetRoundHistoryRequest{
+		nil,
+		{},
+		{FeedId: "feed1", FromRound: 5, ToRound: 4},
+		{FeedId: "feed1", StartTime: 10, EndTime: 9},
+		{FeedId: "feed1", Pagination: &query.PageRequest{Key: []byte{1}}},
+		{FeedId: "feed1", Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(1), Offset: 1}},
+	}
+	for _, req := range invalidRequests {
+		_, err := k.GetRoundFeedDataHistory(ctx, req)
+		require.Error(t, err)
+	}
+}
+
+func TestKeeper_GetLatestRoundFeedDataByFilter(t *testing.T) {
+	k, ctx := setupKeeper(t)
 
 	testCases := []struct {
 		feedId    string
@@ -235,12 +384,13 @@ func TestKeeper_GetLatestRoundFeedDataByFilter(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			if tc.insert {
 				// force set roundId-1 for SetFeedData
-				roundStore.Set(types.GetRoundIdKey(tc.feedId), i64tob(tc.roundId-1))
+				k.setLatestRoundId(ctx, tc.feedId, tc.roundId-1)
 
 				msgFeedData := types.MsgFeedData{
 					FeedId:              tc.feedId,
 					ObservationFeedData: tc.feedData,
 					Submitter:           tc.submitter,
+					Report:              GenerateReport(t, int64(tc.roundId)),
 				}
 
 				_, _, err := k.SetFeedData(ctx, &msgFeedData)
@@ -257,8 +407,9 @@ func TestKeeper_GetLatestRoundFeedDataByFilter(t *testing.T) {
 			// if roundId is expected
 			if tc.expected > 0 {
 				require.Equal(t, 1, len(roundData))
-				require.Equal(t, strconv.FormatUint(tc.expected, 10), string(roundData[0].GetFeedData().Context))
 				require.Equal(t, tc.feedId, roundData[0].FeedId)
+				require.Equal(t, tc.expected, roundData[0].GetRoundId())
+				require.Equal(t, sdk.NewIntFromUint64(tc.expected), roundData[0].Answer)
 			} else {
 				require.Equal(t, 0, len(roundData))
 			}
@@ -268,7 +419,6 @@ func TestKeeper_GetLatestRoundFeedDataByFilter(t *testing.T) {
 
 func TestKeeper_GetLatestRoundId(t *testing.T) {
 	k, ctx := setupKeeper(t)
-	roundStore := ctx.KVStore(k.roundStoreKey)
 
 	testCases := []struct {
 		name    string
@@ -288,7 +438,7 @@ func TestKeeper_GetLatestRoundId(t *testing.T) {
 		testName := fmt.Sprintf("feed:%s,round:%d", tc.feedId, tc.roundId)
 		t.Run(testName, func(t *testing.T) {
 			if tc.insert {
-				roundStore.Set(types.GetRoundIdKey(tc.feedId), i64tob(tc.roundId))
+				k.setLatestRoundId(ctx, tc.feedId, tc.roundId)
 			}
 
 			latestRoundId := k.GetLatestRoundId(ctx, tc.feedId)
@@ -427,6 +577,68 @@ func TestKeeper_SetAndGetFeed(t *testing.T) {
 	require.Equal(t, feedToInsert.GetModuleOwnerAddress(), result.GetFeed().GetModuleOwnerAddress())
 }
 
+func TestKeeper_GetFeedsByFilter(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	owner1, owner2 := GenerateAccount(), GenerateAccount()
+	provider1, provider2 := GenerateAccount(), GenerateAccount()
+
+	feeds := []types.MsgFeed{
+		{FeedId: "feed1", FeedOwner: owner1, DataProviders: types.DataProviders{{Address: provider1}}, FeedReward: &types.FeedRewardSchema{Amount: 1, Strategy: "none"}},
+		{FeedId: "feed2", FeedOwner: owner1, DataProviders: types.DataProviders{{Address: provider1}, {Address: provider2}}, FeedReward: &types.FeedRewardSchema{Amount: 1}},
+		{FeedId: "feed3", FeedOwner: owner2, DataProviders: types.DataProviders{{Address: provider2}}, FeedReward: &types.FeedRewardSchema{Amount: 1, Strategy: "none"}},
+		{FeedId: "feed4", FeedOwner: owner2, DataProviders: types.DataProviders{{Address: provider2}}, FeedReward: &types.FeedRewardSchema{Amount: 1}},
+	}
+	for i := range feeds {
+		k.SetFeed(ctx, &feeds[i])
+	}
+	// feed info store entries which are not feeds must not be listed
+	k.SetLastUpdateTime(ctx, "feed1", 1)
+	k.ScheduleHeartbeat(ctx, "feed1", 1, 1)
+
+	testCases := []struct {
+		name     string
+		req      *types.ListFeedsRequest
+		expected []string
+	}{
+		{name: "no filter", req: &types.ListFeedsRequest{}, expected: []string{"feed1", "feed2", "feed3", "feed4"}},
+		{name: "feed owner", req: &types.ListFeedsRequest{FeedOwner: owner2}, expected: []string{"feed3", "feed4"}},
+		{name: "data provider", req: &types.ListFeedsRequest{DataProvider: provider2}, expected: []string{"feed2", "feed3", "feed4"}},
+		{name: "reward strategy", req: &types.ListFeedsRequest{FeedRewardStrategy: "none"}, expected: []string{"feed1", "feed3"}},
+		{name: "combined filters", req: &types.ListFeedsRequest{FeedOwner: owner1, DataProvider: provider2}, expected: []string{"feed2"}},
+		{name: "no match", req: &types.ListFeedsRequest{FeedOwner: provider1}, expected: []string{}},
+		{name: "paginated filter", req: &types.ListFeedsRequest{DataProvider: provider2, Pagination: &query.PageRequest{Limit: 2}}, expected: []string{"feed2", "feed3"}},
+		{name: "paginated filter with offset", req: &types.ListFeedsRequest{DataProvider: provider2, Pagination: &query.PageRequest{Offset: 2, Limit: 2}}, expected: []string{"feed4"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := k.GetFeedsByFilter(ctx, tc.req)
+			require.NoError(t, err)
+
+			feedIds := make([]string, 0, len(resp.GetFeeds()))
+			for _, feed := range resp.GetFeeds() {
+				feedIds = append(feedIds, feed.GetFeedId())
+			}
+			require.Equal(t, tc.expected, feedIds)
+		})
+	}
+
+	// the next page starts right after the last listed feed
+	resp, err := k.GetFeedsByFilter(ctx, &types.ListFeedsRequest{DataProvider: provider2, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.NotNil(t, resp.GetPagination().GetNextKey())
+
+	resp, err = k.GetFeedsByFilter(ctx, &types.ListFeedsRequest{DataProvider: provider2, Pagination: &query.PageRequest{Key: resp.GetPagination().GetNextKey(), Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.GetFeeds()))
+	require.Equal(t, "feed4", resp.GetFeeds()[0].GetFeedId())
+	require.Nil(t, resp.GetPagination().GetNextKey())
+
+	_, err = k.GetFeedsByFilter(ctx, nil)
+	require.Error(t, err)
+}
+
 func TestKeeper_AddDataProvider(t *testing.T) {
 	k, ctx := setupKeeper(t)
 
@@ -507,6 +719,47 @@ func TestKeeper_RemoveDataProvider(t *testing.T) {
 	}, result.GetFeed().GetDataProviders())
 }
 
+func TestKeeper_AddRemoveTransmitter(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	dataProvider := GenerateAccount()
+	transmitter1 := GenerateAccount()
+	transmitter2 := GenerateAccount()
+
+	feedToInsert := types.MsgFeed{
+		FeedId: "feed1",
+		DataProviders: types.DataProviders{
+			{Address: dataProvider},
+		},
+	}
+
+	// without transmitters the data providers transmit their own reports
+	k.SetFeed(ctx, &feedToInsert)
+	require.True(t, k.GetFeed(ctx, "feed1").GetFeed().IsTransmitter(dataProvider))
+	require.False(t, k.GetFeed(ctx, "feed1").GetFeed().IsTransmitter(transmitter1))
+
+	_, _, err := k.AddTransmitter(ctx, &types.MsgAddTransmitter{FeedId: "feed1", Address: transmitter1})
+	require.NoError(t, err)
+	_, _, err = k.AddTransmitter(ctx, &types.MsgAddTransmitter{FeedId: "feed1", Address: transmitter2})
+	require.NoError(t, err)
+
+	// once transmitters are set, only they can transmit
+	feed := k.GetFeed(ctx, "feed1").GetFeed()
+	require.Equal(t, []sdk.AccAddress{transmitter1, transmitter2}, feed.GetTransmitters())
+	require.True(t, feed.IsTransmitter(transmitter1))
+	require.False(t, feed.IsTransmitter(dataProvider))
+	require.EqualValues(t, feedToInsert.GetDataProviders(), feed.GetDataProviders())
+
+	_, _, err = k.RemoveTransmitter(ctx, &types.MsgRemoveTransmitter{FeedId: "feed1", Address: transmitter1})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{transmitter2}, k.GetFeed(ctx, "feed1").GetFeed().GetTransmitters())
+
+	_, _, err = k.AddTransmitter(ctx, &types.MsgAddTransmitter{FeedId: "feed2", Address: transmitter1})
+	require.Error(t, err)
+	_, _, err = k.RemoveTransmitter(ctx, &types.MsgRemoveTransmitter{FeedId: "feed2", Address: transmitter1})
+	require.Error(t, err)
+}
+
 func TestKeeper_ModifyFeedInfo(t *testing.T) {
 	k, ctx := setupKeeper(t)
 
@@ -579,8 +832,335 @@ func TestKeeper_ModifyFeedInfo(t *testing.T) {
 	}
 }
 
+func TestKeeper_SetFeedMetadata(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1"})
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed2", Desc: "feed 2 description"})
+
+	// feed without metadata
+	resp, err := k.GetFeedMetadataByFeedId(ctx, &types.GetFeedMetadataRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, "feed1", resp.GetFeedId())
+	require.Equal(t, uint32(0), resp.GetDecimals())
+	require.Equal(t, "", resp.GetDescription())
+	require.Equal(t, &types.FeedMetadata{}, resp.GetMetadata())
+
+	metadata := &types.FeedMetadata{Decimals: 8, Version: 3, BaseAsset: "ATOM", QuoteAsset: "USD", Unit: "usd"}
+
+	_, _, err = k.SetFeedMetadata(ctx, &types.MsgSetFeedMetadata{FeedId: "feed1", Metadata: metadata})
+	require.NoError(t, err)
+	_, _, err = k.SetFeedMetadata(ctx, &types.MsgSetFeedMetadata{FeedId: "feed2", Metadata: metadata})
+	require.NoError(t, err)
+
+	// description falls back to the asset pair
+	resp, err = k.GetFeedMetadataByFeedId(ctx, &types.GetFeedMetadataRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(8), resp.GetDecimals())
+	require.Equal(t, uint64(3), resp.GetVersion())
+	require.Equal(t, "ATOM / USD", resp.GetDescription())
+	require.Equal(t, metadata, resp.GetMetadata())
+
+	// feed description takes precedence
+	resp, err = k.GetFeedMetadataByFeedId(ctx, &types.GetFeedMetadataRequest{FeedId: "feed2"})
+	require.NoError(t, err)
+	require.Equal(t, "feed 2 description", resp.GetDescription())
+
+	// unknown feed
+	_, _, err = k.SetFeedMetadata(ctx, &types.MsgSetFeedMetadata{FeedId: "feed3", Metadata: metadata})
+	require.Error(t, err)
+	_, err = k.GetFeedMetadataByFeedId(ctx, &types.GetFeedMetadataRequest{FeedId: "feed3"})
+	require.Error(t, err)
+}
+
 func TestKeeper_DistributeReward(t *testing.T) {
-	t.Skip("TODO")
+	k, ctx := setupKeeper(t)
+	bank := newFakeBankKeeper()
+	k.bankKeeper = bank
+
+	feedOwner := GenerateAccount()
+	signer1 := GenerateAccount()
+	signer2 := GenerateAccount()
+	transmitter := GenerateAccount()
+
+	// the rewards and the tx fee are paid out of the feed escrow
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner})
+	bank.accountBalances[feedOwner.String()] = sdk.NewCoins(types.NewLinkCoinInt64(13))
+	_, _, err := k.FundFeed(ctx, types.NewMsgFundFeed(feedOwner, "feed1", 13))
+	require.NoError(t, err)
+
+	msg := &types.MsgFeedData{
+		FeedId:    "feed1",
+		Submitter: transmitter,
+		TxFee:     &types.Coin{Denom: types.LinkDenom, Amount: 3},
+	}
+	payouts := []types.RewardPayout{
+		{DataProvider: &types.DataProvider{Address: signer1}, Amount: 5, Role: types.RewardRoleSigner},
+		{DataProvider: &types.DataProvider{Address: signer2}, Amount: 5, Role: types.RewardRoleSigner},
+		{DataProvider: &types.DataProvider{Address: transmitter}, Amount: 0, Role: types.RewardRoleTransmitter},
+	}
+
+	err = k.DistributeReward(ctx, msg, payouts, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), k.GetFeedEscrow(ctx, "feed1"))
+
+	// signers get their reward credited, the transmitter gets the tx fee reimbursement credited
+	require.Equal(t, uint64(5), k.GetOwedAmount(ctx, signer1, "feed1"))
+	require.Equal(t, uint64(5), k.GetOwedAmount(ctx, signer2, "feed1"))
+	require.Equal(t, uint64(3), k.GetOwedAmount(ctx, transmitter, "feed1"))
+	require.True(t, bank.accountBalances[signer1.String()].IsZero())
+	require.Equal(t, int64(13), bank.moduleBalances[types.ModuleName].AmountOf(types.LinkDenom).Int64())
+
+	// the credits are paid when withdrawn
+	for _, oracle := range []sdk.AccAddress{signer1, signer2, transmitter} {
+		_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(oracle, ""))
+		require.NoError(t, err)
+		require.Equal(t, uint64(0), k.GetOwedAmount(ctx, oracle, ""))
+	}
+	require.Equal(t, int64(5), bank.accountBalances[signer1.String()].AmountOf(types.LinkDenom).Int64())
+	require.Equal(t, int64(5), bank.accountBalances[signer2.String()].AmountOf(types.LinkDenom).Int64())
+	require.Equal(t, int64(3), bank.accountBalances[transmitter.String()].AmountOf(types.LinkDenom).Int64())
+	require.True(t, bank.moduleBalances[types.ModuleName].IsZero())
+
+	// every payout emits an OraclePaid event carrying its role
+	var roles []string
+	for _, event := range ctx.EventManager().Events() {
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == "role" {
+				roles = append(roles, string(attribute.Value))
+			}
+		}
+	}
+	require.Len(t, roles, 3)
+	require.Contains(t, roles, "\"signer\"")
+	require.Contains(t, roles, "\"transmitter\"")
+}
+
+func TestKeeper_DistributeReward_TxFeeReimbursement(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	bank := newFakeBankKeeper()
+	k.bankKeeper = bank
+
+	params := k.GetParams(ctx)
+	params.MaxFeeReimbursement = 5
+	k.SetParams(ctx, params)
+
+	feedOwner := GenerateAccount()
+	transmitter := GenerateAccount()
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner})
+	bank.accountBalances[feedOwner.String()] = sdk.NewCoins(types.NewLinkCoinInt64(100))
+	_, _, err := k.FundFeed(ctx, types.NewMsgFundFeed(feedOwner, "feed1", 100))
+	require.NoError(t, err)
+
+	payouts := []types.RewardPayout{
+		{DataProvider: &types.DataProvider{Address: transmitter}, Amount: 0, Role: types.RewardRoleTransmitter},
+	}
+
+	// a fee paid in another denom than the reward denom is not reimbursed out of the escrow
+	require.NoError(t, k.DistributeReward(ctx, &types.MsgFeedData{FeedId: "feed1", Submitter: transmitter, TxFee: &types.Coin{Denom: "stake", Amount: 50}}, payouts, 0))
+	require.Equal(t, uint64(100), k.GetFeedEscrow(ctx, "feed1"))
+	require.Equal(t, uint64(0), k.GetOwedAmount(ctx, transmitter, "feed1"))
+
+	// a fee paid in the reward denom is reimbursed up to maxFeeReimbursement
+	require.NoError(t, k.DistributeReward(ctx, &types.MsgFeedData{FeedId: "feed1", Submitter: transmitter, TxFee: &types.Coin{Denom: types.LinkDenom, Amount: 50}}, payouts, 0))
+	require.Equal(t, uint64(95), k.GetFeedEscrow(ctx, "feed1"))
+	require.Equal(t, uint64(5), k.GetOwedAmount(ctx, transmitter, "feed1"))
+}
+
+func TestKeeper_DistributeReward_PiggyAddress(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	bank := newFakeBankKeeper()
+	k.bankKeeper = bank
+
+	signer := GenerateAccount()
+	piggy := GenerateAccount()
+	transmitter := GenerateAccount()
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: GenerateAccount(), MintRewards: true})
+
+	// the signer registered a chainlink account with a piggy address, the transmitter did not
+	_, _, err := k.AddAccount(ctx, &types.MsgAccount{
+		Submitter:           signer,
+		ChainlinkPublicKey:  []byte("chainlinkPublicKey"),
+		ChainlinkSigningKey: []byte("chainlinkSigningKey"),
+		PiggyAddress:        piggy,
+	})
+	require.NoError(t, err)
+	require.Equal(t, piggy, k.GetPayeeAddress(ctx, signer))
+	require.Equal(t, transmitter, k.GetPayeeAddress(ctx, transmitter))
+
+	msg := &types.MsgFeedData{FeedId: "feed1", Submitter: transmitter, TxFee: &types.Coin{Denom: types.LinkDenom, Amount: 3}}
+	payouts := []types.RewardPayout{
+		{DataProvider: &types.DataProvider{Address: signer}, Amount: 5, Role: types.RewardRoleSigner},
+		{DataProvider: &types.DataProvider{Address: transmitter}, Amount: 0, Role: types.RewardRoleTransmitter},
+	}
+
+	err = k.DistributeReward(ctx, msg, payouts, 5)
+	require.NoError(t, err)
+	err = k.DistributeReward(ctx, msg, payouts, 5)
+	require.NoError(t, err)
+
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(signer, "feed1"))
+	require.NoError(t, err)
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(transmitter, "feed1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), bank.accountBalances[piggy.String()].AmountOf(types.LinkDenom).Int64())
+	require.True(t, bank.accountBalances[signer.String()].IsZero())
+	require.Equal(t, int64(6), bank.accountBalances[transmitter.String()].AmountOf(types.LinkDenom).Int64())
+
+	// editing the piggy address redirects the next withdrawals
+	newPiggy := GenerateAccount()
+	_, _, err = k.EditAccount(ctx, &types.MsgEditAccount{Submitter: signer, PiggyAddress: newPiggy})
+	require.NoError(t, err)
+	require.Equal(t, newPiggy, k.GetPayeeAddress(ctx, signer))
+
+	// every OraclePaid event carries the provider and the payee
+	var payees []string
+	for _, event := range ctx.EventManager().Events() {
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == "payee" {
+				payees = append(payees, string(attribute.Value))
+			}
+		}
+	}
+	require.Len(t, payees, 4)
+}
+
+func TestKeeper_FeedEscrow(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	bank := newFakeBankKeeper()
+	k.bankKeeper = bank
+
+	feedOwner := GenerateAccount()
+	signer := GenerateAccount()
+	moduleOwner := GenerateAccount()
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner})
+	bank.accountBalances[feedOwner.String()] = sdk.NewCoins(types.NewLinkCoinInt64(100))
+
+	msg := &types.MsgFeedData{FeedId: "feed1", TxFee: &types.Coin{}}
+	payouts := []types.RewardPayout{
+		{DataProvider: &types.DataProvider{Address: signer}, Amount: 10, Role: types.RewardRoleSigner},
+	}
+
+	// fund and withdraw
+	_, _, err := k.FundFeed(ctx, types.NewMsgFundFeed(feedOwner, "feed1", 60))
+	require.NoError(t, err)
+	_, _, err = k.WithdrawFeedFunds(ctx, types.NewMsgWithdrawFeedFunds(feedOwner, "feed1", 20))
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), k.GetFeedEscrow(ctx, "feed1"))
+	require.Equal(t, int64(60), bank.accountBalances[feedOwner.String()].AmountOf(types.LinkDenom).Int64())
+
+	_, _, err = k.WithdrawFeedFunds(ctx, types.NewMsgWithdrawFeedFunds(feedOwner, "feed1", 41))
+	require.ErrorIs(t, err, types.ErrInsufficientFeedFunds)
+
+	_, _, err = k.FundFeed(ctx, types.NewMsgFundFeed(feedOwner, "feed2", 10))
+	require.Error(t, err)
+
+	resp, err := k.GetFeedEscrowByFeedId(ctx, &types.GetFeedEscrowBalanceRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, &types.Coin{Denom: types.LinkDenom, Amount: 40}, resp.GetBalance())
+
+	// an underfunded feed pays nothing unless it opted in to minting
+	err = k.DistributeReward(ctx, msg, payouts, 50)
+	require.ErrorIs(t, err, types.ErrInsufficientFeedFunds)
+	require.Equal(t, uint64(40), k.GetFeedEscrow(ctx, "feed1"))
+	require.True(t, bank.accountBalances[signer.String()].IsZero())
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner, MintRewards: true, Deprecated: true})
+	payouts[0].Amount = 50
+	err = k.DistributeReward(ctx, msg, payouts, 50)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), k.GetFeedEscrow(ctx, "feed1"))
+	require.Equal(t, uint64(50), k.GetOwedAmount(ctx, signer, "feed1"))
+
+	// deleting a feed refunds its escrow to the feed owner
+	_, _, err = k.FundFeed(ctx, types.NewMsgFundFeed(feedOwner, "feed1", 15))
+	require.NoError(t, err)
+	_, _, err = k.DeleteFeed(ctx, &types.MsgDeleteFeed{FeedId: "feed1", Signer: moduleOwner})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), k.GetFeedEscrow(ctx, "feed1"))
+	require.Equal(t, int64(60), bank.accountBalances[feedOwner.String()].AmountOf(types.LinkDenom).Int64())
+
+	// the payments owed by a deleted feed can still be withdrawn
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(signer, "feed1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(50), bank.accountBalances[signer.String()].AmountOf(types.LinkDenom).Int64())
+}
+
+func TestKeeper_OwedPayments(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	bank := newFakeBankKeeper()
+	k.bankKeeper = bank
+
+	oracle1 := GenerateAccount()
+	oracle2 := GenerateAccount()
+
+	for _, feedId := range []string{"feed1", "feed2"} {
+		k.SetFeed(ctx, &types.MsgFeed{FeedId: feedId, FeedOwner: GenerateAccount(), MintRewards: true})
+		err := k.DistributeReward(ctx, &types.MsgFeedData{FeedId: feedId, TxFee: &types.Coin{}}, []types.RewardPayout{
+			{DataProvider: &types.DataProvider{Address: oracle1}, Amount: 10, Role: types.RewardRoleSigner},
+			{DataProvider: &types.DataProvider{Address: oracle2}, Amount: 20, Role: types.RewardRoleSigner},
+		}, 30)
+		require.NoError(t, err)
+	}
+
+	// owed balances per oracle and per feed
+	resp, err := k.GetOwedPaymentList(ctx, &types.ListOwedPaymentsRequest{Oracle: oracle1})
+	require.NoError(t, err)
+	require.Len(t, resp.GetPayments(), 2)
+	for _, payment := range resp.GetPayments() {
+		require.Equal(t, oracle1, payment.GetOracle())
+		require.Equal(t, uint64(10), payment.GetAmount())
+	}
+
+	resp, err = k.GetOwedPaymentList(ctx, &types.ListOwedPaymentsRequest{FeedId: "feed2"})
+	require.NoError(t, err)
+	require.Len(t, resp.GetPayments(), 2)
+	for _, payment := range resp.GetPayments() {
+		require.Equal(t, "feed2", payment.GetFeedId())
+	}
+
+	resp, err = k.GetOwedPaymentList(ctx, &types.ListOwedPaymentsRequest{Oracle: oracle2, FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, []*types.OwedPayment{{FeedId: "feed1", Oracle: oracle2, Amount: 20}}, resp.GetPayments())
+
+	// withdrawing the payments of a feed leaves the payments owed by the other feeds
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(oracle1, "feed1"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), k.GetOwedAmount(ctx, oracle1, "feed1"))
+	require.Equal(t, uint64(10), k.GetOwedAmount(ctx, oracle1, ""))
+
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(oracle1, "feed1"))
+	require.ErrorIs(t, err, types.ErrNoPaymentOwed)
+
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(oracle2, ""))
+	require.NoError(t, err)
+	require.Equal(t, int64(40), bank.accountBalances[oracle2.String()].AmountOf(types.LinkDenom).Int64())
+	require.Equal(t, uint64(0), k.GetOwedAmount(ctx, oracle2, ""))
+}
+
+func TestKeeper_GetRegisteredFeedRewardStrategies(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	registered, descriptions := types.FeedRewardStrategyConvertor, types.FeedRewardStrategyDescriptions
+	defer func() {
+		types.FeedRewardStrategyConvertor, types.FeedRewardStrategyDescriptions = registered, descriptions
+	}()
+	types.NewFeedRewardStrategyRegister(types.BuiltinFeedRewardStrategies())
+	types.NewFeedRewardStrategyDescriptionRegister(types.BuiltinFeedRewardStrategyDescriptions())
+
+	resp := k.GetRegisteredFeedRewardStrategies(ctx)
+	require.Equal(t, []string{
+		types.FeedRewardStrategyAccuracyWeighted,
+		types.FeedRewardStrategyEqualSplit,
+		types.FeedRewardStrategyParticipation,
+		types.FeedRewardStrategyTransmitterBonus,
+	}, resp.GetAvailStrategies())
+	require.Len(t, resp.GetStrategies(), 4)
+	for _, strategy := range resp.GetStrategies() {
+		require.Equal(t, types.BuiltinFeedRewardStrategyDescriptions()[strategy.GetName()], strategy.GetDescription())
+	}
 }
 
 func TestKeeper_FeedOwnershipTransfer(t *testing.T) {
@@ -614,3 +1194,1284 @@ func TestKeeper_FeedOwnershipTransfer(t *testing.T) {
 	require.Equal(t, feedToInsert.GetFeedId(), result.GetFeed().GetFeedId())
 	require.Equal(t, newFeedOwner, result.GetFeed().GetFeedOwner())
 }
+
+func TestKeeper_SetFeedData_AnswerBounds(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	latestRound := func(ctx sdk.Context, feedId string) *types.OCRFeedDataInStore {
+		return k.GetFeedDataInStore(ctx, feedId, k.GetLatestRoundId(ctx, feedId))
+	}
+	validationFailedEvents := func(ctx sdk.Context) int {
+		var events int
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "chainlink.v1beta.MsgFeedDataValidationFailedEvent" {
+				events++
+			}
+		}
+		return events
+	}
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "reject"})
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "flag"})
+
+	_, _, err := k.SetAnswerBounds(ctx, &types.MsgSetAnswerBounds{FeedId: "reject",
+		AnswerBounds: &types.AnswerBounds{MinAnswer: sdk.NewInt(100), MaxAnswer: sdk.NewInt(200)}})
+	require.NoError(t, err)
+	_, _, err = k.SetAnswerBounds(ctx, &types.MsgSetAnswerBounds{FeedId: "flag",
+		AnswerBounds: &types.AnswerBounds{MinAnswer: sdk.NewInt(100), MaxAnswer: sdk.NewInt(200), Mode: types.AnswerBoundsModeFlag}})
+	require.NoError(t, err)
+	_, _, err = k.SetAnswerBounds(ctx, &types.MsgSetAnswerBounds{FeedId: "unknown"})
+	require.Error(t, err)
+
+	testCases := []struct {
+		name    string
+		answer  int64
+		inBound bool
+	}{
+		{name: "within bounds", answer: 150, inBound: true},
+		{name: "below minAnswer", answer: 99, inBound: false},
+		{name: "maxAnswer", answer: 200, inBound: true},
+		{name: "above maxAnswer", answer: 1000000, inBound: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := ctx.WithEventManager(sdk.NewEventManager())
+			_, _, err := k.SetFeedData(c, &types.MsgFeedData{FeedId: "reject", Report: GenerateReport(t, tc.answer)})
+			if tc.inBound {
+				require.NoError(t, err)
+				require.Equal(t, sdk.NewInt(tc.answer), latestRound(c, "reject").Answer)
+			} else {
+				require.ErrorIs(t, err, types.ErrAnswerOutOfBounds)
+				require.NotEqual(t, sdk.NewInt(tc.answer), latestRound(c, "reject").Answer)
+			}
+
+			// out of bounds rounds of the flag feed are kept but flagged and not rewarded
+			c = ctx.WithEventManager(sdk.NewEventManager())
+			_, _, err = k.SetFeedData(c, &types.MsgFeedData{FeedId: "flag", IsFeedDataValid: true, Report: GenerateReport(t, tc.answer)})
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewInt(tc.answer), latestRound(c, "flag").Answer)
+			require.Equal(t, tc.inBound, latestRound(c, "flag").GetRewardable())
+			if tc.inBound {
+				require.Equal(t, 0, validationFailedEvents(c))
+			} else {
+				require.Equal(t, 1, validationFailedEvents(c))
+			}
+		})
+	}
+
+	// removing the bounds accepts any answer
+	_, _, err = k.SetAnswerBounds(ctx, &types.MsgSetAnswerBounds{FeedId: "reject"})
+	require.NoError(t, err)
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "reject", Report: GenerateReport(t, 1000000)})
+	require.NoError(t, err)
+}
+
+func TestKeeper_PauseFeed(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", HeartbeatTrigger: 1000})
+	k.ScheduleHeartbeat(ctx, "feed1", 1000, blockTimeMillis(ctx))
+	_, _, err := k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReport(t, 1)})
+	require.NoError(t, err)
+
+	_, _, err = k.PauseFeed(ctx, &types.MsgPauseFeed{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.True(t, k.GetFeed(ctx, "feed1").GetFeed().GetPaused())
+
+	// the rounds history of a paused feed stays available
+	latest, err := k.GetLatestRoundFeedDataByFilter(ctx, &types.GetLatestRoundDataRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Len(t, latest.GetRoundData(), 1)
+
+	// a paused feed does not request new rounds on heartbeat
+	c := ctx.WithBlockTime(testBlockTime.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	k.ProcessHeartbeats(c)
+	require.Empty(t, c.EventManager().Events())
+
+	_, _, err = k.UnpauseFeed(c, &types.MsgUnpauseFeed{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.False(t, k.GetFeed(c, "feed1").GetFeed().GetPaused())
+
+	c = ctx.WithBlockTime(testBlockTime.Add(2 * time.Second)).WithEventManager(sdk.NewEventManager())
+	k.ProcessHeartbeats(c)
+	require.Len(t, c.EventManager().Events(), 1)
+
+	_, _, err = k.PauseFeed(ctx, &types.MsgPauseFeed{FeedId: "feed2"})
+	require.Error(t, err)
+	_, _, err = k.UnpauseFeed(ctx, &types.MsgUnpauseFeed{FeedId: "feed2"})
+	require.Error(t, err)
+}
+
+func TestKeeper_DeprecateAndDeleteFeed(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	feedOwner := GenerateAccount()
+	moduleOwner := GenerateAccount()
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner, Desc: "feed 1", HeartbeatTrigger: 1000})
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed12", HeartbeatTrigger: 1000})
+	for _, feedId := range []string{"feed1", "feed12"} {
+		for i := 0; i < 3; i++ {
+			_, _, err := k.SetFeedData(ctx, &types.MsgFeedData{FeedId: feedId, Report: GenerateReport(t, int64(i+1))})
+			require.NoError(t, err)
+		}
+	}
+
+	// a feed must be deprecated before being deleted
+	_, _, err := k.DeleteFeed(ctx, &types.MsgDeleteFeed{FeedId: "feed1", Signer: moduleOwner})
+	require.Error(t, err)
+
+	newFeedOwner := GenerateAccount()
+	_, err = k.ProposeFeedOwnershipTransfer(ctx, types.NewMsgFeedOwnershipTransfer(feedOwner, "feed1", newFeedOwner))
+	require.NoError(t, err)
+
+	_, _, err = k.DeprecateFeed(ctx, &types.MsgDeprecateFeed{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.True(t, k.GetFeed(ctx, "feed1").GetFeed().GetDeprecated())
+
+	// the ownership transfer proposed before the deprecation can not be accepted
+	require.Nil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed1"))
+	_, err = k.AcceptFeedOwnership(ctx, types.NewMsgAcceptFeedOwnership(newFeedOwner, "feed1"))
+	require.Error(t, err)
+	require.Equal(t, feedOwner, k.GetFeed(ctx, "feed1").GetFeed().GetFeedOwner())
+
+	// the rounds of a deprecated feed stay available, its heartbeat is unscheduled
+	history, err := k.GetRoundFeedDataHistory(ctx, &types.GetRoundHistoryRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Len(t, history.GetRoundData(), 3)
+	c := ctx.WithBlockTime(testBlockTime.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	k.ProcessHeartbeats(c)
+	for _, event := range c.EventManager().Events() {
+		for _, attr := range event.Attributes {
+			require.NotEqual(t, `"feed1"`, string(attr.Value))
+		}
+	}
+
+	_, _, err = k.DeleteFeed(ctx, &types.MsgDeleteFeed{FeedId: "feed1", Signer: moduleOwner})
+	require.NoError(t, err)
+
+	// the feed and its rounds are pruned, the tombstone is left in place
+	require.Nil(t, k.GetFeed(ctx, "feed1").GetFeed())
+	require.Equal(t, uint64(0), k.GetLatestRoundId(ctx, "feed1"))
+	require.Nil(t, k.GetFeedDataInStore(ctx, "feed1", 1))
+	require.Equal(t, uint64(0), k.GetLastUpdateTime(ctx, "feed1"))
+	require.Equal(t, uint64(0), k.GetLatestEpochAndRound(ctx, "feed1"))
+	require.Equal(t, &types.FeedTombstone{
+		FeedId:          "feed1",
+		FeedOwner:       feedOwner,
+		Desc:            "feed 1",
+		LastRoundId:     3,
+		DeletedAtHeight: ctx.BlockHeight(),
+		DeletedBy:       moduleOwner,
+	}, k.GetFeedTombstone(ctx, "feed1"))
+
+	resp, err := k.GetFeedByFeedId(sdk.WrapSDKContext(ctx), &types.GetFeedByIdRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Nil(t, resp.GetFeed())
+	require.Equal(t, "feed1", resp.GetTombstone().GetFeedId())
+
+	// other feeds are untouched
+	require.Nil(t, k.GetFeedTombstone(ctx, "feed12"))
+	require.Equal(t, uint64(3), k.GetLatestRoundId(ctx, "feed12"))
+	require.NotNil(t, k.GetFeedDataInStore(ctx, "feed12", 3))
+
+	_, _, err = k.DeprecateFeed(ctx, &types.MsgDeprecateFeed{FeedId: "feed1"})
+	require.Error(t, err)
+	_, _, err = k.DeleteFeed(ctx, &types.MsgDeleteFeed{FeedId: "feed1"})
+	require.Error(t, err)
+}
+
+func TestKeeper_ProcessHeartbeats(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	newRoundRequests := func(ctx sdk.Context) []string {
+		feedIds := make([]string, 0)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != "chainlink.v1beta.MsgNewRoundRequestEvent" {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				feedIds = append(feedIds, string(attr.Value))
+			}
+		}
+		return feedIds
+	}
+	atTime := func(ms int64) sdk.Context {
+		return ctx.WithBlockTime(testBlockTime.Add(time.Duration(ms) * time.Millisecond)).WithEventManager(sdk.NewEventManager())
+	}
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", HeartbeatTrigger: 1000})
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed2", HeartbeatTrigger: 3000})
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed3"})
+	k.ScheduleHeartbeat(ctx, "feed1", 1000, blockTimeMillis(ctx))
+	k.ScheduleHeartbeat(ctx, "feed2", 3000, blockTimeMillis(ctx))
+	k.ScheduleHeartbeat(ctx, "feed3", 0, blockTimeMillis(ctx))
+
+	// nothing is due before the heartbeat interval elapsed
+	c := atTime(999)
+	k.ProcessHeartbeats(c)
+	require.Empty(t, newRoundRequests(c))
+
+	// feed1 heartbeat is due
+	c = atTime(1000)
+	k.ProcessHeartbeats(c)
+	require.Equal(t, []string{`"feed1"`}, newRoundRequests(c))
+
+	// a new round of feed1 postpones its heartbeat
+	c = atTime(1500)
+	_, _, err := k.SetFeedData(c, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReport(t, 1)})
+	require.NoError(t, err)
+	require.Equal(t, uint64(testBlockTime.UnixNano()/int64(time.Millisecond))+1500, k.GetLastUpdateTime(c, "feed1"))
+
+	c = atTime(2000)
+	k.ProcessHeartbeats(c)
+	require.Empty(t, newRoundRequests(c))
+
+	// both feeds are due, in scheduling order, feed3 never is
+	c = atTime(3000)
+	k.ProcessHeartbeats(c)
+	require.Equal(t, []string{`"feed1"`, `"feed2"`}, newRoundRequests(c))
+
+	// updating the heartbeat trigger reschedules from the latest round
+	_, _, err = k.SetHeartbeatTrigger(c, &types.MsgSetHeartbeatTrigger{FeedId: "feed1", HeartbeatTrigger: 5000})
+	require.NoError(t, err)
+	c = atTime(6000)
+	k.ProcessHeartbeats(c)
+	require.Equal(t, []string{`"feed2"`}, newRoundRequests(c))
+	c = atTime(6500)
+	k.ProcessHeartbeats(c)
+	require.Equal(t, []string{`"feed1"`}, newRoundRequests(c))
+}
+
+func TestKeeper_SetFeedData_DeviationThreshold(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	atTime := func(ms int64) sdk.Context {
+		return ctx.WithBlockTime(testBlockTime.Add(time.Duration(ms) * time.Millisecond)).WithEventManager(sdk.NewEventManager())
+	}
+	latestRound := func(ctx sdk.Context, feedId string) *types.OCRFeedDataInStore {
+		return k.GetFeedDataInStore(ctx, feedId, k.GetLatestRoundId(ctx, feedId))
+	}
+
+	// 1% deviation threshold, 10s heartbeat
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "reject", HeartbeatTrigger: 10000, DeviationThresholdTrigger: 1000})
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "nonRewardable", HeartbeatTrigger: 10000, DeviationThresholdTrigger: 1000,
+		DeviationThresholdPolicy: types.DeviationThresholdPolicyNonRewardable})
+
+	// the nonRewardable feed keeps the rounds below the threshold, so its deviations differ from the reject feed ones
+	testCases := []struct {
+		name                   string
+		time                   int64
+		answer                 int64
+		deviation              uint64
+		nonRewardableDeviation uint64
+		rejected               bool
+		rewardable             bool
+	}{
+		{name: "first round", time: 0, answer: 10000, deviation: 0, nonRewardableDeviation: 0, rewardable: true},
+		{name: "below threshold", time: 1000, answer: 10050, deviation: 500, nonRewardableDeviation: 500, rejected: true, rewardable: false},
+		{name: "meets threshold", time: 2000, answer: 10200, deviation: 2000, nonRewardableDeviation: 1492, rewardable: true},
+		{name: "below threshold after heartbeat", time: 12000, answer: 10200, deviation: 0, nonRewardableDeviation: 0, rewardable: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := atTime(tc.time)
+			_, _, err := k.SetFeedData(c, &types.MsgFeedData{FeedId: "reject", Report: GenerateReport(t, tc.answer)})
+			if tc.rejected {
+				require.ErrorIs(t, err, types.ErrDeviationThresholdNotMet)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, sdk.NewInt(tc.answer), latestRound(c, "reject").Answer)
+				require.Equal(t, tc.deviation, latestRound(c, "reject").GetDeviation())
+				require.True(t, latestRound(c, "reject").GetRewardable())
+			}
+
+			c = atTime(tc.time)
+			_, _, err = k.SetFeedData(c, &types.MsgFeedData{FeedId: "nonRewardable", Report: GenerateReport(t, tc.answer)})
+			require.NoError(t, err)
+			require.Equal(t, tc.nonRewardableDeviation, latestRound(c, "nonRewardable").GetDeviation())
+			require.Equal(t, tc.rewardable, latestRound(c, "nonRewardable").GetRewardable())
+
+			var deviationEvents int
+			for _, event := range c.EventManager().Events() {
+				if event.Type == "chainlink.v1beta.MsgRoundDeviationEvent" {
+					deviationEvents++
+				}
+			}
+			require.Equal(t, 1, deviationEvents)
+		})
+	}
+}
+
+func TestKeeper_SetFeedData_ReplayProtection(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1"})
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed2"})
+
+	report := GenerateReportWithContext(t, nil, 2, 3, 100)
+	_, _, err := k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: report})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2<<8|3), k.GetLatestEpochAndRound(ctx, "feed1"))
+
+	testCases := []struct {
+		name   string
+		epoch  uint32
+		round  uint8
+		expErr error
+	}{
+		{name: "duplicate report", epoch: 2, round: 3, expErr: types.ErrStaleReport},
+		{name: "older round", epoch: 2, round: 2, expErr: types.ErrStaleReport},
+		{name: "older epoch", epoch: 1, round: 9, expErr: types.ErrStaleReport},
+		{name: "newer round", epoch: 2, round: 4},
+		{name: "newer epoch", epoch: 3, round: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			latestRoundId := k.GetLatestRoundId(ctx, "feed1")
+			_, _, err := k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReportWithContext(t, nil, tc.epoch, tc.round, 100)})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				require.Equal(t, latestRoundId, k.GetLatestRoundId(ctx, "feed1"))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, latestRoundId+1, k.GetLatestRoundId(ctx, "feed1"))
+			require.Equal(t, uint64(tc.epoch)<<8|uint64(tc.round), k.GetLatestEpochAndRound(ctx, "feed1"))
+		})
+	}
+
+	// the epoch and round are tracked per feed
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed2", Report: report})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetLatestRoundId(ctx, "feed2"))
+}
+
+func TestKeeper_SetOCRConfig(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	ctx = ctx.WithChainID("chainlink")
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1"})
+
+	transmitters := []sdk.AccAddress{GenerateAccount(), GenerateAccount(), GenerateAccount(), GenerateAccount()}
+	signingKeys := [][]byte{[]byte("key0"), []byte("key1"), []byte("key2"), []byte("key3")}
+	setConfig := &types.MsgSetOCRConfig{FeedId: "feed1", SigningKeys: signingKeys, Transmitters: transmitters, F: 1,
+		OnchainConfig: []byte("onchain"), OffchainConfigVersion: 1, OffchainConfig: []byte("offchain")}
+
+	// a feed without OCR config has empty config details
+	details, err := k.GetLatestConfigDetails(ctx, &types.LatestConfigDetailsRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, &types.LatestConfigDetailsResponse{}, details)
+
+	_, err = k.GetLatestConfigDetails(ctx, &types.LatestConfigDetailsRequest{FeedId: "feed2"})
+	require.Error(t, err)
+	_, _, err = k.SetOCRConfig(ctx, &types.MsgSetOCRConfig{FeedId: "feed2"})
+	require.Error(t, err)
+
+	// the rounds before the first config are not bound to a config digest
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReportWithContext(t, nil, 5, 1, 100)})
+	require.NoError(t, err)
+
+	_, _, err = k.SetOCRConfig(ctx, setConfig)
+	require.NoError(t, err)
+
+	config := k.GetOCRConfig(ctx, "feed1")
+	require.Equal(t, uint64(1), config.GetConfigCount())
+	require.Equal(t, ctx.BlockHeight(), config.GetBlockNumber())
+	require.Equal(t, signingKeys, config.GetSigningKeys())
+	require.Equal(t, transmitters, config.GetTransmitters())
+	require.Equal(t, types.ComputeConfigDigest("chainlink", "feed1", 1, config), config.GetConfigDigest())
+	require.Len(t, config.GetConfigDigest(), types.OCRConfigDigestLength)
+
+	details, err = k.GetLatestConfigDetails(ctx, &types.LatestConfigDetailsRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, config.GetConfigCount(), details.GetConfigCount())
+	require.Equal(t, config.GetBlockNumber(), details.GetBlockNumber())
+	require.Equal(t, config.GetConfigDigest(), details.GetConfigDigest())
+
+	// only the reports of the active config are accepted, the epoch and round tracking restarts with the config
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReportWithContext(t, nil, 6, 1, 100)})
+	require.ErrorIs(t, err, types.ErrConfigDigestMismatch)
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReportWithContext(t, config.GetConfigDigest(), 1, 1, 100)})
+	require.NoError(t, err)
+
+	// a new config gets a new digest, the reports of the previous config are no longer accepted
+	previousDigest := config.GetConfigDigest()
+	_, _, err = k.SetOCRConfig(ctx.WithBlockHeight(ctx.BlockHeight()+1), setConfig)
+	require.NoError(t, err)
+	config = k.GetOCRConfig(ctx, "feed1")
+	require.Equal(t, uint64(2), config.GetConfigCount())
+	require.NotEqual(t, previousDigest, config.GetConfigDigest())
+	require.Equal(t, uint64(0), k.GetLatestEpochAndRound(ctx, "feed1"))
+
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReportWithContext(t, previousDigest, 2, 1, 100)})
+	require.ErrorIs(t, err, types.ErrConfigDigestMismatch)
+	_, _, err = k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReportWithContext(t, config.GetConfigDigest(), 1, 1, 100)})
+	require.NoError(t, err)
+}
+
+func TestKeeper_MigrateFeedDataStoreLayout(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	roundStore := ctx.KVStore(k.roundStoreKey)
+	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
+
+	// write rounds the way the legacy layout did: string roundIds and little endian latest roundIds
+	legacyRounds := map[string][]uint64{
+		"feed1":  {1, 2, 10},
+		"feed11": {256},
+	}
+	for feedId, roundIds := range legacyRounds {
+		for _, roundId := range roundIds {
+			feedData := types.OCRFeedDataInStore{
+				FeedData: &types.MsgFeedData{FeedId: feedId},
+				RoundId:  roundId,
+				Answer:   sdk.NewInt(int64(roundId)),
+			}
+			feedDataStore.Set(types.GetLegacyFeedDataKey(feedId, strconv.FormatUint(roundId, 10)), k.cdc.MustMarshalBinaryBare(&feedData))
+		}
+		roundStore.Set(types.GetLegacyRoundIdKey(feedId), i64tob(roundIds[len(roundIds)-1]))
+	}
+
+	require.NoError(t, k.MigrateFeedDataStoreLayout(ctx))
+
+	for feedId, roundIds := range legacyRounds {
+		require.Equal(t, roundIds[len(roundIds)-1], k.GetLatestRoundId(ctx, feedId))
+		for _, roundId := range roundIds {
+			feedData := k.GetFeedDataInStore(ctx, feedId, roundId)
+			require.NotNil(t, feedData)
+			require.Equal(t, feedId, feedData.GetFeedData().GetFeedId())
+			require.Equal(t, sdk.NewInt(int64(roundId)), feedData.Answer)
+			require.False(t, feedDataStore.Has(types.GetLegacyFeedDataKey(feedId, strconv.FormatUint(roundId, 10))))
+		}
+		require.False(t, roundStore.Has(types.GetLegacyRoundIdKey(feedId)))
+	}
+	require.Equal(t, uint64(256), k.GetLatestRoundId(ctx, ""))
+
+	// running it again is a no-op
+	require.NoError(t, k.MigrateFeedDataStoreLayout(ctx))
+	require.Equal(t, uint64(10), k.GetLatestRoundId(ctx, "feed1"))
+}
+
+func TestKeeper_AddAccount(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	alice, bob := GenerateAccount(), GenerateAccount()
+
+	_, _, err := k.AddAccount(ctx, types.NewMsgAddAccount(alice, []byte("alicePubKey"), []byte("aliceSigningKey"), alice))
+	require.NoError(t, err)
+
+	require.Equal(t, alice, k.GetAccountAddressByChainlinkKey(ctx, []byte("alicePubKey")))
+	require.Equal(t, alice, k.GetAccountAddressByChainlinkKey(ctx, []byte("aliceSigningKey")))
+	require.Nil(t, k.GetAccountAddressByChainlinkKey(ctx, []byte("bobPubKey")))
+
+	// neither the public key nor the signing key of alice can be registered by bob
+	_, _, err = k.AddAccount(ctx, types.NewMsgAddAccount(bob, []byte("alicePubKey"), []byte("bobSigningKey"), bob))
+	require.ErrorIs(t, err, types.ErrChainlinkKeyRegistered)
+	_, _, err = k.AddAccount(ctx, types.NewMsgAddAccount(bob, []byte("bobPubKey"), []byte("alicePubKey"), bob))
+	require.ErrorIs(t, err, types.ErrChainlinkKeyRegistered)
+	require.Nil(t, k.GetAccountAddressByChainlinkKey(ctx, []byte("bobPubKey")))
+	require.Nil(t, k.GetAccountAddressByChainlinkKey(ctx, []byte("bobSigningKey")))
+	require.Nil(t, k.GetAccount(ctx, &types.GetAccountRequest{AccountAddress: bob}).GetAccount().GetSubmitter())
+
+	_, _, err = k.AddAccount(ctx, types.NewMsgAddAccount(bob, []byte("bobPubKey"), []byte("bobSigningKey"), bob))
+	require.NoError(t, err)
+
+	resp, err := k.LookupAccountByChainlinkKey(ctx, &types.GetAccountByChainlinkKeyRequest{ChainlinkKey: []byte("bobSigningKey")})
+	require.NoError(t, err)
+	require.Equal(t, bob, resp.GetAccount().GetSubmitter())
+	require.Equal(t, []byte("bobPubKey"), resp.GetAccount().GetChainlinkPublicKey())
+
+	resp, err = k.LookupAccountByChainlinkKey(ctx, &types.GetAccountByChainlinkKeyRequest{ChainlinkKey: []byte("unknownKey")})
+	require.NoError(t, err)
+	require.Nil(t, resp.GetAccount().GetSubmitter())
+
+	_, err = k.LookupAccountByChainlinkKey(ctx, &types.GetAccountByChainlinkKeyRequest{})
+	require.Error(t, err)
+}
+
+func TestKeeper_AddAccount_ChainlinkKeyEncodings(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	alice, bob := GenerateAccount(), GenerateAccount()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	compressed := []byte(hex.EncodeToString(crypto.CompressPubkey(&key.PublicKey)))
+	uncompressed := []byte("0x" + hex.EncodeToString(crypto.FromECDSAPub(&key.PublicKey)))
+	address := crypto.PubkeyToAddress(key.PublicKey)
+
+	_, _, err = k.AddAccount(ctx, types.NewMsgAddAccount(alice, compressed, []byte("aliceSigningKey"), alice))
+	require.NoError(t, err)
+
+	// the key is found whatever its encoding
+	require.Equal(t, alice, k.GetAccountAddressByChainlinkKey(ctx, uncompressed))
+	require.Equal(t, alice, k.GetAccountAddressByChainlinkKey(ctx, address.Bytes()))
+	require.Equal(t, alice, k.GetAccountAddressByChainlinkKey(ctx, []byte(address.Hex())))
+
+	// bob can not register the key of alice in another encoding
+	_, _, err = k.AddAccount(ctx, types.NewMsgAddAccount(bob, uncompressed, []byte("bobSigningKey"), bob))
+	require.ErrorIs(t, err, types.ErrChainlinkKeyRegistered)
+	_, _, err = k.AddAccount(ctx, types.NewMsgAddAccount(bob, []byte("bobPubKey"), []byte(address.Hex()), bob))
+	require.ErrorIs(t, err, types.ErrChainlinkKeyRegistered)
+
+	// nor can a migrated account
+	accStore := ctx.KVStore(k.accountStoreKey)
+	accStore.Set(types.GetAccountKey(bob.String()), k.cdc.MustMarshalBinaryBare(types.NewMsgAddAccount(bob, uncompressed, []byte("bobSigningKey"), bob)))
+	require.NoError(t, k.MigrateChainlinkKeyIndex(ctx))
+	require.Equal(t, alice, k.GetAccountAddressByChainlinkKey(ctx, uncompressed))
+	require.Equal(t, bob, k.GetAccountAddressByChainlinkKey(ctx, []byte("bobSigningKey")))
+}
+
+func TestKeeper_GetAccountList(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	registered := make(map[string]bool)
+	for i := 0; i < 5; i++ {
+		acc := GenerateAccount()
+		_, _, err := k.AddAccount(ctx, types.NewMsgAddAccount(acc, []byte(fmt.Sprintf("pubKey%d", i)), []byte(fmt.Sprintf("signingKey%d", i)), acc))
+		require.NoError(t, err)
+		registered[acc.String()] = true
+	}
+
+	listed := make(map[string]bool)
+	var nextKey []byte
+	for {
+		resp, err := k.GetAccountList(ctx, &types.ListAccountsRequest{Pagination: &query.PageRequest{Key: nextKey, Limit: 2}})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(resp.GetAccounts()), 2)
+
+		for _, account := range resp.GetAccounts() {
+			listed[account.GetSubmitter().String()] = true
+		}
+
+		nextKey = resp.GetPagination().GetNextKey()
+		if nextKey == nil {
+			break
+		}
+	}
+	// the chainlink key index entries are not listed as accounts
+	require.Equal(t, registered, listed)
+}
+
+func TestKeeper_MigrateChainlinkKeyIndex(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	accStore := ctx.KVStore(k.accountStoreKey)
+
+	// accounts registered before the index existed, sharing a chainlink key
+	alice, bob := GenerateAccount(), GenerateAccount()
+	for _, acc := range []*types.MsgAccount{
+		types.NewMsgAddAccount(alice, []byte("alicePubKey"), []byte("sharedSigningKey"), alice),
+		types.NewMsgAddAccount(bob, []byte("bobPubKey"), []byte("sharedSigningKey"), bob),
+	} {
+		accStore.Set(types.GetAccountKey(acc.GetSubmitter().String()), k.cdc.MustMarshalBinaryBare(acc))
+	}
+
+	require.NoError(t, k.MigrateChainlinkKeyIndex(ctx))
+
+	require.Equal(t, alice, k.GetAccountAddressByChainlinkKey(ctx, []byte("alicePubKey")))
+	require.Equal(t, bob, k.GetAccountAddressByChainlinkKey(ctx, []byte("bobPubKey")))
+
+	// the shared key is kept by the first account in address order
+	first := alice
+	if bytes.Compare([]byte(bob.String()), []byte(alice.String())) < 0 {
+		first = bob
+	}
+	require.Equal(t, first, k.GetAccountAddressByChainlinkKey(ctx, []byte("sharedSigningKey")))
+}
+
+func TestKeeper_Params(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	// the params never set have their default value
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	params := types.NewParams(4, 32, "ulink", 2, types.FeeReimbursementPolicyNone, true, 3, 10, true, 20, 50)
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	res, err := k.GetModuleParams(sdk.WrapSDKContext(ctx), &types.GetModuleParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &params, res.GetParams())
+}
+
+func TestKeeper_Params_RewardDenomAndFeeReimbursement(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	bank := newFakeBankKeeper()
+	k.bankKeeper = bank
+	k.SetParams(ctx, types.NewParams(types.DefaultMaxDataProviders, types.DefaultMaxFeedIdLength, "ulink", 0, types.FeeReimbursementPolicyNone, false, types.DefaultOwnerApprovalThreshold, types.DefaultOwnershipTransferExpiry, false, types.DefaultOwnerProposalExpiry, types.DefaultMaxFeeReimbursement))
+
+	feedOwner := GenerateAccount()
+	signer := GenerateAccount()
+	transmitter := GenerateAccount()
+
+	// the feed escrow holds the reward denom
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner})
+	bank.accountBalances[feedOwner.String()] = sdk.NewCoins(sdk.NewInt64Coin("ulink", 5), types.NewLinkCoinInt64(5))
+	_, _, err := k.FundFeed(ctx, types.NewMsgFundFeed(feedOwner, "feed1", 5))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), bank.moduleBalances[types.ModuleName].AmountOf("ulink").Int64())
+	require.Equal(t, int64(5), bank.accountBalances[feedOwner.String()].AmountOf(types.LinkDenom).Int64())
+
+	escrow, err := k.GetFeedEscrowBalance(sdk.WrapSDKContext(ctx), &types.GetFeedEscrowBalanceRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, "ulink", escrow.GetBalance().GetDenom())
+
+	// the transmitter does not get its tx fee reimbursed, the escrow only covers the rewards
+	msg := &types.MsgFeedData{
+		FeedId:    "feed1",
+		Submitter: transmitter,
+		TxFee:     &types.Coin{Denom: types.LinkDenom, Amount: 3},
+	}
+	payouts := []types.RewardPayout{
+		{DataProvider: &types.DataProvider{Address: signer}, Amount: 5, Role: types.RewardRoleSigner},
+		{DataProvider: &types.DataProvider{Address: transmitter}, Amount: 0, Role: types.RewardRoleTransmitter},
+	}
+	require.NoError(t, k.DistributeReward(ctx, msg, payouts, 5))
+	require.Equal(t, uint64(0), k.GetFeedEscrow(ctx, "feed1"))
+	require.Equal(t, uint64(5), k.GetOwedAmount(ctx, signer, "feed1"))
+	require.Equal(t, uint64(0), k.GetOwedAmount(ctx, transmitter, "feed1"))
+
+	// the payments are withdrawn in the reward denom
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(signer, "feed1"))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), bank.accountBalances[signer.String()].AmountOf("ulink").Int64())
+}
+
+func TestKeeper_Params_RoundRetention(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: GenerateAccount()})
+
+	submitRounds := func(count int) {
+		for i := 0; i < count; i++ {
+			_, _, err := k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReport(t, int64(i))})
+			require.NoError(t, err)
+		}
+	}
+	keptRounds := func() []uint64 {
+		var roundIds []uint64
+		for roundId := uint64(1); roundId <= k.GetLatestRoundId(ctx, "feed1"); roundId++ {
+			if k.GetFeedDataInStore(ctx, "feed1", roundId) != nil {
+				roundIds = append(roundIds, roundId)
+			}
+		}
+		return roundIds
+	}
+
+	// every round is kept by default
+	submitRounds(5)
+	require.Equal(t, []uint64{1, 2, 3, 4, 5}, keptRounds())
+
+	// lowering the retention prunes every round out of it on the next round
+	params := types.DefaultParams()
+	params.RoundRetention = 2
+	k.SetParams(ctx, params)
+	submitRounds(1)
+	require.Equal(t, []uint64{5, 6}, keptRounds())
+
+	submitRounds(2)
+	require.Equal(t, []uint64{7, 8}, keptRounds())
+
+	// the latest round data is still served
+	res, err := k.GetLatestRoundFeedDataByFilter(ctx, &types.GetLatestRoundDataRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Len(t, res.GetRoundData(), 1)
+	require.Equal(t, uint64(8), res.GetRoundData()[0].GetRoundId())
+}
+
+func TestKeeper_Params_RoundRetention_LargeHistory(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: GenerateAccount()})
+
+	submitRound := func() {
+		_, _, err := k.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReport(t, 1)})
+		require.NoError(t, err)
+	}
+	keptRounds := func() int {
+		kept := 0
+		for roundId := uint64(1); roundId <= k.GetLatestRoundId(ctx, "feed1"); roundId++ {
+			if k.GetFeedDataInStore(ctx, "feed1", roundId) != nil {
+				kept++
+			}
+		}
+		return kept
+	}
+
+	history := 5 * maxPrunedRounds
+	for i := 0; i < history; i++ {
+		submitRound()
+	}
+
+	// lowering the retention on a feed with a large history prunes it over the next rounds, a batch per round
+	params := types.DefaultParams()
+	params.RoundRetention = 1
+	k.SetParams(ctx, params)
+
+	submitRound()
+	require.Equal(t, history+1-maxPrunedRounds, keptRounds())
+	require.Nil(t, k.GetFeedDataInStore(ctx, "feed1", maxPrunedRounds))
+	require.NotNil(t, k.GetFeedDataInStore(ctx, "feed1", maxPrunedRounds+1))
+
+	for keptRounds() > 1 {
+		submitRound()
+	}
+	require.NotNil(t, k.GetFeedDataInStore(ctx, "feed1", k.GetLatestRoundId(ctx, "feed1")))
+	require.Less(t, k.GetLatestRoundId(ctx, "feed1"), uint64(history+maxPrunedRounds))
+}
+
+func TestKeeper_ValidateNewFeed(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1"})
+	ctx.KVStore(k.feedInfoStoreKey).Set(types.GetFeedTombstoneKey("feed2"), k.cdc.MustMarshalBinaryBare(&types.FeedTombstone{FeedId: "feed2"}))
+
+	params := types.DefaultParams()
+	params.MaxFeedIdLength = 5
+	params.MaxDataProviders = 1
+	k.SetParams(ctx, params)
+
+	testCases := []struct {
+		description string
+		feed        *types.MsgFeed
+		valid       bool
+	}{
+		{description: "new feed", feed: &types.MsgFeed{FeedId: "feed3"}, valid: true},
+		{description: "existing feed", feed: &types.MsgFeed{FeedId: "feed1"}},
+		{description: "deleted feed", feed: &types.MsgFeed{FeedId: "feed2"}},
+		{description: "feedId too long", feed: &types.MsgFeed{FeedId: "feed34"}},
+		{
+			description: "too many data providers",
+			feed: &types.MsgFeed{FeedId: "feed3", DataProviders: []*types.DataProvider{
+				{Address: GenerateAccount()}, {Address: GenerateAccount()},
+			}},
+		},
+		{
+			description: "unknown reward strategy",
+			feed:        &types.MsgFeed{FeedId: "feed3", FeedReward: &types.FeedRewardSchema{Strategy: "unknown"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			err := k.ValidateNewFeed(ctx, tc.feed)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestKeeper_HandleAddFeedProposal(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	feedOwner := GenerateAccount()
+	p := types.NewAddFeedProposal("add feed1", "adds feed1", &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner, HeartbeatTrigger: 1000})
+
+	require.NoError(t, HandleAddFeedProposal(ctx, *k, p))
+	feed := k.GetFeed(ctx, "feed1").GetFeed()
+	require.Equal(t, feedOwner, feed.GetFeedOwner())
+	require.Equal(t, types.GovModuleAddress(), feed.GetModuleOwnerAddress())
+
+	// the feed can not be added twice
+	require.Error(t, HandleAddFeedProposal(ctx, *k, p))
+}
+
+func TestKeeper_ValidateParamChangeProposal_RewardDenom(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	bank := newFakeBankKeeper()
+	k.bankKeeper = bank
+
+	changeDenom := paramproposal.NewParameterChangeProposal("reward denom", "changes the reward denom", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyRewardDenom), `"stake"`),
+	})
+	sameDenom := paramproposal.NewParameterChangeProposal("reward denom", "keeps the reward denom", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyRewardDenom), `"link"`),
+	})
+
+	// no escrow and no owed payment, the denom can change
+	require.NoError(t, ValidateParamChangeProposal(ctx, *k, changeDenom))
+
+	feedOwner := GenerateAccount()
+	oracle := GenerateAccount()
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner})
+	bank.accountBalances[feedOwner.String()] = sdk.NewCoins(types.NewLinkCoinInt64(10))
+	_, _, err := k.FundFeed(ctx, types.NewMsgFundFeed(feedOwner, "feed1", 10))
+	require.NoError(t, err)
+
+	// the escrow of feed1 holds link
+	err = ValidateParamChangeProposal(ctx, *k, changeDenom)
+	require.ErrorIs(t, err, types.ErrRewardBalancesOutstanding)
+	require.NoError(t, ValidateParamChangeProposal(ctx, *k, sameDenom))
+
+	payouts := []types.RewardPayout{
+		{DataProvider: &types.DataProvider{Address: oracle}, Amount: 10, Role: types.RewardRoleSigner},
+	}
+	require.NoError(t, k.DistributeReward(ctx, &types.MsgFeedData{FeedId: "feed1", Submitter: oracle}, payouts, 10))
+	require.Equal(t, uint64(0), k.GetFeedEscrow(ctx, "feed1"))
+
+	// the escrow is empty but link is owed to the oracle
+	err = ValidateParamChangeProposal(ctx, *k, changeDenom)
+	require.ErrorIs(t, err, types.ErrRewardBalancesOutstanding)
+
+	_, _, err = k.WithdrawPayment(ctx, types.NewMsgWithdrawPayment(oracle, ""))
+	require.NoError(t, err)
+	require.NoError(t, ValidateParamChangeProposal(ctx, *k, changeDenom))
+
+	// the changes of the other params and of the other modules are not checked
+	other := paramproposal.NewParameterChangeProposal("other", "other params", []paramproposal.ParamChange{
+		paramproposal.NewParamChange("bank", string(types.KeyRewardDenom), `invalid`),
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMaxFeeReimbursement), `"5"`),
+	})
+	require.NoError(t, ValidateParamChangeProposal(ctx, *k, other))
+}
+
+func TestKeeper_HandleModuleOwnerProposals(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	_, pubKey1, acc1 := testdata.KeyTestPubAddr()
+	cosmosPubKey1, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey1)
+	require.NoError(t, err)
+	_, pubKey2, acc2 := testdata.KeyTestPubAddr()
+	cosmosPubKey2, err := sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey2)
+	require.NoError(t, err)
+
+	require.NoError(t, HandleAddModuleOwnerProposal(ctx, *k, types.NewAddModuleOwnerProposal("add", "adds acc1", acc1, []byte(cosmosPubKey1))))
+	require.NoError(t, HandleAddModuleOwnerProposal(ctx, *k, types.NewAddModuleOwnerProposal("add", "adds acc2", acc2, []byte(cosmosPubKey2))))
+	require.Error(t, HandleAddModuleOwnerProposal(ctx, *k, types.NewAddModuleOwnerProposal("add", "adds acc1", acc1, []byte(cosmosPubKey1))))
+
+	moduleOwners := k.GetModuleOwnerList(ctx).GetModuleOwner()
+	require.Len(t, moduleOwners, 2)
+	for _, moduleOwner := range moduleOwners {
+		require.Equal(t, types.GovModuleAddress(), moduleOwner.GetAssignerAddress())
+	}
+
+	// the proposals emit the events of the module owner msgs, signed by the gov module account
+	added := eventsOfType(ctx, "chainlink.v1beta.MsgModuleOwnerAddedEvent")
+	require.Len(t, added, 2)
+	for _, event := range added {
+		signed := false
+		for _, attribute := range event.Attributes {
+			if string(attribute.Key) == "signer" {
+				require.Contains(t, string(attribute.Value), types.GovModuleAddress().String())
+				signed = true
+			}
+		}
+		require.True(t, signed)
+	}
+
+	// only module owners can be removed, and never the last one
+	require.Error(t, HandleRemoveModuleOwnerProposal(ctx, *k, types.NewRemoveModuleOwnerProposal("remove", "removes", GenerateAccount())))
+	require.NoError(t, HandleRemoveModuleOwnerProposal(ctx, *k, types.NewRemoveModuleOwnerProposal("remove", "removes acc1", acc1)))
+	err = HandleRemoveModuleOwnerProposal(ctx, *k, types.NewRemoveModuleOwnerProposal("remove", "removes acc2", acc2))
+	require.ErrorIs(t, err, types.ErrLastModuleOwner)
+
+	moduleOwners = k.GetModuleOwnerList(ctx).GetModuleOwner()
+	require.Len(t, moduleOwners, 1)
+	require.Equal(t, acc2, moduleOwners[0].GetAddress())
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgModuleOwnerRemovedEvent"), 1)
+}
+
+// eventsOfType returns the events of the given type emitted so far
+func eventsOfType(ctx sdk.Context, eventType string) []sdk.Event {
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestKeeper_RemoveModuleOwnerTx(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := NewMsgServerImpl(*k)
+
+	owner1, owner2 := GenerateAccount(), GenerateAccount()
+	k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: owner1})
+	k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: owner2})
+
+	// only module owners can be removed
+	_, err := msgServer.RemoveModuleOwnerTx(sdk.WrapSDKContext(ctx), types.NewMsgRemoveModuleOwner(owner1, GenerateAccount()))
+	require.Error(t, err)
+
+	_, err = msgServer.RemoveModuleOwnerTx(sdk.WrapSDKContext(ctx), types.NewMsgRemoveModuleOwner(owner1, owner2))
+	require.NoError(t, err)
+	require.False(t, types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner()).Contains(owner2))
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgModuleOwnerRemovedEvent"), 1)
+
+	// the last module owner can not be removed, not even by itself
+	_, err = msgServer.RemoveModuleOwnerTx(sdk.WrapSDKContext(ctx), types.NewMsgRemoveModuleOwner(owner1, owner1))
+	require.ErrorIs(t, err, types.ErrLastModuleOwner)
+	require.Len(t, k.GetModuleOwnerList(ctx).GetModuleOwner(), 1)
+}
+
+func TestKeeper_OwnerProposals(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := NewMsgServerImpl(*k)
+
+	owner1, owner2, owner3 := GenerateAccount(), GenerateAccount(), GenerateAccount()
+	for _, owner := range []sdk.AccAddress{owner1, owner2, owner3} {
+		k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: owner})
+	}
+
+	params := types.DefaultParams()
+	params.OwnerApprovalThreshold = 2
+	k.SetParams(ctx, params)
+	require.Equal(t, uint32(2), k.GetOwnerApprovalThreshold(ctx))
+
+	listProposals := func() []*types.OwnerProposal {
+		res, err := k.GetOwnerProposalList(ctx, &types.ListOwnerProposalsRequest{})
+		require.NoError(t, err)
+		return res.GetProposals()
+	}
+	approve := func(signer sdk.AccAddress, proposalId uint64) error {
+		_, err := msgServer.ApproveOwnerProposalTx(sdk.WrapSDKContext(ctx), types.NewMsgApproveOwnerProposal(signer, proposalId))
+		return err
+	}
+
+	// the new feed waits for the approval of a second module owner
+	feed := &types.MsgFeed{FeedId: "feed1", FeedOwner: GenerateAccount(), ModuleOwnerAddress: owner1, HeartbeatTrigger: 1000}
+	_, err := msgServer.AddFeedTx(sdk.WrapSDKContext(ctx), feed)
+	require.NoError(t, err)
+	require.Nil(t, k.GetFeed(ctx, "feed1").GetFeed())
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnerProposalSubmittedEvent"), 1)
+
+	proposals := listProposals()
+	require.Len(t, proposals, 1)
+	require.Equal(t, uint64(1), proposals[0].GetId())
+	require.Equal(t, feed, proposals[0].GetFeed())
+	require.Equal(t, []sdk.AccAddress{owner1}, proposals[0].GetApprovals())
+
+	// a module owner approves a proposal once, unknown proposals can not be approved
+	require.Error(t, approve(owner1, 1))
+	require.Error(t, approve(owner2, 2))
+
+	require.NoError(t, approve(owner2, 1))
+	require.Equal(t, feed, k.GetFeed(ctx, "feed1").GetFeed())
+	require.Empty(t, listProposals())
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgNewFeedEvent"), 1)
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnerProposalExecutedEvent"), 1)
+
+	// the approvals of removed module owners do not count
+	newOwner := GenerateAccount()
+	_, err = msgServer.RemoveModuleOwnerTx(sdk.WrapSDKContext(ctx), types.NewMsgRemoveModuleOwner(owner1, owner3))
+	require.NoError(t, err)
+	_, err = msgServer.AddModuleOwnerTx(sdk.WrapSDKContext(ctx), &types.MsgModuleOwner{Address: newOwner, AssignerAddress: owner3})
+	require.NoError(t, err)
+	require.Len(t, listProposals(), 2)
+
+	require.NoError(t, approve(owner2, 2))
+	require.False(t, types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner()).Contains(owner3))
+	require.NoError(t, approve(owner2, 3))
+	require.False(t, types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner()).Contains(newOwner))
+	require.Len(t, listProposals(), 1)
+
+	require.NoError(t, approve(owner1, 3))
+	require.True(t, types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner()).Contains(newOwner))
+	require.Empty(t, listProposals())
+
+	// a proposal whose msg fails is removed, its state changes are discarded and the error is reported
+	_, err = msgServer.AddFeedTx(sdk.WrapSDKContext(ctx), &types.MsgFeed{FeedId: "feed1", ModuleOwnerAddress: owner1, HeartbeatTrigger: 1})
+	require.NoError(t, err)
+	c := ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.ApproveOwnerProposalTx(sdk.WrapSDKContext(c), types.NewMsgApproveOwnerProposal(newOwner, 4))
+	require.NoError(t, err)
+	require.Equal(t, feed, k.GetFeed(ctx, "feed1").GetFeed())
+	require.Empty(t, listProposals())
+	require.Empty(t, eventsOfType(c, "chainlink.v1beta.MsgNewFeedEvent"))
+	executed := eventsOfType(c, "chainlink.v1beta.MsgOwnerProposalExecutedEvent")
+	require.Len(t, executed, 1)
+	for _, attribute := range executed[0].Attributes {
+		if string(attribute.Key) == "error" {
+			require.Contains(t, string(attribute.Value), "feed already exists")
+		}
+	}
+
+	// the threshold is capped at the number of module owners
+	params.OwnerApprovalThreshold = 5
+	k.SetParams(ctx, params)
+	require.Equal(t, uint32(3), k.GetOwnerApprovalThreshold(ctx))
+}
+
+func TestKeeper_OwnerProposals_ExecuteCancelExpire(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := NewMsgServerImpl(*k)
+
+	owner1, owner2, owner3 := GenerateAccount(), GenerateAccount(), GenerateAccount()
+	for _, owner := range []sdk.AccAddress{owner1, owner2, owner3} {
+		k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: owner})
+	}
+
+	params := types.DefaultParams()
+	params.OwnerApprovalThreshold = 3
+	params.OwnerProposalExpiry = 10
+	k.SetParams(ctx, params)
+
+	approve := func(ctx sdk.Context, signer sdk.AccAddress, proposalId uint64) error {
+		_, err := msgServer.ApproveOwnerProposalTx(sdk.WrapSDKContext(ctx), types.NewMsgApproveOwnerProposal(signer, proposalId))
+		return err
+	}
+	cancel := func(signer sdk.AccAddress, proposalId uint64) error {
+		_, err := msgServer.CancelOwnerProposalTx(sdk.WrapSDKContext(ctx), types.NewMsgCancelOwnerProposal(signer, proposalId))
+		return err
+	}
+	proposeFeed := func(feedId string) {
+		_, err := msgServer.AddFeedTx(sdk.WrapSDKContext(ctx), &types.MsgFeed{FeedId: feedId, FeedOwner: owner1, ModuleOwnerAddress: owner1, HeartbeatTrigger: 1000})
+		require.NoError(t, err)
+	}
+
+	// the proposal expires ownerProposalExpiry blocks after its submission
+	proposeFeed("feed1")
+	require.Equal(t, testBlockHeight+10, k.GetOwnerProposal(ctx, 1).GetExpiresAtHeight())
+	require.NoError(t, approve(ctx, owner2, 1))
+	require.Nil(t, k.GetFeed(ctx, "feed1").GetFeed())
+
+	// a lowered threshold lets a module owner who already approved execute the proposal
+	require.Error(t, approve(ctx, owner2, 1))
+	params.OwnerApprovalThreshold = 2
+	k.SetParams(ctx, params)
+	require.NoError(t, approve(ctx, owner2, 1))
+	require.NotNil(t, k.GetFeed(ctx, "feed1").GetFeed())
+	require.Nil(t, k.GetOwnerProposal(ctx, 1))
+
+	// so does the removal of a module owner capping the threshold
+	params.OwnerApprovalThreshold = 3
+	k.SetParams(ctx, params)
+	proposeFeed("feed2")
+	require.NoError(t, approve(ctx, owner2, 2))
+	require.NoError(t, HandleRemoveModuleOwnerProposal(ctx, *k, types.NewRemoveModuleOwnerProposal("remove", "removes owner3", owner3)))
+	require.NoError(t, approve(ctx, owner1, 2))
+	require.NotNil(t, k.GetFeed(ctx, "feed2").GetFeed())
+	require.Nil(t, k.GetOwnerProposal(ctx, 2))
+
+	// only the proposer cancels its proposal
+	proposeFeed("feed3")
+	require.ErrorIs(t, cancel(owner2, 3), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, cancel(owner1, 4), sdkerrors.ErrKeyNotFound)
+	require.NoError(t, cancel(owner1, 3))
+	require.Nil(t, k.GetOwnerProposal(ctx, 3))
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnerProposalCancelledEvent"), 1)
+	require.ErrorIs(t, approve(ctx, owner2, 3), sdkerrors.ErrKeyNotFound)
+
+	// the expired proposals can not be approved and are dropped at the end of their expiry block
+	proposeFeed("feed4")
+	expiresAt := k.GetOwnerProposal(ctx, 4).GetExpiresAtHeight()
+
+	c := ctx.WithBlockHeight(expiresAt - 1).WithEventManager(sdk.NewEventManager())
+	k.ExpireOwnerProposals(c)
+	require.NotNil(t, k.GetOwnerProposal(c, 4))
+	require.Empty(t, eventsOfType(c, "chainlink.v1beta.MsgOwnerProposalExpiredEvent"))
+	require.Error(t, approve(ctx.WithBlockHeight(expiresAt+1), owner2, 4))
+
+	c = ctx.WithBlockHeight(expiresAt).WithEventManager(sdk.NewEventManager())
+	k.ExpireOwnerProposals(c)
+	require.Nil(t, k.GetOwnerProposal(c, 4))
+	require.Nil(t, k.GetFeed(c, "feed4").GetFeed())
+	require.Len(t, eventsOfType(c, "chainlink.v1beta.MsgOwnerProposalExpiredEvent"), 1)
+
+	// the proposals never expire when ownerProposalExpiry is 0
+	params.OwnerProposalExpiry = 0
+	k.SetParams(ctx, params)
+	proposeFeed("feed5")
+	require.Zero(t, k.GetOwnerProposal(ctx, 5).GetExpiresAtHeight())
+	k.ExpireOwnerProposals(ctx.WithBlockHeight(expiresAt + 1000))
+	require.NotNil(t, k.GetOwnerProposal(ctx, 5))
+}
+
+func TestKeeper_FeedOwnershipTransferTx(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := NewMsgServerImpl(*k)
+
+	feedOwner, newFeedOwner, stranger := GenerateAccount(), GenerateAccount(), GenerateAccount()
+	k.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: feedOwner})
+
+	accept := func(signer sdk.AccAddress) error {
+		_, err := msgServer.AcceptFeedOwnershipTx(sdk.WrapSDKContext(ctx), types.NewMsgAcceptFeedOwnership(signer, "feed1"))
+		return err
+	}
+	cancel := func(signer sdk.AccAddress) error {
+		_, err := msgServer.CancelFeedOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgCancelFeedOwnershipTransfer(signer, "feed1"))
+		return err
+	}
+	propose := func(newOwner sdk.AccAddress) {
+		_, err := msgServer.FeedOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgFeedOwnershipTransfer(feedOwner, "feed1", newOwner))
+		require.NoError(t, err)
+	}
+
+	// nothing to accept or cancel before a transfer is proposed
+	require.ErrorIs(t, accept(newFeedOwner), sdkerrors.ErrKeyNotFound)
+	require.ErrorIs(t, cancel(feedOwner), sdkerrors.ErrKeyNotFound)
+
+	// the proposed transfer leaves the feed owner unchanged
+	propose(stranger)
+	propose(newFeedOwner)
+	require.Equal(t, feedOwner, k.GetFeed(ctx, "feed1").GetFeed().GetFeedOwner())
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnershipTransferProposedEvent"), 2)
+
+	res, err := k.GetFeedOwnershipTransfer(sdk.WrapSDKContext(ctx), &types.GetFeedOwnershipTransferRequest{FeedId: "feed1"})
+	require.NoError(t, err)
+	require.Equal(t, &types.OwnershipTransfer{
+		FeedId:           "feed1",
+		Owner:            feedOwner,
+		NewOwner:         newFeedOwner,
+		ProposedAtHeight: ctx.BlockHeight(),
+		ExpiresAtHeight:  ctx.BlockHeight() + int64(types.DefaultOwnershipTransferExpiry),
+	}, res.GetTransfer())
+
+	// the second proposal replaced the first one
+	require.ErrorIs(t, accept(stranger), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, cancel(stranger), sdkerrors.ErrUnauthorized)
+
+	require.NoError(t, accept(newFeedOwner))
+	require.Equal(t, newFeedOwner, k.GetFeed(ctx, "feed1").GetFeed().GetFeedOwner())
+	require.Nil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed1"))
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgFeedOwnershipTransferEvent"), 1)
+
+	// the transfer can only be accepted once
+	require.ErrorIs(t, accept(newFeedOwner), sdkerrors.ErrKeyNotFound)
+
+	// either side of the transfer can cancel it
+	feedOwner, newFeedOwner = newFeedOwner, GenerateAccount()
+	propose(newFeedOwner)
+	require.NoError(t, cancel(newFeedOwner))
+	propose(newFeedOwner)
+	require.NoError(t, cancel(feedOwner))
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnershipTransferCancelledEvent"), 2)
+	require.ErrorIs(t, accept(newFeedOwner), sdkerrors.ErrKeyNotFound)
+	require.Equal(t, feedOwner, k.GetFeed(ctx, "feed1").GetFeed().GetFeedOwner())
+
+	// the single step mode keeps the legacy behaviour
+	params := types.DefaultParams()
+	params.SingleStepOwnershipTransfer = true
+	k.SetParams(ctx, params)
+	propose(newFeedOwner)
+	require.Equal(t, newFeedOwner, k.GetFeed(ctx, "feed1").GetFeed().GetFeedOwner())
+	require.Nil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed1"))
+}
+
+func TestKeeper_ExpireOwnershipTransfers(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockHeight(10)
+
+	params := types.DefaultParams()
+	params.OwnershipTransferExpiry = 5
+	k.SetParams(ctx, params)
+
+	feedOwner, newFeedOwner := GenerateAccount(), GenerateAccount()
+	moduleOwner, newModuleOwner := GenerateAccount(), GenerateAccount()
+	k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: moduleOwner})
+	for _, feedId := range []string{"feed1", "feed2"} {
+		k.SetFeed(ctx, &types.MsgFeed{FeedId: feedId, FeedOwner: feedOwner})
+	}
+
+	_, err := msgServer.FeedOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgFeedOwnershipTransfer(feedOwner, "feed1", newFeedOwner))
+	require.NoError(t, err)
+	_, err = msgServer.ModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgModuleOwnershipTransfer(moduleOwner, newModuleOwner, []byte("pubKey")))
+	require.NoError(t, err)
+
+	// the transfers proposed later expire later
+	ctx = ctx.WithBlockHeight(12)
+	_, err = msgServer.FeedOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgFeedOwnershipTransfer(feedOwner, "feed2", newFeedOwner))
+	require.NoError(t, err)
+
+	// the transfers can be accepted up to their expiry height
+	ctx = ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	k.ExpireOwnershipTransfers(ctx.WithBlockHeight(14))
+	require.Empty(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnershipTransferExpiredEvent"))
+	require.NotNil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed1"))
+
+	k.ExpireOwnershipTransfers(ctx)
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnershipTransferExpiredEvent"), 2)
+	require.Nil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed1"))
+	require.Nil(t, k.GetPendingModuleOwnershipTransfer(ctx, moduleOwner))
+	require.NotNil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed2"))
+
+	_, err = msgServer.AcceptFeedOwnershipTx(sdk.WrapSDKContext(ctx), types.NewMsgAcceptFeedOwnership(newFeedOwner, "feed1"))
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.Equal(t, feedOwner, k.GetFeed(ctx, "feed1").GetFeed().GetFeedOwner())
+
+	// an expired transfer the end blocker did not drop yet can not be accepted
+	ctx = ctx.WithBlockHeight(18)
+	_, err = msgServer.AcceptFeedOwnershipTx(sdk.WrapSDKContext(ctx), types.NewMsgAcceptFeedOwnership(newFeedOwner, "feed2"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the transfers never expire without expiry
+	params.OwnershipTransferExpiry = 0
+	k.SetParams(ctx, params)
+	_, err = msgServer.FeedOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgFeedOwnershipTransfer(feedOwner, "feed1", newFeedOwner))
+	require.NoError(t, err)
+	k.ExpireOwnershipTransfers(ctx.WithBlockHeight(1000000))
+	require.NotNil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed1"))
+	require.Nil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed2"))
+
+	// deleting the feed drops its pending transfer
+	feed := k.GetFeed(ctx, "feed1").GetFeed()
+	feed.Deprecated = true
+	k.SetFeed(ctx, feed)
+	_, _, err = k.DeleteFeed(ctx, types.NewMsgDeleteFeed(feedOwner, "feed1"))
+	require.NoError(t, err)
+	require.Nil(t, k.GetPendingFeedOwnershipTransfer(ctx, "feed1"))
+}
+
+func TestKeeper_ModuleOwnershipTransferTx(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := NewMsgServerImpl(*k)
+
+	owner1, owner2, newOwner := GenerateAccount(), GenerateAccount(), GenerateAccount()
+	k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: owner1})
+	k.SetModuleOwner(ctx, &types.MsgModuleOwner{Address: owner2})
+
+	listTransfers := func() []*types.OwnershipTransfer {
+		res, err := k.ListModuleOwnershipTransfers(sdk.WrapSDKContext(ctx), &types.ListModuleOwnershipTransfersRequest{})
+		require.NoError(t, err)
+		return res.GetTransfers()
+	}
+	accept := func(signer, assigner sdk.AccAddress) error {
+		_, err := msgServer.AcceptModuleOwnershipTx(sdk.WrapSDKContext(ctx), types.NewMsgAcceptModuleOwnership(signer, assigner))
+		return err
+	}
+
+	_, err := msgServer.ModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgModuleOwnershipTransfer(owner1, newOwner, []byte("pubKey")))
+	require.NoError(t, err)
+	_, err = msgServer.ModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgModuleOwnershipTransfer(owner2, newOwner, []byte("pubKey")))
+	require.NoError(t, err)
+	require.Len(t, listTransfers(), 2)
+	require.False(t, types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner()).Contains(newOwner))
+
+	// only the new module owner accepts the transfer
+	require.ErrorIs(t, accept(owner2, owner1), sdkerrors.ErrUnauthorized)
+	require.NoError(t, accept(newOwner, owner1))
+	moduleOwners := types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner())
+	require.True(t, moduleOwners.Contains(newOwner))
+	require.False(t, moduleOwners.Contains(owner1))
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgModuleOwnershipTransferEvent"), 1)
+	require.Len(t, listTransfers(), 1)
+
+	// the transfer of a removed module owner is dropped
+	_, err = msgServer.RemoveModuleOwnerTx(sdk.WrapSDKContext(ctx), types.NewMsgRemoveModuleOwner(newOwner, owner2))
+	require.NoError(t, err)
+	require.Empty(t, listTransfers())
+	require.ErrorIs(t, accept(newOwner, owner2), sdkerrors.ErrKeyNotFound)
+
+	// the proposing module owner cancels the transfer
+	_, err = msgServer.ModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgModuleOwnershipTransfer(newOwner, owner2, []byte("pubKey")))
+	require.NoError(t, err)
+	_, err = msgServer.CancelModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgCancelModuleOwnershipTransfer(owner1, newOwner))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.CancelModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgCancelModuleOwnershipTransfer(newOwner, newOwner))
+	require.NoError(t, err)
+	require.Empty(t, listTransfers())
+	require.Len(t, eventsOfType(ctx, "chainlink.v1beta.MsgOwnershipTransferCancelledEvent"), 1)
+
+	// the single step mode keeps the legacy behaviour
+	params := types.DefaultParams()
+	params.SingleStepOwnershipTransfer = true
+	k.SetParams(ctx, params)
+	_, err = msgServer.ModuleOwnershipTransferTx(sdk.WrapSDKContext(ctx), types.NewMsgModuleOwnershipTransfer(newOwner, owner2, []byte("pubKey")))
+	require.NoError(t, err)
+	require.Equal(t, []*types.MsgModuleOwner{{Address: owner2, PubKey: []byte("pubKey"), AssignerAddress: newOwner}}, k.GetModuleOwnerList(ctx).GetModuleOwner())
+	require.Empty(t, listTransfers())
+}
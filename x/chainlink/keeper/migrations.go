@@ -0,0 +1,96 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateFeedDataStoreLayout moves the feed data and round stores from the legacy string keys
+// (feedData/feedId/roundId and roundId/feedId with little endian roundIds) to the length-prefixed, big endian layout.
+// It is idempotent, once migrated no legacy key is left behind.
+func (k Keeper) MigrateFeedDataStoreLayout(ctx sdk.Context) error {
+	feedDataStore := ctx.KVStore(k.feedDataStoreKey)
+
+	legacyFeedDataKeys := make([][]byte, 0)
+	iterator := sdk.KVStorePrefixIterator(feedDataStore, types.GetLegacyFeedDataKey("", ""))
+	for ; iterator.Valid(); iterator.Next() {
+		legacyFeedDataKeys = append(legacyFeedDataKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range legacyFeedDataKeys {
+		value := feedDataStore.Get(key)
+
+		var feedData types.OCRFeedDataInStore
+		if err := k.cdc.UnmarshalBinaryBare(value, &feedData); err != nil {
+			return fmt.Errorf("failed to migrate feed data %s: %w", key, err)
+		}
+		if len(feedData.GetFeedData().GetFeedId()) > types.MaxFeedIdLength {
+			return fmt.Errorf("failed to migrate feed data %s: feedId longer than %d", key, types.MaxFeedIdLength)
+		}
+
+		feedDataStore.Set(types.GetFeedDataKey(feedData.GetFeedData().GetFeedId(), feedData.GetRoundId()), value)
+		feedDataStore.Delete(key)
+	}
+
+	roundStore := ctx.KVStore(k.roundStoreKey)
+	legacyRoundIdPrefix := types.GetLegacyRoundIdKey("")
+
+	legacyRoundIdKeys := make([][]byte, 0)
+	iterator = sdk.KVStorePrefixIterator(roundStore, legacyRoundIdPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		legacyRoundIdKeys = append(legacyRoundIdKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range legacyRoundIdKeys {
+		feedId := string(key[len(legacyRoundIdPrefix):])
+		if len(feedId) > types.MaxFeedIdLength {
+			return fmt.Errorf("failed to migrate latest roundId of %s: feedId longer than %d", feedId, types.MaxFeedIdLength)
+		}
+
+		// legacy roundIds are stored little endian
+		k.setLatestRoundId(ctx, feedId, btoi64(roundStore.Get(key)))
+		roundStore.Delete(key)
+	}
+
+	return nil
+}
+
+// MigrateChainlinkKeyIndex indexes the chainlink keys of the accounts registered before the chainlink key index existed.
+// When a key was registered by several accounts, the first account in address order keeps it.
+func (k Keeper) MigrateChainlinkKeyIndex(ctx sdk.Context) error {
+	accStore := ctx.KVStore(k.accountStoreKey)
+
+	accounts := make([]types.MsgAccount, 0)
+	iterator := sdk.KVStorePrefixIterator(accStore, types.GetAccountKey(""))
+	for ; iterator.Valid(); iterator.Next() {
+		var account types.MsgAccount
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &account); err != nil {
+			iterator.Close()
+			return fmt.Errorf("failed to migrate account %s: %w", iterator.Key(), err)
+		}
+		accounts = append(accounts, account)
+	}
+	iterator.Close()
+
+	for _, account := range accounts {
+		for _, chainlinkKey := range [][]byte{account.GetChainlinkPublicKey(), account.GetChainlinkSigningKey()} {
+			if len(chainlinkKey) == 0 {
+				continue
+			}
+			if owner := k.GetAccountAddressByChainlinkKey(ctx, chainlinkKey); owner != nil && !owner.Equals(account.GetSubmitter()) {
+				k.Logger(ctx).Error("chainlink key registered by several accounts", "key", string(chainlinkKey), "kept", owner.String(), "dropped", account.GetSubmitter().String())
+				continue
+			}
+			accStore.Set(types.GetChainlinkKeyIndexKey(chainlinkKey), account.GetSubmitter())
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,8 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,9 +17,15 @@ const (
 
 	DataProviderSetChangeTypeAdd          = "Add"
 	DataProviderSetChangeTypeRemove       = "Remove"
+	TransmitterSetChangeTypeAdd           = "Add"
+	TransmitterSetChangeTypeRemove        = "Remove"
 	FeedParamChangeTypeSubmissionCount    = "SubmissionCount"
 	FeedParamChangeTypeHeartbeat          = "Heartbeat"
 	FeedParamChangeTypeDeviationThreshold = "DeviationThreshold"
+	FeedParamChangeTypeAnswerBounds       = "AnswerBounds"
+	FeedEscrowChangeTypeFund              = "fund"
+	FeedEscrowChangeTypeWithdraw          = "withdraw"
+	FeedEscrowChangeTypeRefund            = "refund"
 )
 
 type msgServer struct {
@@ -43,13 +51,33 @@ func (s msgServer) SubmitFeedDataTx(c context.Context, msg *types.MsgFeedData) (
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
 	}
 
-	rewardDecision, totalReward, err := msg.RewardCalculator(s.GetFeed(ctx, msg.FeedId).GetFeed(), msg)
+	// rounds accepted below the deviation threshold and rounds of deprecated feeds are not rewarded
+	round := s.GetFeedDataInStore(ctx, msg.GetFeedId(), s.GetLatestRoundId(ctx, msg.GetFeedId()))
+	if !round.GetRewardable() || s.GetFeed(ctx, msg.GetFeedId()).GetFeed().GetDeprecated() {
+		return &types.MsgResponse{
+			Height: uint64(height),
+			TxHash: string(txHash),
+		}, nil
+	}
+
+	feed := s.GetFeed(ctx, msg.GetFeedId()).GetFeed()
+	rewardDecision, totalReward, err := msg.RewardCalculator(feed, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	// reward distribution
+	// reward distribution, the rounds the feed escrow can not reward are either rejected or not rewarded
 	err = s.DistributeReward(ctx, msg, rewardDecision, totalReward)
+	if errors.Is(err, types.ErrInsufficientFeedFunds) && !feed.RejectsUnderfundedRounds() {
+		s.setRoundNonRewardable(ctx, msg.GetFeedId(), round.GetRoundId())
+
+		err = types.EmitEvent(&types.MsgFeedUnderfundedEvent{
+			FeedId:   msg.GetFeedId(),
+			RoundId:  round.GetRoundId(),
+			Balance:  s.GetFeedEscrow(ctx, msg.GetFeedId()),
+			Required: totalReward + s.txFeeReimbursement(ctx, msg),
+		}, ctx.EventManager())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -63,12 +91,28 @@ func (s msgServer) SubmitFeedDataTx(c context.Context, msg *types.MsgFeedData) (
 // AddModuleOwnerTx implements the tx/AddModuleOwnerTx gRPC method
 func (s msgServer) AddModuleOwnerTx(c context.Context, msg *types.MsgModuleOwner) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	if s.GetOwnerApprovalThreshold(ctx) > 1 {
+		return s.proposeToModuleOwners(ctx, msg, msg.GetAssignerAddress())
+	}
+	return s.addModuleOwner(ctx, msg)
+}
+
+func (s msgServer) addModuleOwner(ctx sdk.Context, msg *types.MsgModuleOwner) (*types.MsgResponse, error) {
 	height, txHash := s.SetModuleOwner(ctx, msg)
 
 	if height == 0 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
 	}
 
+	// emit ModuleOwnerAdded event
+	err := types.EmitEvent(&types.MsgModuleOwnerAddedEvent{
+		ModuleOwnerAddr: msg.GetAddress(),
+		Signer:          msg.GetAssignerAddress(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.MsgResponse{
 		Height: uint64(height),
 		TxHash: string(txHash),
@@ -79,7 +123,43 @@ func (s msgServer) AddModuleOwnerTx(c context.Context, msg *types.MsgModuleOwner
 func (s msgServer) ModuleOwnershipTransferTx(c context.Context, msg *types.MsgModuleOwnershipTransfer) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if s.GetParams(ctx).SingleStepOwnershipTransfer {
+		return s.transferModuleOwnership(ctx, msg)
+	}
+
+	transfer, err := s.ProposeModuleOwnershipTransfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.ownershipTransferProposed(ctx, transfer)
+}
+
+// AcceptModuleOwnershipTx implements the tx/AcceptModuleOwnershipTx gRPC method
+func (s msgServer) AcceptModuleOwnershipTx(c context.Context, msg *types.MsgAcceptModuleOwnership) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transfer, err := s.AcceptModuleOwnership(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.transferModuleOwnership(ctx, types.NewMsgModuleOwnershipTransfer(transfer.GetOwner(), transfer.GetNewOwner(), transfer.GetNewOwnerPubKey()))
+}
+
+// CancelModuleOwnershipTransferTx implements the tx/CancelModuleOwnershipTransferTx gRPC method
+func (s msgServer) CancelModuleOwnershipTransferTx(c context.Context, msg *types.MsgCancelModuleOwnershipTransfer) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transfer, err := s.CancelModuleOwnershipTransfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.ownershipTransferCancelled(ctx, transfer, msg.GetSigner())
+}
+
+// transferModuleOwnership replaces the assigner by the new module owner
+func (s msgServer) transferModuleOwnership(ctx sdk.Context, msg *types.MsgModuleOwnershipTransfer) (*types.MsgResponse, error) {
 	_, _ = s.RemoveModuleOwner(ctx, msg)
+	s.DeleteModuleOwnershipTransfer(ctx, msg.GetAssignerAddress())
 
 	transferMsg := &types.MsgModuleOwner{
 		Address:         msg.GetNewModuleOwnerAddress(),
@@ -107,16 +187,175 @@ func (s msgServer) ModuleOwnershipTransferTx(c context.Context, msg *types.MsgMo
 	}, nil
 }
 
+// ownershipTransferProposed emits the MsgOwnershipTransferProposedEvent of a feed or module ownership transfer
+func (s msgServer) ownershipTransferProposed(ctx sdk.Context, transfer *types.OwnershipTransfer) (*types.MsgResponse, error) {
+	err := types.EmitEvent(&types.MsgOwnershipTransferProposedEvent{
+		FeedId:          transfer.GetFeedId(),
+		Owner:           transfer.GetOwner(),
+		NewOwner:        transfer.GetNewOwner(),
+		ExpiresAtHeight: transfer.GetExpiresAtHeight(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(ctx.BlockHeight()),
+		TxHash: string(ctx.TxBytes()),
+	}, nil
+}
+
+// ownershipTransferCancelled emits the MsgOwnershipTransferCancelledEvent of a feed or module ownership transfer
+func (s msgServer) ownershipTransferCancelled(ctx sdk.Context, transfer *types.OwnershipTransfer, signer sdk.AccAddress) (*types.MsgResponse, error) {
+	err := types.EmitEvent(&types.MsgOwnershipTransferCancelledEvent{
+		FeedId:   transfer.GetFeedId(),
+		Owner:    transfer.GetOwner(),
+		NewOwner: transfer.GetNewOwner(),
+		Signer:   signer,
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(ctx.BlockHeight()),
+		TxHash: string(ctx.TxBytes()),
+	}, nil
+}
+
+// RemoveModuleOwnerTx implements the tx/RemoveModuleOwnerTx gRPC method
+func (s msgServer) RemoveModuleOwnerTx(c context.Context, msg *types.MsgRemoveModuleOwner) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := s.ValidateModuleOwnerRemoval(ctx, msg.GetAddress()); err != nil {
+		return nil, err
+	}
+	if s.GetOwnerApprovalThreshold(ctx) > 1 {
+		return s.proposeToModuleOwners(ctx, msg, msg.GetAssignerAddress())
+	}
+	return s.removeModuleOwner(ctx, msg)
+}
+
+func (s msgServer) removeModuleOwner(ctx sdk.Context, msg *types.MsgRemoveModuleOwner) (*types.MsgResponse, error) {
+	if err := s.DeleteModuleOwner(ctx, msg.GetAddress()); err != nil {
+		return nil, err
+	}
+
+	// emit ModuleOwnerRemoved event
+	err := types.EmitEvent(&types.MsgModuleOwnerRemovedEvent{
+		ModuleOwnerAddr: msg.GetAddress(),
+		Signer:          msg.GetAssignerAddress(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(ctx.BlockHeight()),
+		TxHash: string(ctx.TxBytes()),
+	}, nil
+}
+
+// ApproveOwnerProposalTx implements the tx/ApproveOwnerProposalTx gRPC method
+func (s msgServer) ApproveOwnerProposalTx(c context.Context, msg *types.MsgApproveOwnerProposal) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, err := s.ApproveOwnerProposal(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	if proposal != nil {
+		if err := s.executeOwnerProposal(ctx, proposal); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(ctx.BlockHeight()),
+		TxHash: string(ctx.TxBytes()),
+	}, nil
+}
+
+// CancelOwnerProposalTx implements the tx/CancelOwnerProposalTx gRPC method
+func (s msgServer) CancelOwnerProposalTx(c context.Context, msg *types.MsgCancelOwnerProposal) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := s.CancelOwnerProposal(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(ctx.BlockHeight()),
+		TxHash: string(ctx.TxBytes()),
+	}, nil
+}
+
+// proposeToModuleOwners holds a module owner msg until the approval threshold of module owners approve it
+func (s msgServer) proposeToModuleOwners(ctx sdk.Context, msg sdk.Msg, proposer sdk.AccAddress) (*types.MsgResponse, error) {
+	if _, err := s.SubmitOwnerProposal(ctx, msg, proposer); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(ctx.BlockHeight()),
+		TxHash: string(ctx.TxBytes()),
+	}, nil
+}
+
+// executeOwnerProposal executes the msg of an approved owner proposal and removes the proposal, the state changes
+// and the events of a failed msg are discarded and its error is reported in the MsgOwnerProposalExecutedEvent
+func (s msgServer) executeOwnerProposal(ctx sdk.Context, proposal *types.OwnerProposal) error {
+	s.DeleteOwnerProposal(ctx, proposal.GetId())
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	var err error
+	switch msg := proposal.Msg().(type) {
+	case *types.MsgFeed:
+		if err = s.ValidateNewFeed(cacheCtx, msg); err == nil {
+			_, err = s.addFeed(cacheCtx, msg)
+		}
+	case *types.MsgModuleOwner:
+		_, err = s.addModuleOwner(cacheCtx, msg)
+	case *types.MsgRemoveModuleOwner:
+		_, err = s.removeModuleOwner(cacheCtx, msg)
+	default:
+		err = sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "owner proposal %d holds no msg", proposal.GetId())
+	}
+
+	executed := &types.MsgOwnerProposalExecutedEvent{
+		ProposalId: proposal.GetId(),
+		MsgType:    proposal.MsgType(),
+	}
+	if err != nil {
+		executed.Error = err.Error()
+	} else {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	return types.EmitEvent(executed, ctx.EventManager())
+}
+
 // AddFeedTx implements the tx/AddFeedTx gRPC method
 func (s msgServer) AddFeedTx(c context.Context, msg *types.MsgFeed) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	if s.GetOwnerApprovalThreshold(ctx) > 1 {
+		return s.proposeToModuleOwners(ctx, msg, msg.GetModuleOwnerAddress())
+	}
+	return s.addFeed(ctx, msg)
+}
 
+func (s msgServer) addFeed(ctx sdk.Context, msg *types.MsgFeed) (*types.MsgResponse, error) {
 	height, txHash := s.SetFeed(ctx, msg)
 
 	if height == 0 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
 	}
 
+	// the first heartbeat of the feed is due heartbeatTrigger after its creation
+	s.ScheduleHeartbeat(ctx, msg.GetFeedId(), msg.GetHeartbeatTrigger(), blockTimeMillis(ctx))
+
 	// emit NewFeed event
 	err := types.EmitEvent(&types.MsgNewFeedEvent{
 		FeedId:        msg.GetFeedId(),
@@ -195,6 +434,68 @@ func (s msgServer) RemoveDataProviderTx(c context.Context, msg *types.MsgRemoveD
 	}, nil
 }
 
+// AddTransmitterTx implements the tx/AddTransmitter gRPC method
+func (s msgServer) AddTransmitterTx(c context.Context, msg *types.MsgAddTransmitter) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.AddTransmitter(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit TransmitterSetChange event
+	err = types.EmitEvent(&types.MsgTransmitterSetChangeEvent{
+		FeedId:      msg.GetFeedId(),
+		ChangeType:  TransmitterSetChangeTypeAdd,
+		Transmitter: msg.GetAddress(),
+		Signer:      msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+// RemoveTransmitterTx implements the tx/RemoveTransmitter gRPC method
+func (s msgServer) RemoveTransmitterTx(c context.Context, msg *types.MsgRemoveTransmitter) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.RemoveTransmitter(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit TransmitterSetChange event
+	err = types.EmitEvent(&types.MsgTransmitterSetChangeEvent{
+		FeedId:      msg.GetFeedId(),
+		ChangeType:  TransmitterSetChangeTypeRemove,
+		Transmitter: msg.GetAddress(),
+		Signer:      msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
 func (s msgServer) SetSubmissionCountTx(c context.Context, msg *types.MsgSetSubmissionCount) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -285,6 +586,76 @@ func (s msgServer) SetDeviationThresholdTriggerTx(c context.Context, msg *types.
 	}, nil
 }
 
+func (s msgServer) SetAnswerBoundsTx(c context.Context, msg *types.MsgSetAnswerBounds) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.SetAnswerBounds(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedParameterChange event
+	err = types.EmitEvent(&types.MsgFeedParameterChangeEvent{
+		FeedId:          msg.GetFeedId(),
+		ChangeType:      FeedParamChangeTypeAnswerBounds,
+		NewAnswerBounds: msg.GetAnswerBounds(),
+		Signer:          msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+func (s msgServer) SetOCRConfigTx(c context.Context, msg *types.MsgSetOCRConfig) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	previousConfigBlockNumber := s.GetOCRConfig(ctx, msg.GetFeedId()).GetBlockNumber()
+
+	height, txHash, err := s.SetOCRConfig(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit ConfigSet event
+	config := s.GetOCRConfig(ctx, msg.GetFeedId())
+	err = types.EmitEvent(&types.MsgConfigSetEvent{
+		FeedId:                    msg.GetFeedId(),
+		PreviousConfigBlockNumber: previousConfigBlockNumber,
+		ConfigDigest:              config.GetConfigDigest(),
+		ConfigCount:               config.GetConfigCount(),
+		SigningKeys:               config.GetSigningKeys(),
+		Transmitters:              config.GetTransmitters(),
+		F:                         config.GetF(),
+		OnchainConfig:             config.GetOnchainConfig(),
+		OffchainConfigVersion:     config.GetOffchainConfigVersion(),
+		OffchainConfig:            config.GetOffchainConfig(),
+		Signer:                    msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
 func (s msgServer) SetFeedRewardTx(c context.Context, msg *types.MsgSetFeedReward) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -315,9 +686,59 @@ func (s msgServer) SetFeedRewardTx(c context.Context, msg *types.MsgSetFeedRewar
 	}, nil
 }
 
+// FeedOwnershipTransferTx implements the tx/FeedOwnershipTransferTx gRPC method
 func (s msgServer) FeedOwnershipTransferTx(c context.Context, msg *types.MsgFeedOwnershipTransfer) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
+	if s.GetParams(ctx).SingleStepOwnershipTransfer {
+		return s.transferFeedOwnership(ctx, msg)
+	}
+
+	transfer, err := s.ProposeFeedOwnershipTransfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.ownershipTransferProposed(ctx, transfer)
+}
+
+// AcceptFeedOwnershipTx implements the tx/AcceptFeedOwnershipTx gRPC method
+func (s msgServer) AcceptFeedOwnershipTx(c context.Context, msg *types.MsgAcceptFeedOwnership) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transfer, err := s.AcceptFeedOwnership(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// emit FeedOwnershipTransfer event
+	err = types.EmitEvent(&types.MsgFeedOwnershipTransferEvent{
+		FeedId:           msg.GetFeedId(),
+		NewFeedOwnerAddr: transfer.GetNewOwner(),
+		Signer:           transfer.GetOwner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(ctx.BlockHeight()),
+		TxHash: string(ctx.TxBytes()),
+	}, nil
+}
+
+// CancelFeedOwnershipTransferTx implements the tx/CancelFeedOwnershipTransferTx gRPC method
+func (s msgServer) CancelFeedOwnershipTransferTx(c context.Context, msg *types.MsgCancelFeedOwnershipTransfer) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	transfer, err := s.CancelFeedOwnershipTransfer(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.ownershipTransferCancelled(ctx, transfer, msg.GetSigner())
+}
+
+// transferFeedOwnership hands the feed over to the new feed owner right away
+func (s msgServer) transferFeedOwnership(ctx sdk.Context, msg *types.MsgFeedOwnershipTransfer) (*types.MsgResponse, error) {
 	height, txHash, err := s.FeedOwnershipTransfer(ctx, msg)
 
 	if err != nil {
@@ -327,6 +748,7 @@ func (s msgServer) FeedOwnershipTransferTx(c context.Context, msg *types.MsgFeed
 	if height == 0 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
 	}
+	s.DeleteFeedOwnershipTransfer(ctx, msg.GetFeedId())
 
 	// emit FeedOwnershipTransfer event
 	err = types.EmitEvent(&types.MsgFeedOwnershipTransferEvent{
@@ -344,6 +766,245 @@ func (s msgServer) FeedOwnershipTransferTx(c context.Context, msg *types.MsgFeed
 	}, nil
 }
 
+func (s msgServer) PauseFeedTx(c context.Context, msg *types.MsgPauseFeed) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.PauseFeed(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedPaused event
+	err = types.EmitEvent(&types.MsgFeedPausedEvent{
+		FeedId: msg.GetFeedId(),
+		Signer: msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+func (s msgServer) UnpauseFeedTx(c context.Context, msg *types.MsgUnpauseFeed) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.UnpauseFeed(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedUnpaused event
+	err = types.EmitEvent(&types.MsgFeedUnpausedEvent{
+		FeedId: msg.GetFeedId(),
+		Signer: msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+func (s msgServer) DeprecateFeedTx(c context.Context, msg *types.MsgDeprecateFeed) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.DeprecateFeed(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedDeprecated event
+	err = types.EmitEvent(&types.MsgFeedDeprecatedEvent{
+		FeedId: msg.GetFeedId(),
+		Signer: msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+func (s msgServer) DeleteFeedTx(c context.Context, msg *types.MsgDeleteFeed) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.DeleteFeed(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedDeleted event
+	err = types.EmitEvent(&types.MsgFeedDeletedEvent{
+		FeedId:      msg.GetFeedId(),
+		LastRoundId: s.GetFeedTombstone(ctx, msg.GetFeedId()).GetLastRoundId(),
+		Signer:      msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+// FundFeedTx implements the tx/FundFeedTx gRPC method
+func (s msgServer) FundFeedTx(c context.Context, msg *types.MsgFundFeed) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.FundFeed(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedEscrowChange event
+	err = types.EmitEvent(&types.MsgFeedEscrowChangeEvent{
+		FeedId:     msg.GetFeedId(),
+		ChangeType: FeedEscrowChangeTypeFund,
+		Amount:     msg.GetAmount(),
+		Balance:    s.GetFeedEscrow(ctx, msg.GetFeedId()),
+		Signer:     msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+// WithdrawFeedFundsTx implements the tx/WithdrawFeedFundsTx gRPC method
+func (s msgServer) WithdrawFeedFundsTx(c context.Context, msg *types.MsgWithdrawFeedFunds) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.WithdrawFeedFunds(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedEscrowChange event
+	err = types.EmitEvent(&types.MsgFeedEscrowChangeEvent{
+		FeedId:     msg.GetFeedId(),
+		ChangeType: FeedEscrowChangeTypeWithdraw,
+		Amount:     msg.GetAmount(),
+		Balance:    s.GetFeedEscrow(ctx, msg.GetFeedId()),
+		Signer:     msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+// WithdrawPaymentTx implements the tx/WithdrawPaymentTx gRPC method
+func (s msgServer) WithdrawPaymentTx(c context.Context, msg *types.MsgWithdrawPayment) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	amount := s.GetOwedAmount(ctx, msg.GetSigner(), msg.GetFeedId())
+
+	height, txHash, err := s.WithdrawPayment(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit PaymentWithdrawn event
+	err = types.EmitEvent(&types.MsgPaymentWithdrawnEvent{
+		Oracle: msg.GetSigner(),
+		Payee:  s.GetPayeeAddress(ctx, msg.GetSigner()),
+		FeedId: msg.GetFeedId(),
+		Amount: amount,
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
+func (s msgServer) SetFeedMetadataTx(c context.Context, msg *types.MsgSetFeedMetadata) (*types.MsgResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	height, txHash, err := s.SetFeedMetadata(ctx, msg)
+
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
+	}
+
+	// emit FeedMetadataChange event
+	err = types.EmitEvent(&types.MsgFeedMetadataChangeEvent{
+		FeedId:      msg.GetFeedId(),
+		NewMetadata: msg.GetMetadata(),
+		Signer:      msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResponse{
+		Height: uint64(height),
+		TxHash: string(txHash),
+	}, nil
+}
+
 func (s msgServer) RequestNewRoundTx(c context.Context, msg *types.MsgRequestNewRound) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -365,7 +1026,10 @@ func (s msgServer) RequestNewRoundTx(c context.Context, msg *types.MsgRequestNew
 func (s msgServer) AddAccountTx(c context.Context, msg *types.MsgAccount) (*types.MsgResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	height, txHash := s.AddAccount(ctx, msg)
+	height, txHash, err := s.AddAccount(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
 
 	if height == 0 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, ErrIncorrectHeightFound)
@@ -0,0 +1,216 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package keeper
+
+import (
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetOwnerApprovalThreshold returns the number of module owner approvals an owner proposal needs to execute
+func (k Keeper) GetOwnerApprovalThreshold(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).ApprovalThreshold(len(k.GetModuleOwnerList(ctx).GetModuleOwner()))
+}
+
+// SubmitOwnerProposal holds the module owner msg until enough module owners approve it, the proposer approves it
+// on submission
+func (k Keeper) SubmitOwnerProposal(ctx sdk.Context, msg sdk.Msg, proposer sdk.AccAddress) (*types.OwnerProposal, error) {
+	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
+
+	var proposalId uint64 = 1
+	if bz := moduleStore.Get(types.KeyPrefix(types.LastOwnerProposalIdKey)); bz != nil {
+		proposalId = sdk.BigEndianToUint64(bz) + 1
+	}
+
+	proposal, err := types.NewOwnerProposal(proposalId, msg, proposer, ctx.BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+	proposal.ExpiresAtHeight = k.GetParams(ctx).OwnerProposalExpiresAt(ctx.BlockHeight())
+
+	moduleStore.Set(types.KeyPrefix(types.LastOwnerProposalIdKey), sdk.Uint64ToBigEndian(proposalId))
+	k.SetOwnerProposal(ctx, proposal)
+
+	err = types.EmitEvent(&types.MsgOwnerProposalSubmittedEvent{
+		ProposalId: proposalId,
+		MsgType:    msg.Type(),
+		Proposer:   proposer,
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// SetOwnerProposal stores the pending owner proposal along with its expiry index entry
+func (k Keeper) SetOwnerProposal(ctx sdk.Context, proposal *types.OwnerProposal) {
+	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
+	moduleStore.Set(types.GetOwnerProposalKey(proposal.GetId()), k.cdc.MustMarshalBinaryBare(proposal))
+	if proposal.GetExpiresAtHeight() > 0 {
+		moduleStore.Set(types.GetOwnerProposalExpiryKey(proposal.GetExpiresAtHeight(), proposal.GetId()), sdk.Uint64ToBigEndian(proposal.GetId()))
+	}
+}
+
+// GetOwnerProposal returns the pending owner proposal, nil if there is none with this id
+func (k Keeper) GetOwnerProposal(ctx sdk.Context, proposalId uint64) *types.OwnerProposal {
+	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
+	bz := moduleStore.Get(types.GetOwnerProposalKey(proposalId))
+	if bz == nil {
+		return nil
+	}
+
+	var proposal types.OwnerProposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return &proposal
+}
+
+// DeleteOwnerProposal drops the pending owner proposal along with its expiry index entry
+func (k Keeper) DeleteOwnerProposal(ctx sdk.Context, proposalId uint64) {
+	proposal := k.GetOwnerProposal(ctx, proposalId)
+	if proposal == nil {
+		return
+	}
+
+	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
+	if proposal.GetExpiresAtHeight() > 0 {
+		moduleStore.Delete(types.GetOwnerProposalExpiryKey(proposal.GetExpiresAtHeight(), proposalId))
+	}
+	moduleStore.Delete(types.GetOwnerProposalKey(proposalId))
+}
+
+// ApproveOwnerProposal records the approval of a module owner, it returns the proposal once the approvals of the
+// current module owners reach the approval threshold, nil otherwise.
+// A module owner who already approved the proposal does not approve it twice but gets the threshold checked again,
+// so that a proposal which reached the threshold after module owners got removed or the threshold got lowered can
+// still be executed.
+func (k Keeper) ApproveOwnerProposal(ctx sdk.Context, msg *types.MsgApproveOwnerProposal) (*types.OwnerProposal, error) {
+	proposal := k.GetOwnerProposal(ctx, msg.GetProposalId())
+	if proposal == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "owner proposal %d not found", msg.GetProposalId())
+	}
+	if proposal.IsExpired(ctx.BlockHeight()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "owner proposal %d expired at height %d", msg.GetProposalId(), proposal.GetExpiresAtHeight())
+	}
+
+	moduleOwners := types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner())
+	threshold := k.GetParams(ctx).ApprovalThreshold(len(moduleOwners))
+
+	if proposal.HasApproved(msg.GetSigner()) {
+		if proposal.CountApprovals(moduleOwners) < threshold {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s already approved owner proposal %d", msg.GetSigner(), msg.GetProposalId())
+		}
+		return proposal, nil
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.GetSigner())
+	k.SetOwnerProposal(ctx, proposal)
+
+	approvals := proposal.CountApprovals(moduleOwners)
+
+	err := types.EmitEvent(&types.MsgOwnerProposalApprovedEvent{
+		ProposalId: proposal.GetId(),
+		Approver:   msg.GetSigner(),
+		Approvals:  approvals,
+		Threshold:  threshold,
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	if approvals < threshold {
+		return nil, nil
+	}
+	return proposal, nil
+}
+
+// CancelOwnerProposal drops the pending owner proposal, only the module owner who submitted it can cancel it
+func (k Keeper) CancelOwnerProposal(ctx sdk.Context, msg *types.MsgCancelOwnerProposal) (*types.OwnerProposal, error) {
+	proposal := k.GetOwnerProposal(ctx, msg.GetProposalId())
+	if proposal == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "owner proposal %d not found", msg.GetProposalId())
+	}
+	if !proposal.Proposer().Equals(msg.GetSigner()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s did not submit owner proposal %d", msg.GetSigner(), msg.GetProposalId())
+	}
+
+	k.DeleteOwnerProposal(ctx, proposal.GetId())
+
+	err := types.EmitEvent(&types.MsgOwnerProposalCancelledEvent{
+		ProposalId: proposal.GetId(),
+		MsgType:    proposal.MsgType(),
+		Signer:     msg.GetSigner(),
+	}, ctx.EventManager())
+	if err != nil {
+		return nil, err
+	}
+
+	return proposal, nil
+}
+
+// ExpireOwnerProposals drops the owner proposals whose expiry height is reached, it runs at the end of every block
+func (k Keeper) ExpireOwnerProposals(ctx sdk.Context) {
+	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
+
+	iterator := moduleStore.Iterator(types.GetOwnerProposalExpiryKey(0, 0), types.GetOwnerProposalExpiryKey(ctx.BlockHeight()+1, 0))
+	expiredIds := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		expiredIds = append(expiredIds, sdk.BigEndianToUint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	moduleOwners := types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner())
+	for _, proposalId := range expiredIds {
+		proposal := k.GetOwnerProposal(ctx, proposalId)
+		if proposal == nil {
+			continue
+		}
+		k.DeleteOwnerProposal(ctx, proposalId)
+
+		err := types.EmitEvent(&types.MsgOwnerProposalExpiredEvent{
+			ProposalId: proposalId,
+			MsgType:    proposal.MsgType(),
+			Approvals:  proposal.CountApprovals(moduleOwners),
+		}, ctx.EventManager())
+		if err != nil {
+			k.Logger(ctx).Error("failed to emit MsgOwnerProposalExpiredEvent: ", err.Error())
+		}
+	}
+}
+
+// GetOwnerProposalList returns the pending owner proposals in submission order, paginated
+func (k Keeper) GetOwnerProposalList(ctx sdk.Context, req *types.ListOwnerProposalsRequest) (*types.ListOwnerProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var proposals []*types.OwnerProposal
+
+	proposalStore := prefix.NewStore(ctx.KVStore(k.moduleOwnerStoreKey), types.GetOwnerProposalPrefix())
+
+	pageRes, err := query.Paginate(proposalStore, req.Pagination, func(key []byte, value []byte) error {
+		var proposal types.OwnerProposal
+		if err := k.cdc.UnmarshalBinaryBare(value, &proposal); err != nil {
+			return err
+		}
+
+		proposals = append(proposals, &proposal)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ListOwnerProposalsResponse{
+		Proposals:  proposals,
+		Threshold:  k.GetOwnerApprovalThreshold(ctx),
+		Pagination: pageRes,
+	}, nil
+}
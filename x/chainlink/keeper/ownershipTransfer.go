@@ -0,0 +1,259 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package keeper
+
+import (
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProposeFeedOwnershipTransfer holds the feed ownership transfer until the new feed owner accepts it, it replaces the
+// transfer pending for the feed if any
+func (k Keeper) ProposeFeedOwnershipTransfer(ctx sdk.Context, msg *types.MsgFeedOwnershipTransfer) (*types.OwnershipTransfer, error) {
+	feed := k.GetFeed(ctx, msg.GetFeedId()).GetFeed()
+	if feed == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "feed '%s' not found", msg.GetFeedId())
+	}
+
+	transfer := &types.OwnershipTransfer{
+		FeedId:           msg.GetFeedId(),
+		Owner:            feed.GetFeedOwner(),
+		NewOwner:         msg.GetNewFeedOwnerAddress(),
+		ProposedAtHeight: ctx.BlockHeight(),
+		ExpiresAtHeight:  k.GetParams(ctx).OwnershipTransferExpiresAt(ctx.BlockHeight()),
+	}
+
+	feedInfoStore := ctx.KVStore(k.feedInfoStoreKey)
+	key := types.GetFeedOwnershipTransferKey(msg.GetFeedId())
+	k.deleteOwnershipTransfer(feedInfoStore, key)
+	k.setOwnershipTransfer(feedInfoStore, key, transfer)
+
+	return transfer, nil
+}
+
+// GetPendingFeedOwnershipTransfer returns the ownership transfer pending for the feed, nil if there is none
+func (k Keeper) GetPendingFeedOwnershipTransfer(ctx sdk.Context, feedId string) *types.OwnershipTransfer {
+	return k.getOwnershipTransfer(ctx.KVStore(k.feedInfoStoreKey), types.GetFeedOwnershipTransferKey(feedId))
+}
+
+// DeleteFeedOwnershipTransfer drops the ownership transfer pending for the feed if any
+func (k Keeper) DeleteFeedOwnershipTransfer(ctx sdk.Context, feedId string) {
+	k.deleteOwnershipTransfer(ctx.KVStore(k.feedInfoStoreKey), types.GetFeedOwnershipTransferKey(feedId))
+}
+
+// AcceptFeedOwnership hands the feed over to the new feed owner of the pending transfer, only the new feed owner can
+// accept it before it expires
+func (k Keeper) AcceptFeedOwnership(ctx sdk.Context, msg *types.MsgAcceptFeedOwnership) (*types.OwnershipTransfer, error) {
+	transfer := k.GetPendingFeedOwnershipTransfer(ctx, msg.GetFeedId())
+	if err := k.validateAcceptance(ctx, transfer, msg.GetSigner()); err != nil {
+		return nil, sdkerrors.Wrapf(err, "feed '%s'", msg.GetFeedId())
+	}
+
+	feed := k.GetFeed(ctx, msg.GetFeedId()).GetFeed()
+	if feed == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "feed '%s' not found", msg.GetFeedId())
+	}
+	if feed.GetDeprecated() {
+		return nil, sdkerrors.Wrap(types.ErrFeedDeprecated, msg.GetFeedId())
+	}
+	if !feed.GetFeedOwner().Equals(transfer.GetOwner()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is no longer the owner of feed '%s'", transfer.GetOwner(), msg.GetFeedId())
+	}
+
+	_, _, err := k.FeedOwnershipTransfer(ctx, types.NewMsgFeedOwnershipTransfer(transfer.GetOwner(), msg.GetFeedId(), transfer.GetNewOwner()))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.DeleteFeedOwnershipTransfer(ctx, msg.GetFeedId())
+
+	return transfer, nil
+}
+
+// CancelFeedOwnershipTransfer drops the pending feed ownership transfer, either the feed owner who proposed it or the
+// new feed owner can cancel it
+func (k Keeper) CancelFeedOwnershipTransfer(ctx sdk.Context, msg *types.MsgCancelFeedOwnershipTransfer) (*types.OwnershipTransfer, error) {
+	transfer := k.GetPendingFeedOwnershipTransfer(ctx, msg.GetFeedId())
+	if err := validateCancellation(transfer, msg.GetSigner()); err != nil {
+		return nil, sdkerrors.Wrapf(err, "feed '%s'", msg.GetFeedId())
+	}
+
+	k.DeleteFeedOwnershipTransfer(ctx, msg.GetFeedId())
+	return transfer, nil
+}
+
+// ProposeModuleOwnershipTransfer holds the module ownership transfer until the new module owner accepts it, it
+// replaces the transfer pending for the assigner if any
+func (k Keeper) ProposeModuleOwnershipTransfer(ctx sdk.Context, msg *types.MsgModuleOwnershipTransfer) (*types.OwnershipTransfer, error) {
+	transfer := &types.OwnershipTransfer{
+		Owner:            msg.GetAssignerAddress(),
+		NewOwner:         msg.GetNewModuleOwnerAddress(),
+		NewOwnerPubKey:   msg.GetNewModuleOwnerPubKey(),
+		ProposedAtHeight: ctx.BlockHeight(),
+		ExpiresAtHeight:  k.GetParams(ctx).OwnershipTransferExpiresAt(ctx.BlockHeight()),
+	}
+
+	moduleStore := ctx.KVStore(k.moduleOwnerStoreKey)
+	key := types.GetModuleOwnershipTransferKey(msg.GetAssignerAddress())
+	k.deleteOwnershipTransfer(moduleStore, key)
+	k.setOwnershipTransfer(moduleStore, key, transfer)
+
+	return transfer, nil
+}
+
+// GetPendingModuleOwnershipTransfer returns the module ownership transfer pending for the assigner, nil if there is none
+func (k Keeper) GetPendingModuleOwnershipTransfer(ctx sdk.Context, assigner sdk.AccAddress) *types.OwnershipTransfer {
+	return k.getOwnershipTransfer(ctx.KVStore(k.moduleOwnerStoreKey), types.GetModuleOwnershipTransferKey(assigner))
+}
+
+// DeleteModuleOwnershipTransfer drops the module ownership transfer pending for the assigner if any
+func (k Keeper) DeleteModuleOwnershipTransfer(ctx sdk.Context, assigner sdk.AccAddress) {
+	k.deleteOwnershipTransfer(ctx.KVStore(k.moduleOwnerStoreKey), types.GetModuleOwnershipTransferKey(assigner))
+}
+
+// AcceptModuleOwnership checks the new module owner can accept the pending module ownership transfer and drops it,
+// the caller hands the module ownership over
+func (k Keeper) AcceptModuleOwnership(ctx sdk.Context, msg *types.MsgAcceptModuleOwnership) (*types.OwnershipTransfer, error) {
+	transfer := k.GetPendingModuleOwnershipTransfer(ctx, msg.GetAssignerAddress())
+	if err := k.validateAcceptance(ctx, transfer, msg.GetSigner()); err != nil {
+		return nil, sdkerrors.Wrapf(err, "module owner %s", msg.GetAssignerAddress())
+	}
+
+	moduleOwners := types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner())
+	if !moduleOwners.Contains(transfer.GetOwner()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is no longer a module owner", transfer.GetOwner())
+	}
+
+	k.DeleteModuleOwnershipTransfer(ctx, msg.GetAssignerAddress())
+	return transfer, nil
+}
+
+// CancelModuleOwnershipTransfer drops the pending module ownership transfer, either the module owner who proposed it
+// or the new module owner can cancel it
+func (k Keeper) CancelModuleOwnershipTransfer(ctx sdk.Context, msg *types.MsgCancelModuleOwnershipTransfer) (*types.OwnershipTransfer, error) {
+	transfer := k.GetPendingModuleOwnershipTransfer(ctx, msg.GetAssignerAddress())
+	if err := validateCancellation(transfer, msg.GetSigner()); err != nil {
+		return nil, sdkerrors.Wrapf(err, "module owner %s", msg.GetAssignerAddress())
+	}
+
+	k.DeleteModuleOwnershipTransfer(ctx, msg.GetAssignerAddress())
+	return transfer, nil
+}
+
+// GetModuleOwnershipTransferList returns the pending module ownership transfers in assigner order, paginated
+func (k Keeper) GetModuleOwnershipTransferList(ctx sdk.Context, req *types.ListModuleOwnershipTransfersRequest) (*types.ListModuleOwnershipTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var transfers []*types.OwnershipTransfer
+
+	transferStore := prefix.NewStore(ctx.KVStore(k.moduleOwnerStoreKey), types.GetModuleOwnershipTransferPrefix())
+
+	pageRes, err := query.Paginate(transferStore, req.Pagination, func(key []byte, value []byte) error {
+		var transfer types.OwnershipTransfer
+		if err := k.cdc.UnmarshalBinaryBare(value, &transfer); err != nil {
+			return err
+		}
+
+		transfers = append(transfers, &transfer)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.ListModuleOwnershipTransfersResponse{
+		Transfers:  transfers,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ExpireOwnershipTransfers drops the feed and module ownership transfers whose expiry height is reached, it runs at
+// the end of every block
+func (k Keeper) ExpireOwnershipTransfers(ctx sdk.Context) {
+	for _, store := range []sdk.KVStore{ctx.KVStore(k.feedInfoStoreKey), ctx.KVStore(k.moduleOwnerStoreKey)} {
+		iterator := store.Iterator(types.GetOwnershipTransferExpiryKey(0, nil), types.GetOwnershipTransferExpiryKey(ctx.BlockHeight()+1, nil))
+		expiredKeys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			expiredKeys = append(expiredKeys, iterator.Value())
+		}
+		iterator.Close()
+
+		for _, key := range expiredKeys {
+			transfer := k.getOwnershipTransfer(store, key)
+			if transfer == nil {
+				continue
+			}
+			k.deleteOwnershipTransfer(store, key)
+
+			err := types.EmitEvent(&types.MsgOwnershipTransferExpiredEvent{
+				FeedId:   transfer.GetFeedId(),
+				Owner:    transfer.GetOwner(),
+				NewOwner: transfer.GetNewOwner(),
+			}, ctx.EventManager())
+			if err != nil {
+				k.Logger(ctx).Error("failed to emit MsgOwnershipTransferExpiredEvent: ", err.Error())
+			}
+		}
+	}
+}
+
+func (k Keeper) setOwnershipTransfer(store sdk.KVStore, key []byte, transfer *types.OwnershipTransfer) {
+	store.Set(key, k.cdc.MustMarshalBinaryBare(transfer))
+	if transfer.GetExpiresAtHeight() > 0 {
+		store.Set(types.GetOwnershipTransferExpiryKey(transfer.GetExpiresAtHeight(), key), key)
+	}
+}
+
+func (k Keeper) getOwnershipTransfer(store sdk.KVStore, key []byte) *types.OwnershipTransfer {
+	bz := store.Get(key)
+	if bz == nil {
+		return nil
+	}
+
+	var transfer types.OwnershipTransfer
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return &transfer
+}
+
+// deleteOwnershipTransfer drops the pending ownership transfer along with its expiry index entry
+func (k Keeper) deleteOwnershipTransfer(store sdk.KVStore, key []byte) {
+	transfer := k.getOwnershipTransfer(store, key)
+	if transfer == nil {
+		return
+	}
+	if transfer.GetExpiresAtHeight() > 0 {
+		store.Delete(types.GetOwnershipTransferExpiryKey(transfer.GetExpiresAtHeight(), key))
+	}
+	store.Delete(key)
+}
+
+func (k Keeper) validateAcceptance(ctx sdk.Context, transfer *types.OwnershipTransfer, signer sdk.AccAddress) error {
+	if transfer == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "no pending ownership transfer")
+	}
+	if !transfer.GetNewOwner().Equals(signer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the new owner of the pending ownership transfer", signer)
+	}
+	if expiresAt := transfer.GetExpiresAtHeight(); expiresAt > 0 && ctx.BlockHeight() > expiresAt {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ownership transfer expired at height %d", expiresAt)
+	}
+	return nil
+}
+
+func validateCancellation(transfer *types.OwnershipTransfer, signer sdk.AccAddress) error {
+	if transfer == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "no pending ownership transfer")
+	}
+	if !transfer.GetOwner().Equals(signer) && !transfer.GetNewOwner().Equals(signer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner nor the new owner of the pending ownership transfer", signer)
+	}
+	return nil
+}
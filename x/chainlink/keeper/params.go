@@ -0,0 +1,60 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package keeper
+
+import (
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetParams returns the module params, the params never set, like on the chains started before the module
+// got params, have their default value
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return params
+}
+
+// SetParams sets the module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetModuleParamsResponse returns the module params for the params query
+func (k Keeper) GetModuleParamsResponse(ctx sdk.Context) *types.GetModuleParamsResponse {
+	params := k.GetParams(ctx)
+	return &types.GetModuleParamsResponse{Params: &params}
+}
+
+// ValidateRewardDenomChange checks the reward denom can be changed to denom: the feed escrows and the owed payments
+// are amounts of the current reward denom, the denom can not change while any of them is not zero
+func (k Keeper) ValidateRewardDenomChange(ctx sdk.Context, denom string) error {
+	current := k.GetParams(ctx).RewardDenom
+	if denom == current {
+		return nil
+	}
+
+	escrowIterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.feedInfoStoreKey), types.KeyPrefix(types.FeedEscrowKey+"/"))
+	defer escrowIterator.Close()
+	for ; escrowIterator.Valid(); escrowIterator.Next() {
+		if sdk.BigEndianToUint64(escrowIterator.Value()) > 0 {
+			return sdkerrors.Wrapf(types.ErrRewardBalancesOutstanding, "feed escrows hold %s", current)
+		}
+	}
+
+	paymentIterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.accountStoreKey), types.GetPaymentPrefix(nil))
+	defer paymentIterator.Close()
+	for ; paymentIterator.Valid(); paymentIterator.Next() {
+		var payment types.OwedPayment
+		k.cdc.MustUnmarshalBinaryBare(paymentIterator.Value(), &payment)
+		if payment.GetAmount() > 0 {
+			return sdkerrors.Wrapf(types.ErrRewardBalancesOutstanding, "payments of %s are owed to the oracles", current)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+// HandleAddFeedProposal adds the feed of a passed AddFeedProposal, the checks of the ante handler on MsgFeed apply
+func HandleAddFeedProposal(ctx sdk.Context, k Keeper, p *types.AddFeedProposal) error {
+	feed := p.ProposedFeed()
+	if err := k.ValidateNewFeed(ctx, feed); err != nil {
+		return err
+	}
+
+	// the proposal passed the vote, it does not wait for the approval of the module owners
+	_, err := msgServer{Keeper: k}.addFeed(ctx, feed)
+	return err
+}
+
+// HandleAddModuleOwnerProposal adds the module owner of a passed AddModuleOwnerProposal
+func HandleAddModuleOwnerProposal(ctx sdk.Context, k Keeper, p *types.AddModuleOwnerProposal) error {
+	if types.MsgModuleOwners(k.GetModuleOwnerList(ctx).GetModuleOwner()).Contains(p.GetAddress()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is already a module owner", p.GetAddress())
+	}
+
+	// the module emits the same event as for the module owner msgs, with the gov module account as signer
+	_, err := msgServer{Keeper: k}.addModuleOwner(ctx, p.ModuleOwner())
+	return err
+}
+
+// HandleRemoveModuleOwnerProposal removes the module owner of a passed RemoveModuleOwnerProposal
+func HandleRemoveModuleOwnerProposal(ctx sdk.Context, k Keeper, p *types.RemoveModuleOwnerProposal) error {
+	_, err := msgServer{Keeper: k}.removeModuleOwner(ctx, types.NewMsgRemoveModuleOwner(types.GovModuleAddress(), p.GetAddress()))
+	return err
+}
+
+// ValidateParamChangeProposal checks the changes of the chainlink params in a passed ParameterChangeProposal,
+// the reward denom can not change while the feed escrows or the owed payments are not zero
+func ValidateParamChangeProposal(ctx sdk.Context, k Keeper, p *paramproposal.ParameterChangeProposal) error {
+	for _, change := range p.Changes {
+		if change.GetSubspace() != types.ModuleName || change.GetKey() != string(types.KeyRewardDenom) {
+			continue
+		}
+
+		var denom string
+		if err := json.Unmarshal([]byte(change.GetValue()), &denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid reward denom: %s", err)
+		}
+		if err := k.ValidateRewardDenomChange(ctx, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
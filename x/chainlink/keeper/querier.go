@@ -29,16 +29,40 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 		switch path[0] {
 		case types.QueryRoundFeedData:
 			return getRoundFeedData(ctx, path, k, legacyQuerierCdc)
+		case types.QueryRoundHistory:
+			return getRoundHistory(ctx, req, k, legacyQuerierCdc)
 		case types.QueryLatestFeedData:
 			return latestRoundFeedData(ctx, path, k, legacyQuerierCdc)
 		case types.QueryModuleOwner:
 			return getModuleOwners(ctx, path, k, legacyQuerierCdc)
+		case types.QueryOwnerProposals:
+			return listOwnerProposals(ctx, req, k, legacyQuerierCdc)
+		case types.QueryModuleOwnershipTransfers:
+			return listModuleOwnershipTransfers(ctx, req, k, legacyQuerierCdc)
 		case types.QueryFeedInfo:
 			return getFeedInfo(ctx, path, k, legacyQuerierCdc)
+		case types.QueryFeedMetadata:
+			return getFeedMetadata(ctx, path, k, legacyQuerierCdc)
+		case types.QueryFeedOwnershipTransfer:
+			return getFeedOwnershipTransfer(ctx, path, k, legacyQuerierCdc)
+		case types.QueryLatestConfig:
+			return getLatestConfigDetails(ctx, path, k, legacyQuerierCdc)
+		case types.QueryFeedEscrow:
+			return getFeedEscrowBalance(ctx, path, k, legacyQuerierCdc)
+		case types.QueryFeedList:
+			return listFeeds(ctx, req, k, legacyQuerierCdc)
 		case types.QueryAccountInfo:
 			return getAccountInfo(ctx, path, k, legacyQuerierCdc)
+		case types.QueryAccountList:
+			return listAccounts(ctx, req, k, legacyQuerierCdc)
+		case types.QueryOwedPayments:
+			return listOwedPayments(ctx, req, k, legacyQuerierCdc)
+		case types.QueryAccountByKey:
+			return getAccountByChainlinkKey(ctx, path, k, legacyQuerierCdc)
 		case types.QueryFeedRewardStrategy:
 			return getFeedRewardStrategy(ctx, path, k, legacyQuerierCdc)
+		case types.QueryParams:
+			return getParams(ctx, path, k, legacyQuerierCdc)
 		default:
 			err = sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -76,6 +100,29 @@ func getRoundFeedData(ctx sdk.Context, path []string, keeper Keeper, legacQuerie
 	return bz, nil
 }
 
+// getRoundHistory expects the JSON encoded GetRoundHistoryRequest as query data
+func getRoundHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.GetRoundHistoryRequest
+	if err := legacQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Pagination == nil {
+		params.Pagination = &query.PageRequest{Limit: defaultPageLimit}
+	}
+
+	msgs, err := keeper.GetRoundFeedDataHistory(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, msgs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func latestRoundFeedData(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
 	if len(path) < 2 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
@@ -124,6 +171,30 @@ func getFeedInfo(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc
 
 	resp := keeper.GetFeed(ctx, feedId)
 	if resp.Feed == nil {
+		// a deleted feed is reported by its tombstone
+		resp.Tombstone = keeper.GetFeedTombstone(ctx, feedId)
+		if resp.Tombstone == nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "No feed found")
+		}
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func getFeedMetadata(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+	feedId := path[1]
+
+	resp, err := keeper.GetFeedMetadataByFeedId(ctx, &types.GetFeedMetadataRequest{FeedId: feedId})
+	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "No feed found")
 	}
 
@@ -135,6 +206,86 @@ func getFeedInfo(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc
 	return bz, nil
 }
 
+func getFeedOwnershipTransfer(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+	feedId := path[1]
+
+	resp := &types.GetFeedOwnershipTransferResponse{Transfer: keeper.GetPendingFeedOwnershipTransfer(ctx, feedId)}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func getLatestConfigDetails(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+	feedId := path[1]
+
+	resp, err := keeper.GetLatestConfigDetails(ctx, &types.LatestConfigDetailsRequest{FeedId: feedId})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "No feed found")
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func getFeedEscrowBalance(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+	feedId := path[1]
+
+	resp, err := keeper.GetFeedEscrowByFeedId(ctx, &types.GetFeedEscrowBalanceRequest{FeedId: feedId})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, "No feed found")
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// listFeeds expects the JSON encoded ListFeedsRequest as query data
+func listFeeds(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.ListFeedsRequest
+	if err := legacQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Pagination == nil {
+		params.Pagination = &query.PageRequest{Limit: defaultPageLimit}
+	}
+
+	resp, err := keeper.GetFeedsByFilter(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func getAccountInfo(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
 	if len(path) < 2 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
@@ -158,6 +309,117 @@ func getAccountInfo(ctx sdk.Context, path []string, keeper Keeper, legacQuerierC
 	return bz, nil
 }
 
+// listOwedPayments expects the JSON encoded ListOwedPaymentsRequest as query data
+func listOwedPayments(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.ListOwedPaymentsRequest
+	if err := legacQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Pagination == nil {
+		params.Pagination = &query.PageRequest{Limit: defaultPageLimit}
+	}
+
+	resp, err := keeper.GetOwedPaymentList(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// listOwnerProposals expects the JSON encoded ListOwnerProposalsRequest as query data
+func listOwnerProposals(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.ListOwnerProposalsRequest
+	if err := legacQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Pagination == nil {
+		params.Pagination = &query.PageRequest{Limit: defaultPageLimit}
+	}
+
+	resp, err := keeper.GetOwnerProposalList(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// listModuleOwnershipTransfers expects the JSON encoded ListModuleOwnershipTransfersRequest as query data
+func listModuleOwnershipTransfers(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.ListModuleOwnershipTransfersRequest
+	if err := legacQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Pagination == nil {
+		params.Pagination = &query.PageRequest{Limit: defaultPageLimit}
+	}
+
+	resp, err := keeper.GetModuleOwnershipTransferList(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func listAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.ListAccountsRequest
+	if err := legacQuerierCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Pagination == nil {
+		params.Pagination = &query.PageRequest{Limit: defaultPageLimit}
+	}
+
+	resp, err := keeper.GetAccountList(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func getAccountByChainlinkKey(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 2 parameters is required")
+	}
+
+	req := &types.GetAccountByChainlinkKeyRequest{ChainlinkKey: []byte(path[1])}
+	resp, err := keeper.LookupAccountByChainlinkKey(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func getFeedRewardStrategy(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
 	if len(path) < 1 {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
@@ -173,3 +435,19 @@ func getFeedRewardStrategy(ctx sdk.Context, path []string, keeper Keeper, legacQ
 
 	return bz, nil
 }
+
+func getParams(ctx sdk.Context, path []string, keeper Keeper, legacQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	if len(path) < 1 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			"Insufficient parameters, at least 1 parameters is required")
+	}
+
+	resp := keeper.GetModuleParamsResponse(ctx)
+
+	bz, err := codec.MarshalJSONIndent(legacQuerierCdc, resp)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
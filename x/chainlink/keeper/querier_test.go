@@ -21,7 +21,6 @@ func TestQuerier_GetRoundFeedData(t *testing.T) {
 	keeper, ctx := setupKeeper(t)
 	amino := codec.NewLegacyAmino()
 	querier := NewQuerier(*keeper, amino)
-	roundStore := ctx.KVStore(keeper.roundStoreKey)
 
 	testCases := []struct {
 		feedId          string
@@ -44,7 +43,7 @@ func TestQuerier_GetRoundFeedData(t *testing.T) {
 			continue
 		}
 		// force set roundId-1 for SetFeedData
-		roundStore.Set(types.GetRoundIdKey(tc.feedId), i64tob(tc.roundId-1))
+		keeper.setLatestRoundId(ctx, tc.feedId, tc.roundId-1)
 
 		msgFeedData := types.MsgFeedData{
 			FeedId:                        tc.feedId,
@@ -52,6 +51,7 @@ func TestQuerier_GetRoundFeedData(t *testing.T) {
 			Submitter:                     tc.submitter,
 			ObservationFeedDataSignatures: tc.signature,
 			IsFeedDataValid:               tc.isFeedDataValid,
+			Report:                        GenerateReport(t, int64(tc.roundId), int64(tc.roundId)+1, int64(tc.roundId)+2),
 		}
 
 		_, _, err := keeper.SetFeedData(ctx, &msgFeedData)
@@ -72,14 +72,18 @@ func TestQuerier_GetRoundFeedData(t *testing.T) {
 			if tc.insert {
 				require.Equal(t, 1, len(roundDataResponse.GetRoundData()))
 				require.Equal(t, tc.feedId, roundDataResponse.GetRoundData()[0].GetFeedId())
-				require.Equal(t, strconv.FormatUint(tc.roundId, 10), string(roundDataResponse.GetRoundData()[0].GetFeedData().GetContext()))
-				require.Equal(t, tc.submitter.Bytes(), roundDataResponse.GetRoundData()[0].GetFeedData().GetOracles())
+				require.Equal(t, tc.roundId, roundDataResponse.GetRoundData()[0].GetRoundId())
+				require.Equal(t, sdk.NewIntFromUint64(tc.roundId+1), roundDataResponse.GetRoundData()[0].Answer)
+				require.Equal(t, uint64(testBlockTime.Unix()), roundDataResponse.GetRoundData()[0].GetUpdatedAt())
+				require.Equal(t, testBlockHeight, roundDataResponse.GetRoundData()[0].GetBlockHeight())
+				require.Equal(t, []byte{0, 1, 2}, roundDataResponse.GetRoundData()[0].GetFeedData().GetOracles())
 
 				// TODO if tc.isFeedDataValid is true, check if event is emitted with correct signature
 
 				observations := roundDataResponse.GetRoundData()[0].GetFeedData().GetObservations()
-				for i := 0; i < len(tc.feedData); i++ {
-					require.Equal(t, string(tc.feedData[i]), string(observations[i].Data[0]))
+				require.Equal(t, 3, len(observations))
+				for i, o := range observations {
+					require.Equal(t, sdk.NewIntFromUint64(tc.roundId+uint64(i)), o.Value)
 				}
 			} else {
 				require.Equal(t, 0, len(roundDataResponse.GetRoundData()))
@@ -92,7 +96,6 @@ func TestQuerier_LatestRoundFeedData(t *testing.T) {
 	keeper, ctx := setupKeeper(t)
 	amino := codec.NewLegacyAmino()
 	querier := NewQuerier(*keeper, amino)
-	roundStore := ctx.KVStore(keeper.roundStoreKey)
 
 	testCases := []struct {
 		feedId          string
@@ -117,7 +120,7 @@ func TestQuerier_LatestRoundFeedData(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			if tc.insert {
 				// force set roundId-1 for SetFeedData
-				roundStore.Set(types.GetRoundIdKey(tc.feedId), i64tob(tc.roundId-1))
+				keeper.setLatestRoundId(ctx, tc.feedId, tc.roundId-1)
 
 				msgFeedData := types.MsgFeedData{
 					FeedId:                        tc.feedId,
@@ -125,6 +128,7 @@ func TestQuerier_LatestRoundFeedData(t *testing.T) {
 					Submitter:                     tc.submitter,
 					ObservationFeedDataSignatures: tc.signature,
 					IsFeedDataValid:               tc.isFeedDataValid,
+					Report:                        GenerateReport(t, int64(tc.roundId)),
 				}
 
 				_, _, err := keeper.SetFeedData(ctx, &msgFeedData)
@@ -142,7 +146,8 @@ func TestQuerier_LatestRoundFeedData(t *testing.T) {
 			if tc.expected > 0 {
 				require.Equal(t, 1, len(roundDataResponse.GetRoundData()))
 				require.Equal(t, tc.feedId, roundDataResponse.GetRoundData()[0].GetFeedId())
-				require.Equal(t, strconv.FormatUint(tc.expected, 10), string(roundDataResponse.GetRoundData()[0].GetFeedData().GetContext()))
+				require.Equal(t, tc.expected, roundDataResponse.GetRoundData()[0].GetRoundId())
+				require.Equal(t, sdk.NewIntFromUint64(tc.expected), roundDataResponse.GetRoundData()[0].Answer)
 
 				// TODO if tc.isFeedDataValid is true, check if event is emitted with correct signature
 			} else {
@@ -152,6 +157,34 @@ func TestQuerier_LatestRoundFeedData(t *testing.T) {
 	}
 }
 
+func TestQuerier_GetRoundHistory(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	amino := codec.NewLegacyAmino()
+	querier := NewQuerier(*keeper, amino)
+
+	for i := int64(1); i <= 3; i++ {
+		_, _, err := keeper.SetFeedData(ctx, &types.MsgFeedData{FeedId: "feed1", Report: GenerateReport(t, i)})
+		require.NoError(t, err)
+	}
+
+	reqData, err := amino.MarshalJSON(types.GetRoundHistoryRequest{FeedId: "feed1", Reverse: true})
+	require.NoError(t, err)
+
+	result, err := querier(ctx, []string{types.QueryRoundHistory}, abci.RequestQuery{Data: reqData})
+	require.NoError(t, err)
+
+	var roundHistoryResponse types.GetRoundHistoryResponse
+	require.NoError(t, amino.UnmarshalJSON(result, &roundHistoryResponse))
+	require.Equal(t, 3, len(roundHistoryResponse.GetRoundData()))
+	for i, roundData := range roundHistoryResponse.GetRoundData() {
+		require.Equal(t, uint64(3-i), roundData.GetRoundId())
+		require.Equal(t, sdk.NewInt(int64(3-i)), roundData.Answer)
+	}
+
+	_, err = querier(ctx, []string{types.QueryRoundHistory}, abci.RequestQuery{Data: []byte("not json")})
+	require.ErrorIs(t, err, sdkerrors.ErrJSONUnmarshal)
+}
+
 func TestQuerier_GetFeedInfo(t *testing.T) {
 	keeper, ctx := setupKeeper(t)
 	amino := codec.NewLegacyAmino()
@@ -235,6 +268,28 @@ func TestQuerier_GetFeedInfo(t *testing.T) {
 	}
 }
 
+func TestQuerier_ListFeeds(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+	amino := codec.NewLegacyAmino()
+	querier := NewQuerier(*keeper, amino)
+
+	owner := GenerateAccount()
+	keeper.SetFeed(ctx, &types.MsgFeed{FeedId: "feed1", FeedOwner: owner})
+	keeper.SetFeed(ctx, &types.MsgFeed{FeedId: "feed2", FeedOwner: GenerateAccount()})
+
+	reqData, err := amino.MarshalJSON(types.ListFeedsRequest{FeedOwner: owner})
+	require.NoError(t, err)
+
+	result, err := querier(ctx, []string{types.QueryFeedList}, abci.RequestQuery{Data: reqData})
+	require.NoError(t, err)
+
+	var listFeedsResponse types.ListFeedsResponse
+	require.NoError(t, amino.UnmarshalJSON(result, &listFeedsResponse))
+	require.Equal(t, 1, len(listFeedsResponse.GetFeeds()))
+	require.Equal(t, "feed1", listFeedsResponse.GetFeeds()[0].GetFeedId())
+	require.Equal(t, owner, listFeedsResponse.GetFeeds()[0].GetFeedOwner())
+}
+
 func TestQuerier_GetModuleOwners(t *testing.T) {
 	keeper, ctx := setupKeeper(t)
 	amino := codec.NewLegacyAmino()
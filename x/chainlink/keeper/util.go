@@ -4,29 +4,61 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// feedDataFilter filters the feedData query result by feedId and roundId
-func feedDataFilter(requiredFeedID string, requiredRoundID uint64, feedData types.OCRFeedDataInStore) *types.RoundData {
-	if feedData.GetRoundId() == requiredRoundID {
-		if requiredFeedID == feedData.GetFeedData().GetFeedId() {
-			roundData := &types.RoundData{
-				FeedId:   feedData.GetFeedData().GetFeedId(),
-				FeedData: feedData.GetDeserializedOCRReport(),
-			}
-			return roundData
-		}
-		if requiredFeedID == "" {
-			roundData := &types.RoundData{
-				FeedId:   feedData.GetFeedData().GetFeedId(),
-				FeedData: feedData.GetDeserializedOCRReport(),
+// toRoundData converts the feed data persisted in store into the RoundData query shape
+func toRoundData(feedData types.OCRFeedDataInStore) *types.RoundData {
+	return &types.RoundData{
+		FeedId:          feedData.GetFeedData().GetFeedId(),
+		FeedData:        feedData.GetDeserializedOCRReport(),
+		RoundId:         feedData.GetRoundId(),
+		Answer:          feedData.Answer,
+		StartedAt:       feedData.GetStartedAt(),
+		UpdatedAt:       feedData.GetUpdatedAt(),
+		AnsweredInRound: feedData.GetAnsweredInRound(),
+		BlockHeight:     feedData.GetBlockHeight(),
+		Value:           feedValue(feedData),
+	}
+}
+
+// feedValue returns the typed answer of a round, the rounds persisted before the feeds got a value schema
+// get it aggregated from their report
+func feedValue(feedData types.OCRFeedDataInStore) *types.FeedValue {
+	if feedData.GetValue() != nil {
+		return feedData.GetValue()
+	}
+	return feedData.GetDeserializedOCRReport().Aggregate()
+}
+
+// feedMatchesFilter checks the feed matches every filter set in the ListFeeds request
+func feedMatchesFilter(feed *types.MsgFeed, req *types.ListFeedsRequest) bool {
+	if !req.GetFeedOwner().Empty() && !feed.GetFeedOwner().Equals(req.GetFeedOwner()) {
+		return false
+	}
+
+	if req.GetFeedRewardStrategy() != "" && feed.GetFeedReward().GetStrategy() != req.GetFeedRewardStrategy() {
+		return false
+	}
+
+	if !req.GetDataProvider().Empty() {
+		for _, dataProvider := range feed.GetDataProviders() {
+			if dataProvider.GetAddress().Equals(req.GetDataProvider()) {
+				return true
 			}
-			return roundData
 		}
+		return false
 	}
 
-	return nil
+	return true
+}
+
+// blockTimeMillis returns the block time in unix milliseconds, the unit of the feed heartbeat trigger
+func blockTimeMillis(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().UnixNano() / int64(time.Millisecond))
 }
 
 func i64tob(val uint64) []byte {
@@ -4,11 +4,14 @@
 package keeper
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,6 +24,9 @@ var (
 	testOracles     = []byte{}
 	testObservation = []*types.Observation{}
 
+	testBlockHeight = int64(10)
+	testBlockTime   = time.Unix(1625097600, 0).UTC()
+
 	testNum      = uint64(310)
 	testNumBytes = []uint8([]byte{0x36, 0x1, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0})
 )
@@ -30,20 +36,55 @@ func GenerateAccount() sdk.AccAddress {
 	return addr
 }
 
-func TestFeedDataFilter(t *testing.T) {
+// testReportEpoch is bumped by GenerateReport so that every generated report is newer than the previous ones
+var testReportEpoch uint32
+
+// GenerateReport ABI encodes an OCR report carrying the given sorted observations,
+// observed by oracles 0..len(observations)-1, in an epoch newer than every previously generated report
+func GenerateReport(t testing.TB, observations ...int64) []byte {
+	testReportEpoch++
+	return GenerateReportWithContext(t, nil, testReportEpoch, 1, observations...)
+}
+
+// GenerateReportWithContext ABI encodes an OCR report like GenerateReport under the given config digest, epoch and round
+func GenerateReportWithContext(t testing.TB, configDigest []byte, epoch uint32, round uint8, observations ...int64) []byte {
+	observers := make([]byte, 0, len(observations))
+	values := make([]*big.Int, 0, len(observations))
+	for i, o := range observations {
+		observers = append(observers, byte(i))
+		values = append(values, big.NewInt(o))
+	}
+
+	report, err := types.EncodeOCRReport(types.NewOCRReportContext(configDigest, epoch, round), observers, values)
+	require.NoError(t, err)
+	return report
+}
+
+func TestToRoundData(t *testing.T) {
 	feedData := types.OCRFeedDataInStore{
 		FeedData: &types.MsgFeedData{FeedId: testfeedid, Submitter: GenerateAccount(), ObservationFeedData: testfeedData,
 			ObservationFeedDataSignatures: testsignatures},
 		DeserializedOCRReport: &types.OCRAbiEncoded{Context: testContext, Oracles: testOracles, Observations: testObservation},
 		RoundId:               testRoundID,
+		Answer:                sdk.NewInt(42),
+		StartedAt:             100,
+		UpdatedAt:             100,
+		AnsweredInRound:       testRoundID,
+		BlockHeight:           10,
 	}
 
 	expRoundData := &types.RoundData{
-		FeedId:   feedData.GetFeedData().GetFeedId(),
-		FeedData: feedData.GetDeserializedOCRReport(),
+		FeedId:          feedData.GetFeedData().GetFeedId(),
+		FeedData:        feedData.GetDeserializedOCRReport(),
+		RoundId:         testRoundID,
+		Answer:          sdk.NewInt(42),
+		StartedAt:       100,
+		UpdatedAt:       100,
+		AnsweredInRound: testRoundID,
+		BlockHeight:     10,
 	}
 
-	require.Equal(t, feedDataFilter(testfeedid, testRoundID, feedData), expRoundData)
+	require.Equal(t, expRoundData, toRoundData(feedData))
 }
 
 func TestI64tob(t *testing.T) {
@@ -53,3 +94,60 @@ func TestI64tob(t *testing.T) {
 func TestBtoi64(t *testing.T) {
 	require.Equal(t, testNum, btoi64(testNumBytes))
 }
+
+// fakeBankKeeper is an in memory types.BankKeeper keeping track of the module and account balances
+type fakeBankKeeper struct {
+	moduleBalances  map[string]sdk.Coins
+	accountBalances map[string]sdk.Coins
+}
+
+func newFakeBankKeeper() *fakeBankKeeper {
+	return &fakeBankKeeper{
+		moduleBalances:  map[string]sdk.Coins{},
+		accountBalances: map[string]sdk.Coins{},
+	}
+}
+
+func (b *fakeBankKeeper) SendCoins(_ sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, ok := b.accountBalances[fromAddr.String()].SafeSub(amt)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.accountBalances[fromAddr.String()] = balance
+	b.accountBalances[toAddr.String()] = b.accountBalances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (b *fakeBankKeeper) MintCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	b.moduleBalances[moduleName] = b.moduleBalances[moduleName].Add(amt...)
+	return nil
+}
+
+func (b *fakeBankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	balance, ok := b.moduleBalances[moduleName].SafeSub(amt)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.moduleBalances[moduleName] = balance
+	return nil
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, ok := b.moduleBalances[senderModule].SafeSub(amt)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.moduleBalances[senderModule] = balance
+	b.accountBalances[recipientAddr.String()] = b.accountBalances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (b *fakeBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, ok := b.accountBalances[senderAddr.String()].SafeSub(amt)
+	if ok {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.accountBalances[senderAddr.String()] = balance
+	b.moduleBalances[recipientModule] = b.moduleBalances[recipientModule].Add(amt...)
+	return nil
+}
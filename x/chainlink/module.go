@@ -170,7 +170,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONMarshaler) json
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// requests a new round for every feed whose heartbeat is due, drops the expired ownership
+// transfers and owner proposals and returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.ProcessHeartbeats(ctx)
+	am.keeper.ExpireOwnershipTransfers(ctx)
+	am.keeper.ExpireOwnerProposals(ctx)
 	return []abci.ValidatorUpdate{}
 }
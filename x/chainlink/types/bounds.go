@@ -0,0 +1,59 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// AnswerBoundsModeReject rejects the rounds whose answer is out of bounds
+	AnswerBoundsModeReject = "reject"
+	// AnswerBoundsModeFlag accepts the rounds whose answer is out of bounds but does not reward them
+	// and emits a MsgFeedDataValidationFailedEvent
+	AnswerBoundsModeFlag = "flag"
+)
+
+// ValidateAnswerBoundsMode checks the mode is a known one, empty means AnswerBoundsModeReject
+func ValidateAnswerBoundsMode(mode string) error {
+	switch mode {
+	case "", AnswerBoundsModeReject, AnswerBoundsModeFlag:
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid answer bounds mode %s", mode)
+	}
+}
+
+// Validate checks both bounds are set, the minimum does not exceed the maximum and the mode is a known one
+func (m *AnswerBounds) Validate() error {
+	if m.MinAnswer.IsNil() || m.MaxAnswer.IsNil() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "minAnswer and maxAnswer must both be set")
+	}
+	if m.MinAnswer.GT(m.MaxAnswer) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "minAnswer %s is greater than maxAnswer %s", m.MinAnswer, m.MaxAnswer)
+	}
+	return ValidateAnswerBoundsMode(m.GetMode())
+}
+
+// Contains tells whether the answer lies within the bounds, nil bounds contain every answer
+func (m *AnswerBounds) Contains(answer sdk.Int) bool {
+	if m == nil {
+		return true
+	}
+	return answer.GTE(m.MinAnswer) && answer.LTE(m.MaxAnswer)
+}
+
+// Check returns ErrAnswerOutOfBounds when the answer does not lie within the bounds
+func (m *AnswerBounds) Check(answer sdk.Int) error {
+	if m.Contains(answer) {
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrAnswerOutOfBounds, "answer %s is out of [%s, %s]", answer, m.MinAnswer, m.MaxAnswer)
+}
+
+// FlagsOutOfBounds tells whether the rounds whose answer is out of bounds are accepted and flagged instead of rejected
+func (m *AnswerBounds) FlagsOutOfBounds() bool {
+	return m.GetMode() == AnswerBoundsModeFlag
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_ValidateAnswerBoundsMode(t *testing.T) {
+	require.NoError(t, ValidateAnswerBoundsMode(""))
+	require.NoError(t, ValidateAnswerBoundsMode(AnswerBoundsModeReject))
+	require.NoError(t, ValidateAnswerBoundsMode(AnswerBoundsModeFlag))
+	require.Error(t, ValidateAnswerBoundsMode("ignore"))
+}
+
+func TestTypes_AnswerBounds(t *testing.T) {
+	bounds := &AnswerBounds{MinAnswer: sdk.NewInt(-10), MaxAnswer: sdk.NewInt(100)}
+	require.NoError(t, bounds.Validate())
+	require.False(t, bounds.FlagsOutOfBounds())
+
+	testCases := []struct {
+		answer   int64
+		contains bool
+	}{
+		{answer: -11, contains: false},
+		{answer: -10, contains: true},
+		{answer: 0, contains: true},
+		{answer: 100, contains: true},
+		{answer: 101, contains: false},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.contains, bounds.Contains(sdk.NewInt(tc.answer)), "answer %d", tc.answer)
+		if tc.contains {
+			require.NoError(t, bounds.Check(sdk.NewInt(tc.answer)))
+		} else {
+			require.ErrorIs(t, bounds.Check(sdk.NewInt(tc.answer)), ErrAnswerOutOfBounds)
+		}
+	}
+
+	// nil bounds contain every answer
+	var noBounds *AnswerBounds
+	require.True(t, noBounds.Contains(sdk.NewInt(1000000)))
+	require.NoError(t, noBounds.Check(sdk.NewInt(1000000)))
+	require.False(t, noBounds.FlagsOutOfBounds())
+
+	require.Error(t, (&AnswerBounds{MinAnswer: sdk.NewInt(1)}).Validate())
+	require.Error(t, (&AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(0)}).Validate())
+	require.Error(t, (&AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(1), Mode: "ignore"}).Validate())
+	require.True(t, (&AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(1), Mode: AnswerBoundsModeFlag}).FlagsOutOfBounds())
+}
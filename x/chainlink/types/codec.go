@@ -8,22 +8,45 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgFeedData{}, "chainlink/SubmitFeedData", nil)
 	cdc.RegisterConcrete(MsgModuleOwner{}, "chainlink/AddModuleOwner", nil)
 	cdc.RegisterConcrete(MsgModuleOwnershipTransfer{}, "chainlink/ModuleOwnershipTransfer", nil)
+	cdc.RegisterConcrete(MsgAcceptModuleOwnership{}, "chainlink/AcceptModuleOwnership", nil)
+	cdc.RegisterConcrete(MsgCancelModuleOwnershipTransfer{}, "chainlink/CancelModuleOwnershipTransfer", nil)
+	cdc.RegisterConcrete(MsgRemoveModuleOwner{}, "chainlink/RemoveModuleOwner", nil)
+	cdc.RegisterConcrete(MsgApproveOwnerProposal{}, "chainlink/ApproveOwnerProposal", nil)
+	cdc.RegisterConcrete(MsgCancelOwnerProposal{}, "chainlink/CancelOwnerProposal", nil)
 	cdc.RegisterConcrete(MsgFeed{}, "chainlink/AddFeed", nil)
 	cdc.RegisterConcrete(MsgAddDataProvider{}, "chainlink/AddDataProvider", nil)
 	cdc.RegisterConcrete(MsgRemoveDataProvider{}, "chainlink/RemoveDataProvider", nil)
+	cdc.RegisterConcrete(MsgAddTransmitter{}, "chainlink/AddTransmitter", nil)
+	cdc.RegisterConcrete(MsgRemoveTransmitter{}, "chainlink/RemoveTransmitter", nil)
 	cdc.RegisterConcrete(MsgSetSubmissionCount{}, "chainlink/SetSubmissionCount", nil)
 	cdc.RegisterConcrete(MsgSetHeartbeatTrigger{}, "chainlink/SetHeartbeatTrigger", nil)
 	cdc.RegisterConcrete(MsgSetDeviationThresholdTrigger{}, "chainlink/SetDeviationThresholdTrigger", nil)
 	cdc.RegisterConcrete(MsgSetFeedReward{}, "chainlink/SetFeedReward", nil)
+	cdc.RegisterConcrete(MsgSetFeedMetadata{}, "chainlink/SetFeedMetadata", nil)
+	cdc.RegisterConcrete(MsgSetAnswerBounds{}, "chainlink/SetAnswerBounds", nil)
+	cdc.RegisterConcrete(MsgSetOCRConfig{}, "chainlink/SetOCRConfig", nil)
 	cdc.RegisterConcrete(MsgFeedOwnershipTransfer{}, "chainlink/FeedOwnershipTransfer", nil)
+	cdc.RegisterConcrete(MsgAcceptFeedOwnership{}, "chainlink/AcceptFeedOwnership", nil)
+	cdc.RegisterConcrete(MsgCancelFeedOwnershipTransfer{}, "chainlink/CancelFeedOwnershipTransfer", nil)
+	cdc.RegisterConcrete(MsgPauseFeed{}, "chainlink/PauseFeed", nil)
+	cdc.RegisterConcrete(MsgUnpauseFeed{}, "chainlink/UnpauseFeed", nil)
+	cdc.RegisterConcrete(MsgDeprecateFeed{}, "chainlink/DeprecateFeed", nil)
+	cdc.RegisterConcrete(MsgDeleteFeed{}, "chainlink/DeleteFeed", nil)
+	cdc.RegisterConcrete(MsgFundFeed{}, "chainlink/FundFeed", nil)
+	cdc.RegisterConcrete(MsgWithdrawFeedFunds{}, "chainlink/WithdrawFeedFunds", nil)
+	cdc.RegisterConcrete(MsgWithdrawPayment{}, "chainlink/WithdrawPayment", nil)
 	cdc.RegisterConcrete(MsgAccount{}, "chainlink/AddAccount", nil)
 	cdc.RegisterConcrete(MsgEditAccount{}, "chainlink/EditAccount", nil)
+	cdc.RegisterConcrete(&AddFeedProposal{}, "chainlink/AddFeedProposal", nil)
+	cdc.RegisterConcrete(&AddModuleOwnerProposal{}, "chainlink/AddModuleOwnerProposal", nil)
+	cdc.RegisterConcrete(&RemoveModuleOwnerProposal{}, "chainlink/RemoveModuleOwnerProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -31,18 +54,43 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgFeedData{},
 		&MsgModuleOwner{},
 		&MsgModuleOwnershipTransfer{},
+		&MsgAcceptModuleOwnership{},
+		&MsgCancelModuleOwnershipTransfer{},
+		&MsgRemoveModuleOwner{},
+		&MsgApproveOwnerProposal{},
+		&MsgCancelOwnerProposal{},
 		&MsgFeed{},
 		&MsgAddDataProvider{},
 		&MsgRemoveDataProvider{},
+		&MsgAddTransmitter{},
+		&MsgRemoveTransmitter{},
 		&MsgSetSubmissionCount{},
 		&MsgSetHeartbeatTrigger{},
 		&MsgSetDeviationThresholdTrigger{},
 		&MsgSetFeedReward{},
+		&MsgSetFeedMetadata{},
+		&MsgSetAnswerBounds{},
+		&MsgSetOCRConfig{},
 		&MsgFeedOwnershipTransfer{},
+		&MsgAcceptFeedOwnership{},
+		&MsgCancelFeedOwnershipTransfer{},
+		&MsgPauseFeed{},
+		&MsgUnpauseFeed{},
+		&MsgDeprecateFeed{},
+		&MsgDeleteFeed{},
+		&MsgFundFeed{},
+		&MsgWithdrawFeedFunds{},
+		&MsgWithdrawPayment{},
 		&MsgAccount{},
 		&MsgEditAccount{},
 	)
 
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&AddFeedProposal{},
+		&AddModuleOwnerProposal{},
+		&RemoveModuleOwnerProposal{},
+	)
+
 	/*registry.RegisterInterface(
 		"chainlink.v1beta.RoundDataI",
 		(*exported.RoundDataI)(nil),
@@ -56,9 +104,15 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 
 	registry.RegisterInterface(
-		"chainlink.OCRAbiEncodedI.",
-		(*exported.OCRAbiEncodedI)(nil),
+		"chainlink.v1beta.ReportI",
+		(*exported.ReportI)(nil),
 		&OCRAbiEncoded{},
+	)
+
+	registry.RegisterInterface(
+		"chainlink.v1beta.FeedValueI",
+		(*exported.FeedValueI)(nil),
+		&FeedValue{},
 	)*/
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -26,16 +26,38 @@ func TestTypes_RegisterCodec(t *testing.T) {
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SubmitFeedData")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddModuleOwner")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/ModuleOwnershipTransfer")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AcceptModuleOwnership")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/CancelModuleOwnershipTransfer")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveModuleOwner")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/ApproveOwnerProposal")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/CancelOwnerProposal")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddFeed")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddDataProvider")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveDataProvider")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddTransmitter")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveTransmitter")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/FundFeed")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/WithdrawFeedFunds")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/WithdrawPayment")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetSubmissionCount")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetHeartbeatTrigger")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetDeviationThresholdTrigger")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetFeedReward")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetFeedMetadata")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetAnswerBounds")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetOCRConfig")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/FeedOwnershipTransfer")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AcceptFeedOwnership")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/CancelFeedOwnershipTransfer")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/PauseFeed")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/UnpauseFeed")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/DeprecateFeed")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/DeleteFeed")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddAccount")))
 	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/EditAccount")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddFeedProposal")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddModuleOwnerProposal")))
+	require.False(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveModuleOwnerProposal")))
 
 	RegisterCodec(cdc)
 
@@ -46,16 +68,38 @@ func TestTypes_RegisterCodec(t *testing.T) {
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SubmitFeedData")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddModuleOwner")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/ModuleOwnershipTransfer")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AcceptModuleOwnership")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/CancelModuleOwnershipTransfer")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveModuleOwner")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/ApproveOwnerProposal")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/CancelOwnerProposal")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddFeed")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddDataProvider")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveDataProvider")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddTransmitter")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveTransmitter")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/FundFeed")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/WithdrawFeedFunds")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/WithdrawPayment")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetSubmissionCount")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetHeartbeatTrigger")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetDeviationThresholdTrigger")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetFeedReward")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetFeedMetadata")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetAnswerBounds")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/SetOCRConfig")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/FeedOwnershipTransfer")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AcceptFeedOwnership")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/CancelFeedOwnershipTransfer")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/PauseFeed")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/UnpauseFeed")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/DeprecateFeed")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/DeleteFeed")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddAccount")))
 	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/EditAccount")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddFeedProposal")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/AddModuleOwnerProposal")))
+	require.True(t, bytes.Contains(buf.Bytes(), []byte("chainlink/RemoveModuleOwnerProposal")))
 }
 
 func TestTypes_RegisterInterfaces(t *testing.T) {
@@ -75,6 +119,21 @@ func TestTypes_RegisterInterfaces(t *testing.T) {
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgModuleOwnershipTransfer{}))
 	require.NoError(t, e)
 
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgAcceptModuleOwnership{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgCancelModuleOwnershipTransfer{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgRemoveModuleOwner{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgApproveOwnerProposal{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgCancelOwnerProposal{}))
+	require.NoError(t, e)
+
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgFeed{}))
 	require.NoError(t, e)
 
@@ -84,6 +143,21 @@ func TestTypes_RegisterInterfaces(t *testing.T) {
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgRemoveDataProvider{}))
 	require.NoError(t, e)
 
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgAddTransmitter{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgRemoveTransmitter{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgFundFeed{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgWithdrawFeedFunds{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgWithdrawPayment{}))
+	require.NoError(t, e)
+
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgSetSubmissionCount{}))
 	require.NoError(t, e)
 
@@ -96,12 +170,48 @@ func TestTypes_RegisterInterfaces(t *testing.T) {
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgSetFeedReward{}))
 	require.NoError(t, e)
 
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgSetFeedMetadata{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgSetAnswerBounds{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgSetOCRConfig{}))
+	require.NoError(t, e)
+
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgFeedOwnershipTransfer{}))
 	require.NoError(t, e)
 
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgAcceptFeedOwnership{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgCancelFeedOwnershipTransfer{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgPauseFeed{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgUnpauseFeed{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgDeprecateFeed{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&MsgDeleteFeed{}))
+	require.NoError(t, e)
+
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgAccount{}))
 	require.NoError(t, e)
 
 	_, e = nir.Resolve("/" + proto.MessageName(&MsgEditAccount{}))
 	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&AddFeedProposal{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&AddModuleOwnerProposal{}))
+	require.NoError(t, e)
+
+	_, e = nir.Resolve("/" + proto.MessageName(&RemoveModuleOwnerProposal{}))
+	require.NoError(t, e)
 }
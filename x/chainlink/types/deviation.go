@@ -0,0 +1,52 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"math"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// DeviationThresholdPolicyReject rejects the rounds that neither meet the deviation threshold nor the heartbeat
+	DeviationThresholdPolicyReject = "reject"
+	// DeviationThresholdPolicyNonRewardable accepts the rounds that neither meet the deviation threshold nor the heartbeat
+	// but does not reward them
+	DeviationThresholdPolicyNonRewardable = "nonRewardable"
+
+	// deviationPrecision expresses deviations in thousandths of a percent, the unit of the deviation threshold trigger
+	deviationPrecision = 100000
+)
+
+// ValidateDeviationThresholdPolicy checks the policy is a known one, empty means DeviationThresholdPolicyReject
+func ValidateDeviationThresholdPolicy(policy string) error {
+	switch policy {
+	case "", DeviationThresholdPolicyReject, DeviationThresholdPolicyNonRewardable:
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid deviation threshold policy %s", policy)
+	}
+}
+
+// ComputeDeviation returns the deviation of answer from previousAnswer in thousandths of a percent.
+// A zero previousAnswer has no meaningful relative deviation, any change is then reported as the maximum deviation.
+func ComputeDeviation(previousAnswer, answer sdk.Int) uint64 {
+	diff := new(big.Int).Abs(answer.Sub(previousAnswer).BigInt())
+	if diff.Sign() == 0 {
+		return 0
+	}
+	if previousAnswer.IsZero() {
+		return math.MaxUint64
+	}
+
+	deviation := diff.Mul(diff, big.NewInt(deviationPrecision))
+	deviation.Quo(deviation, new(big.Int).Abs(previousAnswer.BigInt()))
+	if !deviation.IsUint64() {
+		return math.MaxUint64
+	}
+	return deviation.Uint64()
+}
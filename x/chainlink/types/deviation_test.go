@@ -0,0 +1,42 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_ComputeDeviation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		previousAnswer sdk.Int
+		answer         sdk.Int
+		expected       uint64
+	}{
+		{name: "no change", previousAnswer: sdk.NewInt(100), answer: sdk.NewInt(100), expected: 0},
+		{name: "1% increase", previousAnswer: sdk.NewInt(100), answer: sdk.NewInt(101), expected: 1000},
+		{name: "1% decrease", previousAnswer: sdk.NewInt(100), answer: sdk.NewInt(99), expected: 1000},
+		{name: "0.5% increase", previousAnswer: sdk.NewInt(20000), answer: sdk.NewInt(20100), expected: 500},
+		{name: "negative previous answer", previousAnswer: sdk.NewInt(-200), answer: sdk.NewInt(-100), expected: 50000},
+		{name: "from zero", previousAnswer: sdk.ZeroInt(), answer: sdk.NewInt(1), expected: math.MaxUint64},
+		{name: "zero to zero", previousAnswer: sdk.ZeroInt(), answer: sdk.ZeroInt(), expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ComputeDeviation(tc.previousAnswer, tc.answer))
+		})
+	}
+}
+
+func TestTypes_ValidateDeviationThresholdPolicy(t *testing.T) {
+	require.NoError(t, ValidateDeviationThresholdPolicy(""))
+	require.NoError(t, ValidateDeviationThresholdPolicy(DeviationThresholdPolicyReject))
+	require.NoError(t, ValidateDeviationThresholdPolicy(DeviationThresholdPolicyNonRewardable))
+	require.Error(t, ValidateDeviationThresholdPolicy("ignore"))
+}
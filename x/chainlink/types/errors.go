@@ -11,6 +11,19 @@ import (
 
 // x/chainlink module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrSample                    = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidOCRReport          = sdkerrors.Register(ModuleName, 1101, "invalid OCR report")
+	ErrDeviationThresholdNotMet  = sdkerrors.Register(ModuleName, 1102, "deviation threshold not met")
+	ErrChainlinkKeyRegistered    = sdkerrors.Register(ModuleName, 1103, "chainlink key already registered")
+	ErrFeedPaused                = sdkerrors.Register(ModuleName, 1104, "feed is paused")
+	ErrFeedDeprecated            = sdkerrors.Register(ModuleName, 1105, "feed is deprecated")
+	ErrFeedDeleted               = sdkerrors.Register(ModuleName, 1106, "feed has been deleted")
+	ErrAnswerOutOfBounds         = sdkerrors.Register(ModuleName, 1107, "answer out of bounds")
+	ErrStaleReport               = sdkerrors.Register(ModuleName, 1108, "stale report")
+	ErrConfigDigestMismatch      = sdkerrors.Register(ModuleName, 1109, "config digest mismatch")
+	ErrInsufficientFeedFunds     = sdkerrors.Register(ModuleName, 1110, "insufficient feed funds")
+	ErrNoPaymentOwed             = sdkerrors.Register(ModuleName, 1111, "no payment owed")
+	ErrLastModuleOwner           = sdkerrors.Register(ModuleName, 1112, "can not remove the last module owner")
+	ErrRewardBalancesOutstanding = sdkerrors.Register(ModuleName, 1113, "reward balances outstanding")
 	// this line is used by starport scaffolding # ibc/errors
 )
@@ -0,0 +1,31 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// InsufficientFundsPolicyNonRewardable accepts the rounds the feed escrow can not reward but does not reward them
+	InsufficientFundsPolicyNonRewardable = "nonRewardable"
+	// InsufficientFundsPolicyReject rejects the submissions the feed escrow can not reward
+	InsufficientFundsPolicyReject = "reject"
+)
+
+// ValidateInsufficientFundsPolicy checks the policy is a known one, empty means InsufficientFundsPolicyNonRewardable
+// so that the feeds created before the escrow keep accepting rounds until they get funded
+func ValidateInsufficientFundsPolicy(policy string) error {
+	switch policy {
+	case "", InsufficientFundsPolicyNonRewardable, InsufficientFundsPolicyReject:
+		return nil
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid insufficient funds policy %s", policy)
+	}
+}
+
+// RejectsUnderfundedRounds tells whether the submissions the feed escrow can not reward are rejected
+func (m *MsgFeed) RejectsUnderfundedRounds() bool {
+	return m.GetInsufficientFundsPolicy() == InsufficientFundsPolicyReject
+}
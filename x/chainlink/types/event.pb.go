@@ -88,6 +88,10 @@ type MsgNewRoundDataEvent struct {
 	FeedId   string   `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 	RoundId  uint64   `protobuf:"varint,2,opt,name=roundId,proto3" json:"roundId,omitempty"`
 	FeedData [][]byte `protobuf:"bytes,3,rep,name=feedData,proto3" json:"feedData,omitempty"`
+	// answer is the median of the round observations
+	Answer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=answer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"answer"`
+	// value is the answer of the round typed after the feed value schema
+	Value *FeedValue `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (m *MsgNewRoundDataEvent) Reset()         { *m = MsgNewRoundDataEvent{} }
@@ -144,6 +148,101 @@ func (m *MsgNewRoundDataEvent) GetFeedData() [][]byte {
 	return nil
 }
 
+func (m *MsgNewRoundDataEvent) GetValue() *FeedValue {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+type MsgRoundDeviationEvent struct {
+	FeedId         string                                 `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	RoundId        uint64                                 `protobuf:"varint,2,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	PreviousAnswer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=previousAnswer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"previousAnswer"`
+	Answer         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=answer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"answer"`
+	// deviation of the answer from the previous answer, in thousandths of a percent
+	Deviation                 uint64 `protobuf:"varint,5,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	DeviationThresholdTrigger uint32 `protobuf:"varint,6,opt,name=deviationThresholdTrigger,proto3" json:"deviationThresholdTrigger,omitempty"`
+	HeartbeatElapsed          bool   `protobuf:"varint,7,opt,name=heartbeatElapsed,proto3" json:"heartbeatElapsed,omitempty"`
+	// rewardable is false when the round neither met the deviation threshold nor the heartbeat
+	Rewardable bool `protobuf:"varint,8,opt,name=rewardable,proto3" json:"rewardable,omitempty"`
+}
+
+func (m *MsgRoundDeviationEvent) Reset()         { *m = MsgRoundDeviationEvent{} }
+func (m *MsgRoundDeviationEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgRoundDeviationEvent) ProtoMessage()    {}
+func (*MsgRoundDeviationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{2}
+}
+func (m *MsgRoundDeviationEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRoundDeviationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRoundDeviationEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRoundDeviationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRoundDeviationEvent.Merge(m, src)
+}
+func (m *MsgRoundDeviationEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRoundDeviationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRoundDeviationEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRoundDeviationEvent proto.InternalMessageInfo
+
+func (m *MsgRoundDeviationEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *MsgRoundDeviationEvent) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *MsgRoundDeviationEvent) GetDeviation() uint64 {
+	if m != nil {
+		return m.Deviation
+	}
+	return 0
+}
+
+func (m *MsgRoundDeviationEvent) GetDeviationThresholdTrigger() uint32 {
+	if m != nil {
+		return m.DeviationThresholdTrigger
+	}
+	return 0
+}
+
+func (m *MsgRoundDeviationEvent) GetHeartbeatElapsed() bool {
+	if m != nil {
+		return m.HeartbeatElapsed
+	}
+	return false
+}
+
+func (m *MsgRoundDeviationEvent) GetRewardable() bool {
+	if m != nil {
+		return m.Rewardable
+	}
+	return false
+}
+
 type MsgNewRoundRequestEvent struct {
 	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 }
@@ -152,7 +251,7 @@ func (m *MsgNewRoundRequestEvent) Reset()         { *m = MsgNewRoundRequestEvent
 func (m *MsgNewRoundRequestEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgNewRoundRequestEvent) ProtoMessage()    {}
 func (*MsgNewRoundRequestEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{2}
+	return fileDescriptor_23aee62a42efb6bc, []int{3}
 }
 func (m *MsgNewRoundRequestEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,16 +289,21 @@ func (m *MsgNewRoundRequestEvent) GetFeedId() string {
 
 type MsgOraclePaidEvent struct {
 	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// The account that was paid to
+	// The data provider or transmitter account the payout is credited to
 	Account github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=account,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"account,omitempty"`
 	Value   uint64                                        `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	// role is what the account got paid for: "signer" of an observation or "transmitter" of the report
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// payee is the account the payout is withdrawn to when credited: the piggy address of the chainlink account registered by the paid
+	// account, the paid account itself when it has no piggy address
+	Payee github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=payee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"payee,omitempty"`
 }
 
 func (m *MsgOraclePaidEvent) Reset()         { *m = MsgOraclePaidEvent{} }
 func (m *MsgOraclePaidEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgOraclePaidEvent) ProtoMessage()    {}
 func (*MsgOraclePaidEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{3}
+	return fileDescriptor_23aee62a42efb6bc, []int{4}
 }
 func (m *MsgOraclePaidEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,6 +353,20 @@ func (m *MsgOraclePaidEvent) GetValue() uint64 {
 	return 0
 }
 
+func (m *MsgOraclePaidEvent) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *MsgOraclePaidEvent) GetPayee() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Payee
+	}
+	return nil
+}
+
 type MsgDataProviderSetChangeEvent struct {
 	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 	// changeType: either add or remove
@@ -261,7 +379,7 @@ func (m *MsgDataProviderSetChangeEvent) Reset()         { *m = MsgDataProviderSe
 func (m *MsgDataProviderSetChangeEvent) String() string { return proto.CompactTextString(m) }
 func (*MsgDataProviderSetChangeEvent) ProtoMessage()    {}
 func (*MsgDataProviderSetChangeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{4}
+	return fileDescriptor_23aee62a42efb6bc, []int{5}
 }
 func (m *MsgDataProviderSetChangeEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,26 +436,26 @@ func (m *MsgDataProviderSetChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk
 	return nil
 }
 
-type MsgFeedParameterChangeEvent struct {
+type MsgTransmitterSetChangeEvent struct {
 	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// changeType: either DeviationThreshold, heartbeatTrigger, submissionCount
-	ChangeType        string                                        `protobuf:"bytes,2,opt,name=changeType,proto3" json:"changeType,omitempty"`
-	NewParameterValue uint32                                        `protobuf:"varint,3,opt,name=newParameterValue,proto3" json:"newParameterValue,omitempty"`
-	Signer            github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+	// changeType: either add or remove
+	ChangeType  string                                        `protobuf:"bytes,2,opt,name=changeType,proto3" json:"changeType,omitempty"`
+	Transmitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=transmitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"transmitter,omitempty"`
+	Signer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgFeedParameterChangeEvent) Reset()         { *m = MsgFeedParameterChangeEvent{} }
-func (m *MsgFeedParameterChangeEvent) String() string { return proto.CompactTextString(m) }
-func (*MsgFeedParameterChangeEvent) ProtoMessage()    {}
-func (*MsgFeedParameterChangeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{5}
+func (m *MsgTransmitterSetChangeEvent) Reset()         { *m = MsgTransmitterSetChangeEvent{} }
+func (m *MsgTransmitterSetChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgTransmitterSetChangeEvent) ProtoMessage()    {}
+func (*MsgTransmitterSetChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{6}
 }
-func (m *MsgFeedParameterChangeEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgTransmitterSetChangeEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFeedParameterChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgTransmitterSetChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFeedParameterChangeEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgTransmitterSetChangeEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -347,63 +465,68 @@ func (m *MsgFeedParameterChangeEvent) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgFeedParameterChangeEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFeedParameterChangeEvent.Merge(m, src)
+func (m *MsgTransmitterSetChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransmitterSetChangeEvent.Merge(m, src)
 }
-func (m *MsgFeedParameterChangeEvent) XXX_Size() int {
+func (m *MsgTransmitterSetChangeEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFeedParameterChangeEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFeedParameterChangeEvent.DiscardUnknown(m)
+func (m *MsgTransmitterSetChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransmitterSetChangeEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFeedParameterChangeEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgTransmitterSetChangeEvent proto.InternalMessageInfo
 
-func (m *MsgFeedParameterChangeEvent) GetFeedId() string {
+func (m *MsgTransmitterSetChangeEvent) GetFeedId() string {
 	if m != nil {
 		return m.FeedId
 	}
 	return ""
 }
 
-func (m *MsgFeedParameterChangeEvent) GetChangeType() string {
+func (m *MsgTransmitterSetChangeEvent) GetChangeType() string {
 	if m != nil {
 		return m.ChangeType
 	}
 	return ""
 }
 
-func (m *MsgFeedParameterChangeEvent) GetNewParameterValue() uint32 {
+func (m *MsgTransmitterSetChangeEvent) GetTransmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.NewParameterValue
+		return m.Transmitter
 	}
-	return 0
+	return nil
 }
 
-func (m *MsgFeedParameterChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgTransmitterSetChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
 		return m.Signer
 	}
 	return nil
 }
 
-type MsgModuleOwnershipTransferEvent struct {
-	NewModuleOwnerAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=newModuleOwnerAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newModuleOwnerAddr,omitempty"`
-	Signer             github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+type MsgFeedEscrowChangeEvent struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// changeType: either fund, withdraw or refund
+	ChangeType string `protobuf:"bytes,2,opt,name=changeType,proto3" json:"changeType,omitempty"`
+	Amount     uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// balance is the feed escrow balance after the change
+	Balance uint64                                        `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	Signer  github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgModuleOwnershipTransferEvent) Reset()         { *m = MsgModuleOwnershipTransferEvent{} }
-func (m *MsgModuleOwnershipTransferEvent) String() string { return proto.CompactTextString(m) }
-func (*MsgModuleOwnershipTransferEvent) ProtoMessage()    {}
-func (*MsgModuleOwnershipTransferEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{6}
+func (m *MsgFeedEscrowChangeEvent) Reset()         { *m = MsgFeedEscrowChangeEvent{} }
+func (m *MsgFeedEscrowChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedEscrowChangeEvent) ProtoMessage()    {}
+func (*MsgFeedEscrowChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{7}
 }
-func (m *MsgModuleOwnershipTransferEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgFeedEscrowChangeEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgModuleOwnershipTransferEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFeedEscrowChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgModuleOwnershipTransferEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFeedEscrowChangeEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -413,50 +536,75 @@ func (m *MsgModuleOwnershipTransferEvent) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgModuleOwnershipTransferEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgModuleOwnershipTransferEvent.Merge(m, src)
+func (m *MsgFeedEscrowChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedEscrowChangeEvent.Merge(m, src)
 }
-func (m *MsgModuleOwnershipTransferEvent) XXX_Size() int {
+func (m *MsgFeedEscrowChangeEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgModuleOwnershipTransferEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgModuleOwnershipTransferEvent.DiscardUnknown(m)
+func (m *MsgFeedEscrowChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedEscrowChangeEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgModuleOwnershipTransferEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgFeedEscrowChangeEvent proto.InternalMessageInfo
 
-func (m *MsgModuleOwnershipTransferEvent) GetNewModuleOwnerAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgFeedEscrowChangeEvent) GetFeedId() string {
 	if m != nil {
-		return m.NewModuleOwnerAddr
+		return m.FeedId
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgModuleOwnershipTransferEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgFeedEscrowChangeEvent) GetChangeType() string {
+	if m != nil {
+		return m.ChangeType
+	}
+	return ""
+}
+
+func (m *MsgFeedEscrowChangeEvent) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgFeedEscrowChangeEvent) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *MsgFeedEscrowChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
 		return m.Signer
 	}
 	return nil
 }
 
-type MsgFeedOwnershipTransferEvent struct {
-	FeedId           string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	NewFeedOwnerAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=newFeedOwnerAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newFeedOwnerAddr,omitempty"`
-	Signer           github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+// MsgPaymentWithdrawnEvent is emitted when an oracle withdraws the payments owed to it
+type MsgPaymentWithdrawnEvent struct {
+	Oracle github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=oracle,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle,omitempty"`
+	// payee is the account the payments were sent to
+	Payee github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=payee,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"payee,omitempty"`
+	// feedId is the feed the payments were owed by, empty when the payments of every feed were withdrawn
+	FeedId string `protobuf:"bytes,3,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Amount uint64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgFeedOwnershipTransferEvent) Reset()         { *m = MsgFeedOwnershipTransferEvent{} }
-func (m *MsgFeedOwnershipTransferEvent) String() string { return proto.CompactTextString(m) }
-func (*MsgFeedOwnershipTransferEvent) ProtoMessage()    {}
-func (*MsgFeedOwnershipTransferEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{7}
+func (m *MsgPaymentWithdrawnEvent) Reset()         { *m = MsgPaymentWithdrawnEvent{} }
+func (m *MsgPaymentWithdrawnEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgPaymentWithdrawnEvent) ProtoMessage()    {}
+func (*MsgPaymentWithdrawnEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{8}
 }
-func (m *MsgFeedOwnershipTransferEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgPaymentWithdrawnEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFeedOwnershipTransferEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgPaymentWithdrawnEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFeedOwnershipTransferEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgPaymentWithdrawnEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -466,62 +614,68 @@ func (m *MsgFeedOwnershipTransferEvent) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MsgFeedOwnershipTransferEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFeedOwnershipTransferEvent.Merge(m, src)
+func (m *MsgPaymentWithdrawnEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPaymentWithdrawnEvent.Merge(m, src)
 }
-func (m *MsgFeedOwnershipTransferEvent) XXX_Size() int {
+func (m *MsgPaymentWithdrawnEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFeedOwnershipTransferEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFeedOwnershipTransferEvent.DiscardUnknown(m)
+func (m *MsgPaymentWithdrawnEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPaymentWithdrawnEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFeedOwnershipTransferEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgPaymentWithdrawnEvent proto.InternalMessageInfo
 
-func (m *MsgFeedOwnershipTransferEvent) GetFeedId() string {
+func (m *MsgPaymentWithdrawnEvent) GetOracle() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.FeedId
+		return m.Oracle
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgFeedOwnershipTransferEvent) GetNewFeedOwnerAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgPaymentWithdrawnEvent) GetPayee() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.NewFeedOwnerAddr
+		return m.Payee
 	}
 	return nil
 }
 
-func (m *MsgFeedOwnershipTransferEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgPaymentWithdrawnEvent) GetFeedId() string {
 	if m != nil {
-		return m.Signer
+		return m.FeedId
 	}
-	return nil
+	return ""
 }
 
-type MsgFeedDataValidationFailedEvent struct {
-	FeedId    string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	FeedOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=feedOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"feedOwner,omitempty"`
-	// DataProviders is the init list of data provider of the feed
-	DataProviders []*DataProvider                               `protobuf:"bytes,3,rep,name=dataProviders,proto3" json:"dataProviders,omitempty"`
-	Submitter     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
-	FeedData      [][]byte                                      `protobuf:"bytes,5,rep,name=feedData,proto3" json:"feedData,omitempty"`
-	// Signatures is the data provider signature list of the current round
-	Signatures [][]byte `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures,omitempty"`
+func (m *MsgPaymentWithdrawnEvent) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *MsgFeedDataValidationFailedEvent) Reset()         { *m = MsgFeedDataValidationFailedEvent{} }
-func (m *MsgFeedDataValidationFailedEvent) String() string { return proto.CompactTextString(m) }
-func (*MsgFeedDataValidationFailedEvent) ProtoMessage()    {}
-func (*MsgFeedDataValidationFailedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{8}
+// MsgFeedUnderfundedEvent is emitted when the feed escrow can not cover the rewards of a round,
+// the shortfall is minted when the feed opted in, the round is not rewarded otherwise
+type MsgFeedUnderfundedEvent struct {
+	FeedId   string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	RoundId  uint64 `protobuf:"varint,2,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Balance  uint64 `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Required uint64 `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Minted   uint64 `protobuf:"varint,5,opt,name=minted,proto3" json:"minted,omitempty"`
 }
-func (m *MsgFeedDataValidationFailedEvent) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgFeedUnderfundedEvent) Reset()         { *m = MsgFeedUnderfundedEvent{} }
+func (m *MsgFeedUnderfundedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedUnderfundedEvent) ProtoMessage()    {}
+func (*MsgFeedUnderfundedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{9}
+}
+func (m *MsgFeedUnderfundedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFeedDataValidationFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFeedUnderfundedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFeedDataValidationFailedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFeedUnderfundedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -531,79 +685,75 @@ func (m *MsgFeedDataValidationFailedEvent) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgFeedDataValidationFailedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFeedDataValidationFailedEvent.Merge(m, src)
+func (m *MsgFeedUnderfundedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedUnderfundedEvent.Merge(m, src)
 }
-func (m *MsgFeedDataValidationFailedEvent) XXX_Size() int {
+func (m *MsgFeedUnderfundedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFeedDataValidationFailedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFeedDataValidationFailedEvent.DiscardUnknown(m)
+func (m *MsgFeedUnderfundedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedUnderfundedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFeedDataValidationFailedEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgFeedUnderfundedEvent proto.InternalMessageInfo
 
-func (m *MsgFeedDataValidationFailedEvent) GetFeedId() string {
+func (m *MsgFeedUnderfundedEvent) GetFeedId() string {
 	if m != nil {
 		return m.FeedId
 	}
 	return ""
 }
 
-func (m *MsgFeedDataValidationFailedEvent) GetFeedOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.FeedOwner
-	}
-	return nil
-}
-
-func (m *MsgFeedDataValidationFailedEvent) GetDataProviders() []*DataProvider {
+func (m *MsgFeedUnderfundedEvent) GetRoundId() uint64 {
 	if m != nil {
-		return m.DataProviders
+		return m.RoundId
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgFeedDataValidationFailedEvent) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgFeedUnderfundedEvent) GetBalance() uint64 {
 	if m != nil {
-		return m.Submitter
+		return m.Balance
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgFeedDataValidationFailedEvent) GetFeedData() [][]byte {
+func (m *MsgFeedUnderfundedEvent) GetRequired() uint64 {
 	if m != nil {
-		return m.FeedData
+		return m.Required
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgFeedDataValidationFailedEvent) GetSignatures() [][]byte {
+func (m *MsgFeedUnderfundedEvent) GetMinted() uint64 {
 	if m != nil {
-		return m.Signatures
+		return m.Minted
 	}
-	return nil
+	return 0
 }
 
-type MsgFeedRewardSchemaChangeEvent struct {
-	FeedId        string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	NewStrategy   string                                        `protobuf:"bytes,2,opt,name=newStrategy,proto3" json:"newStrategy,omitempty"`
-	NewBaseAmount uint64                                        `protobuf:"varint,3,opt,name=newBaseAmount,proto3" json:"newBaseAmount,omitempty"`
-	Signer        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+type MsgFeedParameterChangeEvent struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// changeType: either DeviationThreshold, heartbeatTrigger, submissionCount, answerBounds
+	ChangeType        string                                        `protobuf:"bytes,2,opt,name=changeType,proto3" json:"changeType,omitempty"`
+	NewParameterValue uint32                                        `protobuf:"varint,3,opt,name=newParameterValue,proto3" json:"newParameterValue,omitempty"`
+	Signer            github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+	// newAnswerBounds is only set for the AnswerBounds changeType
+	NewAnswerBounds *AnswerBounds `protobuf:"bytes,5,opt,name=newAnswerBounds,proto3" json:"newAnswerBounds,omitempty"`
 }
 
-func (m *MsgFeedRewardSchemaChangeEvent) Reset()         { *m = MsgFeedRewardSchemaChangeEvent{} }
-func (m *MsgFeedRewardSchemaChangeEvent) String() string { return proto.CompactTextString(m) }
-func (*MsgFeedRewardSchemaChangeEvent) ProtoMessage()    {}
-func (*MsgFeedRewardSchemaChangeEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23aee62a42efb6bc, []int{9}
+func (m *MsgFeedParameterChangeEvent) Reset()         { *m = MsgFeedParameterChangeEvent{} }
+func (m *MsgFeedParameterChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedParameterChangeEvent) ProtoMessage()    {}
+func (*MsgFeedParameterChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{10}
 }
-func (m *MsgFeedRewardSchemaChangeEvent) XXX_Unmarshal(b []byte) error {
+func (m *MsgFeedParameterChangeEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFeedRewardSchemaChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFeedParameterChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFeedRewardSchemaChangeEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFeedParameterChangeEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -613,827 +763,7275 @@ func (m *MsgFeedRewardSchemaChangeEvent) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MsgFeedRewardSchemaChangeEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFeedRewardSchemaChangeEvent.Merge(m, src)
+func (m *MsgFeedParameterChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedParameterChangeEvent.Merge(m, src)
 }
-func (m *MsgFeedRewardSchemaChangeEvent) XXX_Size() int {
+func (m *MsgFeedParameterChangeEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFeedRewardSchemaChangeEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFeedRewardSchemaChangeEvent.DiscardUnknown(m)
+func (m *MsgFeedParameterChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedParameterChangeEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFeedRewardSchemaChangeEvent proto.InternalMessageInfo
+var xxx_messageInfo_MsgFeedParameterChangeEvent proto.InternalMessageInfo
 
-func (m *MsgFeedRewardSchemaChangeEvent) GetFeedId() string {
+func (m *MsgFeedParameterChangeEvent) GetFeedId() string {
 	if m != nil {
 		return m.FeedId
 	}
 	return ""
 }
 
-func (m *MsgFeedRewardSchemaChangeEvent) GetNewStrategy() string {
+func (m *MsgFeedParameterChangeEvent) GetChangeType() string {
 	if m != nil {
-		return m.NewStrategy
+		return m.ChangeType
 	}
 	return ""
 }
 
-func (m *MsgFeedRewardSchemaChangeEvent) GetNewBaseAmount() uint64 {
+func (m *MsgFeedParameterChangeEvent) GetNewParameterValue() uint32 {
 	if m != nil {
-		return m.NewBaseAmount
+		return m.NewParameterValue
 	}
 	return 0
 }
 
-func (m *MsgFeedRewardSchemaChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgFeedParameterChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
 		return m.Signer
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgNewFeedEvent)(nil), "chainlink.v1beta.MsgNewFeedEvent")
-	proto.RegisterType((*MsgNewRoundDataEvent)(nil), "chainlink.v1beta.MsgNewRoundDataEvent")
-	proto.RegisterType((*MsgNewRoundRequestEvent)(nil), "chainlink.v1beta.MsgNewRoundRequestEvent")
-	proto.RegisterType((*MsgOraclePaidEvent)(nil), "chainlink.v1beta.MsgOraclePaidEvent")
-	proto.RegisterType((*MsgDataProviderSetChangeEvent)(nil), "chainlink.v1beta.MsgDataProviderSetChangeEvent")
-	proto.RegisterType((*MsgFeedParameterChangeEvent)(nil), "chainlink.v1beta.MsgFeedParameterChangeEvent")
-	proto.RegisterType((*MsgModuleOwnershipTransferEvent)(nil), "chainlink.v1beta.MsgModuleOwnershipTransferEvent")
-	proto.RegisterType((*MsgFeedOwnershipTransferEvent)(nil), "chainlink.v1beta.MsgFeedOwnershipTransferEvent")
-	proto.RegisterType((*MsgFeedDataValidationFailedEvent)(nil), "chainlink.v1beta.MsgFeedDataValidationFailedEvent")
-	proto.RegisterType((*MsgFeedRewardSchemaChangeEvent)(nil), "chainlink.v1beta.MsgFeedRewardSchemaChangeEvent")
+func (m *MsgFeedParameterChangeEvent) GetNewAnswerBounds() *AnswerBounds {
+	if m != nil {
+		return m.NewAnswerBounds
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("chainlink/v1beta/event.proto", fileDescriptor_23aee62a42efb6bc) }
-
-var fileDescriptor_23aee62a42efb6bc = []byte{
-	// 676 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0x13, 0x5d,
-	0x14, 0xe6, 0xb6, 0x50, 0x5e, 0x0e, 0x90, 0x17, 0x27, 0x44, 0x47, 0xd4, 0x61, 0x32, 0x71, 0xd1,
-	0x85, 0xb4, 0x41, 0x17, 0xae, 0xf9, 0x90, 0x84, 0x98, 0x0a, 0x4e, 0x09, 0x0b, 0x13, 0x16, 0xb7,
-	0x73, 0x0f, 0xd3, 0x09, 0xed, 0x9d, 0x7a, 0xef, 0x9d, 0x0e, 0x2c, 0xfd, 0x05, 0xfa, 0x93, 0x5c,
-	0x9a, 0x98, 0x28, 0x4b, 0x57, 0x44, 0xe1, 0x5f, 0xb8, 0x32, 0x77, 0x66, 0x68, 0xa7, 0x56, 0xc1,
-	0xb4, 0x5d, 0xb5, 0xf7, 0x9c, 0xd3, 0xe7, 0x9c, 0xe7, 0x7c, 0x3c, 0x29, 0x3c, 0xf4, 0x9a, 0x34,
-	0xe0, 0xad, 0x80, 0x9f, 0x54, 0xbb, 0xeb, 0x0d, 0x54, 0xb4, 0x8a, 0x5d, 0xe4, 0xaa, 0xd2, 0x11,
-	0xa1, 0x0a, 0x8d, 0xa5, 0x9e, 0xb7, 0x92, 0x7a, 0x57, 0x96, 0xfd, 0xd0, 0x0f, 0x13, 0x67, 0x55,
-	0x7f, 0x4b, 0xe3, 0x56, 0xee, 0x0f, 0xa1, 0xa8, 0xd3, 0xd4, 0xe5, 0x7c, 0x24, 0xf0, 0x7f, 0x4d,
-	0xfa, 0xaf, 0x30, 0xde, 0x41, 0x64, 0x2f, 0x34, 0xb8, 0x71, 0x17, 0x4a, 0xc7, 0x88, 0x6c, 0x97,
-	0x99, 0xc4, 0x26, 0xe5, 0x39, 0x37, 0x7b, 0x19, 0xdb, 0xb0, 0xc8, 0xa8, 0xa2, 0xfb, 0x22, 0xec,
-	0x06, 0x0c, 0x85, 0x34, 0x0b, 0x76, 0xb1, 0x3c, 0xff, 0xd4, 0xaa, 0xfc, 0x5e, 0x46, 0x65, 0x3b,
-	0x17, 0xe6, 0x0e, 0xfe, 0xc8, 0xd8, 0x83, 0x39, 0x8d, 0xb7, 0x17, 0x73, 0x14, 0x66, 0xd1, 0x26,
-	0xe5, 0x85, 0xcd, 0xf5, 0x9f, 0x17, 0xab, 0x6b, 0x7e, 0xa0, 0x9a, 0x51, 0xa3, 0xe2, 0x85, 0xed,
-	0xaa, 0x17, 0xca, 0x76, 0x28, 0xb3, 0x8f, 0x35, 0xc9, 0x4e, 0xaa, 0xea, 0xac, 0x83, 0xb2, 0xb2,
-	0xe1, 0x79, 0x1b, 0x8c, 0x09, 0x94, 0xd2, 0xed, 0x63, 0x38, 0x0c, 0x96, 0x53, 0x06, 0x6e, 0x18,
-	0x71, 0xa6, 0x53, 0xdf, 0x4c, 0xc3, 0x84, 0x59, 0xa1, 0x23, 0x77, 0x99, 0x59, 0xb0, 0x49, 0x79,
-	0xda, 0xbd, 0x7e, 0x1a, 0x2b, 0xf0, 0x9f, 0x8e, 0xd1, 0x10, 0x66, 0xd1, 0x2e, 0x96, 0x17, 0xdc,
-	0xde, 0xdb, 0x59, 0x87, 0x7b, 0xb9, 0x2c, 0x2e, 0xbe, 0x8d, 0x50, 0xaa, 0x1b, 0x13, 0x39, 0xef,
-	0x09, 0x18, 0x35, 0xe9, 0xef, 0x09, 0xea, 0xb5, 0x70, 0x9f, 0x06, 0xb7, 0xb4, 0xf7, 0x25, 0xcc,
-	0x52, 0xcf, 0x0b, 0x23, 0xae, 0x92, 0xba, 0x46, 0x6a, 0xcb, 0x35, 0x82, 0xb1, 0x0c, 0x33, 0x5d,
-	0xda, 0x8a, 0x30, 0xe9, 0xf0, 0xb4, 0x9b, 0x3e, 0x9c, 0x77, 0x05, 0x78, 0x54, 0x93, 0x7e, 0x7e,
-	0x3c, 0x75, 0x54, 0x5b, 0x4d, 0xca, 0x7d, 0xbc, 0xb9, 0x38, 0x0b, 0xc0, 0x4b, 0xc2, 0x0e, 0xce,
-	0x3a, 0x98, 0xd4, 0x37, 0xe7, 0xe6, 0x2c, 0xc6, 0x11, 0x2c, 0xe5, 0xc7, 0xac, 0xeb, 0x19, 0x7d,
-	0xb8, 0x43, 0x50, 0xc6, 0x2e, 0x94, 0x64, 0xe0, 0xeb, 0x8d, 0x99, 0x1e, 0x15, 0x34, 0x03, 0x70,
-	0xbe, 0x10, 0x78, 0x50, 0x93, 0xbe, 0x5e, 0xf7, 0x7d, 0x2a, 0x68, 0x1b, 0x15, 0x8a, 0x49, 0x74,
-	0xe0, 0x09, 0xdc, 0xe1, 0x18, 0xf7, 0x20, 0x0f, 0x7b, 0xdd, 0x5f, 0x74, 0x87, 0x1d, 0x93, 0x24,
-	0xf4, 0x95, 0xc0, 0x6a, 0x4d, 0xfa, 0xb5, 0x90, 0x45, 0x2d, 0x4c, 0x4e, 0x42, 0x36, 0x83, 0xce,
-	0x81, 0xa0, 0x5c, 0x1e, 0xa3, 0x48, 0x49, 0x51, 0x30, 0x38, 0xc6, 0xb9, 0x90, 0x64, 0x40, 0x64,
-	0xd4, 0xd4, 0x7f, 0x00, 0x9b, 0x24, 0xa3, 0x1f, 0x24, 0x59, 0xd3, 0x9d, 0xeb, 0x13, 0x1f, 0xe6,
-	0xf3, 0xb7, 0x21, 0x1d, 0xc1, 0x12, 0x4f, 0xa5, 0xac, 0xcf, 0x72, 0xe4, 0x63, 0x1a, 0x82, 0xca,
-	0x71, 0x2c, 0x8e, 0xcb, 0xf1, 0xa2, 0x00, 0x76, 0xc6, 0x51, 0x9f, 0xe3, 0x21, 0x6d, 0x05, 0x8c,
-	0xaa, 0x20, 0xe4, 0x3b, 0x34, 0x68, 0xdd, 0xa6, 0xc4, 0x03, 0x1a, 0x5a, 0x18, 0x5f, 0x43, 0x87,
-	0xa5, 0xbd, 0x38, 0xa2, 0xb4, 0xcb, 0xa8, 0xd1, 0x0e, 0x94, 0x1a, 0x67, 0x0b, 0xfa, 0x18, 0x03,
-	0x82, 0x3c, 0x33, 0x28, 0xc8, 0xfa, 0x1e, 0x75, 0x2b, 0xa9, 0x8a, 0x04, 0x4a, 0xb3, 0x94, 0x78,
-	0x73, 0x16, 0xe7, 0x33, 0x01, 0x2b, 0x6b, 0xb0, 0x8b, 0x31, 0x15, 0xac, 0xee, 0x35, 0xb1, 0x4d,
-	0xff, 0xe5, 0xd4, 0x6d, 0x98, 0xe7, 0x18, 0xd7, 0x95, 0xa0, 0x0a, 0xfd, 0xb3, 0xec, 0xd6, 0xf3,
-	0x26, 0xe3, 0x31, 0x2c, 0x72, 0x8c, 0x37, 0xa9, 0xc4, 0x8d, 0x76, 0xa2, 0xd8, 0xa9, 0xcc, 0x0e,
-	0x1a, 0x27, 0x78, 0x12, 0x9b, 0xaf, 0x3f, 0x5d, 0x5a, 0xe4, 0xfc, 0xd2, 0x22, 0xdf, 0x2f, 0x2d,
-	0xf2, 0xe1, 0xca, 0x9a, 0x3a, 0xbf, 0xb2, 0xa6, 0xbe, 0x5d, 0x59, 0x53, 0x6f, 0x9e, 0xe7, 0x00,
-	0xb7, 0xf4, 0xb4, 0xea, 0xf4, 0x18, 0xab, 0xbd, 0xb9, 0xad, 0x65, 0x49, 0x4e, 0xfb, 0xa6, 0x34,
-	0x4b, 0xa3, 0x94, 0xfc, 0x03, 0x78, 0xf6, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x9c, 0xcc, 0x01,
-	0x64, 0x08, 0x00, 0x00,
+type MsgModuleOwnershipTransferEvent struct {
+	NewModuleOwnerAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=newModuleOwnerAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newModuleOwnerAddr,omitempty"`
+	Signer             github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgNewFeedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgModuleOwnershipTransferEvent) Reset()         { *m = MsgModuleOwnershipTransferEvent{} }
+func (m *MsgModuleOwnershipTransferEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgModuleOwnershipTransferEvent) ProtoMessage()    {}
+func (*MsgModuleOwnershipTransferEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{11}
+}
+func (m *MsgModuleOwnershipTransferEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModuleOwnershipTransferEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModuleOwnershipTransferEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgModuleOwnershipTransferEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModuleOwnershipTransferEvent.Merge(m, src)
+}
+func (m *MsgModuleOwnershipTransferEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModuleOwnershipTransferEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModuleOwnershipTransferEvent.DiscardUnknown(m)
 }
 
-func (m *MsgNewFeedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgModuleOwnershipTransferEvent proto.InternalMessageInfo
+
+func (m *MsgModuleOwnershipTransferEvent) GetNewModuleOwnerAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewModuleOwnerAddr
+	}
+	return nil
 }
 
-func (m *MsgNewFeedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeedOwner) > 0 {
-		i -= len(m.FeedOwner)
-		copy(dAtA[i:], m.FeedOwner)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedOwner)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgModuleOwnershipTransferEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.DataProviders) > 0 {
-		for iNdEx := len(m.DataProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DataProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return nil
+}
+
+// MsgModuleOwnerAddedEvent is emitted when a module owner is added, the signer is the gov module account when the
+// module owner is added by a governance proposal
+type MsgModuleOwnerAddedEvent struct {
+	ModuleOwnerAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=moduleOwnerAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"moduleOwnerAddr,omitempty"`
+	Signer          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgModuleOwnerAddedEvent) Reset()         { *m = MsgModuleOwnerAddedEvent{} }
+func (m *MsgModuleOwnerAddedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgModuleOwnerAddedEvent) ProtoMessage()    {}
+func (*MsgModuleOwnerAddedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{12}
+}
+func (m *MsgModuleOwnerAddedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModuleOwnerAddedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModuleOwnerAddedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgModuleOwnerAddedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModuleOwnerAddedEvent.Merge(m, src)
+}
+func (m *MsgModuleOwnerAddedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModuleOwnerAddedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModuleOwnerAddedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModuleOwnerAddedEvent proto.InternalMessageInfo
+
+func (m *MsgModuleOwnerAddedEvent) GetModuleOwnerAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ModuleOwnerAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgNewRoundDataEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgModuleOwnerAddedEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgNewRoundDataEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgModuleOwnerRemovedEvent is emitted when a module owner is removed
+type MsgModuleOwnerRemovedEvent struct {
+	ModuleOwnerAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=moduleOwnerAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"moduleOwnerAddr,omitempty"`
+	Signer          github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgNewRoundDataEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeedData) > 0 {
-		for iNdEx := len(m.FeedData) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FeedData[iNdEx])
-			copy(dAtA[i:], m.FeedData[iNdEx])
-			i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedData[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgModuleOwnerRemovedEvent) Reset()         { *m = MsgModuleOwnerRemovedEvent{} }
+func (m *MsgModuleOwnerRemovedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgModuleOwnerRemovedEvent) ProtoMessage()    {}
+func (*MsgModuleOwnerRemovedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{13}
+}
+func (m *MsgModuleOwnerRemovedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgModuleOwnerRemovedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgModuleOwnerRemovedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RoundId != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.RoundId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgModuleOwnerRemovedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgModuleOwnerRemovedEvent.Merge(m, src)
+}
+func (m *MsgModuleOwnerRemovedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgModuleOwnerRemovedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgModuleOwnerRemovedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgModuleOwnerRemovedEvent proto.InternalMessageInfo
+
+func (m *MsgModuleOwnerRemovedEvent) GetModuleOwnerAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ModuleOwnerAddr
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgNewRoundRequestEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgModuleOwnerRemovedEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgNewRoundRequestEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOwnerProposalSubmittedEvent is emitted when a module owner msg is held for the approval of the other module owners
+type MsgOwnerProposalSubmittedEvent struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	// msgType is the type of the proposed msg
+	MsgType  string                                        `protobuf:"bytes,2,opt,name=msgType,proto3" json:"msgType,omitempty"`
+	Proposer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=proposer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"proposer,omitempty"`
 }
 
-func (m *MsgNewRoundRequestEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgOwnerProposalSubmittedEvent) Reset()         { *m = MsgOwnerProposalSubmittedEvent{} }
+func (m *MsgOwnerProposalSubmittedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnerProposalSubmittedEvent) ProtoMessage()    {}
+func (*MsgOwnerProposalSubmittedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{14}
+}
+func (m *MsgOwnerProposalSubmittedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnerProposalSubmittedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnerProposalSubmittedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOwnerProposalSubmittedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnerProposalSubmittedEvent.Merge(m, src)
+}
+func (m *MsgOwnerProposalSubmittedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnerProposalSubmittedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnerProposalSubmittedEvent.DiscardUnknown(m)
 }
 
-func (m *MsgOraclePaidEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOwnerProposalSubmittedEvent proto.InternalMessageInfo
+
+func (m *MsgOwnerProposalSubmittedEvent) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgOraclePaidEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOwnerProposalSubmittedEvent) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
 }
 
-func (m *MsgOraclePaidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgOwnerProposalSubmittedEvent) GetProposer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Proposer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDataProviderSetChangeEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgOwnerProposalApprovedEvent is emitted when a module owner approves a pending owner proposal
+type MsgOwnerProposalApprovedEvent struct {
+	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	Approver   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=approver,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"approver,omitempty"`
+	// approvals is the number of module owners who approved the proposal so far
+	Approvals uint32 `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"`
+	// threshold is the number of approvals the proposal needs to execute
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (m *MsgDataProviderSetChangeEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOwnerProposalApprovedEvent) Reset()         { *m = MsgOwnerProposalApprovedEvent{} }
+func (m *MsgOwnerProposalApprovedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnerProposalApprovedEvent) ProtoMessage()    {}
+func (*MsgOwnerProposalApprovedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{15}
 }
-
-func (m *MsgDataProviderSetChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgOwnerProposalApprovedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnerProposalApprovedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnerProposalApprovedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DataProviderAddr) > 0 {
-		i -= len(m.DataProviderAddr)
-		copy(dAtA[i:], m.DataProviderAddr)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.DataProviderAddr)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgOwnerProposalApprovedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnerProposalApprovedEvent.Merge(m, src)
+}
+func (m *MsgOwnerProposalApprovedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnerProposalApprovedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnerProposalApprovedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOwnerProposalApprovedEvent proto.InternalMessageInfo
+
+func (m *MsgOwnerProposalApprovedEvent) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if len(m.ChangeType) > 0 {
-		i -= len(m.ChangeType)
-		copy(dAtA[i:], m.ChangeType)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChangeType)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgOwnerProposalApprovedEvent) GetApprover() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Approver
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgOwnerProposalApprovedEvent) GetApprovals() uint32 {
+	if m != nil {
+		return m.Approvals
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgFeedParameterChangeEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOwnerProposalApprovedEvent) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgFeedParameterChangeEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOwnerProposalExecutedEvent is emitted when an owner proposal reached the approval threshold, the proposal is
+// removed whether its msg succeeded or not
+type MsgOwnerProposalExecutedEvent struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	MsgType    string `protobuf:"bytes,2,opt,name=msgType,proto3" json:"msgType,omitempty"`
+	// error is the reason the proposed msg failed, empty when it succeeded
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *MsgFeedParameterChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NewParameterValue != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.NewParameterValue))
-		i--
-		dAtA[i] = 0x18
+func (m *MsgOwnerProposalExecutedEvent) Reset()         { *m = MsgOwnerProposalExecutedEvent{} }
+func (m *MsgOwnerProposalExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnerProposalExecutedEvent) ProtoMessage()    {}
+func (*MsgOwnerProposalExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{16}
+}
+func (m *MsgOwnerProposalExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnerProposalExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnerProposalExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChangeType) > 0 {
-		i -= len(m.ChangeType)
-		copy(dAtA[i:], m.ChangeType)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChangeType)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgOwnerProposalExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnerProposalExecutedEvent.Merge(m, src)
+}
+func (m *MsgOwnerProposalExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnerProposalExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnerProposalExecutedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOwnerProposalExecutedEvent proto.InternalMessageInfo
+
+func (m *MsgOwnerProposalExecutedEvent) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgOwnerProposalExecutedEvent) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgModuleOwnershipTransferEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOwnerProposalExecutedEvent) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgModuleOwnershipTransferEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOwnerProposalCancelledEvent is emitted when the proposer of a pending owner proposal cancels it
+type MsgOwnerProposalCancelledEvent struct {
+	ProposalId uint64                                        `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	MsgType    string                                        `protobuf:"bytes,2,opt,name=msgType,proto3" json:"msgType,omitempty"`
+	Signer     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgModuleOwnershipTransferEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.NewModuleOwnerAddr) > 0 {
-		i -= len(m.NewModuleOwnerAddr)
-		copy(dAtA[i:], m.NewModuleOwnerAddr)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewModuleOwnerAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgOwnerProposalCancelledEvent) Reset()         { *m = MsgOwnerProposalCancelledEvent{} }
+func (m *MsgOwnerProposalCancelledEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnerProposalCancelledEvent) ProtoMessage()    {}
+func (*MsgOwnerProposalCancelledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{17}
+}
+func (m *MsgOwnerProposalCancelledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnerProposalCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnerProposalCancelledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgOwnerProposalCancelledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnerProposalCancelledEvent.Merge(m, src)
+}
+func (m *MsgOwnerProposalCancelledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnerProposalCancelledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnerProposalCancelledEvent.DiscardUnknown(m)
 }
 
-func (m *MsgFeedOwnershipTransferEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgOwnerProposalCancelledEvent proto.InternalMessageInfo
+
+func (m *MsgOwnerProposalCancelledEvent) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgFeedOwnershipTransferEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOwnerProposalCancelledEvent) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
 }
 
-func (m *MsgFeedOwnershipTransferEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewFeedOwnerAddr) > 0 {
-		i -= len(m.NewFeedOwnerAddr)
-		copy(dAtA[i:], m.NewFeedOwnerAddr)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewFeedOwnerAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgOwnerProposalCancelledEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgFeedDataValidationFailedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgOwnerProposalExpiredEvent is emitted at the end of the block a pending owner proposal expired in
+type MsgOwnerProposalExpiredEvent struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	MsgType    string `protobuf:"bytes,2,opt,name=msgType,proto3" json:"msgType,omitempty"`
+	// approvals is the number of module owners who approved the proposal before it expired
+	Approvals uint32 `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"`
 }
 
-func (m *MsgFeedDataValidationFailedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOwnerProposalExpiredEvent) Reset()         { *m = MsgOwnerProposalExpiredEvent{} }
+func (m *MsgOwnerProposalExpiredEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnerProposalExpiredEvent) ProtoMessage()    {}
+func (*MsgOwnerProposalExpiredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{18}
 }
-
-func (m *MsgFeedDataValidationFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signatures[iNdEx])
-			copy(dAtA[i:], m.Signatures[iNdEx])
-			i = encodeVarintEvent(dAtA, i, uint64(len(m.Signatures[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.FeedData) > 0 {
-		for iNdEx := len(m.FeedData) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FeedData[iNdEx])
-			copy(dAtA[i:], m.FeedData[iNdEx])
-			i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedData[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.DataProviders) > 0 {
-		for iNdEx := len(m.DataProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DataProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgOwnerProposalExpiredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnerProposalExpiredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnerProposalExpiredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.FeedOwner) > 0 {
-		i -= len(m.FeedOwner)
-		copy(dAtA[i:], m.FeedOwner)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedOwner)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgOwnerProposalExpiredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnerProposalExpiredEvent.Merge(m, src)
+}
+func (m *MsgOwnerProposalExpiredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnerProposalExpiredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnerProposalExpiredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOwnerProposalExpiredEvent proto.InternalMessageInfo
+
+func (m *MsgOwnerProposalExpiredEvent) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgOwnerProposalExpiredEvent) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFeedRewardSchemaChangeEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOwnerProposalExpiredEvent) GetApprovals() uint32 {
+	if m != nil {
+		return m.Approvals
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgFeedRewardSchemaChangeEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgFeedOwnershipTransferEvent struct {
+	FeedId           string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	NewFeedOwnerAddr github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=newFeedOwnerAddr,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newFeedOwnerAddr,omitempty"`
+	Signer           github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgFeedRewardSchemaChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NewBaseAmount != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.NewBaseAmount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.NewStrategy) > 0 {
-		i -= len(m.NewStrategy)
-		copy(dAtA[i:], m.NewStrategy)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewStrategy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgFeedOwnershipTransferEvent) Reset()         { *m = MsgFeedOwnershipTransferEvent{} }
+func (m *MsgFeedOwnershipTransferEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedOwnershipTransferEvent) ProtoMessage()    {}
+func (*MsgFeedOwnershipTransferEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{19}
+}
+func (m *MsgFeedOwnershipTransferEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedOwnershipTransferEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedOwnershipTransferEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFeedOwnershipTransferEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedOwnershipTransferEvent.Merge(m, src)
+}
+func (m *MsgFeedOwnershipTransferEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedOwnershipTransferEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedOwnershipTransferEvent.DiscardUnknown(m)
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgFeedOwnershipTransferEvent proto.InternalMessageInfo
+
+func (m *MsgFeedOwnershipTransferEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MsgNewFeedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgFeedOwnershipTransferEvent) GetNewFeedOwnerAddr() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewFeedOwnerAddr
 	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *MsgFeedOwnershipTransferEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.DataProviders) > 0 {
-		for _, e := range m.DataProviders {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+// MsgOwnershipTransferProposedEvent is emitted when a feed or module owner proposes an ownership transfer, feedId is
+// empty for a module ownership transfer
+type MsgOwnershipTransferProposedEvent struct {
+	FeedId   string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Owner    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	NewOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=newOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newOwner,omitempty"`
+	// expiresAtHeight is the last height the new owner can accept the transfer at, 0 when it never expires
+	ExpiresAtHeight int64 `protobuf:"varint,4,opt,name=expiresAtHeight,proto3" json:"expiresAtHeight,omitempty"`
+}
+
+func (m *MsgOwnershipTransferProposedEvent) Reset()         { *m = MsgOwnershipTransferProposedEvent{} }
+func (m *MsgOwnershipTransferProposedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnershipTransferProposedEvent) ProtoMessage()    {}
+func (*MsgOwnershipTransferProposedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{20}
+}
+func (m *MsgOwnershipTransferProposedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnershipTransferProposedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnershipTransferProposedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.FeedOwner)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
+}
+func (m *MsgOwnershipTransferProposedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnershipTransferProposedEvent.Merge(m, src)
+}
+func (m *MsgOwnershipTransferProposedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnershipTransferProposedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnershipTransferProposedEvent.DiscardUnknown(m)
 }
 
-func (m *MsgNewRoundDataEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.RoundId != 0 {
-		n += 1 + sovEvent(uint64(m.RoundId))
+var xxx_messageInfo_MsgOwnershipTransferProposedEvent proto.InternalMessageInfo
+
+func (m *MsgOwnershipTransferProposedEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	if len(m.FeedData) > 0 {
-		for _, b := range m.FeedData {
-			l = len(b)
-			n += 1 + l + sovEvent(uint64(l))
-		}
+	return ""
+}
+
+func (m *MsgOwnershipTransferProposedEvent) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
 	}
-	return n
+	return nil
 }
 
-func (m *MsgNewRoundRequestEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOwnershipTransferProposedEvent) GetNewOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewOwner
 	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *MsgOwnershipTransferProposedEvent) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
 	}
-	return n
+	return 0
 }
 
-func (m *MsgOraclePaidEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+// MsgOwnershipTransferCancelledEvent is emitted when the owner or the new owner cancels a pending ownership transfer
+type MsgOwnershipTransferCancelledEvent struct {
+	FeedId   string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Owner    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	NewOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=newOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newOwner,omitempty"`
+	Signer   github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgOwnershipTransferCancelledEvent) Reset()         { *m = MsgOwnershipTransferCancelledEvent{} }
+func (m *MsgOwnershipTransferCancelledEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnershipTransferCancelledEvent) ProtoMessage()    {}
+func (*MsgOwnershipTransferCancelledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{21}
+}
+func (m *MsgOwnershipTransferCancelledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnershipTransferCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnershipTransferCancelledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Value != 0 {
-		n += 1 + sovEvent(uint64(m.Value))
+}
+func (m *MsgOwnershipTransferCancelledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnershipTransferCancelledEvent.Merge(m, src)
+}
+func (m *MsgOwnershipTransferCancelledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnershipTransferCancelledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnershipTransferCancelledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOwnershipTransferCancelledEvent proto.InternalMessageInfo
+
+func (m *MsgOwnershipTransferCancelledEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgDataProviderSetChangeEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOwnershipTransferCancelledEvent) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *MsgOwnershipTransferCancelledEvent) GetNewOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewOwner
 	}
-	l = len(m.ChangeType)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *MsgOwnershipTransferCancelledEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	l = len(m.DataProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+// MsgOwnershipTransferExpiredEvent is emitted at the end of the block a pending ownership transfer expired in
+type MsgOwnershipTransferExpiredEvent struct {
+	FeedId   string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Owner    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	NewOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=newOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newOwner,omitempty"`
+}
+
+func (m *MsgOwnershipTransferExpiredEvent) Reset()         { *m = MsgOwnershipTransferExpiredEvent{} }
+func (m *MsgOwnershipTransferExpiredEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgOwnershipTransferExpiredEvent) ProtoMessage()    {}
+func (*MsgOwnershipTransferExpiredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{22}
+}
+func (m *MsgOwnershipTransferExpiredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOwnershipTransferExpiredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOwnershipTransferExpiredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *MsgOwnershipTransferExpiredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOwnershipTransferExpiredEvent.Merge(m, src)
+}
+func (m *MsgOwnershipTransferExpiredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOwnershipTransferExpiredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOwnershipTransferExpiredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgOwnershipTransferExpiredEvent proto.InternalMessageInfo
+
+func (m *MsgOwnershipTransferExpiredEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgFeedParameterChangeEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgOwnershipTransferExpiredEvent) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Owner
 	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+func (m *MsgOwnershipTransferExpiredEvent) GetNewOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewOwner
 	}
-	l = len(m.ChangeType)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+type MsgFeedPausedEvent struct {
+	FeedId string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgFeedPausedEvent) Reset()         { *m = MsgFeedPausedEvent{} }
+func (m *MsgFeedPausedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedPausedEvent) ProtoMessage()    {}
+func (*MsgFeedPausedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{23}
+}
+func (m *MsgFeedPausedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedPausedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedPausedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.NewParameterValue != 0 {
-		n += 1 + sovEvent(uint64(m.NewParameterValue))
+}
+func (m *MsgFeedPausedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedPausedEvent.Merge(m, src)
+}
+func (m *MsgFeedPausedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedPausedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedPausedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeedPausedEvent proto.InternalMessageInfo
+
+func (m *MsgFeedPausedEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *MsgFeedPausedEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return n
+	return nil
 }
 
-func (m *MsgModuleOwnershipTransferEvent) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgFeedUnpausedEvent struct {
+	FeedId string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgFeedUnpausedEvent) Reset()         { *m = MsgFeedUnpausedEvent{} }
+func (m *MsgFeedUnpausedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedUnpausedEvent) ProtoMessage()    {}
+func (*MsgFeedUnpausedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{24}
+}
+func (m *MsgFeedUnpausedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedUnpausedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedUnpausedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.NewModuleOwnerAddr)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *MsgFeedUnpausedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedUnpausedEvent.Merge(m, src)
+}
+func (m *MsgFeedUnpausedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedUnpausedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedUnpausedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeedUnpausedEvent proto.InternalMessageInfo
+
+func (m *MsgFeedUnpausedEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *MsgFeedUnpausedEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return n
+	return nil
 }
 
-func (m *MsgFeedOwnershipTransferEvent) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgFeedDeprecatedEvent struct {
+	FeedId string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgFeedDeprecatedEvent) Reset()         { *m = MsgFeedDeprecatedEvent{} }
+func (m *MsgFeedDeprecatedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedDeprecatedEvent) ProtoMessage()    {}
+func (*MsgFeedDeprecatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{25}
+}
+func (m *MsgFeedDeprecatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedDeprecatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedDeprecatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *MsgFeedDeprecatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedDeprecatedEvent.Merge(m, src)
+}
+func (m *MsgFeedDeprecatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedDeprecatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedDeprecatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeedDeprecatedEvent proto.InternalMessageInfo
+
+func (m *MsgFeedDeprecatedEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	l = len(m.NewFeedOwnerAddr)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *MsgFeedDeprecatedEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+type MsgFeedDeletedEvent struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// lastRoundId is the latest round of the feed, every round up to it got pruned
+	LastRoundId uint64                                        `protobuf:"varint,2,opt,name=lastRoundId,proto3" json:"lastRoundId,omitempty"`
+	Signer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgFeedDeletedEvent) Reset()         { *m = MsgFeedDeletedEvent{} }
+func (m *MsgFeedDeletedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedDeletedEvent) ProtoMessage()    {}
+func (*MsgFeedDeletedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{26}
+}
+func (m *MsgFeedDeletedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedDeletedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedDeletedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgFeedDeletedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedDeletedEvent.Merge(m, src)
+}
+func (m *MsgFeedDeletedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedDeletedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedDeletedEvent.DiscardUnknown(m)
 }
 
-func (m *MsgFeedDataValidationFailedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgFeedDeletedEvent proto.InternalMessageInfo
+
+func (m *MsgFeedDeletedEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *MsgFeedDeletedEvent) GetLastRoundId() uint64 {
+	if m != nil {
+		return m.LastRoundId
 	}
-	l = len(m.FeedOwner)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+func (m *MsgFeedDeletedEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	if len(m.DataProviders) > 0 {
-		for _, e := range m.DataProviders {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	return nil
+}
+
+type MsgFeedDataValidationFailedEvent struct {
+	FeedId    string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	FeedOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=feedOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"feedOwner,omitempty"`
+	// DataProviders is the init list of data provider of the feed
+	DataProviders []*DataProvider                               `protobuf:"bytes,3,rep,name=dataProviders,proto3" json:"dataProviders,omitempty"`
+	Submitter     github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
+	FeedData      [][]byte                                      `protobuf:"bytes,5,rep,name=feedData,proto3" json:"feedData,omitempty"`
+	// Signatures is the data provider signature list of the current round
+	Signatures [][]byte `protobuf:"bytes,6,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// reason is empty when the feed data failed the external validation
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgFeedDataValidationFailedEvent) Reset()         { *m = MsgFeedDataValidationFailedEvent{} }
+func (m *MsgFeedDataValidationFailedEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedDataValidationFailedEvent) ProtoMessage()    {}
+func (*MsgFeedDataValidationFailedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{27}
+}
+func (m *MsgFeedDataValidationFailedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedDataValidationFailedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedDataValidationFailedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *MsgFeedDataValidationFailedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedDataValidationFailedEvent.Merge(m, src)
+}
+func (m *MsgFeedDataValidationFailedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedDataValidationFailedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedDataValidationFailedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeedDataValidationFailedEvent proto.InternalMessageInfo
+
+func (m *MsgFeedDataValidationFailedEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	if len(m.FeedData) > 0 {
-		for _, b := range m.FeedData {
-			l = len(b)
-			n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *MsgFeedDataValidationFailedEvent) GetFeedOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FeedOwner
+	}
+	return nil
+}
+
+func (m *MsgFeedDataValidationFailedEvent) GetDataProviders() []*DataProvider {
+	if m != nil {
+		return m.DataProviders
+	}
+	return nil
+}
+
+func (m *MsgFeedDataValidationFailedEvent) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
+	}
+	return nil
+}
+
+func (m *MsgFeedDataValidationFailedEvent) GetFeedData() [][]byte {
+	if m != nil {
+		return m.FeedData
+	}
+	return nil
+}
+
+func (m *MsgFeedDataValidationFailedEvent) GetSignatures() [][]byte {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func (m *MsgFeedDataValidationFailedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type MsgFeedMetadataChangeEvent struct {
+	FeedId      string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	NewMetadata *FeedMetadata                                 `protobuf:"bytes,2,opt,name=newMetadata,proto3" json:"newMetadata,omitempty"`
+	Signer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgFeedMetadataChangeEvent) Reset()         { *m = MsgFeedMetadataChangeEvent{} }
+func (m *MsgFeedMetadataChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedMetadataChangeEvent) ProtoMessage()    {}
+func (*MsgFeedMetadataChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{28}
+}
+func (m *MsgFeedMetadataChangeEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedMetadataChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedMetadataChangeEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Signatures) > 0 {
-		for _, b := range m.Signatures {
-			l = len(b)
-			n += 1 + l + sovEvent(uint64(l))
+}
+func (m *MsgFeedMetadataChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedMetadataChangeEvent.Merge(m, src)
+}
+func (m *MsgFeedMetadataChangeEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedMetadataChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedMetadataChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeedMetadataChangeEvent proto.InternalMessageInfo
+
+func (m *MsgFeedMetadataChangeEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *MsgFeedMetadataChangeEvent) GetNewMetadata() *FeedMetadata {
+	if m != nil {
+		return m.NewMetadata
+	}
+	return nil
+}
+
+func (m *MsgFeedMetadataChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+type MsgFeedRewardSchemaChangeEvent struct {
+	FeedId        string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	NewStrategy   string                                        `protobuf:"bytes,2,opt,name=newStrategy,proto3" json:"newStrategy,omitempty"`
+	NewBaseAmount uint64                                        `protobuf:"varint,3,opt,name=newBaseAmount,proto3" json:"newBaseAmount,omitempty"`
+	Signer        github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) Reset()         { *m = MsgFeedRewardSchemaChangeEvent{} }
+func (m *MsgFeedRewardSchemaChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedRewardSchemaChangeEvent) ProtoMessage()    {}
+func (*MsgFeedRewardSchemaChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{29}
+}
+func (m *MsgFeedRewardSchemaChangeEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedRewardSchemaChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedRewardSchemaChangeEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeedRewardSchemaChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedRewardSchemaChangeEvent.Merge(m, src)
+}
+func (m *MsgFeedRewardSchemaChangeEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedRewardSchemaChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedRewardSchemaChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeedRewardSchemaChangeEvent proto.InternalMessageInfo
+
+func (m *MsgFeedRewardSchemaChangeEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) GetNewStrategy() string {
+	if m != nil {
+		return m.NewStrategy
+	}
+	return ""
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) GetNewBaseAmount() uint64 {
+	if m != nil {
+		return m.NewBaseAmount
+	}
+	return 0
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+// MsgConfigSetEvent mirrors the ConfigSet event of the OCR aggregator
+type MsgConfigSetEvent struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// previousConfigBlockNumber is the height the previous config got set in, 0 for the first config
+	PreviousConfigBlockNumber int64                                           `protobuf:"varint,2,opt,name=previousConfigBlockNumber,proto3" json:"previousConfigBlockNumber,omitempty"`
+	ConfigDigest              []byte                                          `protobuf:"bytes,3,opt,name=configDigest,proto3" json:"configDigest,omitempty"`
+	ConfigCount               uint64                                          `protobuf:"varint,4,opt,name=configCount,proto3" json:"configCount,omitempty"`
+	SigningKeys               [][]byte                                        `protobuf:"bytes,5,rep,name=signingKeys,proto3" json:"signingKeys,omitempty"`
+	Transmitters              []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,6,rep,name=transmitters,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"transmitters,omitempty"`
+	F                         uint32                                          `protobuf:"varint,7,opt,name=f,proto3" json:"f,omitempty"`
+	OnchainConfig             []byte                                          `protobuf:"bytes,8,opt,name=onchainConfig,proto3" json:"onchainConfig,omitempty"`
+	OffchainConfigVersion     uint64                                          `protobuf:"varint,9,opt,name=offchainConfigVersion,proto3" json:"offchainConfigVersion,omitempty"`
+	OffchainConfig            []byte                                          `protobuf:"bytes,10,opt,name=offchainConfig,proto3" json:"offchainConfig,omitempty"`
+	Signer                    github_com_cosmos_cosmos_sdk_types.AccAddress   `protobuf:"bytes,11,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgConfigSetEvent) Reset()         { *m = MsgConfigSetEvent{} }
+func (m *MsgConfigSetEvent) String() string { return proto.CompactTextString(m) }
+func (*MsgConfigSetEvent) ProtoMessage()    {}
+func (*MsgConfigSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23aee62a42efb6bc, []int{30}
+}
+func (m *MsgConfigSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfigSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfigSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgConfigSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfigSetEvent.Merge(m, src)
+}
+func (m *MsgConfigSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfigSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfigSetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgConfigSetEvent proto.InternalMessageInfo
+
+func (m *MsgConfigSetEvent) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *MsgConfigSetEvent) GetPreviousConfigBlockNumber() int64 {
+	if m != nil {
+		return m.PreviousConfigBlockNumber
+	}
+	return 0
+}
+
+func (m *MsgConfigSetEvent) GetConfigDigest() []byte {
+	if m != nil {
+		return m.ConfigDigest
+	}
+	return nil
+}
+
+func (m *MsgConfigSetEvent) GetConfigCount() uint64 {
+	if m != nil {
+		return m.ConfigCount
+	}
+	return 0
+}
+
+func (m *MsgConfigSetEvent) GetSigningKeys() [][]byte {
+	if m != nil {
+		return m.SigningKeys
+	}
+	return nil
+}
+
+func (m *MsgConfigSetEvent) GetTransmitters() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Transmitters
+	}
+	return nil
+}
+
+func (m *MsgConfigSetEvent) GetF() uint32 {
+	if m != nil {
+		return m.F
+	}
+	return 0
+}
+
+func (m *MsgConfigSetEvent) GetOnchainConfig() []byte {
+	if m != nil {
+		return m.OnchainConfig
+	}
+	return nil
+}
+
+func (m *MsgConfigSetEvent) GetOffchainConfigVersion() uint64 {
+	if m != nil {
+		return m.OffchainConfigVersion
+	}
+	return 0
+}
+
+func (m *MsgConfigSetEvent) GetOffchainConfig() []byte {
+	if m != nil {
+		return m.OffchainConfig
+	}
+	return nil
+}
+
+func (m *MsgConfigSetEvent) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgNewFeedEvent)(nil), "chainlink.v1beta.MsgNewFeedEvent")
+	proto.RegisterType((*MsgNewRoundDataEvent)(nil), "chainlink.v1beta.MsgNewRoundDataEvent")
+	proto.RegisterType((*MsgRoundDeviationEvent)(nil), "chainlink.v1beta.MsgRoundDeviationEvent")
+	proto.RegisterType((*MsgNewRoundRequestEvent)(nil), "chainlink.v1beta.MsgNewRoundRequestEvent")
+	proto.RegisterType((*MsgOraclePaidEvent)(nil), "chainlink.v1beta.MsgOraclePaidEvent")
+	proto.RegisterType((*MsgDataProviderSetChangeEvent)(nil), "chainlink.v1beta.MsgDataProviderSetChangeEvent")
+	proto.RegisterType((*MsgTransmitterSetChangeEvent)(nil), "chainlink.v1beta.MsgTransmitterSetChangeEvent")
+	proto.RegisterType((*MsgFeedEscrowChangeEvent)(nil), "chainlink.v1beta.MsgFeedEscrowChangeEvent")
+	proto.RegisterType((*MsgPaymentWithdrawnEvent)(nil), "chainlink.v1beta.MsgPaymentWithdrawnEvent")
+	proto.RegisterType((*MsgFeedUnderfundedEvent)(nil), "chainlink.v1beta.MsgFeedUnderfundedEvent")
+	proto.RegisterType((*MsgFeedParameterChangeEvent)(nil), "chainlink.v1beta.MsgFeedParameterChangeEvent")
+	proto.RegisterType((*MsgModuleOwnershipTransferEvent)(nil), "chainlink.v1beta.MsgModuleOwnershipTransferEvent")
+	proto.RegisterType((*MsgModuleOwnerAddedEvent)(nil), "chainlink.v1beta.MsgModuleOwnerAddedEvent")
+	proto.RegisterType((*MsgModuleOwnerRemovedEvent)(nil), "chainlink.v1beta.MsgModuleOwnerRemovedEvent")
+	proto.RegisterType((*MsgOwnerProposalSubmittedEvent)(nil), "chainlink.v1beta.MsgOwnerProposalSubmittedEvent")
+	proto.RegisterType((*MsgOwnerProposalApprovedEvent)(nil), "chainlink.v1beta.MsgOwnerProposalApprovedEvent")
+	proto.RegisterType((*MsgOwnerProposalExecutedEvent)(nil), "chainlink.v1beta.MsgOwnerProposalExecutedEvent")
+	proto.RegisterType((*MsgOwnerProposalCancelledEvent)(nil), "chainlink.v1beta.MsgOwnerProposalCancelledEvent")
+	proto.RegisterType((*MsgOwnerProposalExpiredEvent)(nil), "chainlink.v1beta.MsgOwnerProposalExpiredEvent")
+	proto.RegisterType((*MsgFeedOwnershipTransferEvent)(nil), "chainlink.v1beta.MsgFeedOwnershipTransferEvent")
+	proto.RegisterType((*MsgOwnershipTransferProposedEvent)(nil), "chainlink.v1beta.MsgOwnershipTransferProposedEvent")
+	proto.RegisterType((*MsgOwnershipTransferCancelledEvent)(nil), "chainlink.v1beta.MsgOwnershipTransferCancelledEvent")
+	proto.RegisterType((*MsgOwnershipTransferExpiredEvent)(nil), "chainlink.v1beta.MsgOwnershipTransferExpiredEvent")
+	proto.RegisterType((*MsgFeedPausedEvent)(nil), "chainlink.v1beta.MsgFeedPausedEvent")
+	proto.RegisterType((*MsgFeedUnpausedEvent)(nil), "chainlink.v1beta.MsgFeedUnpausedEvent")
+	proto.RegisterType((*MsgFeedDeprecatedEvent)(nil), "chainlink.v1beta.MsgFeedDeprecatedEvent")
+	proto.RegisterType((*MsgFeedDeletedEvent)(nil), "chainlink.v1beta.MsgFeedDeletedEvent")
+	proto.RegisterType((*MsgFeedDataValidationFailedEvent)(nil), "chainlink.v1beta.MsgFeedDataValidationFailedEvent")
+	proto.RegisterType((*MsgFeedMetadataChangeEvent)(nil), "chainlink.v1beta.MsgFeedMetadataChangeEvent")
+	proto.RegisterType((*MsgFeedRewardSchemaChangeEvent)(nil), "chainlink.v1beta.MsgFeedRewardSchemaChangeEvent")
+	proto.RegisterType((*MsgConfigSetEvent)(nil), "chainlink.v1beta.MsgConfigSetEvent")
+}
+
+func init() { proto.RegisterFile("chainlink/v1beta/event.proto", fileDescriptor_23aee62a42efb6bc) }
+
+var fileDescriptor_23aee62a42efb6bc = []byte{
+	// 1565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xbd, 0x6f, 0x1b, 0x47,
+	0x16, 0xd7, 0x92, 0x14, 0x2d, 0x3d, 0x49, 0x96, 0xbc, 0xa7, 0x93, 0xd7, 0xb2, 0x4c, 0xf1, 0x16,
+	0x07, 0x83, 0x38, 0x9c, 0x25, 0xc8, 0x77, 0xc0, 0x35, 0x57, 0x9c, 0x3e, 0x6d, 0xc1, 0xa0, 0xad,
+	0x1b, 0xc9, 0x3a, 0xe0, 0x02, 0x17, 0xc3, 0xdd, 0xc7, 0xe5, 0xc2, 0xcb, 0x5d, 0x7a, 0x66, 0x48,
+	0x4a, 0x48, 0x95, 0x22, 0x7d, 0x90, 0x2a, 0x49, 0x93, 0x2a, 0x45, 0xfe, 0x8b, 0x24, 0x48, 0x61,
+	0x24, 0x45, 0x8c, 0x54, 0x41, 0x0a, 0x25, 0xb1, 0xdb, 0xd4, 0x2e, 0x02, 0x04, 0x08, 0x66, 0x66,
+	0x49, 0x2e, 0x49, 0x7d, 0x81, 0x24, 0x0c, 0xb8, 0x12, 0xdf, 0x9b, 0x37, 0xef, 0xcd, 0xef, 0xcd,
+	0x7b, 0x6f, 0xde, 0x3e, 0xc1, 0x92, 0x53, 0xa1, 0x7e, 0x18, 0xf8, 0xe1, 0xd3, 0xd5, 0xc6, 0x5a,
+	0x09, 0x05, 0x5d, 0xc5, 0x06, 0x86, 0x62, 0xa5, 0xc6, 0x22, 0x11, 0x99, 0x73, 0xed, 0xd5, 0x15,
+	0xbd, 0xba, 0x38, 0xef, 0x45, 0x5e, 0xa4, 0x16, 0x57, 0xe5, 0x2f, 0x2d, 0xb7, 0x78, 0xa3, 0x4f,
+	0x8b, 0x38, 0xd2, 0x4b, 0xf6, 0x17, 0x06, 0xcc, 0x16, 0xb9, 0xf7, 0x10, 0x9b, 0x3b, 0x88, 0xee,
+	0xb6, 0x54, 0x6e, 0x2e, 0x40, 0xb6, 0x8c, 0xe8, 0xee, 0xba, 0x96, 0x91, 0x37, 0x0a, 0x93, 0x24,
+	0xa6, 0xcc, 0x2d, 0x98, 0x71, 0xa9, 0xa0, 0x7b, 0x2c, 0x6a, 0xf8, 0x2e, 0x32, 0x6e, 0xa5, 0xf2,
+	0xe9, 0xc2, 0xd4, 0xdd, 0xdc, 0x4a, 0xef, 0x31, 0x56, 0xb6, 0x12, 0x62, 0xa4, 0x7b, 0x93, 0xf9,
+	0x08, 0x26, 0xa5, 0xbe, 0x47, 0xcd, 0x10, 0x99, 0x95, 0xce, 0x1b, 0x85, 0xe9, 0x8d, 0xb5, 0xdf,
+	0x4e, 0x96, 0xef, 0x78, 0xbe, 0xa8, 0xd4, 0x4b, 0x2b, 0x4e, 0x54, 0x5d, 0x75, 0x22, 0x5e, 0x8d,
+	0x78, 0xfc, 0xe7, 0x0e, 0x77, 0x9f, 0xae, 0x8a, 0xe3, 0x1a, 0xf2, 0x95, 0x75, 0xc7, 0x59, 0x77,
+	0x5d, 0x86, 0x9c, 0x93, 0x8e, 0x0e, 0xfb, 0x27, 0x03, 0xe6, 0x35, 0x04, 0x12, 0xd5, 0x43, 0x57,
+	0xda, 0x3e, 0x1f, 0x87, 0x05, 0x57, 0x98, 0x94, 0xdc, 0x75, 0xad, 0x54, 0xde, 0x28, 0x64, 0x48,
+	0x8b, 0x34, 0x17, 0x61, 0x42, 0xca, 0x48, 0x15, 0x56, 0x3a, 0x9f, 0x2e, 0x4c, 0x93, 0x36, 0x6d,
+	0xee, 0x40, 0x96, 0x86, 0xbc, 0x89, 0xcc, 0xca, 0x48, 0x6d, 0x1b, 0x2b, 0xcf, 0x4f, 0x96, 0xc7,
+	0x7e, 0x3c, 0x59, 0xbe, 0x7d, 0x89, 0x83, 0xef, 0x86, 0x82, 0xc4, 0xbb, 0xcd, 0x35, 0x18, 0x6f,
+	0xd0, 0xa0, 0x8e, 0xd6, 0x78, 0xde, 0x28, 0x4c, 0xdd, 0xbd, 0xd9, 0xef, 0x3d, 0x79, 0x13, 0x87,
+	0x52, 0x84, 0x68, 0x49, 0xfb, 0xc3, 0x34, 0x2c, 0x14, 0xb9, 0xa7, 0xe1, 0x61, 0xc3, 0xa7, 0xc2,
+	0x8f, 0xc2, 0x41, 0x31, 0x1e, 0xc2, 0xd5, 0x1a, 0xc3, 0x86, 0x1f, 0xd5, 0xf9, 0xba, 0xc6, 0x93,
+	0x1e, 0x08, 0x4f, 0x8f, 0x96, 0x91, 0xf9, 0x67, 0x09, 0x26, 0xdd, 0x16, 0x46, 0xe5, 0xa3, 0x0c,
+	0xe9, 0x30, 0xcc, 0x7f, 0xc3, 0x8d, 0x36, 0x71, 0x50, 0x61, 0xc8, 0x2b, 0x51, 0xe0, 0x1e, 0x30,
+	0xdf, 0xf3, 0x90, 0x59, 0xd9, 0xbc, 0x51, 0x98, 0x21, 0x67, 0x0b, 0x98, 0x7f, 0x83, 0xb9, 0x0a,
+	0x52, 0x26, 0x4a, 0x48, 0xc5, 0x76, 0x40, 0x6b, 0x1c, 0x5d, 0xeb, 0x4a, 0xde, 0x28, 0x4c, 0x90,
+	0x3e, 0xbe, 0x99, 0x03, 0x60, 0xd8, 0xa4, 0xcc, 0xa5, 0xa5, 0x00, 0xad, 0x09, 0x25, 0x95, 0xe0,
+	0xd8, 0x6b, 0x70, 0x3d, 0x11, 0x75, 0x04, 0x9f, 0xd5, 0x91, 0x8b, 0x73, 0x2f, 0xc5, 0xfe, 0xd5,
+	0x00, 0xb3, 0xc8, 0xbd, 0x47, 0x8c, 0x3a, 0x01, 0xee, 0x51, 0xff, 0x82, 0x7c, 0x7b, 0x00, 0x57,
+	0xa8, 0xe3, 0x44, 0xf5, 0x50, 0x58, 0xa9, 0x41, 0xf3, 0xa4, 0xa5, 0xc1, 0x9c, 0x6f, 0x85, 0x5d,
+	0x5a, 0xb9, 0x54, 0x13, 0xa6, 0x09, 0x19, 0x16, 0x05, 0xa8, 0xaf, 0x8c, 0xa8, 0xdf, 0xe6, 0x3d,
+	0x18, 0xaf, 0xd1, 0x63, 0xd4, 0x01, 0x3a, 0x90, 0x51, 0xbd, 0xdf, 0x7e, 0x2f, 0x05, 0xb7, 0x8a,
+	0xdc, 0x4b, 0x16, 0x83, 0x7d, 0x14, 0x9b, 0x15, 0x1a, 0x7a, 0x78, 0x3e, 0xf2, 0x1c, 0x80, 0xa3,
+	0xc4, 0x0e, 0x8e, 0x6b, 0xa8, 0xc0, 0x4f, 0x92, 0x04, 0xc7, 0x7c, 0x02, 0x73, 0xc9, 0xa2, 0x22,
+	0xed, 0x0e, 0x5e, 0x4a, 0xfa, 0x54, 0x99, 0xbb, 0x90, 0xe5, 0xbe, 0x17, 0xc6, 0xa1, 0x3c, 0x90,
+	0xd2, 0x58, 0x81, 0xfd, 0xda, 0x80, 0xa5, 0x22, 0xf7, 0x0e, 0x18, 0x0d, 0x79, 0xd5, 0x17, 0x62,
+	0x64, 0x2e, 0xd8, 0x87, 0x29, 0xd1, 0x51, 0x3a, 0x38, 0xfa, 0xa4, 0x96, 0x51, 0x02, 0xff, 0xce,
+	0x00, 0xab, 0xc8, 0x3d, 0xf5, 0xaa, 0x70, 0x87, 0x45, 0xcd, 0x51, 0x80, 0x5e, 0x80, 0x2c, 0xad,
+	0xaa, 0x84, 0xd0, 0x51, 0x1c, 0x53, 0xb2, 0xda, 0x95, 0x68, 0x40, 0x43, 0x47, 0x47, 0x72, 0x86,
+	0xb4, 0xc8, 0x04, 0xa2, 0xf1, 0x61, 0x11, 0x9d, 0x68, 0x44, 0x7b, 0xf4, 0xb8, 0x8a, 0xa1, 0xf8,
+	0x9f, 0x2f, 0x2a, 0x2e, 0xa3, 0xcd, 0xb8, 0x0e, 0xef, 0x42, 0x36, 0x52, 0x69, 0x6d, 0x19, 0x03,
+	0xdb, 0xd1, 0x0a, 0x3a, 0xf9, 0x97, 0x1a, 0x2e, 0xff, 0x12, 0x5e, 0x4e, 0x77, 0x79, 0xb9, 0xe3,
+	0xc5, 0x4c, 0xd2, 0x8b, 0xf6, 0xc7, 0x06, 0x5c, 0x8f, 0xaf, 0xec, 0x71, 0xe8, 0x22, 0x2b, 0xd7,
+	0x43, 0x17, 0xdd, 0x41, 0xdf, 0x99, 0xc4, 0x9d, 0xa4, 0xbb, 0xef, 0x64, 0x11, 0x26, 0x18, 0x3e,
+	0xab, 0xfb, 0x0c, 0xdd, 0xf8, 0x04, 0x6d, 0x5a, 0xda, 0xa9, 0xfa, 0xa1, 0x40, 0x37, 0x2e, 0xfd,
+	0x31, 0x65, 0x7f, 0x94, 0x82, 0x9b, 0xf1, 0xd9, 0xf6, 0x28, 0xa3, 0x55, 0x14, 0xc8, 0x46, 0x11,
+	0x51, 0x7f, 0x87, 0x6b, 0x21, 0x36, 0xdb, 0x2a, 0x0f, 0xdb, 0x25, 0x72, 0x86, 0xf4, 0x2f, 0x8c,
+	0x30, 0x3f, 0xcc, 0xfb, 0x30, 0x1b, 0x62, 0x53, 0xbf, 0x9d, 0x1b, 0xd2, 0x65, 0x3c, 0x6e, 0x08,
+	0x4e, 0x69, 0xa7, 0x92, 0x52, 0xa4, 0x77, 0x9b, 0xcc, 0xb4, 0xe5, 0x22, 0xf7, 0x8a, 0x91, 0x5b,
+	0x0f, 0x50, 0xb5, 0x44, 0xbc, 0xe2, 0xd7, 0x54, 0xc5, 0x29, 0x23, 0xd3, 0xee, 0xa1, 0x60, 0x86,
+	0xd8, 0x4c, 0x88, 0xa8, 0x92, 0x39, 0x70, 0xa8, 0x9e, 0xa2, 0x6c, 0x94, 0xb5, 0xe3, 0x2b, 0x9d,
+	0x69, 0xdd, 0x16, 0x5a, 0x91, 0xf8, 0x0e, 0xcc, 0x56, 0x47, 0x85, 0x63, 0xb6, 0x7a, 0x26, 0x88,
+	0xd4, 0xb0, 0x20, 0xbe, 0x36, 0x60, 0xb1, 0x1b, 0x04, 0xc1, 0x6a, 0xd4, 0x78, 0xdb, 0x60, 0x7c,
+	0x6e, 0x40, 0x4e, 0xf6, 0x2c, 0x52, 0xf7, 0x1e, 0x8b, 0x6a, 0x11, 0xa7, 0xc1, 0x7e, 0xbd, 0xa4,
+	0x1e, 0x8c, 0x18, 0x4a, 0x0e, 0xa0, 0x16, 0xaf, 0xc4, 0xf9, 0x97, 0x21, 0x09, 0x8e, 0xac, 0x04,
+	0x55, 0xee, 0x25, 0x12, 0xb0, 0x45, 0x9a, 0x45, 0x98, 0xd0, 0x72, 0xc3, 0xbc, 0x60, 0x6d, 0x15,
+	0xf6, 0x37, 0x06, 0xdc, 0xea, 0x3d, 0xeb, 0x7a, 0xad, 0xc6, 0xa2, 0xc6, 0x65, 0x8f, 0x5a, 0x84,
+	0x09, 0xaa, 0x37, 0x0c, 0xe1, 0xba, 0xb6, 0x0a, 0xd9, 0xcb, 0xea, 0xdf, 0x34, 0xe0, 0x71, 0x55,
+	0xe9, 0x30, 0xe4, 0xaa, 0x68, 0x75, 0xa8, 0x2a, 0x69, 0x66, 0x48, 0x87, 0x61, 0x47, 0xfd, 0x58,
+	0xb6, 0x8f, 0xd0, 0xa9, 0x8f, 0xc0, 0xed, 0xf3, 0x30, 0x8e, 0x8c, 0x45, 0x71, 0xe7, 0x4f, 0x34,
+	0x61, 0x7f, 0x76, 0xca, 0x4d, 0x6f, 0xca, 0x82, 0x1d, 0x04, 0xc3, 0x9b, 0xec, 0x44, 0x64, 0x7a,
+	0xd8, 0x88, 0x6c, 0xc0, 0x52, 0xef, 0x31, 0xb7, 0x8f, 0x6a, 0x3e, 0x1b, 0xfe, 0x90, 0xe7, 0x5e,
+	0x97, 0xfd, 0x8b, 0x8e, 0xae, 0x9d, 0xd6, 0x87, 0x67, 0x7f, 0x95, 0x3d, 0xeb, 0x11, 0x7a, 0x02,
+	0x73, 0x21, 0x36, 0xdb, 0x1b, 0x55, 0xb2, 0x0f, 0x1c, 0x5d, 0x7d, 0xaa, 0x46, 0xe9, 0xdb, 0xdf,
+	0x0d, 0xf8, 0x4b, 0xcb, 0xb9, 0x49, 0x7c, 0xda, 0xd1, 0x17, 0x35, 0x03, 0xf7, 0x60, 0x3c, 0x6a,
+	0x0e, 0x55, 0x75, 0xf4, 0x7e, 0x99, 0x86, 0x21, 0x36, 0x87, 0x1c, 0x11, 0xb4, 0x55, 0x98, 0x05,
+	0x98, 0x45, 0x15, 0x21, 0x7c, 0x5d, 0xdc, 0x47, 0xdf, 0xab, 0xe8, 0xce, 0x27, 0x4d, 0x7a, 0xd9,
+	0xf6, 0xa7, 0x29, 0xb0, 0x4f, 0xc3, 0xdf, 0x93, 0x07, 0x6f, 0x9b, 0x03, 0x46, 0xf8, 0x36, 0x7f,
+	0x6f, 0x40, 0xfe, 0x34, 0x0f, 0x75, 0xa5, 0xe0, 0x5b, 0xe6, 0x1f, 0xbb, 0xa9, 0xbe, 0xcb, 0x75,
+	0x73, 0x59, 0xbf, 0x30, 0xcc, 0x47, 0xf8, 0xba, 0x1e, 0xc3, 0x7c, 0x6c, 0xf8, 0x71, 0x58, 0x7b,
+	0xb3, 0xa6, 0xdf, 0x85, 0x85, 0xd8, 0xf4, 0x16, 0xd6, 0x18, 0x3a, 0x54, 0xbc, 0x41, 0xe3, 0x9f,
+	0x18, 0xf0, 0xa7, 0xb6, 0xf5, 0x00, 0x2f, 0x34, 0x9d, 0x87, 0xa9, 0x80, 0x72, 0x41, 0xba, 0x3e,
+	0x35, 0x92, 0xac, 0x51, 0x16, 0xc1, 0xd7, 0x29, 0xc8, 0xb7, 0x0e, 0x47, 0x05, 0x3d, 0xa4, 0x81,
+	0xef, 0xaa, 0x79, 0xd2, 0x0e, 0xf5, 0x2f, 0x2c, 0x01, 0x5d, 0xe3, 0xcd, 0xd4, 0xf0, 0xe3, 0xcd,
+	0xfe, 0xa9, 0x6b, 0x7a, 0xc0, 0xa9, 0x2b, 0x8f, 0xbb, 0xb6, 0x21, 0x8a, 0x40, 0x47, 0x47, 0xd7,
+	0xa8, 0x74, 0xbc, 0x67, 0x54, 0x9a, 0x03, 0x90, 0xae, 0xa4, 0xa2, 0xce, 0x90, 0x5b, 0x59, 0xb5,
+	0x9a, 0xe0, 0x48, 0xdf, 0x31, 0xa4, 0x3c, 0x0a, 0xd5, 0xf0, 0x6d, 0x92, 0xc4, 0x94, 0xfd, 0xa5,
+	0x6e, 0x99, 0xa5, 0xe3, 0x8b, 0x28, 0xa8, 0x44, 0x70, 0x99, 0x6f, 0xbc, 0xff, 0xc0, 0x94, 0xfc,
+	0x1e, 0x89, 0x77, 0x28, 0xa7, 0x9f, 0xea, 0x9f, 0xa4, 0x5e, 0x92, 0xdc, 0x32, 0xca, 0xe0, 0xf9,
+	0x56, 0x77, 0x51, 0xd2, 0x16, 0x51, 0xc3, 0xc2, 0x7d, 0xa7, 0x82, 0xd5, 0x4b, 0xe1, 0xc8, 0x2b,
+	0x1c, 0xfb, 0x82, 0x51, 0x81, 0xde, 0x71, 0xdc, 0x9c, 0x24, 0x59, 0xe6, 0x5f, 0x61, 0x26, 0xc4,
+	0xe6, 0x06, 0xe5, 0xb8, 0x9e, 0x1c, 0x83, 0x74, 0x33, 0x47, 0x59, 0xed, 0xdf, 0xcf, 0xc0, 0xb5,
+	0x22, 0xf7, 0x36, 0xa3, 0xb0, 0xec, 0x7b, 0xfb, 0x78, 0xfe, 0x7c, 0x53, 0x0e, 0x67, 0x5b, 0x43,
+	0x61, 0xbd, 0x63, 0x23, 0x88, 0x9c, 0xa7, 0x0f, 0xeb, 0xd5, 0x52, 0x9c, 0x0b, 0x69, 0x72, 0xb6,
+	0x80, 0x69, 0xc3, 0xb4, 0xa3, 0x98, 0x5b, 0xbe, 0x87, 0x5c, 0x63, 0x9b, 0x26, 0x5d, 0x3c, 0xe9,
+	0x22, 0x4d, 0x6f, 0x26, 0xe6, 0x17, 0x49, 0x96, 0x94, 0x90, 0x67, 0xf7, 0x43, 0xef, 0x01, 0x1e,
+	0xf3, 0x38, 0x34, 0x93, 0x2c, 0xf3, 0x31, 0x4c, 0x27, 0x66, 0x5e, 0x71, 0x7c, 0x0e, 0xe2, 0xa4,
+	0x2e, 0x35, 0xe6, 0x34, 0x18, 0x65, 0x15, 0xcf, 0x33, 0xc4, 0x28, 0xcb, 0x9b, 0x8a, 0x42, 0x15,
+	0x81, 0x1a, 0xa8, 0x1a, 0x20, 0x4f, 0x93, 0x6e, 0xa6, 0xf9, 0x4f, 0xf8, 0x73, 0x54, 0x2e, 0x27,
+	0x38, 0x87, 0xc8, 0xb8, 0x9c, 0x7b, 0x4f, 0x2a, 0x60, 0xa7, 0x2f, 0x9a, 0xb7, 0xe1, 0x6a, 0xf7,
+	0x82, 0x05, 0x4a, 0x79, 0x0f, 0x37, 0x11, 0x07, 0x53, 0x43, 0xc6, 0xc1, 0xc6, 0x7f, 0x9f, 0xbf,
+	0xcc, 0x19, 0x2f, 0x5e, 0xe6, 0x8c, 0x9f, 0x5f, 0xe6, 0x8c, 0x0f, 0x5e, 0xe5, 0xc6, 0x5e, 0xbc,
+	0xca, 0x8d, 0xfd, 0xf0, 0x2a, 0x37, 0xf6, 0xff, 0x7f, 0x25, 0x14, 0x6e, 0x4a, 0xe3, 0xfb, 0xb4,
+	0x8c, 0xab, 0xed, 0xdc, 0xbb, 0x13, 0x1b, 0x39, 0xea, 0xb0, 0xb4, 0x95, 0x52, 0x56, 0xfd, 0x03,
+	0xea, 0x1f, 0x7f, 0x0c, 0x00, 0x76, 0x4a, 0xeb, 0x2a, 0xe3, 0x1a, 0x00, 0x00,
+}
+
+func (m *MsgNewFeedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewFeedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewFeedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedOwner) > 0 {
+		i -= len(m.FeedOwner)
+		copy(dAtA[i:], m.FeedOwner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataProviders) > 0 {
+		for iNdEx := len(m.DataProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewRoundDataEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewRoundDataEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewRoundDataEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Answer.Size()
+		i -= size
+		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.FeedData) > 0 {
+		for iNdEx := len(m.FeedData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeedData[iNdEx])
+			copy(dAtA[i:], m.FeedData[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedData[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRoundDeviationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRoundDeviationEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRoundDeviationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rewardable {
+		i--
+		if m.Rewardable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HeartbeatElapsed {
+		i--
+		if m.HeartbeatElapsed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DeviationThresholdTrigger != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.DeviationThresholdTrigger))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Deviation != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Deviation))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Answer.Size()
+		i -= size
+		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousAnswer.Size()
+		i -= size
+		if _, err := m.PreviousAnswer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RoundId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgNewRoundRequestEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgNewRoundRequestEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgNewRoundRequestEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOraclePaidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOraclePaidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOraclePaidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDataProviderSetChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDataProviderSetChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDataProviderSetChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataProviderAddr) > 0 {
+		i -= len(m.DataProviderAddr)
+		copy(dAtA[i:], m.DataProviderAddr)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.DataProviderAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChangeType) > 0 {
+		i -= len(m.ChangeType)
+		copy(dAtA[i:], m.ChangeType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChangeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransmitterSetChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransmitterSetChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransmitterSetChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Transmitter) > 0 {
+		i -= len(m.Transmitter)
+		copy(dAtA[i:], m.Transmitter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Transmitter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChangeType) > 0 {
+		i -= len(m.ChangeType)
+		copy(dAtA[i:], m.ChangeType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChangeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedEscrowChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedEscrowChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedEscrowChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Balance != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Amount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChangeType) > 0 {
+		i -= len(m.ChangeType)
+		copy(dAtA[i:], m.ChangeType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChangeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPaymentWithdrawnEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPaymentWithdrawnEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPaymentWithdrawnEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedUnderfundedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedUnderfundedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedUnderfundedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Required != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Required))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Balance != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedParameterChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedParameterChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedParameterChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewAnswerBounds != nil {
+		{
+			size, err := m.NewAnswerBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewParameterValue != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NewParameterValue))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChangeType) > 0 {
+		i -= len(m.ChangeType)
+		copy(dAtA[i:], m.ChangeType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ChangeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModuleOwnershipTransferEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModuleOwnershipTransferEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModuleOwnershipTransferEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewModuleOwnerAddr) > 0 {
+		i -= len(m.NewModuleOwnerAddr)
+		copy(dAtA[i:], m.NewModuleOwnerAddr)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewModuleOwnerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModuleOwnerAddedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModuleOwnerAddedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModuleOwnerAddedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleOwnerAddr) > 0 {
+		i -= len(m.ModuleOwnerAddr)
+		copy(dAtA[i:], m.ModuleOwnerAddr)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ModuleOwnerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgModuleOwnerRemovedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModuleOwnerRemovedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModuleOwnerRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleOwnerAddr) > 0 {
+		i -= len(m.ModuleOwnerAddr)
+		copy(dAtA[i:], m.ModuleOwnerAddr)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ModuleOwnerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnerProposalSubmittedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnerProposalSubmittedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnerProposalSubmittedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnerProposalApprovedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnerProposalApprovedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnerProposalApprovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Approvals != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnerProposalExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnerProposalExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnerProposalExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnerProposalCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnerProposalCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnerProposalCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnerProposalExpiredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnerProposalExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnerProposalExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Approvals != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Approvals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedOwnershipTransferEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedOwnershipTransferEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedOwnershipTransferEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewFeedOwnerAddr) > 0 {
+		i -= len(m.NewFeedOwnerAddr)
+		copy(dAtA[i:], m.NewFeedOwnerAddr)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewFeedOwnerAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnershipTransferProposedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnershipTransferProposedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnershipTransferProposedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnershipTransferCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnershipTransferCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnershipTransferCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOwnershipTransferExpiredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOwnershipTransferExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOwnershipTransferExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedPausedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedPausedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedPausedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedUnpausedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedUnpausedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedUnpausedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedDeprecatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedDeprecatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedDeprecatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedDeletedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedDeletedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedDeletedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastRoundId != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.LastRoundId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedDataValidationFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedDataValidationFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedDataValidationFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeedData) > 0 {
+		for iNdEx := len(m.FeedData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FeedData[iNdEx])
+			copy(dAtA[i:], m.FeedData[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedData[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DataProviders) > 0 {
+		for iNdEx := len(m.DataProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeedOwner) > 0 {
+		i -= len(m.FeedOwner)
+		copy(dAtA[i:], m.FeedOwner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedMetadataChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedMetadataChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedMetadataChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewMetadata != nil {
+		{
+			size, err := m.NewMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NewBaseAmount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.NewBaseAmount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewStrategy) > 0 {
+		i -= len(m.NewStrategy)
+		copy(dAtA[i:], m.NewStrategy)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewStrategy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfigSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfigSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfigSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.OffchainConfig) > 0 {
+		i -= len(m.OffchainConfig)
+		copy(dAtA[i:], m.OffchainConfig)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OffchainConfig)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.OffchainConfigVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OffchainConfigVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.OnchainConfig) > 0 {
+		i -= len(m.OnchainConfig)
+		copy(dAtA[i:], m.OnchainConfig)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OnchainConfig)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.F != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.F))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Transmitters) > 0 {
+		for iNdEx := len(m.Transmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transmitters[iNdEx])
+			copy(dAtA[i:], m.Transmitters[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.Transmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SigningKeys) > 0 {
+		for iNdEx := len(m.SigningKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigningKeys[iNdEx])
+			copy(dAtA[i:], m.SigningKeys[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.SigningKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ConfigCount != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ConfigCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ConfigDigest) > 0 {
+		i -= len(m.ConfigDigest)
+		copy(dAtA[i:], m.ConfigDigest)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ConfigDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PreviousConfigBlockNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PreviousConfigBlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgNewFeedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.DataProviders) > 0 {
+		for _, e := range m.DataProviders {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.FeedOwner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgNewRoundDataEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovEvent(uint64(m.RoundId))
+	}
+	if len(m.FeedData) > 0 {
+		for _, b := range m.FeedData {
+			l = len(b)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = m.Answer.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRoundDeviationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovEvent(uint64(m.RoundId))
+	}
+	l = m.PreviousAnswer.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Answer.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Deviation != 0 {
+		n += 1 + sovEvent(uint64(m.Deviation))
+	}
+	if m.DeviationThresholdTrigger != 0 {
+		n += 1 + sovEvent(uint64(m.DeviationThresholdTrigger))
+	}
+	if m.HeartbeatElapsed {
+		n += 2
+	}
+	if m.Rewardable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgNewRoundRequestEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOraclePaidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 1 + sovEvent(uint64(m.Value))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDataProviderSetChangeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ChangeType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.DataProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransmitterSetChangeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ChangeType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Transmitter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedEscrowChangeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ChangeType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvent(uint64(m.Amount))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovEvent(uint64(m.Balance))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPaymentWithdrawnEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovEvent(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgFeedUnderfundedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovEvent(uint64(m.RoundId))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovEvent(uint64(m.Balance))
+	}
+	if m.Required != 0 {
+		n += 1 + sovEvent(uint64(m.Required))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovEvent(uint64(m.Minted))
+	}
+	return n
+}
+
+func (m *MsgFeedParameterChangeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ChangeType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.NewParameterValue != 0 {
+		n += 1 + sovEvent(uint64(m.NewParameterValue))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.NewAnswerBounds != nil {
+		l = m.NewAnswerBounds.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgModuleOwnershipTransferEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewModuleOwnerAddr)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgModuleOwnerAddedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleOwnerAddr)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgModuleOwnerRemovedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleOwnerAddr)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOwnerProposalSubmittedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOwnerProposalApprovedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Approvals != 0 {
+		n += 1 + sovEvent(uint64(m.Approvals))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovEvent(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgOwnerProposalExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOwnerProposalCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOwnerProposalExpiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovEvent(uint64(m.ProposalId))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Approvals != 0 {
+		n += 1 + sovEvent(uint64(m.Approvals))
+	}
+	return n
+}
+
+func (m *MsgFeedOwnershipTransferEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewFeedOwnerAddr)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOwnershipTransferProposedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *MsgOwnershipTransferCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOwnershipTransferExpiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedPausedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedUnpausedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedDeprecatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedDeletedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.LastRoundId != 0 {
+		n += 1 + sovEvent(uint64(m.LastRoundId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedDataValidationFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FeedOwner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.DataProviders) > 0 {
+		for _, e := range m.DataProviders {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.FeedData) > 0 {
+		for _, b := range m.FeedData {
+			l = len(b)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Signatures) > 0 {
+		for _, b := range m.Signatures {
+			l = len(b)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedMetadataChangeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.NewMetadata != nil {
+		l = m.NewMetadata.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedRewardSchemaChangeEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewStrategy)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.NewBaseAmount != 0 {
+		n += 1 + sovEvent(uint64(m.NewBaseAmount))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfigSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PreviousConfigBlockNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PreviousConfigBlockNumber))
+	}
+	l = len(m.ConfigDigest)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ConfigCount != 0 {
+		n += 1 + sovEvent(uint64(m.ConfigCount))
+	}
+	if len(m.SigningKeys) > 0 {
+		for _, b := range m.SigningKeys {
+			l = len(b)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if len(m.Transmitters) > 0 {
+		for _, b := range m.Transmitters {
+			l = len(b)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.F != 0 {
+		n += 1 + sovEvent(uint64(m.F))
+	}
+	l = len(m.OnchainConfig)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OffchainConfigVersion != 0 {
+		n += 1 + sovEvent(uint64(m.OffchainConfigVersion))
+	}
+	l = len(m.OffchainConfig)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgNewFeedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewFeedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewFeedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataProviders = append(m.DataProviders, &DataProvider{})
+			if err := m.DataProviders[len(m.DataProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeedOwner == nil {
+				m.FeedOwner = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewRoundDataEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewRoundDataEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewRoundDataEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedData = append(m.FeedData, make([]byte, postIndex-iNdEx))
+			copy(m.FeedData[len(m.FeedData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &FeedValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRoundDeviationEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRoundDeviationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRoundDeviationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAnswer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			m.Deviation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deviation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThresholdTrigger", wireType)
+			}
+			m.DeviationThresholdTrigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationThresholdTrigger |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatElapsed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HeartbeatElapsed = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewardable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rewardable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgNewRoundRequestEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgNewRoundRequestEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgNewRoundRequestEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOraclePaidEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOraclePaidEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOraclePaidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = append(m.Account[:0], dAtA[iNdEx:postIndex]...)
+			if m.Account == nil {
+				m.Account = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = append(m.Payee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payee == nil {
+				m.Payee = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDataProviderSetChangeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDataProviderSetChangeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDataProviderSetChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataProviderAddr = append(m.DataProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataProviderAddr == nil {
+				m.DataProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransmitterSetChangeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransmitterSetChangeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransmitterSetChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitter = append(m.Transmitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Transmitter == nil {
+				m.Transmitter = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeedEscrowChangeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeedEscrowChangeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeedEscrowChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPaymentWithdrawnEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPaymentWithdrawnEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPaymentWithdrawnEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = append(m.Oracle[:0], dAtA[iNdEx:postIndex]...)
+			if m.Oracle == nil {
+				m.Oracle = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = append(m.Payee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payee == nil {
+				m.Payee = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeedUnderfundedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeedUnderfundedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeedUnderfundedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			m.Required = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Required |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeedParameterChangeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeedParameterChangeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeedParameterChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParameterValue", wireType)
+			}
+			m.NewParameterValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewParameterValue |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAnswerBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewAnswerBounds == nil {
+				m.NewAnswerBounds = &AnswerBounds{}
+			}
+			if err := m.NewAnswerBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModuleOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleOwnershipTransferEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleOwnershipTransferEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewModuleOwnerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewModuleOwnerAddr = append(m.NewModuleOwnerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewModuleOwnerAddr == nil {
+				m.NewModuleOwnerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModuleOwnerAddedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleOwnerAddedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleOwnerAddedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleOwnerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleOwnerAddr = append(m.ModuleOwnerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ModuleOwnerAddr == nil {
+				m.ModuleOwnerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgModuleOwnerRemovedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleOwnerRemovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleOwnerRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleOwnerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleOwnerAddr = append(m.ModuleOwnerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ModuleOwnerAddr == nil {
+				m.ModuleOwnerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnerProposalSubmittedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnerProposalSubmittedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnerProposalSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = append(m.Proposer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proposer == nil {
+				m.Proposer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnerProposalApprovedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnerProposalApprovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnerProposalApprovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = append(m.Approver[:0], dAtA[iNdEx:postIndex]...)
+			if m.Approver == nil {
+				m.Approver = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			m.Approvals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approvals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnerProposalExecutedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnerProposalExecutedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnerProposalExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnerProposalCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnerProposalCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnerProposalCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnerProposalExpiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnerProposalExpiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnerProposalExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			m.Approvals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Approvals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeedOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeedOwnershipTransferEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeedOwnershipTransferEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFeedOwnerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFeedOwnerAddr = append(m.NewFeedOwnerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewFeedOwnerAddr == nil {
+				m.NewFeedOwnerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnershipTransferProposedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnershipTransferProposedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnershipTransferProposedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOwnershipTransferCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOwnershipTransferCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOwnershipTransferCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgFeedRewardSchemaChangeEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.NewStrategy)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.NewBaseAmount != 0 {
-		n += 1 + sovEvent(uint64(m.NewBaseAmount))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgNewFeedEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgOwnershipTransferExpiredEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1456,10 +8054,10 @@ func (m *MsgNewFeedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewFeedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOwnershipTransferExpiredEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewFeedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOwnershipTransferExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1496,9 +8094,9 @@ func (m *MsgNewFeedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1508,29 +8106,29 @@ func (m *MsgNewFeedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataProviders = append(m.DataProviders, &DataProvider{})
-			if err := m.DataProviders[len(m.DataProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1557,9 +8155,9 @@ func (m *MsgNewFeedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
-			if m.FeedOwner == nil {
-				m.FeedOwner = []byte{}
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1583,7 +8181,7 @@ func (m *MsgNewFeedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewRoundDataEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedPausedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1606,10 +8204,10 @@ func (m *MsgNewRoundDataEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewRoundDataEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeedPausedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewRoundDataEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeedPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1645,10 +8243,10 @@ func (m *MsgNewRoundDataEvent) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.RoundId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1658,14 +8256,111 @@ func (m *MsgNewRoundDataEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RoundId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeedUnpausedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeedUnpausedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeedUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1692,8 +8387,10 @@ func (m *MsgNewRoundDataEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedData = append(m.FeedData, make([]byte, postIndex-iNdEx))
-			copy(m.FeedData[len(m.FeedData)-1], dAtA[iNdEx:postIndex])
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1716,7 +8413,7 @@ func (m *MsgNewRoundDataEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgNewRoundRequestEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedDeprecatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1739,10 +8436,10 @@ func (m *MsgNewRoundRequestEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgNewRoundRequestEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeedDeprecatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgNewRoundRequestEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeedDeprecatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1775,7 +8472,41 @@ func (m *MsgNewRoundRequestEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1798,7 +8529,7 @@ func (m *MsgNewRoundRequestEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgOraclePaidEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedDeletedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1821,10 +8552,10 @@ func (m *MsgOraclePaidEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgOraclePaidEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeedDeletedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgOraclePaidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeedDeletedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1860,10 +8591,10 @@ func (m *MsgOraclePaidEvent) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRoundId", wireType)
 			}
-			var byteLen int
+			m.LastRoundId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1873,31 +8604,16 @@ func (m *MsgOraclePaidEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.LastRoundId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = append(m.Account[:0], dAtA[iNdEx:postIndex]...)
-			if m.Account == nil {
-				m.Account = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.Value = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1907,11 +8623,26 @@ func (m *MsgOraclePaidEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -1933,7 +8664,7 @@ func (m *MsgOraclePaidEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDataProviderSetChangeEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1956,10 +8687,10 @@ func (m *MsgDataProviderSetChangeEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDataProviderSetChangeEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeedDataValidationFailedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDataProviderSetChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeedDataValidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1996,9 +8727,9 @@ func (m *MsgDataProviderSetChangeEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2008,29 +8739,31 @@ func (m *MsgDataProviderSetChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChangeType = string(dAtA[iNdEx:postIndex])
+			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeedOwner == nil {
+				m.FeedOwner = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProviders", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2040,29 +8773,29 @@ func (m *MsgDataProviderSetChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataProviderAddr = append(m.DataProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.DataProviderAddr == nil {
-				m.DataProviderAddr = []byte{}
+			m.DataProviders = append(m.DataProviders, &DataProvider{})
+			if err := m.DataProviders[len(m.DataProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2089,66 +8822,16 @@ func (m *MsgDataProviderSetChangeEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signer == nil {
-				m.Signer = []byte{}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgFeedParameterChangeEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFeedParameterChangeEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFeedParameterChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedData", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2158,29 +8841,29 @@ func (m *MsgFeedParameterChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.FeedData = append(m.FeedData, make([]byte, postIndex-iNdEx))
+			copy(m.FeedData[len(m.FeedData)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2190,48 +8873,29 @@ func (m *MsgFeedParameterChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChangeType = string(dAtA[iNdEx:postIndex])
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParameterValue", wireType)
-			}
-			m.NewParameterValue = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NewParameterValue |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2241,25 +8905,23 @@ func (m *MsgFeedParameterChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signer == nil {
-				m.Signer = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2282,7 +8944,7 @@ func (m *MsgFeedParameterChangeEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgModuleOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedMetadataChangeEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2292,30 +8954,62 @@ func (m *MsgModuleOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEvent
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeedMetadataChangeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeedMetadataChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModuleOwnershipTransferEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModuleOwnershipTransferEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewModuleOwnerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMetadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2325,27 +9019,29 @@ func (m *MsgModuleOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewModuleOwnerAddr = append(m.NewModuleOwnerAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewModuleOwnerAddr == nil {
-				m.NewModuleOwnerAddr = []byte{}
+			if m.NewMetadata == nil {
+				m.NewMetadata = &FeedMetadata{}
+			}
+			if err := m.NewMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2400,7 +9096,7 @@ func (m *MsgModuleOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFeedOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedRewardSchemaChangeEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2423,10 +9119,10 @@ func (m *MsgFeedOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFeedOwnershipTransferEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeedRewardSchemaChangeEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFeedOwnershipTransferEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeedRewardSchemaChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2463,9 +9159,9 @@ func (m *MsgFeedOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFeedOwnerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStrategy", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2475,27 +9171,44 @@ func (m *MsgFeedOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFeedOwnerAddr = append(m.NewFeedOwnerAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewFeedOwnerAddr == nil {
-				m.NewFeedOwnerAddr = []byte{}
-			}
+			m.NewStrategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseAmount", wireType)
+			}
+			m.NewBaseAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewBaseAmount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -2550,7 +9263,7 @@ func (m *MsgFeedOwnershipTransferEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *MsgConfigSetEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2573,10 +9286,10 @@ func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFeedDataValidationFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfigSetEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFeedDataValidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfigSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2612,10 +9325,10 @@ func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConfigBlockNumber", wireType)
 			}
-			var byteLen int
+			m.PreviousConfigBlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2625,31 +9338,16 @@ func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.PreviousConfigBlockNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
-			if m.FeedOwner == nil {
-				m.FeedOwner = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2659,31 +9357,31 @@ func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataProviders = append(m.DataProviders, &DataProvider{})
-			if err := m.DataProviders[len(m.DataProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
 			}
-			var byteLen int
+			m.ConfigCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2693,29 +9391,14 @@ func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ConfigCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2742,12 +9425,12 @@ func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedData = append(m.FeedData, make([]byte, postIndex-iNdEx))
-			copy(m.FeedData[len(m.FeedData)-1], dAtA[iNdEx:postIndex])
+			m.SigningKeys = append(m.SigningKeys, make([]byte, postIndex-iNdEx))
+			copy(m.SigningKeys[len(m.SigningKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2774,64 +9457,33 @@ func (m *MsgFeedDataValidationFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
-			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
+			m.Transmitters = append(m.Transmitters, make([]byte, postIndex-iNdEx))
+			copy(m.Transmitters[len(m.Transmitters)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgFeedRewardSchemaChangeEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFeedRewardSchemaChangeEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFeedRewardSchemaChangeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2841,29 +9493,31 @@ func (m *MsgFeedRewardSchemaChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OnchainConfig == nil {
+				m.OnchainConfig = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewStrategy", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
 			}
-			var stringLen uint64
+			m.OffchainConfigVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2873,29 +9527,16 @@ func (m *MsgFeedRewardSchemaChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewStrategy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBaseAmount", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
 			}
-			m.NewBaseAmount = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2905,12 +9546,27 @@ func (m *MsgFeedRewardSchemaChangeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewBaseAmount |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if byteLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OffchainConfig == nil {
+				m.OffchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
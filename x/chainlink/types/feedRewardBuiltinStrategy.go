@@ -0,0 +1,170 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// FeedRewardStrategyEqualSplit splits the base amount of the feed reward equally between the signers
+	FeedRewardStrategyEqualSplit = "equalSplit"
+	// FeedRewardStrategyTransmitterBonus pays every signer the base amount and the transmitter a bonus of the base amount
+	FeedRewardStrategyTransmitterBonus = "transmitterBonus"
+	// FeedRewardStrategyAccuracyWeighted pays every signer the base amount weighted by the distance of its observation
+	// from the median
+	FeedRewardStrategyAccuracyWeighted = "accuracyWeighted"
+	// FeedRewardStrategyParticipation pays every signer the base amount whatever its observation
+	FeedRewardStrategyParticipation = "participation"
+)
+
+// BuiltinFeedRewardStrategies returns the reward strategies shipped with the module, the app registers them
+// along with its own strategies with NewFeedRewardStrategyRegister
+func BuiltinFeedRewardStrategies() map[string]FeedRewardStrategyFunc {
+	return map[string]FeedRewardStrategyFunc{
+		FeedRewardStrategyEqualSplit:       EqualSplitRewardStrategy,
+		FeedRewardStrategyTransmitterBonus: TransmitterBonusRewardStrategy,
+		FeedRewardStrategyAccuracyWeighted: AccuracyWeightedRewardStrategy,
+		FeedRewardStrategyParticipation:    ParticipationRewardStrategy,
+	}
+}
+
+// BuiltinFeedRewardStrategyDescriptions returns the descriptions of the built-in reward strategies
+func BuiltinFeedRewardStrategyDescriptions() map[string]string {
+	return map[string]string{
+		FeedRewardStrategyEqualSplit: "the base amount is a pot split equally between the signers of the round, " +
+			"the remainder of the split is not paid",
+		FeedRewardStrategyTransmitterBonus: "every signer of the round gets the base amount, " +
+			"the transmitter gets a bonus of the base amount on top of the tx fee reimbursement",
+		FeedRewardStrategyAccuracyWeighted: "every signer of the round gets the base amount reduced in proportion to the " +
+			"relative distance of its observation from the median, nothing from a distance of 100%; " +
+			"the signers of the feeds that are not int192 ones get the base amount",
+		FeedRewardStrategyParticipation: "every signer of the round gets the base amount whatever its observation",
+	}
+}
+
+// EqualSplitRewardStrategy splits the base amount of the feed reward equally between the signers of the round
+func EqualSplitRewardStrategy(feed *MsgFeed, feedData *MsgFeedData) ([]RewardPayout, error) {
+	signers, err := feedDataSigners(feedData)
+	if err != nil || len(signers) == 0 {
+		return nil, err
+	}
+
+	share := feed.GetFeedReward().GetAmount() / uint64(len(signers))
+	return signerPayouts(signers, func(int) uint64 { return share }), nil
+}
+
+// TransmitterBonusRewardStrategy pays every signer of the round the base amount of the feed reward and the transmitter
+// of the round a bonus of the base amount
+func TransmitterBonusRewardStrategy(feed *MsgFeed, feedData *MsgFeedData) ([]RewardPayout, error) {
+	payouts, err := ParticipationRewardStrategy(feed, feedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(payouts, RewardPayout{
+		DataProvider: &DataProvider{
+			Address: feedData.GetSubmitter(),
+		},
+		Amount: feed.GetFeedReward().GetAmount(),
+		Role:   RewardRoleTransmitter,
+	}), nil
+}
+
+// AccuracyWeightedRewardStrategy pays every signer of the round the base amount of the feed reward reduced in
+// proportion to the distance of its observation from the median relative to the median, the signers of observations
+// as far from the median as the median itself or further get nothing. The ante handler binds the data provider of the
+// i-th cosmos pubKey, through its verified signature, to the report observer of the i-th observation, the order of the
+// pubKeys is not left to the transmitter. The signers of the feeds that are not int192 ones get the base amount.
+func AccuracyWeightedRewardStrategy(feed *MsgFeed, feedData *MsgFeedData) ([]RewardPayout, error) {
+	if !feed.GetValueSchema().IsNumeric() {
+		return ParticipationRewardStrategy(feed, feedData)
+	}
+
+	signers, err := feedDataSigners(feedData)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := DecodeFeedReport(feedData.GetReport(), feed.GetValueSchema())
+	if err != nil {
+		return nil, err
+	}
+
+	// every observer of the report signs it, signer i is the observer of observation i
+	observations := report.GetObservations()
+	if len(signers) != len(observations) {
+		return nil, sdkerrors.Wrapf(ErrInvalidOCRReport, "%d signers for %d report observations", len(signers), len(observations))
+	}
+
+	base := sdk.NewDecFromInt(sdk.NewIntFromUint64(feed.GetFeedReward().GetAmount()))
+	median := report.Median()
+
+	return signerPayouts(signers, func(i int) uint64 {
+		return base.Mul(sdk.OneDec().Sub(relativeDistance(observations[i].Value, median))).TruncateInt().Uint64()
+	}), nil
+}
+
+// ParticipationRewardStrategy pays every signer of the round the base amount of the feed reward
+func ParticipationRewardStrategy(feed *MsgFeed, feedData *MsgFeedData) ([]RewardPayout, error) {
+	signers, err := feedDataSigners(feedData)
+	if err != nil {
+		return nil, err
+	}
+
+	return signerPayouts(signers, func(int) uint64 { return feed.GetFeedReward().GetAmount() }), nil
+}
+
+// feedDataSigners returns the addresses of the data providers who signed the observations of the round
+func feedDataSigners(feedData *MsgFeedData) ([]sdk.AccAddress, error) {
+	signers := make([]sdk.AccAddress, 0, len(feedData.GetCosmosPubKeys()))
+	for _, pubKey := range feedData.GetCosmosPubKeys() {
+		signer, err := DeriveCosmosAddrFromPubKey(string(pubKey))
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+func signerPayouts(signers []sdk.AccAddress, amount func(i int) uint64) []RewardPayout {
+	payouts := make([]RewardPayout, 0, len(signers))
+	for i, signer := range signers {
+		payouts = append(payouts, RewardPayout{
+			DataProvider: &DataProvider{
+				Address: signer,
+			},
+			Amount: amount(i),
+			Role:   RewardRoleSigner,
+		})
+	}
+	return payouts
+}
+
+// relativeDistance returns |value - median| / |median| capped at 1, a zero median is only matched exactly
+func relativeDistance(value, median sdk.Int) sdk.Dec {
+	if value.IsNil() {
+		return sdk.OneDec()
+	}
+
+	distance := value.Sub(median)
+	if distance.IsNegative() {
+		distance = distance.Neg()
+	}
+	if distance.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	scale := median
+	if scale.IsNegative() {
+		scale = scale.Neg()
+	}
+	if scale.IsZero() || distance.GTE(scale) {
+		return sdk.OneDec()
+	}
+
+	return sdk.NewDecFromInt(distance).QuoInt(scale)
+}
@@ -0,0 +1,140 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// rewardStrategyFeedData returns a round of the given observations signed by as many new data providers
+func rewardStrategyFeedData(t *testing.T, observations ...int64) (*MsgFeedData, []sdk.AccAddress) {
+	_, _, submitter := GenerateAccount()
+	feedData := &MsgFeedData{FeedId: "feed1", Submitter: submitter}
+
+	signers := make([]sdk.AccAddress, 0, len(observations))
+	values := make([]*big.Int, 0, len(observations))
+	observers := make([]byte, 0, len(observations))
+	for i, o := range observations {
+		_, pubKey, addr := GenerateAccount()
+		feedData.CosmosPubKeys = append(feedData.CosmosPubKeys, []byte(pubKey))
+		signers = append(signers, addr)
+		values = append(values, big.NewInt(o))
+		observers = append(observers, byte(i))
+	}
+
+	report, err := EncodeOCRReport(make([]byte, OCRReportContextLength), observers, values)
+	require.NoError(t, err)
+	feedData.Report = report
+
+	return feedData, signers
+}
+
+func requirePayouts(t *testing.T, payouts []RewardPayout, signers []sdk.AccAddress, amounts ...uint64) {
+	require.Len(t, payouts, len(amounts))
+	for i, amount := range amounts {
+		require.Equal(t, signers[i], payouts[i].DataProvider.GetAddress())
+		require.Equal(t, RewardRoleSigner, payouts[i].Role)
+		require.Equal(t, amount, payouts[i].Amount, "payout %d", i)
+	}
+}
+
+func TestTypes_EqualSplitRewardStrategy(t *testing.T) {
+	feed := &MsgFeed{FeedReward: &FeedRewardSchema{Amount: 100}}
+	feedData, signers := rewardStrategyFeedData(t, 1, 2, 3)
+
+	payouts, err := EqualSplitRewardStrategy(feed, feedData)
+	require.NoError(t, err)
+	requirePayouts(t, payouts, signers, 33, 33, 33)
+
+	// no signer, no payout
+	payouts, err = EqualSplitRewardStrategy(feed, &MsgFeedData{})
+	require.NoError(t, err)
+	require.Empty(t, payouts)
+}
+
+func TestTypes_TransmitterBonusRewardStrategy(t *testing.T) {
+	feed := &MsgFeed{FeedReward: &FeedRewardSchema{Amount: 100}}
+	feedData, signers := rewardStrategyFeedData(t, 1, 2)
+
+	payouts, err := TransmitterBonusRewardStrategy(feed, feedData)
+	require.NoError(t, err)
+	requirePayouts(t, payouts[:2], signers, 100, 100)
+	require.Equal(t, feedData.GetSubmitter(), payouts[2].DataProvider.GetAddress())
+	require.Equal(t, RewardRoleTransmitter, payouts[2].Role)
+	require.Equal(t, uint64(100), payouts[2].Amount)
+
+	// the reward calculator does not add another transmitter payout
+	registered := FeedRewardStrategyConvertor
+	defer func() { FeedRewardStrategyConvertor = registered }()
+	NewFeedRewardStrategyRegister(BuiltinFeedRewardStrategies())
+
+	feed.FeedReward.Strategy = FeedRewardStrategyTransmitterBonus
+	payouts, total, err := feedData.RewardCalculator(feed, feedData)
+	require.NoError(t, err)
+	require.Len(t, payouts, 3)
+	require.Equal(t, uint64(300), total)
+}
+
+func TestTypes_AccuracyWeightedRewardStrategy(t *testing.T) {
+	feed := &MsgFeed{FeedReward: &FeedRewardSchema{Amount: 1000}}
+
+	// median is 100: 120 away gets nothing, 10 away gets 90%, the median gets everything, 50 away gets 50%
+	feedData, signers := rewardStrategyFeedData(t, -20, 90, 100, 150)
+
+	payouts, err := AccuracyWeightedRewardStrategy(feed, feedData)
+	require.NoError(t, err)
+	requirePayouts(t, payouts, signers, 0, 900, 1000, 500)
+
+	// a zero median is only matched exactly
+	feedData, signers = rewardStrategyFeedData(t, -1, 0, 0)
+	payouts, err = AccuracyWeightedRewardStrategy(feed, feedData)
+	require.NoError(t, err)
+	requirePayouts(t, payouts, signers, 0, 1000, 1000)
+
+	// a signer is weighted by the observation of the observer it signed for, not by its rank among the observers
+	feedData, signers = rewardStrategyFeedData(t, 90, 100, 150)
+	report, err := EncodeOCRReport(make([]byte, OCRReportContextLength), []byte{2, 0, 1}, []*big.Int{big.NewInt(90), big.NewInt(100), big.NewInt(150)})
+	require.NoError(t, err)
+	feedData.Report = report
+	payouts, err = AccuracyWeightedRewardStrategy(feed, feedData)
+	require.NoError(t, err)
+	requirePayouts(t, payouts, signers, 900, 1000, 500)
+
+	// a signer without observation of the report is not paid by rank
+	feedData, _ = rewardStrategyFeedData(t, 90, 100, 150)
+	feedData.CosmosPubKeys = feedData.CosmosPubKeys[:2]
+	_, err = AccuracyWeightedRewardStrategy(feed, feedData)
+	require.ErrorIs(t, err, ErrInvalidOCRReport)
+
+	// the signers of a feed that is not an int192 one get the base amount
+	feed.ValueSchema = &FeedValueSchema{Type: FeedValueTypeString}
+	feedData, signers = rewardStrategyFeedData(t, 1, 2)
+	feedData.Report = nil
+	payouts, err = AccuracyWeightedRewardStrategy(feed, feedData)
+	require.NoError(t, err)
+	requirePayouts(t, payouts, signers, 1000, 1000)
+}
+
+func TestTypes_ParticipationRewardStrategy(t *testing.T) {
+	feed := &MsgFeed{FeedReward: &FeedRewardSchema{Amount: 7}}
+	feedData, signers := rewardStrategyFeedData(t, 1, 1000, -3)
+
+	payouts, err := ParticipationRewardStrategy(feed, feedData)
+	require.NoError(t, err)
+	requirePayouts(t, payouts, signers, 7, 7, 7)
+}
+
+func TestTypes_BuiltinFeedRewardStrategies(t *testing.T) {
+	strategies := BuiltinFeedRewardStrategies()
+	descriptions := BuiltinFeedRewardStrategyDescriptions()
+
+	require.Len(t, strategies, 4)
+	for name := range strategies {
+		require.NotEmpty(t, descriptions[name], name)
+	}
+}
@@ -3,16 +3,29 @@
 
 package types
 
-// RewardPayout describes the calculated reward that data provider gets after the selected strategy applied
+const (
+	// RewardRoleSigner is the role of the data providers rewarded for signing an observation of the report
+	RewardRoleSigner = "signer"
+	// RewardRoleTransmitter is the role of the account rewarded for transmitting the report
+	RewardRoleTransmitter = "transmitter"
+)
+
+// RewardPayout describes the calculated reward that data provider gets after the selected strategy applied,
+// the role tells whether the reward is earned as a signer or as the transmitter, an empty role means signer
 type RewardPayout struct {
 	DataProvider *DataProvider `json:"dataProvider"`
 	Amount       uint64        `json:"amount"`
+	Role         string        `json:"role"`
 }
 
 type FeedRewardStrategyFunc func(*MsgFeed, *MsgFeedData) ([]RewardPayout, error)
 
 var FeedRewardStrategyConvertor = map[string]FeedRewardStrategyFunc{}
 
+// FeedRewardStrategyDescriptions describes how the registered strategies pay the rounds, the GetFeedRewardAvailStrategy
+// query returns them along with the strategy names
+var FeedRewardStrategyDescriptions = map[string]string{}
+
 // NewFeedRewardStrategyRegister registers the reward calculation strategies when the chain launches
 func NewFeedRewardStrategyRegister(feedRewardStrategyFns map[string]FeedRewardStrategyFunc) {
 	if feedRewardStrategyFns == nil {
@@ -27,3 +40,13 @@ func NewFeedRewardStrategyRegister(feedRewardStrategyFns map[string]FeedRewardSt
 
 	FeedRewardStrategyConvertor = feedRewardStrategyFns
 }
+
+// NewFeedRewardStrategyDescriptionRegister registers the descriptions of the reward calculation strategies when the
+// chain launches, a strategy without description is listed with an empty one
+func NewFeedRewardStrategyDescriptionRegister(descriptions map[string]string) {
+	if descriptions == nil {
+		return
+	}
+
+	FeedRewardStrategyDescriptions = descriptions
+}
@@ -17,7 +17,8 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 // This is where the init genesis can be defined
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{ModuleOwners: nil}
+	params := DefaultParams()
+	return &GenesisState{ModuleOwners: nil, Params: &params}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -34,6 +35,13 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// the genesis states exported before the module got params have none, the default params apply
+	if gs.GetParams() != nil {
+		if err := gs.GetParams().Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
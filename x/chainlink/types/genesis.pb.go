@@ -27,6 +27,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// MsgModuleOwner is an array containing the chainlink init module owner accounts.
 	ModuleOwners []*MsgModuleOwner `protobuf:"bytes,1,rep,name=moduleOwners,proto3" json:"moduleOwners,omitempty"`
+	// params are the module parameters, the default parameters apply when they are not set
+	Params *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +71,153 @@ func (m *GenesisState) GetModuleOwners() []*MsgModuleOwner {
 	return nil
 }
 
+func (m *GenesisState) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+// Params are the chainlink module parameters, they are changed with x/gov ParameterChangeProposals
+type Params struct {
+	// maxDataProviders is the maximum number of data providers of a feed
+	MaxDataProviders uint32 `protobuf:"varint,1,opt,name=maxDataProviders,proto3" json:"maxDataProviders,omitempty" yaml:"max_data_providers"`
+	// maxFeedIdLength is the maximum length of the feedId of a new feed, at most 255
+	MaxFeedIdLength uint32 `protobuf:"varint,2,opt,name=maxFeedIdLength,proto3" json:"maxFeedIdLength,omitempty" yaml:"max_feed_id_length"`
+	// rewardDenom is the denom of the feed escrows, the rewards and the tx fee reimbursements
+	RewardDenom string `protobuf:"bytes,3,opt,name=rewardDenom,proto3" json:"rewardDenom,omitempty" yaml:"reward_denom"`
+	// roundRetention is the number of latest rounds kept per feed, older rounds are pruned, 0 keeps every round
+	RoundRetention uint64 `protobuf:"varint,4,opt,name=roundRetention,proto3" json:"roundRetention,omitempty" yaml:"round_retention"`
+	// feeReimbursementPolicy tells whether the transmitter of a round gets its tx fee reimbursed: "full" or "none"
+	FeeReimbursementPolicy string `protobuf:"bytes,5,opt,name=feeReimbursementPolicy,proto3" json:"feeReimbursementPolicy,omitempty" yaml:"fee_reimbursement_policy"`
+	// governanceOnly rejects the module owner msgs adding feeds and module owners, they are added by x/gov proposals only
+	GovernanceOnly bool `protobuf:"varint,6,opt,name=governanceOnly,proto3" json:"governanceOnly,omitempty" yaml:"governance_only"`
+	// ownerApprovalThreshold is the number of distinct module owners who must approve the msgs adding feeds and adding
+	// or removing module owners before they execute, 1 executes them right away
+	OwnerApprovalThreshold uint32 `protobuf:"varint,7,opt,name=ownerApprovalThreshold,proto3" json:"ownerApprovalThreshold,omitempty" yaml:"owner_approval_threshold"`
+	// ownershipTransferExpiry is the number of blocks the new owner of a feed or module ownership transfer has to accept
+	// it, 0 never expires the pending transfers
+	OwnershipTransferExpiry uint64 `protobuf:"varint,8,opt,name=ownershipTransferExpiry,proto3" json:"ownershipTransferExpiry,omitempty" yaml:"ownership_transfer_expiry"`
+	// singleStepOwnershipTransfer makes the feed and module ownership transfers take effect right away without the
+	// acceptance of the new owner, it keeps the legacy behaviour for the chains migrating to the two step transfers
+	SingleStepOwnershipTransfer bool `protobuf:"varint,9,opt,name=singleStepOwnershipTransfer,proto3" json:"singleStepOwnershipTransfer,omitempty" yaml:"single_step_ownership_transfer"`
+	// ownerProposalExpiry is the number of blocks the module owners have to approve an owner proposal, 0 never expires
+	// the pending owner proposals
+	OwnerProposalExpiry uint64 `protobuf:"varint,10,opt,name=ownerProposalExpiry,proto3" json:"ownerProposalExpiry,omitempty" yaml:"owner_proposal_expiry"`
+	// maxFeeReimbursement caps the tx fee reimbursed to the transmitter of a round, in the reward denom
+	MaxFeeReimbursement uint64 `protobuf:"varint,11,opt,name=maxFeeReimbursement,proto3" json:"maxFeeReimbursement,omitempty" yaml:"max_fee_reimbursement"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c78e6b00133e68ea, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxDataProviders() uint32 {
+	if m != nil {
+		return m.MaxDataProviders
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFeedIdLength() uint32 {
+	if m != nil {
+		return m.MaxFeedIdLength
+	}
+	return 0
+}
+
+func (m *Params) GetRewardDenom() string {
+	if m != nil {
+		return m.RewardDenom
+	}
+	return ""
+}
+
+func (m *Params) GetRoundRetention() uint64 {
+	if m != nil {
+		return m.RoundRetention
+	}
+	return 0
+}
+
+func (m *Params) GetFeeReimbursementPolicy() string {
+	if m != nil {
+		return m.FeeReimbursementPolicy
+	}
+	return ""
+}
+
+func (m *Params) GetGovernanceOnly() bool {
+	if m != nil {
+		return m.GovernanceOnly
+	}
+	return false
+}
+
+func (m *Params) GetOwnerApprovalThreshold() uint32 {
+	if m != nil {
+		return m.OwnerApprovalThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetOwnershipTransferExpiry() uint64 {
+	if m != nil {
+		return m.OwnershipTransferExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetSingleStepOwnershipTransfer() bool {
+	if m != nil {
+		return m.SingleStepOwnershipTransfer
+	}
+	return false
+}
+
+func (m *Params) GetOwnerProposalExpiry() uint64 {
+	if m != nil {
+		return m.OwnerProposalExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFeeReimbursement() uint64 {
+	if m != nil {
+		return m.MaxFeeReimbursement
+	}
+	return 0
+}
+
 type MsgModuleOwner struct {
 	// address defines the address of the module owner
 	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty" yaml:"address"`
@@ -82,7 +231,7 @@ func (m *MsgModuleOwner) Reset()         { *m = MsgModuleOwner{} }
 func (m *MsgModuleOwner) String() string { return proto.CompactTextString(m) }
 func (*MsgModuleOwner) ProtoMessage()    {}
 func (*MsgModuleOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c78e6b00133e68ea, []int{1}
+	return fileDescriptor_c78e6b00133e68ea, []int{2}
 }
 func (m *MsgModuleOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,33 +283,60 @@ func (m *MsgModuleOwner) GetAssignerAddress() github_com_cosmos_cosmos_sdk_types
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "chainlink.v1beta.GenesisState")
+	proto.RegisterType((*Params)(nil), "chainlink.v1beta.Params")
 	proto.RegisterType((*MsgModuleOwner)(nil), "chainlink.v1beta.MsgModuleOwner")
 }
 
 func init() { proto.RegisterFile("chainlink/v1beta/genesis.proto", fileDescriptor_c78e6b00133e68ea) }
 
 var fileDescriptor_c78e6b00133e68ea = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x48, 0xcc,
-	0xcc, 0xcb, 0xc9, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xcb, 0xeb, 0x41,
-	0xe4, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x52, 0x08,
-	0x17, 0x8f, 0x3b, 0x44, 0x63, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x0b, 0x17, 0x4f, 0x6e, 0x7e,
-	0x4a, 0x69, 0x4e, 0xaa, 0x7f, 0x79, 0x5e, 0x6a, 0x51, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7,
-	0x91, 0x82, 0x1e, 0xba, 0x71, 0x7a, 0xbe, 0xc5, 0xe9, 0xbe, 0x08, 0x85, 0x41, 0x28, 0xba, 0x94,
-	0xfe, 0x30, 0x72, 0xf1, 0xa1, 0x2a, 0x10, 0x8a, 0xe5, 0x62, 0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d,
-	0x06, 0x99, 0xc9, 0xa8, 0xc1, 0xe3, 0xe4, 0xfc, 0xe9, 0x9e, 0x3c, 0x5f, 0x65, 0x62, 0x6e, 0x8e,
-	0x95, 0x12, 0x54, 0x42, 0xe9, 0xd7, 0x3d, 0x79, 0xdd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd,
-	0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0x28, 0xa5, 0x5b, 0x9c, 0x92, 0xad,
-	0x5f, 0x52, 0x59, 0x90, 0x5a, 0xac, 0xe7, 0x98, 0x9c, 0xec, 0x08, 0xd1, 0x11, 0x04, 0x33, 0x53,
-	0x48, 0x8b, 0x8b, 0xad, 0xa0, 0x34, 0xc9, 0x3b, 0xb5, 0x52, 0x82, 0x09, 0x6c, 0xba, 0x10, 0xc2,
-	0xf4, 0x82, 0xd2, 0xa4, 0xf8, 0xec, 0xd4, 0x4a, 0xa5, 0x20, 0xa8, 0x0a, 0xa1, 0x78, 0x2e, 0xfe,
-	0xc4, 0xe2, 0xe2, 0xcc, 0xf4, 0xbc, 0xd4, 0x22, 0xa8, 0x39, 0x12, 0xcc, 0x60, 0x4d, 0xa6, 0x27,
-	0xee, 0xc9, 0x33, 0x92, 0xee, 0x08, 0x74, 0xd3, 0x9c, 0x02, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x1c, 0xc9, 0x64, 0x67, 0x50, 0x90, 0x06, 0x27, 0xa6, 0xa5, 0xea,
-	0xc3, 0x03, 0x57, 0x17, 0x6a, 0x5b, 0x05, 0x42, 0x08, 0x62, 0x5d, 0x12, 0x1b, 0x38, 0xba, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x15, 0x3a, 0x28, 0xf8, 0x01, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xeb, 0x44,
+	0x1c, 0xc5, 0xeb, 0xdb, 0x4b, 0x7a, 0xef, 0x24, 0xf4, 0x56, 0x2e, 0x6a, 0x4d, 0x81, 0xd8, 0x1a,
+	0x40, 0x0a, 0x48, 0x4d, 0xa0, 0x08, 0x21, 0xd8, 0x35, 0x2d, 0x54, 0x15, 0x54, 0x0d, 0xd3, 0xae,
+	0x40, 0x30, 0x9a, 0xc4, 0xff, 0x38, 0x56, 0xec, 0x19, 0x6b, 0x66, 0xd2, 0xc6, 0x2f, 0xc0, 0x12,
+	0xf1, 0x58, 0x5d, 0x76, 0xc9, 0xca, 0x42, 0xed, 0x1b, 0x78, 0x89, 0x58, 0x20, 0x8f, 0x9d, 0xe6,
+	0xa3, 0x2d, 0x12, 0xab, 0x44, 0x3e, 0xbf, 0x73, 0xe6, 0xcc, 0x27, 0x6a, 0x0e, 0x46, 0x2c, 0xe4,
+	0x51, 0xc8, 0xc7, 0x9d, 0xab, 0xcf, 0xfb, 0xa0, 0x59, 0x27, 0x00, 0x0e, 0x2a, 0x54, 0xed, 0x44,
+	0x0a, 0x2d, 0xec, 0xad, 0x07, 0xbd, 0x5d, 0xea, 0x7b, 0xef, 0x04, 0x22, 0x10, 0x46, 0xec, 0x14,
+	0xff, 0x4a, 0x0e, 0xff, 0x66, 0xa1, 0xc6, 0x49, 0xe9, 0xbc, 0xd0, 0x4c, 0x83, 0x7d, 0x8c, 0x1a,
+	0xb1, 0xf0, 0x27, 0x11, 0x9c, 0x5f, 0x73, 0x90, 0xca, 0xb1, 0xbc, 0xf5, 0x56, 0xfd, 0xc0, 0x6b,
+	0xaf, 0xe6, 0xb5, 0xcf, 0x54, 0x70, 0x36, 0x07, 0xc9, 0x92, 0xcb, 0xfe, 0x0c, 0xd5, 0x12, 0x26,
+	0x59, 0xac, 0x9c, 0x17, 0x9e, 0xd5, 0xaa, 0x1f, 0x38, 0x8f, 0xfd, 0x3d, 0xa3, 0x93, 0x8a, 0xc3,
+	0xbf, 0x6f, 0xa0, 0x5a, 0xf9, 0xc9, 0x3e, 0x45, 0x5b, 0x31, 0x9b, 0x1e, 0x33, 0xcd, 0x7a, 0x52,
+	0x5c, 0x85, 0x7e, 0x59, 0xc3, 0x6a, 0xbd, 0xdd, 0xfd, 0x20, 0xcf, 0xdc, 0x77, 0x53, 0x16, 0x47,
+	0xdf, 0xe0, 0x98, 0x4d, 0xa9, 0xcf, 0x34, 0xa3, 0xc9, 0x8c, 0xc1, 0xe4, 0x91, 0xcd, 0x3e, 0x41,
+	0x6f, 0x62, 0x36, 0xfd, 0x0e, 0xc0, 0x3f, 0xf5, 0x7f, 0x00, 0x1e, 0xe8, 0x91, 0xf3, 0xe2, 0xa9,
+	0xa4, 0x21, 0x80, 0x4f, 0x43, 0x9f, 0x46, 0x86, 0xc1, 0x64, 0xd5, 0x65, 0x7f, 0x8d, 0xea, 0x12,
+	0xae, 0x99, 0xf4, 0x8f, 0x81, 0x8b, 0xd8, 0x59, 0xf7, 0xac, 0xd6, 0xeb, 0xee, 0x6e, 0x9e, 0xb9,
+	0xdb, 0x65, 0x48, 0x29, 0x52, 0xbf, 0x50, 0x31, 0x59, 0x64, 0xed, 0x2e, 0xda, 0x94, 0x62, 0xc2,
+	0x7d, 0x02, 0x1a, 0xb8, 0x0e, 0x05, 0x77, 0x5e, 0x7a, 0x56, 0xeb, 0x65, 0x77, 0x2f, 0xcf, 0xdc,
+	0x9d, 0xca, 0x5d, 0xe8, 0x54, 0xce, 0x00, 0x4c, 0x56, 0x1c, 0xf6, 0xcf, 0x68, 0x67, 0x08, 0x40,
+	0x20, 0x8c, 0xfb, 0x13, 0xa9, 0x20, 0x06, 0xae, 0x7b, 0x22, 0x0a, 0x07, 0xa9, 0xf3, 0x96, 0x69,
+	0xf2, 0x61, 0x9e, 0xb9, 0x6e, 0x99, 0x35, 0x04, 0xa0, 0x72, 0x11, 0xa4, 0x89, 0x21, 0x31, 0x79,
+	0x26, 0xa2, 0x28, 0x18, 0x88, 0x2b, 0x90, 0x9c, 0xf1, 0x01, 0x9c, 0xf3, 0x28, 0x75, 0x6a, 0x9e,
+	0xd5, 0x7a, 0xb5, 0x58, 0x70, 0xae, 0x53, 0xc1, 0xa3, 0x14, 0x93, 0x15, 0x47, 0x51, 0x50, 0x14,
+	0x5b, 0x7f, 0x98, 0x14, 0xfb, 0xc1, 0xa2, 0xcb, 0x91, 0x04, 0x35, 0x12, 0x91, 0xef, 0x6c, 0x98,
+	0xf5, 0x5e, 0x28, 0x68, 0x38, 0xca, 0x2a, 0x90, 0xea, 0x19, 0x89, 0xc9, 0x33, 0x11, 0xf6, 0xaf,
+	0x68, 0xd7, 0x28, 0x6a, 0x14, 0x26, 0x97, 0x92, 0x71, 0x35, 0x04, 0xf9, 0xed, 0x34, 0x09, 0x65,
+	0xea, 0xbc, 0x32, 0x4b, 0xf9, 0x51, 0x9e, 0xb9, 0xde, 0x42, 0x7a, 0x01, 0x52, 0x5d, 0x91, 0x14,
+	0x0c, 0x8a, 0xc9, 0x73, 0x21, 0xf6, 0x18, 0xbd, 0xa7, 0x42, 0x1e, 0x44, 0x70, 0xa1, 0x21, 0x39,
+	0x5f, 0x85, 0x9c, 0xd7, 0x66, 0x35, 0x3e, 0xc9, 0x33, 0xf7, 0xe3, 0x72, 0x8c, 0x12, 0xa6, 0x4a,
+	0x43, 0x42, 0x1f, 0x8f, 0x87, 0xc9, 0x7f, 0xa5, 0xd9, 0x04, 0x6d, 0x1b, 0x4f, 0x4f, 0x8a, 0x44,
+	0x28, 0x16, 0x55, 0x13, 0x41, 0x66, 0x22, 0x5e, 0x9e, 0xb9, 0xef, 0x2f, 0x2e, 0x53, 0x52, 0x51,
+	0x0f, 0x93, 0x78, 0xca, 0x5c, 0x64, 0x96, 0x07, 0x76, 0x69, 0x7b, 0x9d, 0xfa, 0x6a, 0x66, 0x75,
+	0xd4, 0x97, 0xcf, 0x07, 0x26, 0x4f, 0x99, 0xf1, 0x3f, 0x16, 0xda, 0x5c, 0xbe, 0xe3, 0xf6, 0x2f,
+	0x68, 0x83, 0xf9, 0xbe, 0x04, 0x55, 0xde, 0xc7, 0x46, 0xf7, 0x28, 0xcf, 0xdc, 0xcd, 0x32, 0xba,
+	0x12, 0xf0, 0xdf, 0x99, 0xbb, 0x1f, 0x84, 0x7a, 0x34, 0xe9, 0xb7, 0x07, 0x22, 0xee, 0x0c, 0x84,
+	0x8a, 0x85, 0xaa, 0x7e, 0xf6, 0x95, 0x3f, 0xee, 0xe8, 0x34, 0x01, 0xd5, 0x3e, 0x1c, 0x0c, 0x0e,
+	0x4b, 0x07, 0x99, 0x65, 0xda, 0x9f, 0xa2, 0x5a, 0x32, 0xe9, 0x7f, 0x0f, 0xa9, 0xb9, 0xa3, 0x8d,
+	0xae, 0x3d, 0x4f, 0x4f, 0x26, 0x7d, 0x3a, 0x86, 0x14, 0x93, 0x8a, 0xb0, 0x29, 0x7a, 0xc3, 0x94,
+	0x0a, 0x83, 0xe2, 0xbc, 0x54, 0x95, 0xd6, 0x8d, 0xe9, 0xcb, 0x9b, 0xcc, 0xb5, 0xfe, 0x7f, 0x89,
+	0xd5, 0xb4, 0xee, 0x8f, 0x37, 0x77, 0x4d, 0xeb, 0xf6, 0xae, 0x69, 0xfd, 0x75, 0xd7, 0xb4, 0xfe,
+	0xb8, 0x6f, 0xae, 0xdd, 0xde, 0x37, 0xd7, 0xfe, 0xbc, 0x6f, 0xae, 0xfd, 0xf4, 0xd5, 0x42, 0xf2,
+	0x51, 0xf1, 0xaa, 0x5d, 0xb0, 0x21, 0x74, 0x1e, 0xde, 0xb7, 0xfd, 0x6a, 0xb4, 0xe9, 0xfc, 0x53,
+	0x39, 0x5c, 0xbf, 0x66, 0x9e, 0xdc, 0x2f, 0xfe, 0x1d, 0x00, 0x5d, 0x34, 0xf2, 0x39, 0xbc, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -183,6 +359,18 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ModuleOwners) > 0 {
 		for iNdEx := len(m.ModuleOwners) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -200,6 +388,98 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFeeReimbursement != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxFeeReimbursement))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.OwnerProposalExpiry != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OwnerProposalExpiry))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SingleStepOwnershipTransfer {
+		i--
+		if m.SingleStepOwnershipTransfer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.OwnershipTransferExpiry != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OwnershipTransferExpiry))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OwnerApprovalThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OwnerApprovalThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GovernanceOnly {
+		i--
+		if m.GovernanceOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FeeReimbursementPolicy) > 0 {
+		i -= len(m.FeeReimbursementPolicy)
+		copy(dAtA[i:], m.FeeReimbursementPolicy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeReimbursementPolicy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RoundRetention != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RoundRetention))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RewardDenom) > 0 {
+		i -= len(m.RewardDenom)
+		copy(dAtA[i:], m.RewardDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RewardDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxFeedIdLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxFeedIdLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxDataProviders != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxDataProviders))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgModuleOwner) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -267,6 +547,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxDataProviders != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxDataProviders))
+	}
+	if m.MaxFeedIdLength != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxFeedIdLength))
+	}
+	l = len(m.RewardDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.RoundRetention != 0 {
+		n += 1 + sovGenesis(uint64(m.RoundRetention))
+	}
+	l = len(m.FeeReimbursementPolicy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.GovernanceOnly {
+		n += 2
+	}
+	if m.OwnerApprovalThreshold != 0 {
+		n += 1 + sovGenesis(uint64(m.OwnerApprovalThreshold))
+	}
+	if m.OwnershipTransferExpiry != 0 {
+		n += 1 + sovGenesis(uint64(m.OwnershipTransferExpiry))
+	}
+	if m.SingleStepOwnershipTransfer {
+		n += 2
+	}
+	if m.OwnerProposalExpiry != 0 {
+		n += 1 + sovGenesis(uint64(m.OwnerProposalExpiry))
+	}
+	if m.MaxFeeReimbursement != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxFeeReimbursement))
+	}
 	return n
 }
 
@@ -360,6 +688,329 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataProviders", wireType)
+			}
+			m.MaxDataProviders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataProviders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeedIdLength", wireType)
+			}
+			m.MaxFeedIdLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeedIdLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundRetention", wireType)
+			}
+			m.RoundRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeReimbursementPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeReimbursementPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GovernanceOnly = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerApprovalThreshold", wireType)
+			}
+			m.OwnerApprovalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerApprovalThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipTransferExpiry", wireType)
+			}
+			m.OwnershipTransferExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnershipTransferExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SingleStepOwnershipTransfer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SingleStepOwnershipTransfer = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerProposalExpiry", wireType)
+			}
+			m.OwnerProposalExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerProposalExpiry |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeReimbursement", wireType)
+			}
+			m.MaxFeeReimbursement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFeeReimbursement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,7 +11,8 @@ import (
 
 func TestTypes_GenesisState_Validate(t *testing.T) {
 	genstate := DefaultGenesis()
-	emptyGenesis := &GenesisState{ModuleOwners: nil}
+	defaultParams := DefaultParams()
+	emptyGenesis := &GenesisState{ModuleOwners: nil, Params: &defaultParams}
 	require.Equal(t, genstate, emptyGenesis)
 	require.Error(t, genstate.Validate())
 
@@ -23,6 +24,13 @@ func TestTypes_GenesisState_Validate(t *testing.T) {
 	genstate.ModuleOwners = append(genstate.ModuleOwners, &MsgModuleOwner{Address: addr, PubKey: []byte(pubKey), AssignerAddress: nil})
 
 	require.NoError(t, genstate.Validate())
+
+	// the genesis states without params get the default ones
+	genstate.Params = nil
+	require.NoError(t, genstate.Validate())
+
+	genstate.Params = &Params{}
+	require.Error(t, genstate.Validate())
 }
 
 func TestTypes_MsgModuleOwner_Validate(t *testing.T) {
@@ -3,6 +3,10 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "chainlink"
@@ -36,25 +40,121 @@ func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+var (
+	// FeedDataKeyPrefix FeedDataStore key pattern: types.FeedDataKeyPrefix | len(feedId) | feedId | bigEndian(roundId)
+	FeedDataKeyPrefix = []byte{0x01}
+
+	// LatestRoundIdKeyPrefix RoundStore key pattern: types.LatestRoundIdKeyPrefix | len(feedId) | feedId
+	// the value is the big endian latest roundId of the feed
+	LatestRoundIdKeyPrefix = []byte{0x02}
+)
+
 const (
-	// FeedDataKey FeedDataStore key pattern: types.FeedDataKey/feedId/roundId
-	FeedDataKey = "feedData"
+	// MaxFeedIdLength is the maximum length of a feedId, feedIds are length-prefixed with a single byte in store keys
+	MaxFeedIdLength = 255
 
-	// RoundIdKey RoundStore key pattern: types.RoundIdKey/feedId
-	RoundIdKey = "roundId"
+	// FeedDataStoreLayoutUpgradeName is the name of the software upgrade migrating the feed data store
+	// from the legacy key layout
+	FeedDataStoreLayoutUpgradeName = "chainlink-feed-data-store-layout"
+
+	// ChainlinkKeyIndexUpgradeName is the name of the software upgrade indexing the chainlink keys of the existing accounts
+	ChainlinkKeyIndexUpgradeName = "chainlink-account-key-index"
+
+	// LegacyFeedDataKey legacy FeedDataStore key pattern: types.LegacyFeedDataKey/feedId/roundId, only used by store migration
+	LegacyFeedDataKey = "feedData"
+
+	// LegacyRoundIdKey legacy RoundStore key pattern: types.LegacyRoundIdKey/feedId, only used by store migration
+	LegacyRoundIdKey = "roundId"
 
 	// ModuleOwnerKey ModuleOwnerStore key pattern: types.ModuleOwnerKey/moduleOwnerAddress
 	ModuleOwnerKey = "moduleOwner"
 
+	// OwnerProposalKey ModuleOwnerStore key pattern: types.OwnerProposalKey/bigEndian(proposalId)
+	// the value is the OwnerProposal pending the approval of the module owners
+	OwnerProposalKey = "ownerProposal"
+
+	// LastOwnerProposalIdKey ModuleOwnerStore key of the big endian id of the latest submitted owner proposal
+	LastOwnerProposalIdKey = "lastOwnerProposalId"
+
+	// OwnerProposalExpiryKey ModuleOwnerStore key pattern:
+	// types.OwnerProposalExpiryKey/bigEndian(expiresAtHeight)/bigEndian(proposalId)
+	// the value is the id of the pending owner proposal expiring at expiresAtHeight
+	OwnerProposalExpiryKey = "ownerProposalExpiry"
+
 	// FeedInfoKey FeedInfoStore key pattern: types.FeedInfoKey/feedId
 	FeedInfoKey = "feed"
 
 	// AccountKey AccountStore key pattern: types.AccountKey/accAddr
 	AccountKey = "account"
+
+	// PaymentKey AccountStore key pattern: types.PaymentKey/lengthPrefixed(oracleAddr)/feedId
+	// the value is the OwedPayment the feed owes to the oracle
+	PaymentKey = "payment"
+
+	// ChainlinkKeyIndexKey AccountStore key pattern: types.ChainlinkKeyIndexKey/chainlinkKey
+	// the value is the cosmos address of the account the chainlink public or signing key is registered under,
+	// the keys ChainlinkPubKeyToAddress parses are indexed by their address whatever their encoding
+	ChainlinkKeyIndexKey = "chainlinkKey"
+
+	// FeedTombstoneKey FeedInfoStore key pattern: types.FeedTombstoneKey/feedId
+	// the value is the FeedTombstone of the deleted feed
+	FeedTombstoneKey = "tombstone"
+
+	// LastUpdateKey FeedInfoStore key pattern: types.LastUpdateKey/feedId
+	LastUpdateKey = "lastUpdate"
+
+	// LatestEpochAndRoundKey FeedInfoStore key pattern: types.LatestEpochAndRoundKey/feedId
+	// the value is the packed OCR epoch and round of the latest accepted report of the feed
+	LatestEpochAndRoundKey = "latestEpochAndRound"
+
+	// OCRConfigKey FeedInfoStore key pattern: types.OCRConfigKey/feedId
+	// the value is the active OCRConfig of the feed
+	OCRConfigKey = "ocrConfig"
+
+	// FeedEscrowKey FeedInfoStore key pattern: types.FeedEscrowKey/feedId
+	// the value is the big endian amount of link escrowed to reward the rounds of the feed
+	FeedEscrowKey = "escrow"
+
+	// HeartbeatDueKey FeedInfoStore key pattern: types.HeartbeatDueKey/feedId
+	HeartbeatDueKey = "heartbeatDue"
+
+	// HeartbeatScheduleKey FeedInfoStore key pattern: types.HeartbeatScheduleKey/bigEndian(dueTime)/feedId
+	HeartbeatScheduleKey = "heartbeatSchedule"
+
+	// FeedOwnershipTransferKey FeedInfoStore key pattern: types.FeedOwnershipTransferKey/feedId
+	// the value is the OwnershipTransfer pending the acceptance of the new feed owner
+	FeedOwnershipTransferKey = "feedOwnershipTransfer"
+
+	// ModuleOwnershipTransferKey ModuleOwnerStore key pattern: types.ModuleOwnershipTransferKey/assignerAddress
+	// the value is the OwnershipTransfer pending the acceptance of the new module owner
+	ModuleOwnershipTransferKey = "moduleOwnershipTransfer"
+
+	// OwnershipTransferExpiryKey FeedInfoStore and ModuleOwnerStore key pattern:
+	// types.OwnershipTransferExpiryKey/bigEndian(expiresAtHeight)/transferKey
+	// the value is the key of the pending ownership transfer expiring at expiresAtHeight
+	OwnershipTransferExpiryKey = "ownershipTransferExpiry"
 )
 
-func GetFeedDataKey(feedId, roundId string) []byte {
-	key := FeedDataKey + "/"
+// lengthPrefix prefixes the feedId with its length so that no feedId key is the prefix of another one
+func lengthPrefix(feedId string) []byte {
+	return append([]byte{byte(len(feedId))}, feedId...)
+}
+
+// GetFeedDataPrefix returns the prefix of all the rounds of a feed, sorted by roundId
+func GetFeedDataPrefix(feedId string) []byte {
+	return append(append([]byte{}, FeedDataKeyPrefix...), lengthPrefix(feedId)...)
+}
+
+func GetFeedDataKey(feedId string, roundId uint64) []byte {
+	return append(GetFeedDataPrefix(feedId), sdk.Uint64ToBigEndian(roundId)...)
+}
+
+func GetRoundIdKey(feedId string) []byte {
+	return append(append([]byte{}, LatestRoundIdKeyPrefix...), lengthPrefix(feedId)...)
+}
+
+func GetLegacyFeedDataKey(feedId, roundId string) []byte {
+	key := LegacyFeedDataKey + "/"
 	if len(feedId) > 0 {
 		key += feedId + "/"
 		if len(roundId) > 0 {
@@ -64,8 +164,8 @@ func GetFeedDataKey(feedId, roundId string) []byte {
 	return KeyPrefix(key)
 }
 
-func GetRoundIdKey(feedId string) []byte {
-	key := RoundIdKey + "/"
+func GetLegacyRoundIdKey(feedId string) []byte {
+	key := LegacyRoundIdKey + "/"
 	if len(feedId) > 0 {
 		key += feedId
 	}
@@ -80,6 +180,23 @@ func GetModuleOwnerKey(moduleOwnerAddress string) []byte {
 	return KeyPrefix(key)
 }
 
+// GetOwnerProposalPrefix returns the prefix of the pending owner proposals, sorted by proposalId
+func GetOwnerProposalPrefix() []byte {
+	return KeyPrefix(OwnerProposalKey + "/")
+}
+
+func GetOwnerProposalKey(proposalId uint64) []byte {
+	return append(GetOwnerProposalPrefix(), sdk.Uint64ToBigEndian(proposalId)...)
+}
+
+// GetOwnerProposalExpiryKey returns the owner proposal expiry index key, the height is big endian encoded so that the
+// index is iterated in expiry order.
+// Passing a 0 proposalId returns the key of the first entry expiring at expiresAtHeight.
+func GetOwnerProposalExpiryKey(expiresAtHeight int64, proposalId uint64) []byte {
+	key := append(KeyPrefix(OwnerProposalExpiryKey+"/"), sdk.Uint64ToBigEndian(uint64(expiresAtHeight))...)
+	return append(key, sdk.Uint64ToBigEndian(proposalId)...)
+}
+
 func GetFeedInfoKey(feedId string) []byte {
 	key := FeedInfoKey + "/"
 	if len(feedId) > 0 {
@@ -95,3 +212,86 @@ func GetAccountKey(account string) []byte {
 	}
 	return KeyPrefix(key)
 }
+
+// GetChainlinkKeyIndexKey returns the index key of a chainlink key, a key in another encoding of the same address
+// gets the same index key; the keys that are neither an address nor a secp256k1 public key are indexed as they are
+func GetChainlinkKeyIndexKey(chainlinkKey []byte) []byte {
+	if address, err := ChainlinkPubKeyToAddress(chainlinkKey); err == nil {
+		chainlinkKey = address.Bytes()
+	}
+	return append(KeyPrefix(ChainlinkKeyIndexKey+"/"), chainlinkKey...)
+}
+
+// GetPaymentPrefix returns the prefix of the payments owed to an oracle, of every payment owed when oracle is empty
+func GetPaymentPrefix(oracle sdk.AccAddress) []byte {
+	key := KeyPrefix(PaymentKey + "/")
+	if len(oracle) > 0 {
+		key = append(key, lengthPrefix(string(oracle))...)
+	}
+	return key
+}
+
+func GetPaymentKey(oracle sdk.AccAddress, feedId string) []byte {
+	return append(GetPaymentPrefix(oracle), feedId...)
+}
+
+func GetFeedTombstoneKey(feedId string) []byte {
+	return KeyPrefix(FeedTombstoneKey + "/" + feedId)
+}
+
+func GetLastUpdateKey(feedId string) []byte {
+	return KeyPrefix(LastUpdateKey + "/" + feedId)
+}
+
+func GetLatestEpochAndRoundKey(feedId string) []byte {
+	return KeyPrefix(LatestEpochAndRoundKey + "/" + feedId)
+}
+
+func GetOCRConfigKey(feedId string) []byte {
+	return KeyPrefix(OCRConfigKey + "/" + feedId)
+}
+
+func GetFeedEscrowKey(feedId string) []byte {
+	return KeyPrefix(FeedEscrowKey + "/" + feedId)
+}
+
+func GetHeartbeatDueKey(feedId string) []byte {
+	return KeyPrefix(HeartbeatDueKey + "/" + feedId)
+}
+
+// GetHeartbeatScheduleKey returns the heartbeat scheduler index key, the due time is big endian encoded
+// so that the index is iterated in chronological order.
+// Passing an empty feedId returns the key of the first entry due at dueTime.
+func GetHeartbeatScheduleKey(dueTime uint64, feedId string) []byte {
+	key := append(KeyPrefix(HeartbeatScheduleKey+"/"), sdk.Uint64ToBigEndian(dueTime)...)
+	return append(key, []byte(feedId)...)
+}
+
+func GetFeedOwnershipTransferKey(feedId string) []byte {
+	return KeyPrefix(FeedOwnershipTransferKey + "/" + feedId)
+}
+
+// GetModuleOwnershipTransferPrefix returns the prefix of the pending module ownership transfers
+func GetModuleOwnershipTransferPrefix() []byte {
+	return KeyPrefix(ModuleOwnershipTransferKey + "/")
+}
+
+func GetModuleOwnershipTransferKey(assigner sdk.AccAddress) []byte {
+	return append(GetModuleOwnershipTransferPrefix(), assigner...)
+}
+
+// GetOwnershipTransferExpiryKey returns the ownership transfer expiry index key, the height is big endian encoded
+// so that the index is iterated in expiry order.
+// Passing an empty transferKey returns the key of the first entry expiring at expiresAtHeight.
+func GetOwnershipTransferExpiryKey(expiresAtHeight int64, transferKey []byte) []byte {
+	key := append(KeyPrefix(OwnershipTransferExpiryKey+"/"), sdk.Uint64ToBigEndian(uint64(expiresAtHeight))...)
+	return append(key, transferKey...)
+}
+
+// FeedIdFromLengthPrefixed returns the feedId of a length-prefixed feedId key part
+func FeedIdFromLengthPrefixed(key []byte) string {
+	if len(key) == 0 || len(key) < 1+int(key[0]) {
+		return ""
+	}
+	return string(key[1 : 1+int(key[0])])
+}
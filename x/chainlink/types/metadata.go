@@ -0,0 +1,50 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxFeedDecimals is the maximum number of decimals of a feed, decimals is an uint8 in AggregatorV3
+	MaxFeedDecimals = 255
+	// MaxFeedMetadataLength is the maximum length of the asset and unit names of the feed metadata
+	MaxFeedMetadataLength = 64
+)
+
+// Validate checks the decimals fit AggregatorV3 and that the base and quote assets are either both set or both empty
+func (m *FeedMetadata) Validate() error {
+	if m.GetDecimals() > MaxFeedDecimals {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "decimals can not be greater than %d", MaxFeedDecimals)
+	}
+	if (m.GetBaseAsset() == "") != (m.GetQuoteAsset() == "") {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "baseAsset and quoteAsset must be set together")
+	}
+	// the fields are checked in a fixed order so that the same metadata always fails with the same error
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"baseAsset", m.GetBaseAsset()},
+		{"quoteAsset", m.GetQuoteAsset()},
+		{"unit", m.GetUnit()},
+	}
+	for _, field := range fields {
+		if len(field.value) > MaxFeedMetadataLength {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s can not be longer than %d", field.name, MaxFeedMetadataLength)
+		}
+	}
+	return nil
+}
+
+// Description returns the AggregatorV3 description of the feed: its description if any, "baseAsset / quoteAsset" otherwise
+func (m *FeedMetadata) Description(feedDesc string) string {
+	if feedDesc != "" || m.GetBaseAsset() == "" {
+		return feedDesc
+	}
+	return fmt.Sprintf("%s / %s", m.GetBaseAsset(), m.GetQuoteAsset())
+}
@@ -14,33 +14,57 @@ import (
 )
 
 const (
-	SubmitFeedData               = "SubmitFeedData"
-	AddModuleOwner               = "AddModuleOwner"
-	ModuleOwnershipTransfer      = "ModuleOwnershipTransfer"
-	AddFeed                      = "AddFeed"
-	AddDataProvider              = "AddDataProvider"
-	RemoveDataProvider           = "RemoveDataProvider"
-	SetSubmissionCount           = "SetSubmissionCount"
-	SetHeartbeatTrigger          = "SetHeartbeatTrigger"
-	SetDeviationThresholdTrigger = "SetDeviationThresholdTrigger"
-	SetFeedReward                = "SetFeedReward"
-	FeedOwnershipTransfer        = "FeedOwnershipTransfer"
-	RequestNewRound              = "RequestNewRound"
-	SetAccountPiggyAddress       = "SetAccountPiggyAddress"
+	SubmitFeedData                = "SubmitFeedData"
+	AddModuleOwner                = "AddModuleOwner"
+	ModuleOwnershipTransfer       = "ModuleOwnershipTransfer"
+	AcceptModuleOwnership         = "AcceptModuleOwnership"
+	CancelModuleOwnershipTransfer = "CancelModuleOwnershipTransfer"
+	RemoveModuleOwner             = "RemoveModuleOwner"
+	ApproveOwnerProposal          = "ApproveOwnerProposal"
+	CancelOwnerProposal           = "CancelOwnerProposal"
+	AddFeed                       = "AddFeed"
+	AddDataProvider               = "AddDataProvider"
+	RemoveDataProvider            = "RemoveDataProvider"
+	AddTransmitter                = "AddTransmitter"
+	RemoveTransmitter             = "RemoveTransmitter"
+	SetSubmissionCount            = "SetSubmissionCount"
+	SetHeartbeatTrigger           = "SetHeartbeatTrigger"
+	SetDeviationThresholdTrigger  = "SetDeviationThresholdTrigger"
+	SetFeedReward                 = "SetFeedReward"
+	SetFeedMetadata               = "SetFeedMetadata"
+	SetAnswerBounds               = "SetAnswerBounds"
+	SetOCRConfig                  = "SetOCRConfig"
+	FeedOwnershipTransfer         = "FeedOwnershipTransfer"
+	AcceptFeedOwnership           = "AcceptFeedOwnership"
+	CancelFeedOwnershipTransfer   = "CancelFeedOwnershipTransfer"
+	RequestNewRound               = "RequestNewRound"
+	PauseFeed                     = "PauseFeed"
+	UnpauseFeed                   = "UnpauseFeed"
+	DeprecateFeed                 = "DeprecateFeed"
+	DeleteFeed                    = "DeleteFeed"
+	FundFeed                      = "FundFeed"
+	WithdrawFeedFunds             = "WithdrawFeedFunds"
+	WithdrawPayment               = "WithdrawPayment"
+	SetAccountPiggyAddress        = "SetAccountPiggyAddress"
 )
 
-var _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg = &MsgFeedData{}, &MsgModuleOwnershipTransfer{}, &MsgModuleOwner{},
+var _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _ sdk.Msg = &MsgFeedData{}, &MsgModuleOwnershipTransfer{}, &MsgModuleOwner{},
 	&MsgFeed{}, &MsgAddDataProvider{}, &MsgRemoveDataProvider{}, &MsgSetSubmissionCount{}, &MsgSetHeartbeatTrigger{},
-	&MsgSetDeviationThresholdTrigger{}, &MsgFeedOwnershipTransfer{}, &MsgRequestNewRound{}, &MsgAccount{}
+	&MsgSetDeviationThresholdTrigger{}, &MsgFeedOwnershipTransfer{}, &MsgRequestNewRound{}, &MsgAccount{}, &MsgSetFeedMetadata{},
+	&MsgPauseFeed{}, &MsgUnpauseFeed{}, &MsgDeprecateFeed{}, &MsgDeleteFeed{}, &MsgSetAnswerBounds{},
+	&MsgSetOCRConfig{}, &MsgAddTransmitter{}, &MsgRemoveTransmitter{}, &MsgFundFeed{}, &MsgWithdrawFeedFunds{}, &MsgWithdrawPayment{},
+	&MsgRemoveModuleOwner{}, &MsgApproveOwnerProposal{}, &MsgAcceptFeedOwnership{}, &MsgCancelFeedOwnershipTransfer{},
+	&MsgAcceptModuleOwnership{}, &MsgCancelModuleOwnershipTransfer{}, &MsgCancelOwnerProposal{}
 
 var _ sdk.Tx = &MsgModuleOwner{}
 
 var _ Validation = &MsgFeedData{}
 
-func NewMsgFeedData(submitter sdk.Address, feedId string, observationFeedData [][]byte, signatures [][]byte, cosmosPubKeys [][]byte) *MsgFeedData {
+func NewMsgFeedData(submitter sdk.Address, feedId string, report []byte, observationFeedData [][]byte, signatures [][]byte, cosmosPubKeys [][]byte) *MsgFeedData {
 	return &MsgFeedData{
 		FeedId:                        feedId,
 		Submitter:                     submitter.Bytes(),
+		Report:                        report,
 		ObservationFeedData:           observationFeedData,
 		ObservationFeedDataSignatures: signatures,
 		// IsFeedDataValid will be true by default
@@ -79,6 +103,9 @@ func (m *MsgFeedData) ValidateBasic() error {
 	if len(m.GetObservationFeedData()) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedData can not be empty")
 	}
+	if err := ValidateReportLayout(m.GetReport()); err != nil {
+		return err
+	}
 	if len(m.GetObservationFeedDataSignatures()) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of oracle signatures does not meet the required number")
 	}
@@ -97,13 +124,15 @@ func (m *MsgFeedData) Validate(fn func(msg sdk.Msg) bool) bool {
 }
 
 // RewardCalculator calculates the reward for each data provider in the current submit feed data tx
-// base on the registered reward strategy
+// base on the registered reward strategy, the submitter always gets a transmitter payout to get the tx fee reimbursed
 // return the slice of reward payout and the total reward amount
 func (m *MsgFeedData) RewardCalculator(feed *MsgFeed, feedData *MsgFeedData) ([]RewardPayout, uint64, error) {
+	var rewardPayout []RewardPayout
+
 	// every one gets the base amount if no strategy configured when chain launching
 	// or the owner of the current feed does not set a strategy
 	if len(FeedRewardStrategyConvertor) == 0 || feed.GetFeedReward().GetStrategy() == "" {
-		rewardPayout := make([]RewardPayout, 0, len(feedData.GetCosmosPubKeys()))
+		rewardPayout = make([]RewardPayout, 0, len(feedData.GetCosmosPubKeys())+1)
 
 		for i := 0; i < len(feedData.GetCosmosPubKeys()); i++ {
 			// err is not possible here since pubkey has been checked in anteHandler
@@ -115,26 +144,43 @@ func (m *MsgFeedData) RewardCalculator(feed *MsgFeed, feedData *MsgFeedData) ([]
 					Address: dataProviderAddr,
 				},
 				Amount: feed.GetFeedReward().GetAmount(),
+				Role:   RewardRoleSigner,
 			}
 			rewardPayout = append(rewardPayout, rp)
 		}
-		return rewardPayout, feed.GetFeedReward().GetAmount() * uint64(len(feedData.GetObservationFeedDataSignatures())), nil
+	} else {
+		// strategy of a feed here has already been checked in anteHandler when set, ok must be true
+		strategyFn, _ := FeedRewardStrategyConvertor[feed.GetFeedReward().GetStrategy()] // nolint
+
+		var err error
+		rewardPayout, err = strategyFn(feed, feedData)
+		if err != nil {
+			return nil, 0, err
+		}
 	}
 
-	// strategy of a feed here has already been checked in anteHandler when set, ok must be true
-	strategyFn, _ := FeedRewardStrategyConvertor[feed.GetFeedReward().GetStrategy()] // nolint
-
-	RewardPayoutList, err := strategyFn(feed, feedData)
-	if err != nil {
-		return nil, 0, err
+	totalRewardAmount := uint64(0)
+	hasTransmitterPayout := false
+	for i := range rewardPayout {
+		if rewardPayout[i].Role == "" {
+			rewardPayout[i].Role = RewardRoleSigner
+		}
+		if rewardPayout[i].Role == RewardRoleTransmitter {
+			hasTransmitterPayout = true
+		}
+		totalRewardAmount += rewardPayout[i].Amount
 	}
 
-	totalRewardAmount := uint64(0)
-	for _, payout := range RewardPayoutList {
-		totalRewardAmount += payout.Amount
+	if !hasTransmitterPayout {
+		rewardPayout = append(rewardPayout, RewardPayout{
+			DataProvider: &DataProvider{
+				Address: feedData.GetSubmitter(),
+			},
+			Role: RewardRoleTransmitter,
+		})
 	}
 
-	return RewardPayoutList, totalRewardAmount, nil
+	return rewardPayout, totalRewardAmount, nil
 }
 
 func NewMsgModuleOwner(assigner, address sdk.Address, pubKey []byte) *MsgModuleOwner {
@@ -214,6 +260,176 @@ func (m *MsgModuleOwnershipTransfer) GetSigners() []githubcosmossdktypes.AccAddr
 	return []sdk.AccAddress{sdk.AccAddress(m.AssignerAddress)}
 }
 
+func NewMsgAcceptModuleOwnership(signer, assigner sdk.AccAddress) *MsgAcceptModuleOwnership {
+	return &MsgAcceptModuleOwnership{
+		AssignerAddress: assigner,
+		Signer:          signer,
+	}
+}
+
+func (m *MsgAcceptModuleOwnership) Route() string {
+	return RouterKey
+}
+
+func (m *MsgAcceptModuleOwnership) Type() string {
+	return AcceptModuleOwnership
+}
+
+func (m *MsgAcceptModuleOwnership) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if m.GetAssignerAddress().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "assigner address can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgAcceptModuleOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgAcceptModuleOwnership) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgCancelModuleOwnershipTransfer(signer, assigner sdk.AccAddress) *MsgCancelModuleOwnershipTransfer {
+	return &MsgCancelModuleOwnershipTransfer{
+		AssignerAddress: assigner,
+		Signer:          signer,
+	}
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) Route() string {
+	return RouterKey
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) Type() string {
+	return CancelModuleOwnershipTransfer
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if m.GetAssignerAddress().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "assigner address can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgRemoveModuleOwner(assigner, address sdk.AccAddress) *MsgRemoveModuleOwner {
+	return &MsgRemoveModuleOwner{
+		Address:         address,
+		AssignerAddress: assigner,
+	}
+}
+
+func (m *MsgRemoveModuleOwner) Route() string {
+	return RouterKey
+}
+
+func (m *MsgRemoveModuleOwner) Type() string {
+	return RemoveModuleOwner
+}
+
+func (m *MsgRemoveModuleOwner) ValidateBasic() error {
+	if m.GetAssignerAddress().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "assigner address can not be empty")
+	}
+	if m.GetAddress().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "module owner address can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgRemoveModuleOwner) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRemoveModuleOwner) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.AssignerAddress}
+}
+
+func NewMsgApproveOwnerProposal(signer sdk.AccAddress, proposalId uint64) *MsgApproveOwnerProposal {
+	return &MsgApproveOwnerProposal{
+		ProposalId: proposalId,
+		Signer:     signer,
+	}
+}
+
+func (m *MsgApproveOwnerProposal) Route() string {
+	return RouterKey
+}
+
+func (m *MsgApproveOwnerProposal) Type() string {
+	return ApproveOwnerProposal
+}
+
+func (m *MsgApproveOwnerProposal) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if m.GetProposalId() == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposalId can not be 0")
+	}
+	return nil
+}
+
+func (m *MsgApproveOwnerProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgApproveOwnerProposal) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgCancelOwnerProposal(signer sdk.AccAddress, proposalId uint64) *MsgCancelOwnerProposal {
+	return &MsgCancelOwnerProposal{
+		ProposalId: proposalId,
+		Signer:     signer,
+	}
+}
+
+func (m *MsgCancelOwnerProposal) Route() string {
+	return RouterKey
+}
+
+func (m *MsgCancelOwnerProposal) Type() string {
+	return CancelOwnerProposal
+}
+
+func (m *MsgCancelOwnerProposal) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if m.GetProposalId() == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposalId can not be 0")
+	}
+	return nil
+}
+
+func (m *MsgCancelOwnerProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCancelOwnerProposal) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
 func NewMsgFeed(feedId, feedDesc string, feedOwner, moduleOwner sdk.Address, initDataProviders []*DataProvider,
 	submissionCount, heartbeatTrigger, deviationThresholdTrigger uint32, baseFeedRewardAmount uint64, feedRewardStrategy string) *MsgFeed {
 	return &MsgFeed{
@@ -247,6 +463,9 @@ func (m *MsgFeed) ValidateBasic() error {
 	if len(m.GetFeedId()) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
 	}
+	if len(m.GetFeedId()) > MaxFeedIdLength {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "feedId can not be longer than %d", MaxFeedIdLength)
+	}
 	if m.GetFeedOwner().Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "feedOwner can not be empty")
 	}
@@ -259,6 +478,28 @@ func (m *MsgFeed) ValidateBasic() error {
 	if m.GetDeviationThresholdTrigger() == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deviationThresholdTrigger must not be 0")
 	}
+	if err := ValidateDeviationThresholdPolicy(m.GetDeviationThresholdPolicy()); err != nil {
+		return err
+	}
+	if m.GetMetadata() != nil {
+		if err := m.GetMetadata().Validate(); err != nil {
+			return err
+		}
+	}
+	if m.GetAnswerBounds() != nil {
+		if err := m.GetAnswerBounds().Validate(); err != nil {
+			return err
+		}
+	}
+	if err := m.GetValueSchema().Validate(); err != nil {
+		return err
+	}
+	if err := ValidateInsufficientFundsPolicy(m.GetInsufficientFundsPolicy()); err != nil {
+		return err
+	}
+	if m.GetAnswerBounds() != nil && !m.GetValueSchema().IsNumeric() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "answer bounds do not apply to %s feeds", m.GetValueSchema().ValueType())
+	}
 	if m.GetFeedReward().GetAmount() == 0 {
 		return errors.New("baseFeedRewardAmount must not be 0")
 	}
@@ -276,6 +517,16 @@ func (m *MsgFeed) ValidateBasic() error {
 	if len(tmp) != len(m.GetDataProviders()) {
 		return errors.New("init data provider list contains duplication")
 	}
+	transmitters := make(map[string]bool, len(m.GetTransmitters()))
+	for _, transmitter := range m.GetTransmitters() {
+		if transmitter.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "transmitter can not be empty")
+		}
+		transmitters[transmitter.String()] = true
+	}
+	if len(transmitters) != len(m.GetTransmitters()) {
+		return errors.New("init transmitter list contains duplication")
+	}
 	return nil
 }
 
@@ -363,6 +614,76 @@ func (m *MsgRemoveDataProvider) GetSigners() []githubcosmossdktypes.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
 
+func NewMsgAddTransmitter(signer githubcosmossdktypes.AccAddress, feedId string, address githubcosmossdktypes.AccAddress) *MsgAddTransmitter {
+	return &MsgAddTransmitter{
+		FeedId:  feedId,
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m *MsgAddTransmitter) Route() string {
+	return RouterKey
+}
+
+func (m *MsgAddTransmitter) Type() string {
+	return AddTransmitter
+}
+
+func (m *MsgAddTransmitter) ValidateBasic() error {
+	if len(m.GetFeedId()) == 0 {
+		return errors.New("invalid feedId")
+	}
+	if m.GetAddress().Empty() {
+		return errors.New("transmitter address is empty")
+	}
+	return nil
+}
+
+func (m *MsgAddTransmitter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgAddTransmitter) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgRemoveTransmitter(signer githubcosmossdktypes.AccAddress, feedId string, address githubcosmossdktypes.AccAddress) *MsgRemoveTransmitter {
+	return &MsgRemoveTransmitter{
+		FeedId:  feedId,
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m *MsgRemoveTransmitter) Route() string {
+	return RouterKey
+}
+
+func (m *MsgRemoveTransmitter) Type() string {
+	return RemoveTransmitter
+}
+
+func (m *MsgRemoveTransmitter) ValidateBasic() error {
+	if len(m.GetFeedId()) == 0 {
+		return errors.New("invalid feedId")
+	}
+	if m.GetAddress().Empty() {
+		return errors.New("transmitter address is empty")
+	}
+	return nil
+}
+
+func (m *MsgRemoveTransmitter) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgRemoveTransmitter) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
 func NewMsgSetSubmissionCount(signer githubcosmossdktypes.AccAddress, feedId string, submissionCount uint32) *MsgSetSubmissionCount {
 	return &MsgSetSubmissionCount{
 		FeedId:          feedId,
@@ -465,6 +786,9 @@ func (m *MsgSetDeviationThresholdTrigger) ValidateBasic() error {
 	if m.GetDeviationThresholdTrigger() == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "deviationThresholdTrigger must not be 0")
 	}
+	if err := ValidateDeviationThresholdPolicy(m.GetDeviationThresholdPolicy()); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -518,6 +842,126 @@ func (m *MsgSetFeedReward) GetSigners() []githubcosmossdktypes.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
 
+func NewMsgSetFeedMetadata(signer githubcosmossdktypes.AccAddress, feedId string, metadata *FeedMetadata) *MsgSetFeedMetadata {
+	return &MsgSetFeedMetadata{
+		FeedId:   feedId,
+		Metadata: metadata,
+		Signer:   signer,
+	}
+}
+
+func (m *MsgSetFeedMetadata) Route() string {
+	return RouterKey
+}
+
+func (m *MsgSetFeedMetadata) Type() string {
+	return SetFeedMetadata
+}
+
+func (m *MsgSetFeedMetadata) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	if m.GetMetadata() == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "metadata can not be empty")
+	}
+	return m.GetMetadata().Validate()
+}
+
+func (m *MsgSetFeedMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetFeedMetadata) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgSetAnswerBounds(signer githubcosmossdktypes.AccAddress, feedId string, answerBounds *AnswerBounds) *MsgSetAnswerBounds {
+	return &MsgSetAnswerBounds{
+		FeedId:       feedId,
+		AnswerBounds: answerBounds,
+		Signer:       signer,
+	}
+}
+
+func (m *MsgSetAnswerBounds) Route() string {
+	return RouterKey
+}
+
+func (m *MsgSetAnswerBounds) Type() string {
+	return SetAnswerBounds
+}
+
+func (m *MsgSetAnswerBounds) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	// empty answer bounds remove the bounds of the feed
+	if m.GetAnswerBounds() != nil {
+		if err := m.GetAnswerBounds().Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *MsgSetAnswerBounds) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetAnswerBounds) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgSetOCRConfig(signer githubcosmossdktypes.AccAddress, feedId string, signingKeys [][]byte, transmitters []sdk.AccAddress,
+	f uint32, onchainConfig []byte, offchainConfigVersion uint64, offchainConfig []byte) *MsgSetOCRConfig {
+	return &MsgSetOCRConfig{
+		FeedId:                feedId,
+		SigningKeys:           signingKeys,
+		Transmitters:          transmitters,
+		F:                     f,
+		OnchainConfig:         onchainConfig,
+		OffchainConfigVersion: offchainConfigVersion,
+		OffchainConfig:        offchainConfig,
+		Signer:                signer,
+	}
+}
+
+func (m *MsgSetOCRConfig) Route() string {
+	return RouterKey
+}
+
+func (m *MsgSetOCRConfig) Type() string {
+	return SetOCRConfig
+}
+
+func (m *MsgSetOCRConfig) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	return ValidateOCRConfig(m.GetSigningKeys(), m.GetTransmitters(), m.GetF())
+}
+
+func (m *MsgSetOCRConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgSetOCRConfig) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
 func NewMsgFeedOwnershipTransfer(signer githubcosmossdktypes.AccAddress, feedId string, newFeedOwnerAddress sdk.AccAddress) *MsgFeedOwnershipTransfer {
 	return &MsgFeedOwnershipTransfer{
 		FeedId:              feedId,
@@ -553,6 +997,74 @@ func (m *MsgFeedOwnershipTransfer) GetSigners() []githubcosmossdktypes.AccAddres
 	return []sdk.AccAddress{m.Signer}
 }
 
+func NewMsgAcceptFeedOwnership(signer githubcosmossdktypes.AccAddress, feedId string) *MsgAcceptFeedOwnership {
+	return &MsgAcceptFeedOwnership{
+		FeedId: feedId,
+		Signer: signer,
+	}
+}
+
+func (m *MsgAcceptFeedOwnership) Route() string {
+	return RouterKey
+}
+
+func (m *MsgAcceptFeedOwnership) Type() string {
+	return AcceptFeedOwnership
+}
+
+func (m *MsgAcceptFeedOwnership) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgAcceptFeedOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgAcceptFeedOwnership) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgCancelFeedOwnershipTransfer(signer githubcosmossdktypes.AccAddress, feedId string) *MsgCancelFeedOwnershipTransfer {
+	return &MsgCancelFeedOwnershipTransfer{
+		FeedId: feedId,
+		Signer: signer,
+	}
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) Route() string {
+	return RouterKey
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) Type() string {
+	return CancelFeedOwnershipTransfer
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
 func NewMsgRequestNewRound(signer githubcosmossdktypes.AccAddress, feedId string) *MsgRequestNewRound {
 	return &MsgRequestNewRound{
 		FeedId: feedId,
@@ -587,6 +1099,249 @@ func (m *MsgRequestNewRound) GetSigners() []githubcosmossdktypes.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
 
+func NewMsgPauseFeed(signer githubcosmossdktypes.AccAddress, feedId string) *MsgPauseFeed {
+	return &MsgPauseFeed{
+		FeedId: feedId,
+		Signer: signer,
+	}
+}
+
+func (m *MsgPauseFeed) Route() string {
+	return RouterKey
+}
+
+func (m *MsgPauseFeed) Type() string {
+	return PauseFeed
+}
+
+func (m *MsgPauseFeed) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgPauseFeed) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgPauseFeed) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgUnpauseFeed(signer githubcosmossdktypes.AccAddress, feedId string) *MsgUnpauseFeed {
+	return &MsgUnpauseFeed{
+		FeedId: feedId,
+		Signer: signer,
+	}
+}
+
+func (m *MsgUnpauseFeed) Route() string {
+	return RouterKey
+}
+
+func (m *MsgUnpauseFeed) Type() string {
+	return UnpauseFeed
+}
+
+func (m *MsgUnpauseFeed) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgUnpauseFeed) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgUnpauseFeed) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgDeprecateFeed(signer githubcosmossdktypes.AccAddress, feedId string) *MsgDeprecateFeed {
+	return &MsgDeprecateFeed{
+		FeedId: feedId,
+		Signer: signer,
+	}
+}
+
+func (m *MsgDeprecateFeed) Route() string {
+	return RouterKey
+}
+
+func (m *MsgDeprecateFeed) Type() string {
+	return DeprecateFeed
+}
+
+func (m *MsgDeprecateFeed) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgDeprecateFeed) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgDeprecateFeed) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgDeleteFeed(signer githubcosmossdktypes.AccAddress, feedId string) *MsgDeleteFeed {
+	return &MsgDeleteFeed{
+		FeedId: feedId,
+		Signer: signer,
+	}
+}
+
+func (m *MsgDeleteFeed) Route() string {
+	return RouterKey
+}
+
+func (m *MsgDeleteFeed) Type() string {
+	return DeleteFeed
+}
+
+func (m *MsgDeleteFeed) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgDeleteFeed) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgDeleteFeed) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgFundFeed(signer githubcosmossdktypes.AccAddress, feedId string, amount uint64) *MsgFundFeed {
+	return &MsgFundFeed{
+		FeedId: feedId,
+		Amount: amount,
+		Signer: signer,
+	}
+}
+
+func (m *MsgFundFeed) Route() string {
+	return RouterKey
+}
+
+func (m *MsgFundFeed) Type() string {
+	return FundFeed
+}
+
+func (m *MsgFundFeed) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	if m.GetAmount() == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must not be 0")
+	}
+	return nil
+}
+
+func (m *MsgFundFeed) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgFundFeed) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgWithdrawFeedFunds(signer githubcosmossdktypes.AccAddress, feedId string, amount uint64) *MsgWithdrawFeedFunds {
+	return &MsgWithdrawFeedFunds{
+		FeedId: feedId,
+		Amount: amount,
+		Signer: signer,
+	}
+}
+
+func (m *MsgWithdrawFeedFunds) Route() string {
+	return RouterKey
+}
+
+func (m *MsgWithdrawFeedFunds) Type() string {
+	return WithdrawFeedFunds
+}
+
+func (m *MsgWithdrawFeedFunds) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	if len(m.GetFeedId()) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feedId can not be empty")
+	}
+	if m.GetAmount() == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "amount must not be 0")
+	}
+	return nil
+}
+
+func (m *MsgWithdrawFeedFunds) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgWithdrawFeedFunds) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func NewMsgWithdrawPayment(signer githubcosmossdktypes.AccAddress, feedId string) *MsgWithdrawPayment {
+	return &MsgWithdrawPayment{
+		FeedId: feedId,
+		Signer: signer,
+	}
+}
+
+func (m *MsgWithdrawPayment) Route() string {
+	return RouterKey
+}
+
+func (m *MsgWithdrawPayment) Type() string {
+	return WithdrawPayment
+}
+
+func (m *MsgWithdrawPayment) ValidateBasic() error {
+	if m.GetSigner().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "signer can not be empty")
+	}
+	return nil
+}
+
+func (m *MsgWithdrawPayment) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m *MsgWithdrawPayment) GetSigners() []githubcosmossdktypes.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
 func NewMsgAddAccount(submitter githubcosmossdktypes.AccAddress, chainlinkPublicKey, chainlinkSigningKey []byte, piggyAddress githubcosmossdktypes.AccAddress) *MsgAccount {
 	return &MsgAccount{
 		Submitter:           submitter,
@@ -4,11 +4,16 @@
 package types
 
 import (
+	"encoding/hex"
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -26,6 +31,7 @@ type MsgFeedDataTestSuite struct {
 	suite.Suite
 	submitter     sdk.AccAddress
 	feedId        string
+	report        []byte
 	feedData      [][]byte
 	signatures    [][]byte
 	cosmosPubKeys [][]byte
@@ -38,6 +44,9 @@ func TestMsgFeedDataTestSuite(t *testing.T) {
 func (ts *MsgFeedDataTestSuite) SetupTest() {
 	_, _, ts.submitter = GenerateAccount()
 	ts.feedId = "testfeed"
+	report, err := EncodeOCRReport(make([]byte, OCRReportContextLength), []byte{0}, []*big.Int{big.NewInt(1)})
+	ts.Require().NoError(err)
+	ts.report = report
 	ts.feedData = [][]byte{[]byte("feedData")}
 	ts.signatures = [][]byte{[]byte("signatures")}
 	ts.cosmosPubKeys = [][]byte{[]byte("cosmosPubKey")}
@@ -47,6 +56,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataConstructor() {
 	msg := NewMsgFeedData(
 		ts.submitter,
 		ts.feedId,
+		ts.report,
 		ts.feedData,
 		ts.signatures,
 		ts.cosmosPubKeys,
@@ -66,6 +76,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 		description   string
 		submitter     sdk.AccAddress
 		feedId        string
+		report        []byte
 		feedData      [][]byte
 		signatures    [][]byte
 		cosmosPubKeys [][]byte
@@ -75,6 +86,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 			description:   "MsgFeedDataTestSuite: passing case - all valid values",
 			submitter:     ts.submitter,
 			feedId:        ts.feedId,
+			report:        ts.report,
 			feedData:      ts.feedData,
 			signatures:    ts.signatures,
 			cosmosPubKeys: ts.cosmosPubKeys,
@@ -84,6 +96,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 			description:   "MsgFeedDataTestSuite: failing case - empty submitter",
 			submitter:     nil,
 			feedId:        ts.feedId,
+			report:        ts.report,
 			feedData:      ts.feedData,
 			signatures:    ts.signatures,
 			cosmosPubKeys: ts.cosmosPubKeys,
@@ -93,6 +106,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 			description:   "MsgFeedDataTestSuite: failing case - empty feedId",
 			submitter:     ts.submitter,
 			feedId:        "",
+			report:        ts.report,
 			feedData:      ts.feedData,
 			signatures:    ts.signatures,
 			cosmosPubKeys: ts.cosmosPubKeys,
@@ -102,6 +116,27 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 			description:   "MsgFeedDataTestSuite: failing case - invalid feedId format",
 			submitter:     ts.submitter,
 			feedId:        "BAD/FEED/ID",
+			report:        ts.report,
+			feedData:      ts.feedData,
+			signatures:    ts.signatures,
+			cosmosPubKeys: ts.cosmosPubKeys,
+			expPass:       false,
+		},
+		{
+			description:   "MsgFeedDataTestSuite: failing case - empty report",
+			submitter:     ts.submitter,
+			feedId:        ts.feedId,
+			report:        nil,
+			feedData:      ts.feedData,
+			signatures:    ts.signatures,
+			cosmosPubKeys: ts.cosmosPubKeys,
+			expPass:       false,
+		},
+		{
+			description:   "MsgFeedDataTestSuite: failing case - malformed report",
+			submitter:     ts.submitter,
+			feedId:        ts.feedId,
+			report:        []byte("report"),
 			feedData:      ts.feedData,
 			signatures:    ts.signatures,
 			cosmosPubKeys: ts.cosmosPubKeys,
@@ -111,6 +146,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 			description:   "MsgFeedDataTestSuite: failing case - empty feedData",
 			submitter:     ts.submitter,
 			feedId:        ts.feedId,
+			report:        ts.report,
 			feedData:      nil,
 			signatures:    ts.signatures,
 			cosmosPubKeys: ts.cosmosPubKeys,
@@ -120,6 +156,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 			description:   "MsgFeedDataTestSuite: failing case - empty signatures",
 			submitter:     ts.submitter,
 			feedId:        ts.feedId,
+			report:        ts.report,
 			feedData:      ts.feedData,
 			signatures:    [][]byte{},
 			cosmosPubKeys: ts.cosmosPubKeys,
@@ -129,6 +166,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 			description:   "MsgFeedDataTestSuite: failing case - empty cosmos public keys",
 			submitter:     ts.submitter,
 			feedId:        ts.feedId,
+			report:        ts.report,
 			feedData:      ts.feedData,
 			signatures:    [][]byte{},
 			cosmosPubKeys: nil,
@@ -140,6 +178,7 @@ func (ts *MsgFeedDataTestSuite) TestMsgFeedDataValidateBasic() {
 		msg := NewMsgFeedData(
 			tc.submitter,
 			tc.feedId,
+			tc.report,
 			tc.feedData,
 			tc.signatures,
 			tc.cosmosPubKeys,
@@ -482,6 +521,53 @@ func (ts *MsgFeedTestSuite) TestMsgFeedValidateBasic() {
 	}
 }
 
+func (ts *MsgFeedTestSuite) TestMsgFeedValidateBasicTransmitters() {
+	_, _, transmitter := GenerateAccount()
+	msg := NewMsgFeed("feedId1", "feedDescription1", ts.feedOwner, ts.moduleOwner, ts.dataProviders, 1, 2, 3, 4, "")
+
+	msg.Transmitters = []sdk.AccAddress{transmitter}
+	ts.Require().NoError(msg.ValidateBasic())
+
+	msg.Transmitters = []sdk.AccAddress{transmitter, transmitter}
+	ts.Require().Error(msg.ValidateBasic())
+
+	msg.Transmitters = []sdk.AccAddress{nil}
+	ts.Require().Error(msg.ValidateBasic())
+}
+
+func (ts *MsgFeedTestSuite) TestMsgFeedValidateBasicValueSchema() {
+	msg := NewMsgFeed("feedId1", "feedDescription1", ts.feedOwner, ts.moduleOwner, ts.dataProviders, 1, 2, 3, 4, "")
+
+	msg.ValueSchema = &FeedValueSchema{Type: FeedValueTypeTuple, TupleSize: 2}
+	ts.Require().NoError(msg.ValidateBasic())
+
+	msg.ValueSchema = &FeedValueSchema{Type: "bool"}
+	ts.Require().Error(msg.ValidateBasic())
+
+	// answer bounds only apply to int192 feeds
+	msg.ValueSchema = &FeedValueSchema{Type: FeedValueTypeString}
+	msg.AnswerBounds = &AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(2)}
+	ts.Require().Error(msg.ValidateBasic())
+
+	msg.ValueSchema = nil
+	ts.Require().NoError(msg.ValidateBasic())
+}
+
+func (ts *MsgFeedTestSuite) TestMsgFeedValidateBasicInsufficientFundsPolicy() {
+	msg := NewMsgFeed("feedId1", "feedDescription1", ts.feedOwner, ts.moduleOwner, ts.dataProviders, 1, 2, 3, 4, "")
+
+	msg.InsufficientFundsPolicy = InsufficientFundsPolicyReject
+	ts.Require().NoError(msg.ValidateBasic())
+	ts.Require().True(msg.RejectsUnderfundedRounds())
+
+	msg.InsufficientFundsPolicy = InsufficientFundsPolicyNonRewardable
+	ts.Require().NoError(msg.ValidateBasic())
+	ts.Require().False(msg.RejectsUnderfundedRounds())
+
+	msg.InsufficientFundsPolicy = "mint"
+	ts.Require().Error(msg.ValidateBasic())
+}
+
 type MsgAddDataProviderTestSuite struct {
 	suite.Suite
 	signer              sdk.AccAddress
@@ -660,72 +746,76 @@ func (ts *MsgRemoveDataProviderTestSuite) TestMsgRemoveDataProviderValidateBasic
 	}
 }
 
-type MsgSetSubmissionCountTestSuite struct {
+type MsgAddTransmitterTestSuite struct {
 	suite.Suite
-	signer sdk.AccAddress
+	signer  sdk.AccAddress
+	address sdk.AccAddress
 }
 
-func TestMsgSetSubmissionCountTestSuite(t *testing.T) {
-	suite.Run(t, new(MsgSetSubmissionCountTestSuite))
+func TestMsgAddTransmitterTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgAddTransmitterTestSuite))
 }
 
-func (ts *MsgSetSubmissionCountTestSuite) SetupTest() {
+func (ts *MsgAddTransmitterTestSuite) SetupTest() {
 	_, _, signerAddr := GenerateAccount()
 	ts.signer = signerAddr
+
+	_, _, validAddr := GenerateAccount()
+	ts.address = validAddr
 }
 
-func (ts *MsgSetSubmissionCountTestSuite) MsgSetSubmissionCountConstructor() {
-	msg := NewMsgSetSubmissionCount(
+func (ts *MsgAddTransmitterTestSuite) TestMsgAddTransmitterConstructor() {
+	msg := NewMsgAddTransmitter(
 		ts.signer,
 		"feedId1",
-		uint32(1),
+		ts.address,
 	)
 
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	signedBytes := sdk.MustSortJSON(bz)
 
 	ts.Require().Equal(msg.Route(), RouterKey)
-	ts.Require().Equal(msg.Type(), SetSubmissionCount)
+	ts.Require().Equal(msg.Type(), AddTransmitter)
 	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
 	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
 }
 
-func (ts *MsgSetSubmissionCountTestSuite) MsgSetSubmissionCountValidateBasic() {
+func (ts *MsgAddTransmitterTestSuite) TestMsgAddTransmitterValidateBasic() {
 	testCases := []struct {
-		description     string
-		feedId          string
-		submissionCount uint32
-		signer          sdk.AccAddress
-		expPass         bool
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		address     sdk.AccAddress
+		expPass     bool
 	}{
 		{
-			description:     "MsgSetSubmissionCountTestSuite: passing case - all valid values",
-			feedId:          "feedId1",
-			signer:          ts.signer,
-			submissionCount: uint32(1),
-			expPass:         true,
+			description: "MsgAddTransmitterTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			address:     ts.address,
+			expPass:     true,
 		},
 		{
-			description:     "MsgSetSubmissionCountTestSuite: failing case - invalid feedId",
-			feedId:          "",
-			signer:          ts.signer,
-			submissionCount: uint32(1),
-			expPass:         false,
+			description: "MsgAddTransmitterTestSuite: failing case - invalid feedId",
+			feedId:      "",
+			signer:      ts.signer,
+			address:     ts.address,
+			expPass:     false,
 		},
 		{
-			description:     "MsgSetSubmissionCountTestSuite: failing case - submissionCount must not be 0",
-			feedId:          "feedId1",
-			signer:          ts.signer,
-			submissionCount: uint32(0),
-			expPass:         false,
+			description: "MsgAddTransmitterTestSuite: failing case - transmitter address is empty",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			address:     nil,
+			expPass:     false,
 		},
 	}
 
 	for i, tc := range testCases {
-		msg := NewMsgSetSubmissionCount(
+		msg := NewMsgAddTransmitter(
 			tc.signer,
 			tc.feedId,
-			tc.submissionCount,
+			tc.address,
 		)
 		err := msg.ValidateBasic()
 
@@ -737,72 +827,76 @@ func (ts *MsgSetSubmissionCountTestSuite) MsgSetSubmissionCountValidateBasic() {
 	}
 }
 
-type MsgSetHeartbeatTriggerTestSuite struct {
+type MsgRemoveTransmitterTestSuite struct {
 	suite.Suite
-	signer sdk.AccAddress
+	signer  sdk.AccAddress
+	address sdk.AccAddress
 }
 
-func TestMsgSetHeartbeatTriggerTestSuite(t *testing.T) {
-	suite.Run(t, new(MsgSetHeartbeatTriggerTestSuite))
+func TestMsgRemoveTransmitterTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgRemoveTransmitterTestSuite))
 }
 
-func (ts *MsgSetHeartbeatTriggerTestSuite) SetupTest() {
+func (ts *MsgRemoveTransmitterTestSuite) SetupTest() {
 	_, _, signerAddr := GenerateAccount()
 	ts.signer = signerAddr
+
+	_, _, validAddr := GenerateAccount()
+	ts.address = validAddr
 }
 
-func (ts *MsgSetHeartbeatTriggerTestSuite) MsgSetHeartbeatTriggerConstructor() {
-	msg := NewMsgSetHeartbeatTrigger(
+func (ts *MsgRemoveTransmitterTestSuite) TestMsgRemoveTransmitterConstructor() {
+	msg := NewMsgRemoveTransmitter(
 		ts.signer,
 		"feedId1",
-		uint32(1),
+		ts.address,
 	)
 
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	signedBytes := sdk.MustSortJSON(bz)
 
 	ts.Require().Equal(msg.Route(), RouterKey)
-	ts.Require().Equal(msg.Type(), SetHeartbeatTrigger)
+	ts.Require().Equal(msg.Type(), RemoveTransmitter)
 	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
 	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
 }
 
-func (ts *MsgSetHeartbeatTriggerTestSuite) MsgSetHeartbeatTriggeralidateBasic() {
+func (ts *MsgRemoveTransmitterTestSuite) TestMsgRemoveTransmitterValidateBasic() {
 	testCases := []struct {
-		description      string
-		feedId           string
-		heartbeatTrigger uint32
-		signer           sdk.AccAddress
-		expPass          bool
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		address     sdk.AccAddress
+		expPass     bool
 	}{
 		{
-			description:      "MsgSetHeartbeatTriggerTestSuite: passing case - all valid values",
-			feedId:           "feedId1",
-			signer:           ts.signer,
-			heartbeatTrigger: uint32(1),
-			expPass:          true,
+			description: "MsgRemoveTransmitterTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			address:     ts.address,
+			expPass:     true,
 		},
 		{
-			description:      "MsgSetHeartbeatTriggerTestSuite: failing case - invalid feedId",
-			feedId:           "",
-			signer:           ts.signer,
-			heartbeatTrigger: uint32(1),
-			expPass:          false,
+			description: "MsgRemoveTransmitterTestSuite: failing case - invalid feedId",
+			feedId:      "",
+			signer:      ts.signer,
+			address:     ts.address,
+			expPass:     false,
 		},
 		{
-			description:      "MsgSetHeartbeatTriggerTestSuite: failing case - heartbeatTrigger must not be 0",
-			feedId:           "feedId1",
-			signer:           ts.signer,
-			heartbeatTrigger: uint32(0),
-			expPass:          false,
+			description: "MsgRemoveTransmitterTestSuite: failing case - transmitter address is empty",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			address:     nil,
+			expPass:     false,
 		},
 	}
 
 	for i, tc := range testCases {
-		msg := NewMsgSetHeartbeatTrigger(
+		msg := NewMsgRemoveTransmitter(
 			tc.signer,
 			tc.feedId,
-			tc.heartbeatTrigger,
+			tc.address,
 		)
 		err := msg.ValidateBasic()
 
@@ -814,22 +908,22 @@ func (ts *MsgSetHeartbeatTriggerTestSuite) MsgSetHeartbeatTriggeralidateBasic()
 	}
 }
 
-type MsgSetDeviationThresholdTestSuite struct {
+type MsgSetSubmissionCountTestSuite struct {
 	suite.Suite
 	signer sdk.AccAddress
 }
 
-func TestNewMsgSetDeviationThresholdTestSuite(t *testing.T) {
-	suite.Run(t, new(MsgSetDeviationThresholdTestSuite))
+func TestMsgSetSubmissionCountTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSetSubmissionCountTestSuite))
 }
 
-func (ts *MsgSetDeviationThresholdTestSuite) SetupTest() {
+func (ts *MsgSetSubmissionCountTestSuite) SetupTest() {
 	_, _, signerAddr := GenerateAccount()
 	ts.signer = signerAddr
 }
 
-func (ts *MsgSetDeviationThresholdTestSuite) MsgSetDeviationThresholdConstructor() {
-	msg := NewMsgSetDeviationThreshold(
+func (ts *MsgSetSubmissionCountTestSuite) MsgSetSubmissionCountConstructor() {
+	msg := NewMsgSetSubmissionCount(
 		ts.signer,
 		"feedId1",
 		uint32(1),
@@ -839,47 +933,47 @@ func (ts *MsgSetDeviationThresholdTestSuite) MsgSetDeviationThresholdConstructor
 	signedBytes := sdk.MustSortJSON(bz)
 
 	ts.Require().Equal(msg.Route(), RouterKey)
-	ts.Require().Equal(msg.Type(), SetDeviationThresholdTrigger)
+	ts.Require().Equal(msg.Type(), SetSubmissionCount)
 	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
 	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
 }
 
-func (ts *MsgSetDeviationThresholdTestSuite) MsgSetDeviationThresholdValidateBasic() {
+func (ts *MsgSetSubmissionCountTestSuite) MsgSetSubmissionCountValidateBasic() {
 	testCases := []struct {
-		description               string
-		feedId                    string
-		deviationThresholdTrigger uint32
-		signer                    sdk.AccAddress
-		expPass                   bool
+		description     string
+		feedId          string
+		submissionCount uint32
+		signer          sdk.AccAddress
+		expPass         bool
 	}{
 		{
-			description:               "MsgSetHeartbeatTriggerTestSuite: passing case - all valid values",
-			feedId:                    "feedId1",
-			signer:                    ts.signer,
-			deviationThresholdTrigger: uint32(1),
-			expPass:                   true,
+			description:     "MsgSetSubmissionCountTestSuite: passing case - all valid values",
+			feedId:          "feedId1",
+			signer:          ts.signer,
+			submissionCount: uint32(1),
+			expPass:         true,
 		},
 		{
-			description:               "MsgSetHeartbeatTriggerTestSuite: failing case - invalid feedId",
-			feedId:                    "",
-			signer:                    ts.signer,
-			deviationThresholdTrigger: uint32(1),
-			expPass:                   false,
+			description:     "MsgSetSubmissionCountTestSuite: failing case - invalid feedId",
+			feedId:          "",
+			signer:          ts.signer,
+			submissionCount: uint32(1),
+			expPass:         false,
 		},
 		{
-			description:               "MsgSetHeartbeatTriggerTestSuite: failing case - deviationThresholdTrigger must not be 0",
-			feedId:                    "feedId1",
-			signer:                    ts.signer,
-			deviationThresholdTrigger: uint32(0),
-			expPass:                   false,
+			description:     "MsgSetSubmissionCountTestSuite: failing case - submissionCount must not be 0",
+			feedId:          "feedId1",
+			signer:          ts.signer,
+			submissionCount: uint32(0),
+			expPass:         false,
 		},
 	}
 
 	for i, tc := range testCases {
-		msg := NewMsgSetDeviationThreshold(
+		msg := NewMsgSetSubmissionCount(
 			tc.signer,
 			tc.feedId,
-			tc.deviationThresholdTrigger,
+			tc.submissionCount,
 		)
 		err := msg.ValidateBasic()
 
@@ -891,74 +985,107 @@ func (ts *MsgSetDeviationThresholdTestSuite) MsgSetDeviationThresholdValidateBas
 	}
 }
 
-type MsgSetFeedRewardTestSuite struct {
+type MsgSetFeedMetadataTestSuite struct {
 	suite.Suite
 	signer sdk.AccAddress
 }
 
-func TestMsgSetFeedRewardTestSuite(t *testing.T) {
-	suite.Run(t, new(MsgSetFeedRewardTestSuite))
+func TestMsgSetFeedMetadataTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSetFeedMetadataTestSuite))
 }
 
-func (ts *MsgSetFeedRewardTestSuite) SetupTest() {
+func (ts *MsgSetFeedMetadataTestSuite) SetupTest() {
 	_, _, signerAddr := GenerateAccount()
 	ts.signer = signerAddr
 }
 
-func (ts *MsgSetFeedRewardTestSuite) MsgSetFeedRewardConstructor() {
-	msg := NewMsgSetFeedReward(
+func (ts *MsgSetFeedMetadataTestSuite) TestMsgSetFeedMetadataConstructor() {
+	msg := NewMsgSetFeedMetadata(
 		ts.signer,
 		"feedId1",
-		uint64(1),
-		"",
+		&FeedMetadata{Decimals: 8, Version: 1, BaseAsset: "ATOM", QuoteAsset: "USD"},
 	)
 
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	signedBytes := sdk.MustSortJSON(bz)
 
 	ts.Require().Equal(msg.Route(), RouterKey)
-	ts.Require().Equal(msg.Type(), SetFeedReward)
+	ts.Require().Equal(msg.Type(), SetFeedMetadata)
 	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
 	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
 }
 
-func (ts *MsgSetFeedRewardTestSuite) MsgSetFeedRewardValidateBasic() {
+func (ts *MsgSetFeedMetadataTestSuite) TestMsgSetFeedMetadataValidateBasic() {
 	testCases := []struct {
 		description string
 		feedId      string
-		feedReward  uint64
+		metadata    *FeedMetadata
 		signer      sdk.AccAddress
 		expPass     bool
 	}{
 		{
-			description: "MsgSetFeedRewardTestSuite: passing case - all valid values",
+			description: "MsgSetFeedMetadataTestSuite: passing case - price pair",
 			feedId:      "feedId1",
+			metadata:    &FeedMetadata{Decimals: 8, Version: 1, BaseAsset: "ATOM", QuoteAsset: "USD"},
 			signer:      ts.signer,
-			feedReward:  uint64(1),
 			expPass:     true,
 		},
 		{
-			description: "MsgSetFeedRewardTestSuite: failing case - invalid feedId",
+			description: "MsgSetFeedMetadataTestSuite: passing case - unit only",
+			feedId:      "feedId1",
+			metadata:    &FeedMetadata{Decimals: 2, Unit: "celsius"},
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgSetFeedMetadataTestSuite: failing case - empty signer",
+			feedId:      "feedId1",
+			metadata:    &FeedMetadata{Decimals: 8},
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgSetFeedMetadataTestSuite: failing case - invalid feedId",
 			feedId:      "",
+			metadata:    &FeedMetadata{Decimals: 8},
 			signer:      ts.signer,
-			feedReward:  uint64(1),
 			expPass:     false,
 		},
 		{
-			description: "MsgSetFeedRewardTestSuite: failing case - feedReward must not be 0",
+			description: "MsgSetFeedMetadataTestSuite: failing case - empty metadata",
 			feedId:      "feedId1",
+			metadata:    nil,
+			signer:      ts.signer,
+			expPass:     false,
+		},
+		{
+			description: "MsgSetFeedMetadataTestSuite: failing case - too many decimals",
+			feedId:      "feedId1",
+			metadata:    &FeedMetadata{Decimals: MaxFeedDecimals + 1},
+			signer:      ts.signer,
+			expPass:     false,
+		},
+		{
+			description: "MsgSetFeedMetadataTestSuite: failing case - base asset without quote asset",
+			feedId:      "feedId1",
+			metadata:    &FeedMetadata{Decimals: 8, BaseAsset: "ATOM"},
+			signer:      ts.signer,
+			expPass:     false,
+		},
+		{
+			description: "MsgSetFeedMetadataTestSuite: failing case - unit too long",
+			feedId:      "feedId1",
+			metadata:    &FeedMetadata{Unit: strings.Repeat("u", MaxFeedMetadataLength+1)},
 			signer:      ts.signer,
-			feedReward:  uint64(0),
 			expPass:     false,
 		},
 	}
 
 	for i, tc := range testCases {
-		msg := NewMsgSetFeedReward(
+		msg := NewMsgSetFeedMetadata(
 			tc.signer,
 			tc.feedId,
-			tc.feedReward,
-			"",
+			tc.metadata,
 		)
 		err := msg.ValidateBasic()
 
@@ -970,76 +1097,105 @@ func (ts *MsgSetFeedRewardTestSuite) MsgSetFeedRewardValidateBasic() {
 	}
 }
 
-type MsgFeedOwnershipTransferTestSuite struct {
-	suite.Suite
-	signer       sdk.AccAddress
-	newFeedOwner sdk.AccAddress
+func (ts *MsgSetFeedMetadataTestSuite) TestFeedMetadataValidateDeterministic() {
+	long := strings.Repeat("x", MaxFeedMetadataLength+1)
+	metadata := &FeedMetadata{BaseAsset: long, QuoteAsset: long, Unit: long}
+
+	// every field is invalid, the first one in the fixed order must always be reported
+	for i := 0; i < 20; i++ {
+		err := metadata.Validate()
+		ts.Require().Error(err)
+		ts.Require().Contains(err.Error(), "baseAsset can not be longer than")
+	}
 }
 
-func TestMsgFeedOwnershipTransferTestSuite(t *testing.T) {
-	suite.Run(t, new(MsgFeedOwnershipTransferTestSuite))
+type MsgSetAnswerBoundsTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
 }
 
-func (ts *MsgFeedOwnershipTransferTestSuite) SetupTest() {
+func TestMsgSetAnswerBoundsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSetAnswerBoundsTestSuite))
+}
+
+func (ts *MsgSetAnswerBoundsTestSuite) SetupTest() {
 	_, _, signerAddr := GenerateAccount()
 	ts.signer = signerAddr
-
-	_, _, newFeedOwnerAddr := GenerateAccount()
-	ts.newFeedOwner = newFeedOwnerAddr
 }
 
-func (ts *MsgFeedOwnershipTransferTestSuite) MsgFeedOwnershipTransferConstructor() {
-	msg := NewMsgFeedOwnershipTransfer(
+func (ts *MsgSetAnswerBoundsTestSuite) TestMsgSetAnswerBoundsConstructor() {
+	msg := NewMsgSetAnswerBounds(
 		ts.signer,
 		"feedId1",
-		ts.newFeedOwner,
+		&AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(100)},
 	)
 
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	signedBytes := sdk.MustSortJSON(bz)
 
 	ts.Require().Equal(msg.Route(), RouterKey)
-	ts.Require().Equal(msg.Type(), FeedOwnershipTransfer)
+	ts.Require().Equal(msg.Type(), SetAnswerBounds)
 	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
 	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
 }
 
-func (ts *MsgFeedOwnershipTransferTestSuite) MsgFeedOwnershipTransferValidateBasic() {
+func (ts *MsgSetAnswerBoundsTestSuite) TestMsgSetAnswerBoundsValidateBasic() {
 	testCases := []struct {
 		description  string
 		feedId       string
+		answerBounds *AnswerBounds
 		signer       sdk.AccAddress
-		newFeedOwner sdk.AccAddress
 		expPass      bool
 	}{
 		{
-			description:  "MsgFeedOwnershipTransferTestSuite: passing case - all valid values",
+			description:  "MsgSetAnswerBoundsTestSuite: passing case - all valid values",
 			feedId:       "feedId1",
+			answerBounds: &AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(100), Mode: AnswerBoundsModeFlag},
 			signer:       ts.signer,
-			newFeedOwner: ts.newFeedOwner,
 			expPass:      true,
 		},
 		{
-			description:  "MsgFeedOwnershipTransferTestSuite: failing case - signer can not be empty",
+			description:  "MsgSetAnswerBoundsTestSuite: passing case - remove bounds",
+			feedId:       "feedId1",
+			answerBounds: nil,
+			signer:       ts.signer,
+			expPass:      true,
+		},
+		{
+			description:  "MsgSetAnswerBoundsTestSuite: failing case - signer can not be empty",
 			feedId:       "feedId1",
+			answerBounds: &AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(100)},
 			signer:       nil,
-			newFeedOwner: ts.newFeedOwner,
 			expPass:      false,
 		},
 		{
-			description:  "MsgFeedOwnershipTransferTestSuite: failing case - feedId can not be empty",
+			description:  "MsgSetAnswerBoundsTestSuite: failing case - feedId can not be empty",
 			feedId:       "",
+			answerBounds: &AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(100)},
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetAnswerBoundsTestSuite: failing case - minAnswer greater than maxAnswer",
+			feedId:       "feedId1",
+			answerBounds: &AnswerBounds{MinAnswer: sdk.NewInt(101), MaxAnswer: sdk.NewInt(100)},
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetAnswerBoundsTestSuite: failing case - invalid mode",
+			feedId:       "feedId1",
+			answerBounds: &AnswerBounds{MinAnswer: sdk.NewInt(1), MaxAnswer: sdk.NewInt(100), Mode: "ignore"},
 			signer:       ts.signer,
-			newFeedOwner: ts.newFeedOwner,
 			expPass:      false,
 		},
 	}
 
 	for i, tc := range testCases {
-		msg := NewMsgFeedOwnershipTransfer(
+		msg := NewMsgSetAnswerBounds(
 			tc.signer,
 			tc.feedId,
-			tc.newFeedOwner,
+			tc.answerBounds,
 		)
 		err := msg.ValidateBasic()
 
@@ -1051,71 +1207,232 @@ func (ts *MsgFeedOwnershipTransferTestSuite) MsgFeedOwnershipTransferValidateBas
 	}
 }
 
-type MsgRequestNewRoundTestSuite struct {
+type MsgSetOCRConfigTestSuite struct {
 	suite.Suite
 	signer       sdk.AccAddress
-	newFeedOwner sdk.AccAddress
+	signingKeys  [][]byte
+	transmitters []sdk.AccAddress
 }
 
-func TestMsgRequestNewRoundTestSuite(t *testing.T) {
-	suite.Run(t, new(MsgRequestNewRoundTestSuite))
+func TestMsgSetOCRConfigTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSetOCRConfigTestSuite))
 }
 
-func (ts *MsgRequestNewRoundTestSuite) SetupTest() {
+func (ts *MsgSetOCRConfigTestSuite) SetupTest() {
 	_, _, signerAddr := GenerateAccount()
 	ts.signer = signerAddr
-
-	_, _, newFeedOwnerAddr := GenerateAccount()
-	ts.newFeedOwner = newFeedOwnerAddr
+	ts.signingKeys = nil
+	ts.transmitters = nil
+	for i := 0; i < 4; i++ {
+		_, _, transmitter := GenerateAccount()
+		key, err := crypto.GenerateKey()
+		ts.Require().NoError(err)
+		ts.signingKeys = append(ts.signingKeys, []byte(hex.EncodeToString(crypto.CompressPubkey(&key.PublicKey))))
+		ts.transmitters = append(ts.transmitters, transmitter)
+	}
 }
 
-func (ts *MsgRequestNewRoundTestSuite) MsgRequestNewRoundConstructor() {
-	msg := NewMsgRequestNewRound(
-		ts.signer,
-		"feedId1",
-	)
+func (ts *MsgSetOCRConfigTestSuite) TestMsgSetOCRConfigConstructor() {
+	msg := NewMsgSetOCRConfig(ts.signer, "feedId1", ts.signingKeys, ts.transmitters, 1, []byte("onchain"), 1, []byte("offchain"))
 
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	signedBytes := sdk.MustSortJSON(bz)
 
 	ts.Require().Equal(msg.Route(), RouterKey)
-	ts.Require().Equal(msg.Type(), FeedOwnershipTransfer)
+	ts.Require().Equal(msg.Type(), SetOCRConfig)
 	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
 	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
 }
 
-func (ts *MsgRequestNewRoundTestSuite) MsgRequestNewRoundValidateBasic() {
+func (ts *MsgSetOCRConfigTestSuite) TestMsgSetOCRConfigValidateBasic() {
 	testCases := []struct {
 		description  string
 		feedId       string
+		signingKeys  [][]byte
+		transmitters []sdk.AccAddress
+		f            uint32
 		signer       sdk.AccAddress
-		newFeedOwner sdk.AccAddress
 		expPass      bool
 	}{
 		{
-			description: "MsgRequestNewRoundTestSuite: passing case - all valid values",
-			feedId:      "feedId1",
-			signer:      ts.signer,
-			expPass:     true,
+			description:  "MsgSetOCRConfigTestSuite: passing case - all valid values",
+			feedId:       "feedId1",
+			signingKeys:  ts.signingKeys,
+			transmitters: ts.transmitters,
+			f:            1,
+			signer:       ts.signer,
+			expPass:      true,
 		},
 		{
-			description: "MsgRequestNewRoundTestSuite: failing case - signer can not be empty",
-			feedId:      "feedId1",
-			signer:      nil,
-			expPass:     false,
+			description:  "MsgSetOCRConfigTestSuite: failing case - signer can not be empty",
+			feedId:       "feedId1",
+			signingKeys:  ts.signingKeys,
+			transmitters: ts.transmitters,
+			f:            1,
+			signer:       nil,
+			expPass:      false,
 		},
 		{
-			description: "MsgRequestNewRoundTestSuite: failing case - feedId can not be empty",
-			feedId:      "",
-			signer:      ts.signer,
-			expPass:     false,
+			description:  "MsgSetOCRConfigTestSuite: failing case - feedId can not be empty",
+			feedId:       "",
+			signingKeys:  ts.signingKeys,
+			transmitters: ts.transmitters,
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - signing keys can not be empty",
+			feedId:       "feedId1",
+			signingKeys:  nil,
+			transmitters: nil,
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - one transmitter per signing key",
+			feedId:       "feedId1",
+			signingKeys:  ts.signingKeys,
+			transmitters: ts.transmitters[:3],
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - f must be positive",
+			feedId:       "feedId1",
+			signingKeys:  ts.signingKeys,
+			transmitters: ts.transmitters,
+			f:            0,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - too few oracles for f",
+			feedId:       "feedId1",
+			signingKeys:  ts.signingKeys[:3],
+			transmitters: ts.transmitters[:3],
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - repeated signing key",
+			feedId:       "feedId1",
+			signingKeys:  [][]byte{ts.signingKeys[0], ts.signingKeys[1], ts.signingKeys[2], ts.signingKeys[0]},
+			transmitters: ts.transmitters,
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - repeated signing key in another encoding",
+			feedId:       "feedId1",
+			signingKeys:  [][]byte{ts.signingKeys[0], ts.signingKeys[1], ts.signingKeys[2], []byte("0x" + string(ts.signingKeys[0]))},
+			transmitters: ts.transmitters,
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - invalid signing key",
+			feedId:       "feedId1",
+			signingKeys:  [][]byte{ts.signingKeys[0], ts.signingKeys[1], ts.signingKeys[2], []byte("chainlinkPubKey3")},
+			transmitters: ts.transmitters,
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
+		},
+		{
+			description:  "MsgSetOCRConfigTestSuite: failing case - repeated transmitter",
+			feedId:       "feedId1",
+			signingKeys:  ts.signingKeys,
+			transmitters: []sdk.AccAddress{ts.transmitters[0], ts.transmitters[1], ts.transmitters[2], ts.transmitters[0]},
+			f:            1,
+			signer:       ts.signer,
+			expPass:      false,
 		},
 	}
 
 	for i, tc := range testCases {
-		msg := NewMsgRequestNewRound(
+		msg := NewMsgSetOCRConfig(tc.signer, tc.feedId, tc.signingKeys, tc.transmitters, tc.f, nil, 0, nil)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgSetHeartbeatTriggerTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgSetHeartbeatTriggerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSetHeartbeatTriggerTestSuite))
+}
+
+func (ts *MsgSetHeartbeatTriggerTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgSetHeartbeatTriggerTestSuite) MsgSetHeartbeatTriggerConstructor() {
+	msg := NewMsgSetHeartbeatTrigger(
+		ts.signer,
+		"feedId1",
+		uint32(1),
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), SetHeartbeatTrigger)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgSetHeartbeatTriggerTestSuite) MsgSetHeartbeatTriggeralidateBasic() {
+	testCases := []struct {
+		description      string
+		feedId           string
+		heartbeatTrigger uint32
+		signer           sdk.AccAddress
+		expPass          bool
+	}{
+		{
+			description:      "MsgSetHeartbeatTriggerTestSuite: passing case - all valid values",
+			feedId:           "feedId1",
+			signer:           ts.signer,
+			heartbeatTrigger: uint32(1),
+			expPass:          true,
+		},
+		{
+			description:      "MsgSetHeartbeatTriggerTestSuite: failing case - invalid feedId",
+			feedId:           "",
+			signer:           ts.signer,
+			heartbeatTrigger: uint32(1),
+			expPass:          false,
+		},
+		{
+			description:      "MsgSetHeartbeatTriggerTestSuite: failing case - heartbeatTrigger must not be 0",
+			feedId:           "feedId1",
+			signer:           ts.signer,
+			heartbeatTrigger: uint32(0),
+			expPass:          false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgSetHeartbeatTrigger(
 			tc.signer,
 			tc.feedId,
+			tc.heartbeatTrigger,
 		)
 		err := msg.ValidateBasic()
 
@@ -1126,3 +1443,1341 @@ func (ts *MsgRequestNewRoundTestSuite) MsgRequestNewRoundValidateBasic() {
 		}
 	}
 }
+
+type MsgSetDeviationThresholdTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestNewMsgSetDeviationThresholdTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSetDeviationThresholdTestSuite))
+}
+
+func (ts *MsgSetDeviationThresholdTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgSetDeviationThresholdTestSuite) MsgSetDeviationThresholdConstructor() {
+	msg := NewMsgSetDeviationThreshold(
+		ts.signer,
+		"feedId1",
+		uint32(1),
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), SetDeviationThresholdTrigger)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgSetDeviationThresholdTestSuite) MsgSetDeviationThresholdValidateBasic() {
+	testCases := []struct {
+		description               string
+		feedId                    string
+		deviationThresholdTrigger uint32
+		signer                    sdk.AccAddress
+		deviationThresholdPolicy  string
+		expPass                   bool
+	}{
+		{
+			description:               "MsgSetHeartbeatTriggerTestSuite: passing case - all valid values",
+			feedId:                    "feedId1",
+			signer:                    ts.signer,
+			deviationThresholdTrigger: uint32(1),
+			expPass:                   true,
+		},
+		{
+			description:               "MsgSetHeartbeatTriggerTestSuite: failing case - invalid feedId",
+			feedId:                    "",
+			signer:                    ts.signer,
+			deviationThresholdTrigger: uint32(1),
+			expPass:                   false,
+		},
+		{
+			description:               "MsgSetHeartbeatTriggerTestSuite: failing case - deviationThresholdTrigger must not be 0",
+			feedId:                    "feedId1",
+			signer:                    ts.signer,
+			deviationThresholdTrigger: uint32(0),
+			expPass:                   false,
+		},
+		{
+			description:               "MsgSetHeartbeatTriggerTestSuite: passing case - nonRewardable deviationThresholdPolicy",
+			feedId:                    "feedId1",
+			signer:                    ts.signer,
+			deviationThresholdTrigger: uint32(1),
+			deviationThresholdPolicy:  DeviationThresholdPolicyNonRewardable,
+			expPass:                   true,
+		},
+		{
+			description:               "MsgSetHeartbeatTriggerTestSuite: failing case - invalid deviationThresholdPolicy",
+			feedId:                    "feedId1",
+			signer:                    ts.signer,
+			deviationThresholdTrigger: uint32(1),
+			deviationThresholdPolicy:  "ignore",
+			expPass:                   false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgSetDeviationThreshold(
+			tc.signer,
+			tc.feedId,
+			tc.deviationThresholdTrigger,
+		)
+		msg.DeviationThresholdPolicy = tc.deviationThresholdPolicy
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgSetFeedRewardTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgSetFeedRewardTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgSetFeedRewardTestSuite))
+}
+
+func (ts *MsgSetFeedRewardTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgSetFeedRewardTestSuite) MsgSetFeedRewardConstructor() {
+	msg := NewMsgSetFeedReward(
+		ts.signer,
+		"feedId1",
+		uint64(1),
+		"",
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), SetFeedReward)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgSetFeedRewardTestSuite) MsgSetFeedRewardValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		feedReward  uint64
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgSetFeedRewardTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			feedReward:  uint64(1),
+			expPass:     true,
+		},
+		{
+			description: "MsgSetFeedRewardTestSuite: failing case - invalid feedId",
+			feedId:      "",
+			signer:      ts.signer,
+			feedReward:  uint64(1),
+			expPass:     false,
+		},
+		{
+			description: "MsgSetFeedRewardTestSuite: failing case - feedReward must not be 0",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			feedReward:  uint64(0),
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgSetFeedReward(
+			tc.signer,
+			tc.feedId,
+			tc.feedReward,
+			"",
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgFeedOwnershipTransferTestSuite struct {
+	suite.Suite
+	signer       sdk.AccAddress
+	newFeedOwner sdk.AccAddress
+}
+
+func TestMsgFeedOwnershipTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgFeedOwnershipTransferTestSuite))
+}
+
+func (ts *MsgFeedOwnershipTransferTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+
+	_, _, newFeedOwnerAddr := GenerateAccount()
+	ts.newFeedOwner = newFeedOwnerAddr
+}
+
+func (ts *MsgFeedOwnershipTransferTestSuite) MsgFeedOwnershipTransferConstructor() {
+	msg := NewMsgFeedOwnershipTransfer(
+		ts.signer,
+		"feedId1",
+		ts.newFeedOwner,
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), FeedOwnershipTransfer)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgFeedOwnershipTransferTestSuite) MsgFeedOwnershipTransferValidateBasic() {
+	testCases := []struct {
+		description  string
+		feedId       string
+		signer       sdk.AccAddress
+		newFeedOwner sdk.AccAddress
+		expPass      bool
+	}{
+		{
+			description:  "MsgFeedOwnershipTransferTestSuite: passing case - all valid values",
+			feedId:       "feedId1",
+			signer:       ts.signer,
+			newFeedOwner: ts.newFeedOwner,
+			expPass:      true,
+		},
+		{
+			description:  "MsgFeedOwnershipTransferTestSuite: failing case - signer can not be empty",
+			feedId:       "feedId1",
+			signer:       nil,
+			newFeedOwner: ts.newFeedOwner,
+			expPass:      false,
+		},
+		{
+			description:  "MsgFeedOwnershipTransferTestSuite: failing case - feedId can not be empty",
+			feedId:       "",
+			signer:       ts.signer,
+			newFeedOwner: ts.newFeedOwner,
+			expPass:      false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgFeedOwnershipTransfer(
+			tc.signer,
+			tc.feedId,
+			tc.newFeedOwner,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgRequestNewRoundTestSuite struct {
+	suite.Suite
+	signer       sdk.AccAddress
+	newFeedOwner sdk.AccAddress
+}
+
+func TestMsgRequestNewRoundTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgRequestNewRoundTestSuite))
+}
+
+func (ts *MsgRequestNewRoundTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+
+	_, _, newFeedOwnerAddr := GenerateAccount()
+	ts.newFeedOwner = newFeedOwnerAddr
+}
+
+func (ts *MsgRequestNewRoundTestSuite) MsgRequestNewRoundConstructor() {
+	msg := NewMsgRequestNewRound(
+		ts.signer,
+		"feedId1",
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), FeedOwnershipTransfer)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgRequestNewRoundTestSuite) MsgRequestNewRoundValidateBasic() {
+	testCases := []struct {
+		description  string
+		feedId       string
+		signer       sdk.AccAddress
+		newFeedOwner sdk.AccAddress
+		expPass      bool
+	}{
+		{
+			description: "MsgRequestNewRoundTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgRequestNewRoundTestSuite: failing case - signer can not be empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgRequestNewRoundTestSuite: failing case - feedId can not be empty",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgRequestNewRound(
+			tc.signer,
+			tc.feedId,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgPauseFeedTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgPauseFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgPauseFeedTestSuite))
+}
+
+func (ts *MsgPauseFeedTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgPauseFeedTestSuite) TestMsgPauseFeedConstructor() {
+	msg := NewMsgPauseFeed(
+		ts.signer,
+		"feedId1",
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), PauseFeed)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgPauseFeedTestSuite) TestMsgPauseFeedValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgPauseFeedTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgPauseFeedTestSuite: failing case - signer can not be empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgPauseFeedTestSuite: failing case - feedId can not be empty",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgPauseFeed(
+			tc.signer,
+			tc.feedId,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgUnpauseFeedTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgUnpauseFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgUnpauseFeedTestSuite))
+}
+
+func (ts *MsgUnpauseFeedTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgUnpauseFeedTestSuite) TestMsgUnpauseFeedConstructor() {
+	msg := NewMsgUnpauseFeed(
+		ts.signer,
+		"feedId1",
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), UnpauseFeed)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgUnpauseFeedTestSuite) TestMsgUnpauseFeedValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgUnpauseFeedTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgUnpauseFeedTestSuite: failing case - signer can not be empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgUnpauseFeedTestSuite: failing case - feedId can not be empty",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgUnpauseFeed(
+			tc.signer,
+			tc.feedId,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgDeprecateFeedTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgDeprecateFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgDeprecateFeedTestSuite))
+}
+
+func (ts *MsgDeprecateFeedTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgDeprecateFeedTestSuite) TestMsgDeprecateFeedConstructor() {
+	msg := NewMsgDeprecateFeed(
+		ts.signer,
+		"feedId1",
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), DeprecateFeed)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgDeprecateFeedTestSuite) TestMsgDeprecateFeedValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgDeprecateFeedTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgDeprecateFeedTestSuite: failing case - signer can not be empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgDeprecateFeedTestSuite: failing case - feedId can not be empty",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgDeprecateFeed(
+			tc.signer,
+			tc.feedId,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgDeleteFeedTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgDeleteFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgDeleteFeedTestSuite))
+}
+
+func (ts *MsgDeleteFeedTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgDeleteFeedTestSuite) TestMsgDeleteFeedConstructor() {
+	msg := NewMsgDeleteFeed(
+		ts.signer,
+		"feedId1",
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), DeleteFeed)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgDeleteFeedTestSuite) TestMsgDeleteFeedValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgDeleteFeedTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgDeleteFeedTestSuite: failing case - signer can not be empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgDeleteFeedTestSuite: failing case - feedId can not be empty",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgDeleteFeed(
+			tc.signer,
+			tc.feedId,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+func TestMsgFeedData_RewardCalculator(t *testing.T) {
+	_, signerPubKey, signerAddr := GenerateAccount()
+	_, _, transmitterAddr := GenerateAccount()
+	feed := &MsgFeed{FeedReward: &FeedRewardSchema{Amount: 10}}
+	feedData := &MsgFeedData{Submitter: transmitterAddr, CosmosPubKeys: [][]byte{[]byte(signerPubKey)},
+		ObservationFeedDataSignatures: [][]byte{[]byte("signature")}}
+
+	payouts, total, err := feedData.RewardCalculator(feed, feedData)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), total)
+	require.Equal(t, []RewardPayout{
+		{DataProvider: &DataProvider{Address: signerAddr}, Amount: 10, Role: RewardRoleSigner},
+		{DataProvider: &DataProvider{Address: transmitterAddr}, Role: RewardRoleTransmitter},
+	}, payouts)
+
+	// a signer transmitting its own report is paid once per role
+	feedData.Submitter = signerAddr
+	payouts, _, err = feedData.RewardCalculator(feed, feedData)
+	require.NoError(t, err)
+	require.Len(t, payouts, 2)
+	require.Equal(t, signerAddr, payouts[1].DataProvider.GetAddress())
+	require.Equal(t, RewardRoleTransmitter, payouts[1].Role)
+}
+
+type MsgFundFeedTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgFundFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgFundFeedTestSuite))
+}
+
+func (ts *MsgFundFeedTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgFundFeedTestSuite) TestMsgFundFeedConstructor() {
+	msg := NewMsgFundFeed(
+		ts.signer,
+		"feedId1",
+		100,
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), FundFeed)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgFundFeedTestSuite) TestMsgFundFeedValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		amount      uint64
+		expPass     bool
+	}{
+		{
+			description: "MsgFundFeedTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			amount:      100,
+			expPass:     true,
+		},
+		{
+			description: "MsgFundFeedTestSuite: failing case - invalid feedId",
+			feedId:      "",
+			signer:      ts.signer,
+			amount:      100,
+			expPass:     false,
+		},
+		{
+			description: "MsgFundFeedTestSuite: failing case - signer is empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			amount:      100,
+			expPass:     false,
+		},
+		{
+			description: "MsgFundFeedTestSuite: failing case - amount is 0",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			amount:      0,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgFundFeed(
+			tc.signer,
+			tc.feedId,
+			tc.amount,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgWithdrawFeedFundsTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgWithdrawFeedFundsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgWithdrawFeedFundsTestSuite))
+}
+
+func (ts *MsgWithdrawFeedFundsTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgWithdrawFeedFundsTestSuite) TestMsgWithdrawFeedFundsConstructor() {
+	msg := NewMsgWithdrawFeedFunds(
+		ts.signer,
+		"feedId1",
+		100,
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), WithdrawFeedFunds)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgWithdrawFeedFundsTestSuite) TestMsgWithdrawFeedFundsValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		amount      uint64
+		expPass     bool
+	}{
+		{
+			description: "MsgWithdrawFeedFundsTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			amount:      100,
+			expPass:     true,
+		},
+		{
+			description: "MsgWithdrawFeedFundsTestSuite: failing case - invalid feedId",
+			feedId:      "",
+			signer:      ts.signer,
+			amount:      100,
+			expPass:     false,
+		},
+		{
+			description: "MsgWithdrawFeedFundsTestSuite: failing case - signer is empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			amount:      100,
+			expPass:     false,
+		},
+		{
+			description: "MsgWithdrawFeedFundsTestSuite: failing case - amount is 0",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			amount:      0,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgWithdrawFeedFunds(
+			tc.signer,
+			tc.feedId,
+			tc.amount,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgWithdrawPaymentTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgWithdrawPaymentTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgWithdrawPaymentTestSuite))
+}
+
+func (ts *MsgWithdrawPaymentTestSuite) SetupTest() {
+	_, _, signerAddr := GenerateAccount()
+	ts.signer = signerAddr
+}
+
+func (ts *MsgWithdrawPaymentTestSuite) TestMsgWithdrawPaymentConstructor() {
+	msg := NewMsgWithdrawPayment(
+		ts.signer,
+		"feedId1",
+	)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), WithdrawPayment)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgWithdrawPaymentTestSuite) TestMsgWithdrawPaymentValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgWithdrawPaymentTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgWithdrawPaymentTestSuite: passing case - every feed",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgWithdrawPaymentTestSuite: failing case - signer is empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgWithdrawPayment(
+			tc.signer,
+			tc.feedId,
+		)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgRemoveModuleOwnerTestSuite struct {
+	suite.Suite
+	assigner    sdk.AccAddress
+	moduleOwner sdk.AccAddress
+}
+
+func TestMsgRemoveModuleOwnerTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgRemoveModuleOwnerTestSuite))
+}
+
+func (ts *MsgRemoveModuleOwnerTestSuite) SetupTest() {
+	_, _, ts.assigner = GenerateAccount()
+	_, _, ts.moduleOwner = GenerateAccount()
+}
+
+func (ts *MsgRemoveModuleOwnerTestSuite) TestMsgRemoveModuleOwnerConstructor() {
+	msg := NewMsgRemoveModuleOwner(ts.assigner, ts.moduleOwner)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), RemoveModuleOwner)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.assigner})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgRemoveModuleOwnerTestSuite) TestMsgRemoveModuleOwnerValidateBasic() {
+	testCases := []struct {
+		description string
+		assigner    sdk.AccAddress
+		moduleOwner sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgRemoveModuleOwnerTestSuite: passing case - all valid values",
+			assigner:    ts.assigner,
+			moduleOwner: ts.moduleOwner,
+			expPass:     true,
+		},
+		{
+			description: "MsgRemoveModuleOwnerTestSuite: passing case - a module owner removes itself",
+			assigner:    ts.assigner,
+			moduleOwner: ts.assigner,
+			expPass:     true,
+		},
+		{
+			description: "MsgRemoveModuleOwnerTestSuite: failing case - assigner can not be empty",
+			assigner:    nil,
+			moduleOwner: ts.moduleOwner,
+			expPass:     false,
+		},
+		{
+			description: "MsgRemoveModuleOwnerTestSuite: failing case - module owner can not be empty",
+			assigner:    ts.assigner,
+			moduleOwner: nil,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgRemoveModuleOwner(tc.assigner, tc.moduleOwner)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgApproveOwnerProposalTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgApproveOwnerProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgApproveOwnerProposalTestSuite))
+}
+
+func (ts *MsgApproveOwnerProposalTestSuite) SetupTest() {
+	_, _, ts.signer = GenerateAccount()
+}
+
+func (ts *MsgApproveOwnerProposalTestSuite) TestMsgApproveOwnerProposalConstructor() {
+	msg := NewMsgApproveOwnerProposal(ts.signer, 1)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), ApproveOwnerProposal)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgApproveOwnerProposalTestSuite) TestMsgApproveOwnerProposalValidateBasic() {
+	testCases := []struct {
+		description string
+		proposalId  uint64
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgApproveOwnerProposalTestSuite: passing case - all valid values",
+			proposalId:  1,
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgApproveOwnerProposalTestSuite: failing case - signer can not be empty",
+			proposalId:  1,
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgApproveOwnerProposalTestSuite: failing case - proposalId can not be 0",
+			proposalId:  0,
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgApproveOwnerProposal(tc.signer, tc.proposalId)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgCancelOwnerProposalTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgCancelOwnerProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgCancelOwnerProposalTestSuite))
+}
+
+func (ts *MsgCancelOwnerProposalTestSuite) SetupTest() {
+	_, _, ts.signer = GenerateAccount()
+}
+
+func (ts *MsgCancelOwnerProposalTestSuite) TestMsgCancelOwnerProposalConstructor() {
+	msg := NewMsgCancelOwnerProposal(ts.signer, 1)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), CancelOwnerProposal)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgCancelOwnerProposalTestSuite) TestMsgCancelOwnerProposalValidateBasic() {
+	testCases := []struct {
+		description string
+		proposalId  uint64
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgCancelOwnerProposalTestSuite: passing case - all valid values",
+			proposalId:  1,
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgCancelOwnerProposalTestSuite: failing case - signer can not be empty",
+			proposalId:  1,
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgCancelOwnerProposalTestSuite: failing case - proposalId can not be 0",
+			proposalId:  0,
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgCancelOwnerProposal(tc.signer, tc.proposalId)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgAcceptFeedOwnershipTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgAcceptFeedOwnershipTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgAcceptFeedOwnershipTestSuite))
+}
+
+func (ts *MsgAcceptFeedOwnershipTestSuite) SetupTest() {
+	_, _, ts.signer = GenerateAccount()
+}
+
+func (ts *MsgAcceptFeedOwnershipTestSuite) TestMsgAcceptFeedOwnershipConstructor() {
+	msg := NewMsgAcceptFeedOwnership(ts.signer, "feedId1")
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), AcceptFeedOwnership)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgAcceptFeedOwnershipTestSuite) TestMsgAcceptFeedOwnershipValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgAcceptFeedOwnershipTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgAcceptFeedOwnershipTestSuite: failing case - signer can not be empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgAcceptFeedOwnershipTestSuite: failing case - feedId can not be empty",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgAcceptFeedOwnership(tc.signer, tc.feedId)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgCancelFeedOwnershipTransferTestSuite struct {
+	suite.Suite
+	signer sdk.AccAddress
+}
+
+func TestMsgCancelFeedOwnershipTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgCancelFeedOwnershipTransferTestSuite))
+}
+
+func (ts *MsgCancelFeedOwnershipTransferTestSuite) SetupTest() {
+	_, _, ts.signer = GenerateAccount()
+}
+
+func (ts *MsgCancelFeedOwnershipTransferTestSuite) TestMsgCancelFeedOwnershipTransferConstructor() {
+	msg := NewMsgCancelFeedOwnershipTransfer(ts.signer, "feedId1")
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), CancelFeedOwnershipTransfer)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgCancelFeedOwnershipTransferTestSuite) TestMsgCancelFeedOwnershipTransferValidateBasic() {
+	testCases := []struct {
+		description string
+		feedId      string
+		signer      sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgCancelFeedOwnershipTransferTestSuite: passing case - all valid values",
+			feedId:      "feedId1",
+			signer:      ts.signer,
+			expPass:     true,
+		},
+		{
+			description: "MsgCancelFeedOwnershipTransferTestSuite: failing case - signer can not be empty",
+			feedId:      "feedId1",
+			signer:      nil,
+			expPass:     false,
+		},
+		{
+			description: "MsgCancelFeedOwnershipTransferTestSuite: failing case - feedId can not be empty",
+			feedId:      "",
+			signer:      ts.signer,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgCancelFeedOwnershipTransfer(tc.signer, tc.feedId)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgAcceptModuleOwnershipTestSuite struct {
+	suite.Suite
+	signer   sdk.AccAddress
+	assigner sdk.AccAddress
+}
+
+func TestMsgAcceptModuleOwnershipTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgAcceptModuleOwnershipTestSuite))
+}
+
+func (ts *MsgAcceptModuleOwnershipTestSuite) SetupTest() {
+	_, _, ts.signer = GenerateAccount()
+	_, _, ts.assigner = GenerateAccount()
+}
+
+func (ts *MsgAcceptModuleOwnershipTestSuite) TestMsgAcceptModuleOwnershipConstructor() {
+	msg := NewMsgAcceptModuleOwnership(ts.signer, ts.assigner)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), AcceptModuleOwnership)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgAcceptModuleOwnershipTestSuite) TestMsgAcceptModuleOwnershipValidateBasic() {
+	testCases := []struct {
+		description string
+		signer      sdk.AccAddress
+		assigner    sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgAcceptModuleOwnershipTestSuite: passing case - all valid values",
+			signer:      ts.signer,
+			assigner:    ts.assigner,
+			expPass:     true,
+		},
+		{
+			description: "MsgAcceptModuleOwnershipTestSuite: failing case - signer can not be empty",
+			signer:      nil,
+			assigner:    ts.assigner,
+			expPass:     false,
+		},
+		{
+			description: "MsgAcceptModuleOwnershipTestSuite: failing case - assigner address can not be empty",
+			signer:      ts.signer,
+			assigner:    nil,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgAcceptModuleOwnership(tc.signer, tc.assigner)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
+
+type MsgCancelModuleOwnershipTransferTestSuite struct {
+	suite.Suite
+	signer   sdk.AccAddress
+	assigner sdk.AccAddress
+}
+
+func TestMsgCancelModuleOwnershipTransferTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgCancelModuleOwnershipTransferTestSuite))
+}
+
+func (ts *MsgCancelModuleOwnershipTransferTestSuite) SetupTest() {
+	_, _, ts.signer = GenerateAccount()
+	_, _, ts.assigner = GenerateAccount()
+}
+
+func (ts *MsgCancelModuleOwnershipTransferTestSuite) TestMsgCancelModuleOwnershipTransferConstructor() {
+	msg := NewMsgCancelModuleOwnershipTransfer(ts.signer, ts.assigner)
+
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	signedBytes := sdk.MustSortJSON(bz)
+
+	ts.Require().Equal(msg.Route(), RouterKey)
+	ts.Require().Equal(msg.Type(), CancelModuleOwnershipTransfer)
+	ts.Require().Equal(msg.GetSigners(), []sdk.AccAddress{ts.signer})
+	ts.Require().Equal(msg.GetSignBytes(), signedBytes)
+}
+
+func (ts *MsgCancelModuleOwnershipTransferTestSuite) TestMsgCancelModuleOwnershipTransferValidateBasic() {
+	testCases := []struct {
+		description string
+		signer      sdk.AccAddress
+		assigner    sdk.AccAddress
+		expPass     bool
+	}{
+		{
+			description: "MsgCancelModuleOwnershipTransferTestSuite: passing case - all valid values",
+			signer:      ts.signer,
+			assigner:    ts.assigner,
+			expPass:     true,
+		},
+		{
+			description: "MsgCancelModuleOwnershipTransferTestSuite: failing case - signer can not be empty",
+			signer:      nil,
+			assigner:    ts.assigner,
+			expPass:     false,
+		},
+		{
+			description: "MsgCancelModuleOwnershipTransferTestSuite: failing case - assigner address can not be empty",
+			signer:      ts.signer,
+			assigner:    nil,
+			expPass:     false,
+		},
+	}
+
+	for i, tc := range testCases {
+		msg := NewMsgCancelModuleOwnershipTransfer(tc.signer, tc.assigner)
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			ts.Require().NoError(err, "valid test %d failed: %s, %v", i, tc.description)
+		} else {
+			ts.Require().Error(err, "invalid test %d passed: %s, %v", i, tc.description)
+		}
+	}
+}
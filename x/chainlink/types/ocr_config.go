@@ -0,0 +1,119 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// OCRConfigDigestLength is the length of the config digest carried by the report context
+const OCRConfigDigestLength = 16
+
+// ocrConfigDigestArguments describes the ABI layout hashed into the config digest:
+// abi.encode(string chainId, string feedId, uint64 configCount, bytes[] signingKeys, bytes[] transmitters, uint32 f,
+// bytes onchainConfig, uint64 offchainConfigVersion, bytes offchainConfig)
+var ocrConfigDigestArguments abi.Arguments
+
+func init() {
+	argumentTypes := []string{"string", "string", "uint64", "bytes[]", "bytes[]", "uint32", "bytes", "uint64", "bytes"}
+	for _, t := range argumentTypes {
+		argumentType, err := abi.NewType(t, "", nil)
+		if err != nil {
+			panic(err)
+		}
+		ocrConfigDigestArguments = append(ocrConfigDigestArguments, abi.Argument{Type: argumentType})
+	}
+}
+
+// ComputeConfigDigest computes the digest of an OCR config like the OCR aggregator does: the keccak256 hash of
+// the ABI encoded config, scoped to the chain and the feed, truncated to the 16 bytes carried by the report context
+func ComputeConfigDigest(chainId, feedId string, configCount uint64, config *OCRConfig) []byte {
+	transmitters := make([][]byte, 0, len(config.GetTransmitters()))
+	for _, t := range config.GetTransmitters() {
+		transmitters = append(transmitters, t)
+	}
+	signingKeys := config.GetSigningKeys()
+	if signingKeys == nil {
+		signingKeys = [][]byte{}
+	}
+
+	encoded, err := ocrConfigDigestArguments.Pack(chainId, feedId, configCount, signingKeys, transmitters, config.GetF(),
+		config.GetOnchainConfig(), config.GetOffchainConfigVersion(), config.GetOffchainConfig())
+	if err != nil {
+		// every argument is typed, packing can not fail
+		panic(err)
+	}
+
+	return crypto.Keccak256(encoded)[:OCRConfigDigestLength]
+}
+
+// ValidateOCRConfig checks the signing keys and transmitters of an OCR config are set, unique and paired one per oracle,
+// and that the oracles outnumber three times the faulty oracles tolerated. The signing keys must be chainlink public keys
+// ChainlinkPubKeyToAddress parses, they are unique by address so that an oracle can not be counted twice.
+func ValidateOCRConfig(signingKeys [][]byte, transmitters []sdk.AccAddress, f uint32) error {
+	if len(signingKeys) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "signing keys can not be empty")
+	}
+	if len(signingKeys) > OCRMaxOracles {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "at most %d signing keys allowed", OCRMaxOracles)
+	}
+	if len(transmitters) != len(signingKeys) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "number of signing keys and transmitters does not match")
+	}
+	if f == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "f must be positive")
+	}
+	if uint64(len(signingKeys)) <= 3*uint64(f) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%d oracles can not tolerate %d faulty oracles", len(signingKeys), f)
+	}
+
+	seenSigners := make(map[common.Address]bool, len(signingKeys))
+	seenTransmitters := make(map[string]bool, len(transmitters))
+	for i := range signingKeys {
+		if len(signingKeys[i]) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "signing key can not be empty")
+		}
+		signer, err := ChainlinkPubKeyToAddress(signingKeys[i])
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid signing key %s", signingKeys[i])
+		}
+		if seenSigners[signer] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "signing key %s repeated", signingKeys[i])
+		}
+		seenSigners[signer] = true
+
+		if transmitters[i].Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "transmitter can not be empty")
+		}
+		if seenTransmitters[transmitters[i].String()] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "transmitter %s repeated", transmitters[i])
+		}
+		seenTransmitters[transmitters[i].String()] = true
+	}
+
+	return nil
+}
+
+// SignerAddress returns the address of the signing key of the oracle at the observer index of the config,
+// the signers are compared by address as the same key can be set in several encodings
+func (m *OCRConfig) SignerAddress(observer uint8) (common.Address, error) {
+	if int(observer) >= len(m.GetSigningKeys()) {
+		return common.Address{}, sdkerrors.Wrapf(ErrInvalidOCRReport, "observer index %d out of the config signers", observer)
+	}
+	return ChainlinkPubKeyToAddress(m.GetSigningKeys()[observer])
+}
+
+// HasTransmitter tells whether the account is a transmitter of the config
+func (m *OCRConfig) HasTransmitter(address sdk.AccAddress) bool {
+	for _, t := range m.GetTransmitters() {
+		if t.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
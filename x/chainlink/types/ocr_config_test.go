@@ -0,0 +1,57 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_ComputeConfigDigest(t *testing.T) {
+	_, _, transmitter := GenerateAccount()
+	config := &OCRConfig{
+		SigningKeys:  [][]byte{[]byte("key0")},
+		Transmitters: []sdk.AccAddress{transmitter},
+		F:            1,
+	}
+
+	digest := ComputeConfigDigest("chainlink", "feed1", 1, config)
+	require.Len(t, digest, OCRConfigDigestLength)
+	require.Equal(t, digest, ComputeConfigDigest("chainlink", "feed1", 1, config))
+
+	// the digest is scoped to the chain, the feed and the config count
+	require.NotEqual(t, digest, ComputeConfigDigest("other-chain", "feed1", 1, config))
+	require.NotEqual(t, digest, ComputeConfigDigest("chainlink", "feed2", 1, config))
+	require.NotEqual(t, digest, ComputeConfigDigest("chainlink", "feed1", 2, config))
+
+	config.OffchainConfig = []byte("offchain")
+	require.NotEqual(t, digest, ComputeConfigDigest("chainlink", "feed1", 1, config))
+}
+
+func TestTypes_OCRConfig_Members(t *testing.T) {
+	_, _, transmitter := GenerateAccount()
+	_, _, other := GenerateAccount()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	config := &OCRConfig{
+		SigningKeys:  [][]byte{[]byte(hex.EncodeToString(crypto.CompressPubkey(&key.PublicKey))), []byte("key1")},
+		Transmitters: []sdk.AccAddress{transmitter},
+	}
+
+	// the signer of an observer index is the address of its signing key
+	signer, err := config.SignerAddress(0)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+	_, err = config.SignerAddress(1)
+	require.Error(t, err)
+	_, err = config.SignerAddress(2)
+	require.ErrorIs(t, err, ErrInvalidOCRReport)
+
+	require.True(t, config.HasTransmitter(transmitter))
+	require.False(t, config.HasTransmitter(other))
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewOwnerProposal holds a module owner msg adding a feed or adding or removing a module owner for the approval of
+// the other module owners, the proposer is its first approval
+func NewOwnerProposal(id uint64, msg sdk.Msg, proposer sdk.AccAddress, height int64) (*OwnerProposal, error) {
+	proposal := &OwnerProposal{
+		Id:                id,
+		Approvals:         []sdk.AccAddress{proposer},
+		SubmittedAtHeight: height,
+	}
+
+	switch t := msg.(type) {
+	case *MsgFeed:
+		proposal.Feed = t
+	case *MsgModuleOwner:
+		proposal.ModuleOwner = t
+	case *MsgRemoveModuleOwner:
+		proposal.RemoveModuleOwner = t
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%T can not be proposed to the module owners", msg)
+	}
+
+	return proposal, nil
+}
+
+// Msg returns the proposed msg
+func (p *OwnerProposal) Msg() sdk.Msg {
+	switch {
+	case p.GetFeed() != nil:
+		return p.GetFeed()
+	case p.GetModuleOwner() != nil:
+		return p.GetModuleOwner()
+	case p.GetRemoveModuleOwner() != nil:
+		return p.GetRemoveModuleOwner()
+	default:
+		return nil
+	}
+}
+
+// MsgType returns the type of the proposed msg
+func (p *OwnerProposal) MsgType() string {
+	if msg := p.Msg(); msg != nil {
+		return msg.Type()
+	}
+	return ""
+}
+
+// Proposer returns the module owner who submitted the proposal, its first approval
+func (p *OwnerProposal) Proposer() sdk.AccAddress {
+	if len(p.GetApprovals()) == 0 {
+		return nil
+	}
+	return p.GetApprovals()[0]
+}
+
+// IsExpired tells whether the proposal can no longer be approved at height
+func (p *OwnerProposal) IsExpired(height int64) bool {
+	return p.GetExpiresAtHeight() > 0 && height > p.GetExpiresAtHeight()
+}
+
+// HasApproved tells whether the module owner approved the proposal
+func (p *OwnerProposal) HasApproved(moduleOwner sdk.AccAddress) bool {
+	for _, approval := range p.GetApprovals() {
+		if approval.Equals(moduleOwner) {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns the number of approvals of the current module owners, the approvals of removed module
+// owners do not count
+func (p *OwnerProposal) CountApprovals(moduleOwners MsgModuleOwners) uint32 {
+	var approvals uint32
+	for _, approval := range p.GetApprovals() {
+		if moduleOwners.Contains(approval) {
+			approvals++
+		}
+	}
+	return approvals
+}
@@ -0,0 +1,55 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_NewOwnerProposal(t *testing.T) {
+	_, _, proposer := GenerateAccount()
+	_, _, moduleOwner := GenerateAccount()
+
+	removal := NewMsgRemoveModuleOwner(proposer, moduleOwner)
+	proposal, err := NewOwnerProposal(1, removal, proposer, 10)
+	require.NoError(t, err)
+	require.Equal(t, removal, proposal.Msg())
+	require.Equal(t, RemoveModuleOwner, proposal.MsgType())
+	require.Equal(t, []sdk.AccAddress{proposer}, proposal.GetApprovals())
+	require.Equal(t, int64(10), proposal.GetSubmittedAtHeight())
+
+	feed := &MsgFeed{FeedId: "feed1"}
+	proposal, err = NewOwnerProposal(2, feed, proposer, 10)
+	require.NoError(t, err)
+	require.Equal(t, feed, proposal.Msg())
+	require.Equal(t, AddFeed, proposal.MsgType())
+
+	// only the msgs adding feeds and adding or removing module owners are proposed
+	_, err = NewOwnerProposal(3, NewMsgDeleteFeed(proposer, "feed1"), proposer, 10)
+	require.Error(t, err)
+
+	require.Nil(t, (&OwnerProposal{}).Msg())
+	require.Empty(t, (&OwnerProposal{}).MsgType())
+}
+
+func TestTypes_OwnerProposal_Approvals(t *testing.T) {
+	_, _, owner1 := GenerateAccount()
+	_, _, owner2 := GenerateAccount()
+	_, _, removedOwner := GenerateAccount()
+
+	proposal, err := NewOwnerProposal(1, &MsgModuleOwner{Address: owner2}, owner1, 10)
+	require.NoError(t, err)
+	proposal.Approvals = append(proposal.Approvals, removedOwner)
+
+	require.True(t, proposal.HasApproved(owner1))
+	require.True(t, proposal.HasApproved(removedOwner))
+	require.False(t, proposal.HasApproved(owner2))
+
+	// the approvals of the accounts that are no module owner anymore do not count
+	moduleOwners := MsgModuleOwners{{Address: owner1}, {Address: owner2}}
+	require.Equal(t, uint32(1), proposal.CountApprovals(moduleOwners))
+}
@@ -0,0 +1,274 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+const (
+	// FeeReimbursementPolicyFull reimburses the transmitter of a round its whole tx fee out of the feed escrow
+	FeeReimbursementPolicyFull = "full"
+	// FeeReimbursementPolicyNone never reimburses the tx fee of the transmitters
+	FeeReimbursementPolicyNone = "none"
+
+	// DefaultMaxDataProviders is the maximum number of oracles of an OCR aggregator
+	DefaultMaxDataProviders uint32 = 31
+	// DefaultMaxFeedIdLength is the longest feedId the store keys can hold
+	DefaultMaxFeedIdLength uint32 = MaxFeedIdLength
+	// DefaultRoundRetention keeps every round
+	DefaultRoundRetention uint64 = 0
+	// DefaultOwnerApprovalThreshold lets a single module owner add feeds and add or remove module owners
+	DefaultOwnerApprovalThreshold uint32 = 1
+	// DefaultOwnershipTransferExpiry gives the new owner about a week of 6s blocks to accept an ownership transfer
+	DefaultOwnershipTransferExpiry uint64 = 100800
+	// DefaultOwnerProposalExpiry gives the module owners about a week of 6s blocks to approve an owner proposal
+	DefaultOwnerProposalExpiry uint64 = 100800
+	// DefaultMaxFeeReimbursement caps the tx fee reimbursed to the transmitter of a round at 100 of the reward denom
+	DefaultMaxFeeReimbursement uint64 = 100
+)
+
+// parameter store keys
+var (
+	KeyMaxDataProviders            = []byte("MaxDataProviders")
+	KeyMaxFeedIdLength             = []byte("MaxFeedIdLength")
+	KeyRewardDenom                 = []byte("RewardDenom")
+	KeyRoundRetention              = []byte("RoundRetention")
+	KeyFeeReimbursementPolicy      = []byte("FeeReimbursementPolicy")
+	KeyGovernanceOnly              = []byte("GovernanceOnly")
+	KeyOwnerApprovalThreshold      = []byte("OwnerApprovalThreshold")
+	KeyOwnershipTransferExpiry     = []byte("OwnershipTransferExpiry")
+	KeySingleStepOwnershipTransfer = []byte("SingleStepOwnershipTransfer")
+	KeyOwnerProposalExpiry         = []byte("OwnerProposalExpiry")
+	KeyMaxFeeReimbursement         = []byte("MaxFeeReimbursement")
+)
+
+// ParamKeyTable returns the parameter key table of the chainlink module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(maxDataProviders, maxFeedIdLength uint32, rewardDenom string, roundRetention uint64, feeReimbursementPolicy string, governanceOnly bool, ownerApprovalThreshold uint32, ownershipTransferExpiry uint64, singleStepOwnershipTransfer bool, ownerProposalExpiry, maxFeeReimbursement uint64) Params {
+	return Params{
+		MaxDataProviders:            maxDataProviders,
+		MaxFeedIdLength:             maxFeedIdLength,
+		RewardDenom:                 rewardDenom,
+		RoundRetention:              roundRetention,
+		FeeReimbursementPolicy:      feeReimbursementPolicy,
+		GovernanceOnly:              governanceOnly,
+		OwnerApprovalThreshold:      ownerApprovalThreshold,
+		OwnershipTransferExpiry:     ownershipTransferExpiry,
+		SingleStepOwnershipTransfer: singleStepOwnershipTransfer,
+		OwnerProposalExpiry:         ownerProposalExpiry,
+		MaxFeeReimbursement:         maxFeeReimbursement,
+	}
+}
+
+// DefaultParams returns the parameters the module behaved with before they got introduced
+func DefaultParams() Params {
+	return NewParams(DefaultMaxDataProviders, DefaultMaxFeedIdLength, LinkDenom, DefaultRoundRetention, FeeReimbursementPolicyFull, false, DefaultOwnerApprovalThreshold, DefaultOwnershipTransferExpiry, false, DefaultOwnerProposalExpiry, DefaultMaxFeeReimbursement)
+}
+
+// ParamSetPairs implements the paramtypes.ParamSet interface
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxDataProviders, &p.MaxDataProviders, validateMaxDataProviders),
+		paramtypes.NewParamSetPair(KeyMaxFeedIdLength, &p.MaxFeedIdLength, validateMaxFeedIdLength),
+		paramtypes.NewParamSetPair(KeyRewardDenom, &p.RewardDenom, validateRewardDenom),
+		paramtypes.NewParamSetPair(KeyRoundRetention, &p.RoundRetention, validateRoundRetention),
+		paramtypes.NewParamSetPair(KeyFeeReimbursementPolicy, &p.FeeReimbursementPolicy, validateFeeReimbursementPolicy),
+		paramtypes.NewParamSetPair(KeyGovernanceOnly, &p.GovernanceOnly, validateGovernanceOnly),
+		paramtypes.NewParamSetPair(KeyOwnerApprovalThreshold, &p.OwnerApprovalThreshold, validateOwnerApprovalThreshold),
+		paramtypes.NewParamSetPair(KeyOwnershipTransferExpiry, &p.OwnershipTransferExpiry, validateOwnershipTransferExpiry),
+		paramtypes.NewParamSetPair(KeySingleStepOwnershipTransfer, &p.SingleStepOwnershipTransfer, validateSingleStepOwnershipTransfer),
+		paramtypes.NewParamSetPair(KeyOwnerProposalExpiry, &p.OwnerProposalExpiry, validateOwnerProposalExpiry),
+		paramtypes.NewParamSetPair(KeyMaxFeeReimbursement, &p.MaxFeeReimbursement, validateMaxFeeReimbursement),
+	}
+}
+
+// Validate checks every parameter
+func (p Params) Validate() error {
+	if err := validateMaxDataProviders(p.MaxDataProviders); err != nil {
+		return err
+	}
+	if err := validateMaxFeedIdLength(p.MaxFeedIdLength); err != nil {
+		return err
+	}
+	if err := validateRewardDenom(p.RewardDenom); err != nil {
+		return err
+	}
+	if err := validateRoundRetention(p.RoundRetention); err != nil {
+		return err
+	}
+	if err := validateFeeReimbursementPolicy(p.FeeReimbursementPolicy); err != nil {
+		return err
+	}
+	if err := validateGovernanceOnly(p.GovernanceOnly); err != nil {
+		return err
+	}
+	if err := validateOwnerApprovalThreshold(p.OwnerApprovalThreshold); err != nil {
+		return err
+	}
+	if err := validateOwnershipTransferExpiry(p.OwnershipTransferExpiry); err != nil {
+		return err
+	}
+	if err := validateSingleStepOwnershipTransfer(p.SingleStepOwnershipTransfer); err != nil {
+		return err
+	}
+	if err := validateOwnerProposalExpiry(p.OwnerProposalExpiry); err != nil {
+		return err
+	}
+	return validateMaxFeeReimbursement(p.MaxFeeReimbursement)
+}
+
+// ReimbursesTxFee tells whether the transmitter of a round gets its tx fee reimbursed
+func (p Params) ReimbursesTxFee() bool {
+	return p.FeeReimbursementPolicy == FeeReimbursementPolicyFull
+}
+
+// TxFeeReimbursement returns the part of the tx fee reimbursed to the transmitter of a round: nothing when the policy
+// is FeeReimbursementPolicyNone or the fee is not paid in the reward denom, the fee capped at maxFeeReimbursement
+// otherwise
+func (p Params) TxFeeReimbursement(txFee *Coin) uint64 {
+	if !p.ReimbursesTxFee() || txFee.GetDenom() != p.RewardDenom {
+		return 0
+	}
+	if txFee.GetAmount() > p.MaxFeeReimbursement {
+		return p.MaxFeeReimbursement
+	}
+	return txFee.GetAmount()
+}
+
+// ApprovalThreshold returns the number of module owner approvals an owner proposal needs to execute, the threshold
+// is capped at the number of module owners so that removing owners never locks the pending proposals
+func (p Params) ApprovalThreshold(moduleOwners int) uint32 {
+	if moduleOwners < int(p.OwnerApprovalThreshold) {
+		return uint32(moduleOwners)
+	}
+	return p.OwnerApprovalThreshold
+}
+
+// OwnershipTransferExpiresAt returns the last height an ownership transfer proposed at height can be accepted at, 0
+// when the transfers never expire
+func (p Params) OwnershipTransferExpiresAt(height int64) int64 {
+	if p.OwnershipTransferExpiry == 0 {
+		return 0
+	}
+	return height + int64(p.OwnershipTransferExpiry)
+}
+
+// OwnerProposalExpiresAt returns the last height an owner proposal submitted at height can be approved at, 0 when
+// the owner proposals never expire
+func (p Params) OwnerProposalExpiresAt(height int64) int64 {
+	if p.OwnerProposalExpiry == 0 {
+		return 0
+	}
+	return height + int64(p.OwnerProposalExpiry)
+}
+
+// RewardCoins returns amount of the reward denom
+func (p Params) RewardCoins(amount uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(p.RewardDenom, sdk.NewIntFromUint64(amount)))
+}
+
+func validateMaxDataProviders(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max data providers must be positive")
+	}
+	return nil
+}
+
+func validateMaxFeedIdLength(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 || v > MaxFeedIdLength {
+		return fmt.Errorf("max feedId length must be between 1 and %d: %d", MaxFeedIdLength, v)
+	}
+	return nil
+}
+
+func validateRewardDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return sdk.ValidateDenom(v)
+}
+
+func validateRoundRetention(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateFeeReimbursementPolicy(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	switch v {
+	case FeeReimbursementPolicyFull, FeeReimbursementPolicyNone:
+		return nil
+	default:
+		return fmt.Errorf("invalid fee reimbursement policy %s", v)
+	}
+}
+
+func validateGovernanceOnly(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOwnerApprovalThreshold(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("owner approval threshold must be positive")
+	}
+	return nil
+}
+
+func validateOwnershipTransferExpiry(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateSingleStepOwnershipTransfer(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateOwnerProposalExpiry(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxFeeReimbursement(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
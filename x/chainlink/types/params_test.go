@@ -0,0 +1,118 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_Params_Validate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	testCases := []struct {
+		name   string
+		modify func(p *Params)
+		valid  bool
+	}{
+		{"keep every round", func(p *Params) { p.RoundRetention = 0 }, true},
+		{"keep the latest round", func(p *Params) { p.RoundRetention = 1 }, true},
+		{"no fee reimbursement", func(p *Params) { p.FeeReimbursementPolicy = FeeReimbursementPolicyNone }, true},
+		{"other reward denom", func(p *Params) { p.RewardDenom = "ulink" }, true},
+		{"shortest feedId", func(p *Params) { p.MaxFeedIdLength = 1 }, true},
+		{"governance only", func(p *Params) { p.GovernanceOnly = true }, true},
+		{"2 of N owner approvals", func(p *Params) { p.OwnerApprovalThreshold = 2 }, true},
+		{"ownership transfers never expire", func(p *Params) { p.OwnershipTransferExpiry = 0 }, true},
+		{"single step ownership transfers", func(p *Params) { p.SingleStepOwnershipTransfer = true }, true},
+		{"owner proposals never expire", func(p *Params) { p.OwnerProposalExpiry = 0 }, true},
+		{"no fee reimbursed", func(p *Params) { p.MaxFeeReimbursement = 0 }, true},
+		{"no data provider", func(p *Params) { p.MaxDataProviders = 0 }, false},
+		{"no feedId", func(p *Params) { p.MaxFeedIdLength = 0 }, false},
+		{"feedId longer than the store keys", func(p *Params) { p.MaxFeedIdLength = MaxFeedIdLength + 1 }, false},
+		{"empty reward denom", func(p *Params) { p.RewardDenom = "" }, false},
+		{"invalid reward denom", func(p *Params) { p.RewardDenom = "1link" }, false},
+		{"unknown fee reimbursement policy", func(p *Params) { p.FeeReimbursementPolicy = "half" }, false},
+		{"no owner approval", func(p *Params) { p.OwnerApprovalThreshold = 0 }, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := DefaultParams()
+			tc.modify(&params)
+			if tc.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
+
+func TestTypes_Params_ParamSetPairs(t *testing.T) {
+	params := DefaultParams()
+
+	// every pair validator accepts the default value
+	for _, pair := range params.ParamSetPairs() {
+		require.NoError(t, pair.ValidatorFn(pairValue(pair.Value)), string(pair.Key))
+	}
+
+	require.True(t, params.ReimbursesTxFee())
+	require.Equal(t, "100link", params.RewardCoins(100).String())
+	require.True(t, params.RewardCoins(0).Empty())
+}
+
+func pairValue(ptr interface{}) interface{} {
+	switch v := ptr.(type) {
+	case *uint32:
+		return *v
+	case *uint64:
+		return *v
+	case *string:
+		return *v
+	case *bool:
+		return *v
+	}
+	return ptr
+}
+
+func TestTypes_Params_ApprovalThreshold(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, uint32(1), params.ApprovalThreshold(3))
+	require.Equal(t, uint32(0), params.ApprovalThreshold(0))
+
+	params.OwnerApprovalThreshold = 3
+	require.Equal(t, uint32(3), params.ApprovalThreshold(5))
+	require.Equal(t, uint32(2), params.ApprovalThreshold(2))
+}
+
+func TestTypes_Params_OwnershipTransferExpiresAt(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, int64(10)+int64(DefaultOwnershipTransferExpiry), params.OwnershipTransferExpiresAt(10))
+
+	params.OwnershipTransferExpiry = 0
+	require.Equal(t, int64(0), params.OwnershipTransferExpiresAt(10))
+}
+
+func TestTypes_Params_OwnerProposalExpiresAt(t *testing.T) {
+	params := DefaultParams()
+	require.Equal(t, int64(10)+int64(DefaultOwnerProposalExpiry), params.OwnerProposalExpiresAt(10))
+
+	params.OwnerProposalExpiry = 0
+	require.Equal(t, int64(0), params.OwnerProposalExpiresAt(10))
+}
+
+func TestTypes_Params_TxFeeReimbursement(t *testing.T) {
+	params := DefaultParams()
+	params.MaxFeeReimbursement = 10
+
+	// only the fee paid in the reward denom is reimbursed, up to maxFeeReimbursement
+	require.Equal(t, uint64(3), params.TxFeeReimbursement(&Coin{Denom: LinkDenom, Amount: 3}))
+	require.Equal(t, uint64(10), params.TxFeeReimbursement(&Coin{Denom: LinkDenom, Amount: 1000}))
+	require.Equal(t, uint64(0), params.TxFeeReimbursement(&Coin{Denom: "stake", Amount: 3}))
+	require.Equal(t, uint64(0), params.TxFeeReimbursement(nil))
+
+	params.FeeReimbursementPolicy = FeeReimbursementPolicyNone
+	require.Equal(t, uint64(0), params.TxFeeReimbursement(&Coin{Denom: LinkDenom, Amount: 3}))
+}
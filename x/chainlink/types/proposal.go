@@ -0,0 +1,119 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeAddFeed           = "AddFeed"
+	ProposalTypeAddModuleOwner    = "AddModuleOwner"
+	ProposalTypeRemoveModuleOwner = "RemoveModuleOwner"
+)
+
+var (
+	_ govtypes.Content = &AddFeedProposal{}
+	_ govtypes.Content = &AddModuleOwnerProposal{}
+	_ govtypes.Content = &RemoveModuleOwnerProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddFeed)
+	govtypes.RegisterProposalTypeCodec(&AddFeedProposal{}, "chainlink/AddFeedProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddModuleOwner)
+	govtypes.RegisterProposalTypeCodec(&AddModuleOwnerProposal{}, "chainlink/AddModuleOwnerProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveModuleOwner)
+	govtypes.RegisterProposalTypeCodec(&RemoveModuleOwnerProposal{}, "chainlink/RemoveModuleOwnerProposal")
+}
+
+// GovModuleAddress returns the address of the gov module account, the module owner of the feeds added by proposal
+// and the assigner of the module owners added by proposal
+func GovModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(govtypes.ModuleName)
+}
+
+func NewAddFeedProposal(title, description string, feed *MsgFeed) *AddFeedProposal {
+	return &AddFeedProposal{Title: title, Description: description, Feed: feed}
+}
+
+func (p *AddFeedProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddFeedProposal) ProposalType() string { return ProposalTypeAddFeed }
+
+// ValidateBasic validates the feed like a MsgFeed added by the gov module account
+func (p *AddFeedProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.GetFeed() == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "feed can not be empty")
+	}
+	return p.ProposedFeed().ValidateBasic()
+}
+
+// ProposedFeed returns the proposed feed with the gov module account as module owner
+func (p *AddFeedProposal) ProposedFeed() *MsgFeed {
+	feed := *p.GetFeed()
+	feed.ModuleOwnerAddress = GovModuleAddress()
+	return &feed
+}
+
+func NewAddModuleOwnerProposal(title, description string, address sdk.AccAddress, pubKey []byte) *AddModuleOwnerProposal {
+	return &AddModuleOwnerProposal{Title: title, Description: description, Address: address, PubKey: pubKey}
+}
+
+func (p *AddModuleOwnerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddModuleOwnerProposal) ProposalType() string { return ProposalTypeAddModuleOwner }
+
+// ValidateBasic checks the address matches the bech32 pubKey of the new module owner
+func (p *AddModuleOwnerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.GetAddress().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "module owner address can not be empty")
+	}
+	pubKey, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, string(p.GetPubKey()))
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	if !bytes.Equal(pubKey.Address().Bytes(), p.GetAddress().Bytes()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidPubKey, "address and pubKey not match")
+	}
+	return nil
+}
+
+// ModuleOwner returns the proposed module owner with the gov module account as assigner
+func (p *AddModuleOwnerProposal) ModuleOwner() *MsgModuleOwner {
+	return &MsgModuleOwner{
+		Address:         p.GetAddress(),
+		PubKey:          p.GetPubKey(),
+		AssignerAddress: GovModuleAddress(),
+	}
+}
+
+func NewRemoveModuleOwnerProposal(title, description string, address sdk.AccAddress) *RemoveModuleOwnerProposal {
+	return &RemoveModuleOwnerProposal{Title: title, Description: description, Address: address}
+}
+
+func (p *RemoveModuleOwnerProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveModuleOwnerProposal) ProposalType() string { return ProposalTypeRemoveModuleOwner }
+
+func (p *RemoveModuleOwnerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if p.GetAddress().Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "module owner address can not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_AddFeedProposal_ValidateBasic(t *testing.T) {
+	_, _, feedOwner := GenerateAccount()
+	_, dataProviderPubKey, dataProvider := GenerateAccount()
+	dataProviders := []*DataProvider{{Address: dataProvider, PubKey: []byte(dataProviderPubKey)}}
+
+	// the module owner of the proposed feed is the gov module account whatever the proposal holds
+	feed := NewMsgFeed("feed1", "feed 1", feedOwner, sdk.AccAddress{}, dataProviders, 1, 2, 3, 4, "")
+	p := NewAddFeedProposal("add feed1", "adds feed1", feed)
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, GovModuleAddress(), p.ProposedFeed().GetModuleOwnerAddress())
+	require.Empty(t, feed.GetModuleOwnerAddress())
+
+	require.Equal(t, RouterKey, p.ProposalRoute())
+	require.Equal(t, ProposalTypeAddFeed, p.ProposalType())
+
+	require.Error(t, NewAddFeedProposal("", "adds feed1", feed).ValidateBasic())
+	require.Error(t, NewAddFeedProposal("add feed1", "adds feed1", nil).ValidateBasic())
+
+	invalidFeed := *feed
+	invalidFeed.SubmissionCount = 0
+	require.Error(t, NewAddFeedProposal("add feed1", "adds feed1", &invalidFeed).ValidateBasic())
+}
+
+func TestTypes_AddModuleOwnerProposal_ValidateBasic(t *testing.T) {
+	_, pubKey, addr := GenerateAccount()
+	_, otherPubKey, _ := GenerateAccount()
+
+	p := NewAddModuleOwnerProposal("add owner", "adds a module owner", addr, []byte(pubKey))
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, ProposalTypeAddModuleOwner, p.ProposalType())
+	require.Equal(t, &MsgModuleOwner{Address: addr, PubKey: []byte(pubKey), AssignerAddress: GovModuleAddress()}, p.ModuleOwner())
+
+	testCases := []struct {
+		description string
+		address     sdk.AccAddress
+		pubKey      []byte
+	}{
+		{description: "empty address", pubKey: []byte(pubKey)},
+		{description: "invalid pubKey", address: addr, pubKey: []byte("pubKey")},
+		{description: "address and pubKey not match", address: addr, pubKey: []byte(otherPubKey)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			require.Error(t, NewAddModuleOwnerProposal("add owner", "adds a module owner", tc.address, tc.pubKey).ValidateBasic())
+		})
+	}
+}
+
+func TestTypes_RemoveModuleOwnerProposal_ValidateBasic(t *testing.T) {
+	_, _, addr := GenerateAccount()
+
+	p := NewRemoveModuleOwnerProposal("remove owner", "removes a module owner", addr)
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, ProposalTypeRemoveModuleOwner, p.ProposalType())
+
+	require.Error(t, NewRemoveModuleOwnerProposal("remove owner", "", addr).ValidateBasic())
+	require.Error(t, NewRemoveModuleOwnerProposal("remove owner", "removes a module owner", nil).ValidateBasic())
+}
@@ -5,10 +5,22 @@ package types
 
 // Supported endpoints
 const (
-	QueryRoundFeedData      = "getRoundFeedData"
-	QueryLatestFeedData     = "getLatestFeedData"
-	QueryModuleOwner        = "getModuleOwner"
-	QueryFeedInfo           = "getFeedInfo"
-	QueryAccountInfo        = "getAccountInfo"
-	QueryFeedRewardStrategy = "getFeedRewardStrategy"
+	QueryRoundFeedData            = "getRoundFeedData"
+	QueryRoundHistory             = "getRoundHistory"
+	QueryLatestFeedData           = "getLatestFeedData"
+	QueryModuleOwner              = "getModuleOwner"
+	QueryOwnerProposals           = "listOwnerProposals"
+	QueryModuleOwnershipTransfers = "listModuleOwnershipTransfers"
+	QueryFeedInfo                 = "getFeedInfo"
+	QueryFeedList                 = "listFeeds"
+	QueryFeedMetadata             = "getFeedMetadata"
+	QueryFeedOwnershipTransfer    = "getFeedOwnershipTransfer"
+	QueryLatestConfig             = "latestConfigDetails"
+	QueryFeedEscrow               = "getFeedEscrowBalance"
+	QueryAccountInfo              = "getAccountInfo"
+	QueryAccountList              = "listAccounts"
+	QueryOwedPayments             = "listOwedPayments"
+	QueryAccountByKey             = "getAccountByChainlinkKey"
+	QueryFeedRewardStrategy       = "getFeedRewardStrategy"
+	QueryParams                   = "getParams"
 )
@@ -31,6 +31,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type GetModuleParamsRequest struct {
+}
+
+func (m *GetModuleParamsRequest) Reset()         { *m = GetModuleParamsRequest{} }
+func (m *GetModuleParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetModuleParamsRequest) ProtoMessage()    {}
+func (*GetModuleParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{0}
+}
+func (m *GetModuleParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetModuleParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetModuleParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetModuleParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetModuleParamsRequest.Merge(m, src)
+}
+func (m *GetModuleParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetModuleParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetModuleParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetModuleParamsRequest proto.InternalMessageInfo
+
+type GetModuleParamsResponse struct {
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *GetModuleParamsResponse) Reset()         { *m = GetModuleParamsResponse{} }
+func (m *GetModuleParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetModuleParamsResponse) ProtoMessage()    {}
+func (*GetModuleParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{1}
+}
+func (m *GetModuleParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetModuleParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetModuleParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetModuleParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetModuleParamsResponse.Merge(m, src)
+}
+func (m *GetModuleParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetModuleParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetModuleParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetModuleParamsResponse proto.InternalMessageInfo
+
+func (m *GetModuleParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 type GetFeedByIdRequest struct {
 	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 }
@@ -39,7 +119,7 @@ func (m *GetFeedByIdRequest) Reset()         { *m = GetFeedByIdRequest{} }
 func (m *GetFeedByIdRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFeedByIdRequest) ProtoMessage()    {}
 func (*GetFeedByIdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{0}
+	return fileDescriptor_21ba17f95e61a418, []int{2}
 }
 func (m *GetFeedByIdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,13 +157,15 @@ func (m *GetFeedByIdRequest) GetFeedId() string {
 
 type GetFeedByIdResponse struct {
 	Feed *MsgFeed `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	// tombstone is set instead of feed when the feed got deleted
+	Tombstone *FeedTombstone `protobuf:"bytes,2,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
 }
 
 func (m *GetFeedByIdResponse) Reset()         { *m = GetFeedByIdResponse{} }
 func (m *GetFeedByIdResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFeedByIdResponse) ProtoMessage()    {}
 func (*GetFeedByIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{1}
+	return fileDescriptor_21ba17f95e61a418, []int{3}
 }
 func (m *GetFeedByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -119,21 +201,29 @@ func (m *GetFeedByIdResponse) GetFeed() *MsgFeed {
 	return nil
 }
 
-type GetModuleOwnerRequest struct {
+func (m *GetFeedByIdResponse) GetTombstone() *FeedTombstone {
+	if m != nil {
+		return m.Tombstone
+	}
+	return nil
 }
 
-func (m *GetModuleOwnerRequest) Reset()         { *m = GetModuleOwnerRequest{} }
-func (m *GetModuleOwnerRequest) String() string { return proto.CompactTextString(m) }
-func (*GetModuleOwnerRequest) ProtoMessage()    {}
-func (*GetModuleOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{2}
+type GetFeedMetadataRequest struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 }
-func (m *GetModuleOwnerRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *GetFeedMetadataRequest) Reset()         { *m = GetFeedMetadataRequest{} }
+func (m *GetFeedMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeedMetadataRequest) ProtoMessage()    {}
+func (*GetFeedMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{4}
+}
+func (m *GetFeedMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetModuleOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFeedMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetModuleOwnerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFeedMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -143,34 +233,47 @@ func (m *GetModuleOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetModuleOwnerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetModuleOwnerRequest.Merge(m, src)
+func (m *GetFeedMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedMetadataRequest.Merge(m, src)
 }
-func (m *GetModuleOwnerRequest) XXX_Size() int {
+func (m *GetFeedMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetModuleOwnerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetModuleOwnerRequest.DiscardUnknown(m)
+func (m *GetFeedMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetModuleOwnerRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetFeedMetadataRequest proto.InternalMessageInfo
 
-type GetModuleOwnerResponse struct {
-	ModuleOwner []*MsgModuleOwner `protobuf:"bytes,1,rep,name=moduleOwner,proto3" json:"moduleOwner,omitempty"`
+func (m *GetFeedMetadataRequest) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
 }
 
-func (m *GetModuleOwnerResponse) Reset()         { *m = GetModuleOwnerResponse{} }
-func (m *GetModuleOwnerResponse) String() string { return proto.CompactTextString(m) }
-func (*GetModuleOwnerResponse) ProtoMessage()    {}
-func (*GetModuleOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{3}
+// GetFeedMetadataResponse mirrors the decimals(), description() and version() getters of AggregatorV3
+type GetFeedMetadataResponse struct {
+	FeedId   string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Decimals uint32 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// description is the feed description, "baseAsset / quoteAsset" when the feed has no description
+	Description string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Version     uint64        `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	Metadata    *FeedMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
 }
-func (m *GetModuleOwnerResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *GetFeedMetadataResponse) Reset()         { *m = GetFeedMetadataResponse{} }
+func (m *GetFeedMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeedMetadataResponse) ProtoMessage()    {}
+func (*GetFeedMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{5}
+}
+func (m *GetFeedMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetModuleOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFeedMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetModuleOwnerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFeedMetadataResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -180,43 +283,69 @@ func (m *GetModuleOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetModuleOwnerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetModuleOwnerResponse.Merge(m, src)
+func (m *GetFeedMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedMetadataResponse.Merge(m, src)
 }
-func (m *GetModuleOwnerResponse) XXX_Size() int {
+func (m *GetFeedMetadataResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetModuleOwnerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetModuleOwnerResponse.DiscardUnknown(m)
+func (m *GetFeedMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedMetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetModuleOwnerResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetFeedMetadataResponse proto.InternalMessageInfo
 
-func (m *GetModuleOwnerResponse) GetModuleOwner() []*MsgModuleOwner {
+func (m *GetFeedMetadataResponse) GetFeedId() string {
 	if m != nil {
-		return m.ModuleOwner
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *GetFeedMetadataResponse) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *GetFeedMetadataResponse) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *GetFeedMetadataResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *GetFeedMetadataResponse) GetMetadata() *FeedMetadata {
+	if m != nil {
+		return m.Metadata
 	}
 	return nil
 }
 
-type GetRoundDataRequest struct {
-	FeedId     string             `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	RoundId    uint64             `protobuf:"varint,2,opt,name=roundId,proto3" json:"roundId,omitempty"`
-	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type LatestConfigDetailsRequest struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 }
 
-func (m *GetRoundDataRequest) Reset()         { *m = GetRoundDataRequest{} }
-func (m *GetRoundDataRequest) String() string { return proto.CompactTextString(m) }
-func (*GetRoundDataRequest) ProtoMessage()    {}
-func (*GetRoundDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{4}
+func (m *LatestConfigDetailsRequest) Reset()         { *m = LatestConfigDetailsRequest{} }
+func (m *LatestConfigDetailsRequest) String() string { return proto.CompactTextString(m) }
+func (*LatestConfigDetailsRequest) ProtoMessage()    {}
+func (*LatestConfigDetailsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{6}
 }
-func (m *GetRoundDataRequest) XXX_Unmarshal(b []byte) error {
+func (m *LatestConfigDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRoundDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LatestConfigDetailsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRoundDataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LatestConfigDetailsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -226,56 +355,46 @@ func (m *GetRoundDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetRoundDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRoundDataRequest.Merge(m, src)
+func (m *LatestConfigDetailsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestConfigDetailsRequest.Merge(m, src)
 }
-func (m *GetRoundDataRequest) XXX_Size() int {
+func (m *LatestConfigDetailsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRoundDataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRoundDataRequest.DiscardUnknown(m)
+func (m *LatestConfigDetailsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestConfigDetailsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRoundDataRequest proto.InternalMessageInfo
+var xxx_messageInfo_LatestConfigDetailsRequest proto.InternalMessageInfo
 
-func (m *GetRoundDataRequest) GetFeedId() string {
+func (m *LatestConfigDetailsRequest) GetFeedId() string {
 	if m != nil {
 		return m.FeedId
 	}
 	return ""
 }
 
-func (m *GetRoundDataRequest) GetRoundId() uint64 {
-	if m != nil {
-		return m.RoundId
-	}
-	return 0
-}
-
-func (m *GetRoundDataRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-type GetRoundDataResponse struct {
-	RoundData  []*RoundData        `protobuf:"bytes,1,rep,name=roundData,proto3" json:"roundData,omitempty"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// LatestConfigDetailsResponse mirrors the latestConfigDetails() getter of the OCR aggregator,
+// every field is empty when the feed never got an OCR config
+type LatestConfigDetailsResponse struct {
+	ConfigCount  uint64     `protobuf:"varint,1,opt,name=configCount,proto3" json:"configCount,omitempty"`
+	BlockNumber  int64      `protobuf:"varint,2,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	ConfigDigest []byte     `protobuf:"bytes,3,opt,name=configDigest,proto3" json:"configDigest,omitempty"`
+	Config       *OCRConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
 }
 
-func (m *GetRoundDataResponse) Reset()         { *m = GetRoundDataResponse{} }
-func (m *GetRoundDataResponse) String() string { return proto.CompactTextString(m) }
-func (*GetRoundDataResponse) ProtoMessage()    {}
-func (*GetRoundDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{5}
+func (m *LatestConfigDetailsResponse) Reset()         { *m = LatestConfigDetailsResponse{} }
+func (m *LatestConfigDetailsResponse) String() string { return proto.CompactTextString(m) }
+func (*LatestConfigDetailsResponse) ProtoMessage()    {}
+func (*LatestConfigDetailsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{7}
 }
-func (m *GetRoundDataResponse) XXX_Unmarshal(b []byte) error {
+func (m *LatestConfigDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetRoundDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *LatestConfigDetailsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetRoundDataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_LatestConfigDetailsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -285,48 +404,62 @@ func (m *GetRoundDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetRoundDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetRoundDataResponse.Merge(m, src)
+func (m *LatestConfigDetailsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatestConfigDetailsResponse.Merge(m, src)
 }
-func (m *GetRoundDataResponse) XXX_Size() int {
+func (m *LatestConfigDetailsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetRoundDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetRoundDataResponse.DiscardUnknown(m)
+func (m *LatestConfigDetailsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatestConfigDetailsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetRoundDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_LatestConfigDetailsResponse proto.InternalMessageInfo
 
-func (m *GetRoundDataResponse) GetRoundData() []*RoundData {
+func (m *LatestConfigDetailsResponse) GetConfigCount() uint64 {
 	if m != nil {
-		return m.RoundData
+		return m.ConfigCount
+	}
+	return 0
+}
+
+func (m *LatestConfigDetailsResponse) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *LatestConfigDetailsResponse) GetConfigDigest() []byte {
+	if m != nil {
+		return m.ConfigDigest
 	}
 	return nil
 }
 
-func (m *GetRoundDataResponse) GetPagination() *query.PageResponse {
+func (m *LatestConfigDetailsResponse) GetConfig() *OCRConfig {
 	if m != nil {
-		return m.Pagination
+		return m.Config
 	}
 	return nil
 }
 
-type GetLatestRoundDataRequest struct {
+type GetFeedEscrowBalanceRequest struct {
 	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 }
 
-func (m *GetLatestRoundDataRequest) Reset()         { *m = GetLatestRoundDataRequest{} }
-func (m *GetLatestRoundDataRequest) String() string { return proto.CompactTextString(m) }
-func (*GetLatestRoundDataRequest) ProtoMessage()    {}
-func (*GetLatestRoundDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{6}
+func (m *GetFeedEscrowBalanceRequest) Reset()         { *m = GetFeedEscrowBalanceRequest{} }
+func (m *GetFeedEscrowBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeedEscrowBalanceRequest) ProtoMessage()    {}
+func (*GetFeedEscrowBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{8}
 }
-func (m *GetLatestRoundDataRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetFeedEscrowBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetLatestRoundDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFeedEscrowBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetLatestRoundDataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFeedEscrowBalanceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -336,41 +469,43 @@ func (m *GetLatestRoundDataRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *GetLatestRoundDataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLatestRoundDataRequest.Merge(m, src)
+func (m *GetFeedEscrowBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedEscrowBalanceRequest.Merge(m, src)
 }
-func (m *GetLatestRoundDataRequest) XXX_Size() int {
+func (m *GetFeedEscrowBalanceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetLatestRoundDataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLatestRoundDataRequest.DiscardUnknown(m)
+func (m *GetFeedEscrowBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedEscrowBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLatestRoundDataRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetFeedEscrowBalanceRequest proto.InternalMessageInfo
 
-func (m *GetLatestRoundDataRequest) GetFeedId() string {
+func (m *GetFeedEscrowBalanceRequest) GetFeedId() string {
 	if m != nil {
 		return m.FeedId
 	}
 	return ""
 }
 
-type GetLatestRoundDataResponse struct {
-	RoundData []*RoundData `protobuf:"bytes,1,rep,name=roundData,proto3" json:"roundData,omitempty"`
+// GetFeedEscrowBalanceResponse is the amount escrowed by the feed owner to reward the rounds of the feed
+type GetFeedEscrowBalanceResponse struct {
+	FeedId  string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Balance *Coin  `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (m *GetLatestRoundDataResponse) Reset()         { *m = GetLatestRoundDataResponse{} }
-func (m *GetLatestRoundDataResponse) String() string { return proto.CompactTextString(m) }
-func (*GetLatestRoundDataResponse) ProtoMessage()    {}
-func (*GetLatestRoundDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{7}
+func (m *GetFeedEscrowBalanceResponse) Reset()         { *m = GetFeedEscrowBalanceResponse{} }
+func (m *GetFeedEscrowBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeedEscrowBalanceResponse) ProtoMessage()    {}
+func (*GetFeedEscrowBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{9}
 }
-func (m *GetLatestRoundDataResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetFeedEscrowBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetLatestRoundDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFeedEscrowBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetLatestRoundDataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFeedEscrowBalanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -380,42 +515,55 @@ func (m *GetLatestRoundDataResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *GetLatestRoundDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetLatestRoundDataResponse.Merge(m, src)
+func (m *GetFeedEscrowBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedEscrowBalanceResponse.Merge(m, src)
 }
-func (m *GetLatestRoundDataResponse) XXX_Size() int {
+func (m *GetFeedEscrowBalanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetLatestRoundDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetLatestRoundDataResponse.DiscardUnknown(m)
+func (m *GetFeedEscrowBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedEscrowBalanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetLatestRoundDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetFeedEscrowBalanceResponse proto.InternalMessageInfo
 
-func (m *GetLatestRoundDataResponse) GetRoundData() []*RoundData {
+func (m *GetFeedEscrowBalanceResponse) GetFeedId() string {
 	if m != nil {
-		return m.RoundData
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *GetFeedEscrowBalanceResponse) GetBalance() *Coin {
+	if m != nil {
+		return m.Balance
 	}
 	return nil
 }
 
-type RoundData struct {
-	FeedId   string         `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	FeedData *OCRAbiEncoded `protobuf:"bytes,2,opt,name=feedData,proto3" json:"feedData,omitempty"`
+// ListFeedsRequest lists the feeds matching every given filter, an empty filter matches every feed
+type ListFeedsRequest struct {
+	// feedOwner only lists the feeds owned by this account
+	FeedOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=feedOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"feedOwner,omitempty"`
+	// dataProvider only lists the feeds this account is a data provider of
+	DataProvider github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=dataProvider,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"dataProvider,omitempty"`
+	// feedRewardStrategy only lists the feeds rewarded with this strategy
+	FeedRewardStrategy string             `protobuf:"bytes,3,opt,name=feedRewardStrategy,proto3" json:"feedRewardStrategy,omitempty"`
+	Pagination         *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *RoundData) Reset()         { *m = RoundData{} }
-func (m *RoundData) String() string { return proto.CompactTextString(m) }
-func (*RoundData) ProtoMessage()    {}
-func (*RoundData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{8}
+func (m *ListFeedsRequest) Reset()         { *m = ListFeedsRequest{} }
+func (m *ListFeedsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFeedsRequest) ProtoMessage()    {}
+func (*ListFeedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{10}
 }
-func (m *RoundData) XXX_Unmarshal(b []byte) error {
+func (m *ListFeedsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RoundData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListFeedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RoundData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListFeedsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -425,48 +573,63 @@ func (m *RoundData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RoundData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoundData.Merge(m, src)
+func (m *ListFeedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFeedsRequest.Merge(m, src)
 }
-func (m *RoundData) XXX_Size() int {
+func (m *ListFeedsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RoundData) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoundData.DiscardUnknown(m)
+func (m *ListFeedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFeedsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RoundData proto.InternalMessageInfo
+var xxx_messageInfo_ListFeedsRequest proto.InternalMessageInfo
 
-func (m *RoundData) GetFeedId() string {
+func (m *ListFeedsRequest) GetFeedOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.FeedId
+		return m.FeedOwner
 	}
-	return ""
+	return nil
 }
 
-func (m *RoundData) GetFeedData() *OCRAbiEncoded {
+func (m *ListFeedsRequest) GetDataProvider() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.FeedData
+		return m.DataProvider
 	}
 	return nil
 }
 
-type GetAccountRequest struct {
-	AccountAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=accountAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"accountAddress,omitempty"`
+func (m *ListFeedsRequest) GetFeedRewardStrategy() string {
+	if m != nil {
+		return m.FeedRewardStrategy
+	}
+	return ""
 }
 
-func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
-func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAccountRequest) ProtoMessage()    {}
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{9}
+func (m *ListFeedsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+
+type ListFeedsResponse struct {
+	Feeds      []*MsgFeed          `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ListFeedsResponse) Reset()         { *m = ListFeedsResponse{} }
+func (m *ListFeedsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFeedsResponse) ProtoMessage()    {}
+func (*ListFeedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{11}
+}
+func (m *ListFeedsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListFeedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListFeedsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -476,41 +639,47 @@ func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+func (m *ListFeedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFeedsResponse.Merge(m, src)
 }
-func (m *GetAccountRequest) XXX_Size() int {
+func (m *ListFeedsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+func (m *ListFeedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFeedsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListFeedsResponse proto.InternalMessageInfo
 
-func (m *GetAccountRequest) GetAccountAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *ListFeedsResponse) GetFeeds() []*MsgFeed {
 	if m != nil {
-		return m.AccountAddress
+		return m.Feeds
 	}
 	return nil
 }
 
-type GetAccountResponse struct {
-	Account *MsgAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+func (m *ListFeedsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *GetAccountResponse) Reset()         { *m = GetAccountResponse{} }
-func (m *GetAccountResponse) String() string { return proto.CompactTextString(m) }
-func (*GetAccountResponse) ProtoMessage()    {}
-func (*GetAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{10}
+type GetModuleOwnerRequest struct {
 }
-func (m *GetAccountResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *GetModuleOwnerRequest) Reset()         { *m = GetModuleOwnerRequest{} }
+func (m *GetModuleOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*GetModuleOwnerRequest) ProtoMessage()    {}
+func (*GetModuleOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{12}
+}
+func (m *GetModuleOwnerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetModuleOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetAccountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetModuleOwnerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -520,40 +689,34 @@ func (m *GetAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetAccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountResponse.Merge(m, src)
+func (m *GetModuleOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetModuleOwnerRequest.Merge(m, src)
 }
-func (m *GetAccountResponse) XXX_Size() int {
+func (m *GetModuleOwnerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetAccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountResponse.DiscardUnknown(m)
+func (m *GetModuleOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetModuleOwnerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAccountResponse proto.InternalMessageInfo
-
-func (m *GetAccountResponse) GetAccount() *MsgAccount {
-	if m != nil {
-		return m.Account
-	}
-	return nil
-}
+var xxx_messageInfo_GetModuleOwnerRequest proto.InternalMessageInfo
 
-type GetFeedRewardAvailStrategiesRequest struct {
+type GetModuleOwnerResponse struct {
+	ModuleOwner []*MsgModuleOwner `protobuf:"bytes,1,rep,name=moduleOwner,proto3" json:"moduleOwner,omitempty"`
 }
 
-func (m *GetFeedRewardAvailStrategiesRequest) Reset()         { *m = GetFeedRewardAvailStrategiesRequest{} }
-func (m *GetFeedRewardAvailStrategiesRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFeedRewardAvailStrategiesRequest) ProtoMessage()    {}
-func (*GetFeedRewardAvailStrategiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{11}
+func (m *GetModuleOwnerResponse) Reset()         { *m = GetModuleOwnerResponse{} }
+func (m *GetModuleOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*GetModuleOwnerResponse) ProtoMessage()    {}
+func (*GetModuleOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{13}
 }
-func (m *GetFeedRewardAvailStrategiesRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetModuleOwnerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFeedRewardAvailStrategiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetModuleOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFeedRewardAvailStrategiesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetModuleOwnerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -563,34 +726,43 @@ func (m *GetFeedRewardAvailStrategiesRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *GetFeedRewardAvailStrategiesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFeedRewardAvailStrategiesRequest.Merge(m, src)
+func (m *GetModuleOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetModuleOwnerResponse.Merge(m, src)
 }
-func (m *GetFeedRewardAvailStrategiesRequest) XXX_Size() int {
+func (m *GetModuleOwnerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFeedRewardAvailStrategiesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFeedRewardAvailStrategiesRequest.DiscardUnknown(m)
+func (m *GetModuleOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetModuleOwnerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFeedRewardAvailStrategiesRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetModuleOwnerResponse proto.InternalMessageInfo
 
-type GetFeedRewardAvailStrategiesResponse struct {
-	AvailStrategies []string `protobuf:"bytes,1,rep,name=availStrategies,proto3" json:"availStrategies,omitempty"`
+func (m *GetModuleOwnerResponse) GetModuleOwner() []*MsgModuleOwner {
+	if m != nil {
+		return m.ModuleOwner
+	}
+	return nil
 }
 
-func (m *GetFeedRewardAvailStrategiesResponse) Reset()         { *m = GetFeedRewardAvailStrategiesResponse{} }
-func (m *GetFeedRewardAvailStrategiesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFeedRewardAvailStrategiesResponse) ProtoMessage()    {}
-func (*GetFeedRewardAvailStrategiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_21ba17f95e61a418, []int{12}
+type GetRoundDataRequest struct {
+	FeedId     string             `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	RoundId    uint64             `protobuf:"varint,2,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *GetFeedRewardAvailStrategiesResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *GetRoundDataRequest) Reset()         { *m = GetRoundDataRequest{} }
+func (m *GetRoundDataRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoundDataRequest) ProtoMessage()    {}
+func (*GetRoundDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{14}
+}
+func (m *GetRoundDataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetFeedRewardAvailStrategiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetRoundDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetFeedRewardAvailStrategiesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetRoundDataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -600,1013 +772,7121 @@ func (m *GetFeedRewardAvailStrategiesResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *GetFeedRewardAvailStrategiesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFeedRewardAvailStrategiesResponse.Merge(m, src)
+func (m *GetRoundDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoundDataRequest.Merge(m, src)
 }
-func (m *GetFeedRewardAvailStrategiesResponse) XXX_Size() int {
+func (m *GetRoundDataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetFeedRewardAvailStrategiesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFeedRewardAvailStrategiesResponse.DiscardUnknown(m)
+func (m *GetRoundDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoundDataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFeedRewardAvailStrategiesResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRoundDataRequest proto.InternalMessageInfo
 
-func (m *GetFeedRewardAvailStrategiesResponse) GetAvailStrategies() []string {
+func (m *GetRoundDataRequest) GetFeedId() string {
 	if m != nil {
-		return m.AvailStrategies
+		return m.FeedId
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*GetFeedByIdRequest)(nil), "chainlink.v1beta.GetFeedByIdRequest")
-	proto.RegisterType((*GetFeedByIdResponse)(nil), "chainlink.v1beta.GetFeedByIdResponse")
-	proto.RegisterType((*GetModuleOwnerRequest)(nil), "chainlink.v1beta.GetModuleOwnerRequest")
-	proto.RegisterType((*GetModuleOwnerResponse)(nil), "chainlink.v1beta.GetModuleOwnerResponse")
-	proto.RegisterType((*GetRoundDataRequest)(nil), "chainlink.v1beta.GetRoundDataRequest")
-	proto.RegisterType((*GetRoundDataResponse)(nil), "chainlink.v1beta.GetRoundDataResponse")
-	proto.RegisterType((*GetLatestRoundDataRequest)(nil), "chainlink.v1beta.GetLatestRoundDataRequest")
-	proto.RegisterType((*GetLatestRoundDataResponse)(nil), "chainlink.v1beta.GetLatestRoundDataResponse")
-	proto.RegisterType((*RoundData)(nil), "chainlink.v1beta.RoundData")
-	proto.RegisterType((*GetAccountRequest)(nil), "chainlink.v1beta.GetAccountRequest")
-	proto.RegisterType((*GetAccountResponse)(nil), "chainlink.v1beta.GetAccountResponse")
-	proto.RegisterType((*GetFeedRewardAvailStrategiesRequest)(nil), "chainlink.v1beta.GetFeedRewardAvailStrategiesRequest")
-	proto.RegisterType((*GetFeedRewardAvailStrategiesResponse)(nil), "chainlink.v1beta.GetFeedRewardAvailStrategiesResponse")
+func (m *GetRoundDataRequest) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("chainlink/v1beta/query.proto", fileDescriptor_21ba17f95e61a418) }
-
-var fileDescriptor_21ba17f95e61a418 = []byte{
-	// 849 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xc1, 0x4e, 0xeb, 0x46,
-	0x14, 0xc5, 0x40, 0xa1, 0x19, 0x10, 0xb4, 0x53, 0x0a, 0x89, 0x8b, 0x92, 0xd4, 0x84, 0x10, 0xd1,
-	0xc4, 0x23, 0x82, 0xa0, 0xaa, 0xba, 0x4a, 0xa0, 0x44, 0x91, 0x40, 0x80, 0x59, 0x54, 0xad, 0xba,
-	0xe8, 0xc4, 0x1e, 0x8c, 0x45, 0xf0, 0x04, 0x7b, 0x02, 0x44, 0x88, 0x4d, 0x37, 0x2c, 0xa9, 0xd4,
-	0xae, 0xd8, 0xf4, 0x43, 0xfa, 0x03, 0x5d, 0x22, 0x75, 0xd3, 0x55, 0xf5, 0x04, 0xef, 0x2b, 0xde,
-	0xea, 0xc9, 0xe3, 0x49, 0xec, 0xc4, 0x86, 0xf0, 0xf4, 0x56, 0x8c, 0x67, 0xce, 0x99, 0x7b, 0xee,
-	0xdc, 0x7b, 0x2e, 0x01, 0x8b, 0xfa, 0x09, 0xb6, 0xec, 0xa6, 0x65, 0x9f, 0xa2, 0x8b, 0xb5, 0x06,
-	0x61, 0x18, 0x9d, 0xb7, 0x89, 0xd3, 0x51, 0x5b, 0x0e, 0x65, 0x14, 0x7e, 0xd6, 0x3b, 0x55, 0xfd,
-	0x53, 0x79, 0x55, 0xa7, 0xee, 0x19, 0x75, 0x51, 0x03, 0xbb, 0xc4, 0x87, 0x0a, 0xde, 0x1a, 0x6a,
-	0x61, 0xd3, 0xb2, 0x31, 0xb3, 0xa8, 0xed, 0xb3, 0xe5, 0x54, 0xe4, 0x6e, 0x76, 0x25, 0x8e, 0x16,
-	0x4d, 0x4a, 0xcd, 0x26, 0x41, 0xb8, 0x65, 0x21, 0x6c, 0xdb, 0x94, 0x71, 0x9e, 0x2b, 0x4e, 0xd3,
-	0x11, 0xa2, 0x49, 0x6c, 0xe2, 0x5a, 0xdd, 0xf3, 0x39, 0x93, 0x9a, 0x94, 0x2f, 0x91, 0xb7, 0xf2,
-	0x77, 0x95, 0x22, 0x80, 0x35, 0xc2, 0x76, 0x08, 0x31, 0xaa, 0x9d, 0xba, 0xa1, 0x91, 0xf3, 0x36,
-	0x71, 0x19, 0x9c, 0x07, 0x13, 0xc7, 0x84, 0x18, 0x75, 0x23, 0x29, 0x65, 0xa5, 0x42, 0x42, 0x13,
-	0x5f, 0xca, 0x36, 0xf8, 0xa2, 0x0f, 0xed, 0xb6, 0xa8, 0xed, 0x12, 0x58, 0x02, 0xe3, 0x1e, 0x80,
-	0x83, 0xa7, 0xca, 0x29, 0x75, 0xf0, 0x01, 0xd4, 0x3d, 0xd7, 0xf4, 0x48, 0x1a, 0x87, 0x29, 0x0b,
-	0xe0, 0xcb, 0x1a, 0x61, 0x7b, 0xd4, 0x68, 0x37, 0xc9, 0xfe, 0xa5, 0x4d, 0x1c, 0x11, 0x56, 0xf9,
-	0x05, 0xcc, 0x0f, 0x1e, 0x88, 0x08, 0x55, 0x30, 0x75, 0x16, 0x6c, 0x27, 0xa5, 0xec, 0x58, 0x61,
-	0xaa, 0x9c, 0x8d, 0x0d, 0x14, 0xa6, 0x87, 0x49, 0xca, 0x9d, 0xc4, 0xd5, 0x6b, 0xb4, 0x6d, 0x1b,
-	0xdb, 0x98, 0xe1, 0x21, 0xc9, 0xc2, 0x24, 0x98, 0x74, 0x3c, 0x6c, 0xdd, 0x48, 0x8e, 0x66, 0xa5,
-	0xc2, 0xb8, 0xd6, 0xfd, 0x84, 0x3b, 0x00, 0x04, 0x75, 0x4b, 0x8e, 0xf1, 0xac, 0xf3, 0xaa, 0x5f,
-	0x64, 0xd5, 0x2b, 0xb2, 0xea, 0xf7, 0x83, 0x28, 0xb2, 0x7a, 0x80, 0x4d, 0x22, 0xa2, 0x69, 0x21,
-	0xa6, 0x72, 0x2f, 0x81, 0xb9, 0x7e, 0x45, 0x22, 0xdd, 0xef, 0x40, 0xc2, 0xe9, 0x6e, 0x8a, 0x64,
-	0xbf, 0x8a, 0x26, 0x1b, 0xf0, 0x02, 0x34, 0xac, 0xf5, 0x69, 0x1b, 0xe5, 0xda, 0x56, 0x86, 0x6a,
-	0xf3, 0xe3, 0xf6, 0x89, 0x5b, 0x07, 0xa9, 0x1a, 0x61, 0xbb, 0x98, 0x79, 0xaa, 0x5f, 0xf9, 0x66,
-	0xca, 0x8f, 0x40, 0x8e, 0x23, 0x7d, 0x74, 0x5a, 0xca, 0xaf, 0x20, 0xd1, 0xdb, 0x7f, 0xb6, 0x62,
-	0xdf, 0x83, 0x4f, 0xbd, 0x15, 0xbf, 0xde, 0xcf, 0x3c, 0x13, 0xbd, 0x7e, 0x7f, 0x4b, 0xab, 0x34,
-	0xac, 0x1f, 0x6c, 0x9d, 0x1a, 0xc4, 0xd0, 0x7a, 0x04, 0xc5, 0x06, 0x9f, 0xd7, 0x08, 0xab, 0xe8,
-	0x3a, 0x6d, 0xdb, 0xac, 0x9b, 0xe7, 0x4f, 0x60, 0x06, 0xfb, 0x3b, 0x15, 0xc3, 0x70, 0x88, 0xeb,
-	0xf2, 0x88, 0xd3, 0xd5, 0xb5, 0x77, 0xff, 0x67, 0x4a, 0xa6, 0xc5, 0x4e, 0xda, 0x0d, 0x55, 0xa7,
-	0x67, 0x48, 0x18, 0xdc, 0xff, 0x53, 0x72, 0x8d, 0x53, 0xc4, 0x3a, 0x2d, 0xe2, 0xaa, 0x15, 0x5d,
-	0x17, 0x44, 0x6d, 0xe0, 0x22, 0x65, 0x97, 0x3b, 0xaf, 0x17, 0x4f, 0x3c, 0xd1, 0x26, 0x98, 0x14,
-	0x38, 0xe1, 0xa6, 0xc5, 0xd8, 0x26, 0xef, 0xd2, 0xba, 0x60, 0x65, 0x19, 0x2c, 0x09, 0x67, 0x6a,
-	0xe4, 0x12, 0x3b, 0x46, 0xe5, 0x02, 0x5b, 0xcd, 0x23, 0xe6, 0x60, 0x46, 0x4c, 0x8b, 0xb8, 0x5d,
-	0x87, 0x1d, 0x80, 0xdc, 0xcb, 0x30, 0x21, 0xa3, 0x00, 0x66, 0x71, 0xff, 0x11, 0xaf, 0x57, 0x42,
-	0x1b, 0xdc, 0x2e, 0xff, 0x35, 0x09, 0x3e, 0x39, 0xf4, 0x3a, 0x0a, 0xfe, 0x29, 0x81, 0xe9, 0x70,
-	0x37, 0xc3, 0xe5, 0xa8, 0xf4, 0x18, 0xff, 0xc9, 0xf9, 0x61, 0x30, 0x5f, 0x93, 0xb2, 0xf1, 0xdb,
-	0xbf, 0x6f, 0xff, 0x18, 0x45, 0xb0, 0x84, 0x82, 0x49, 0xe7, 0x55, 0x0f, 0x19, 0x98, 0x61, 0xc4,
-	0x5b, 0x05, 0x5d, 0x0b, 0x8b, 0xde, 0xa0, 0x6b, 0xbf, 0x27, 0x6e, 0xe0, 0xbd, 0x04, 0x66, 0x07,
-	0x1a, 0x12, 0x7e, 0x13, 0x1b, 0x32, 0xbe, 0xd7, 0xe5, 0xe2, 0xeb, 0xc0, 0x42, 0x65, 0x91, 0xab,
-	0xcc, 0xc3, 0x5c, 0xac, 0xca, 0x26, 0x67, 0x05, 0xe2, 0x6e, 0x25, 0xbf, 0xeb, 0x9a, 0xcd, 0xd0,
-	0xd8, 0x82, 0x2b, 0xb1, 0x11, 0xa3, 0x03, 0x53, 0x2e, 0x0c, 0x07, 0x0a, 0x59, 0x19, 0x2e, 0x2b,
-	0x05, 0x17, 0x42, 0xb2, 0xfc, 0xe1, 0x88, 0x28, 0x8f, 0x79, 0x2b, 0x81, 0xd9, 0xde, 0x6c, 0xdf,
-	0xf1, 0xfd, 0x94, 0x8b, 0xbd, 0x7e, 0xe0, 0x9f, 0x85, 0xbc, 0x3c, 0x04, 0x25, 0x14, 0xac, 0x70,
-	0x05, 0x5f, 0xc3, 0x4c, 0x54, 0x01, 0x7f, 0x9f, 0xde, 0x9b, 0xdc, 0x49, 0x60, 0x26, 0x70, 0x46,
-	0xdd, 0x3e, 0xa6, 0x70, 0x29, 0x36, 0x44, 0xbf, 0x57, 0xe5, 0xdc, 0xcb, 0x20, 0x21, 0xa3, 0xcc,
-	0x65, 0x14, 0xe1, 0x6a, 0x54, 0x86, 0xf0, 0x12, 0xba, 0xee, 0x77, 0xea, 0x0d, 0xfc, 0x5b, 0xe2,
-	0x63, 0x2d, 0xde, 0x36, 0x1d, 0xb8, 0xf1, 0xec, 0x03, 0xbc, 0xe4, 0x45, 0x79, 0xf3, 0x43, 0x69,
-	0x22, 0x03, 0x95, 0x67, 0x50, 0x80, 0xf9, 0x67, 0x1e, 0xd2, 0xe1, 0x6c, 0xe4, 0x0a, 0x79, 0xd5,
-	0xc3, 0x7f, 0x1e, 0xd3, 0xd2, 0xc3, 0x63, 0x5a, 0x7a, 0xf3, 0x98, 0x96, 0x7e, 0x7f, 0x4a, 0x8f,
-	0x3c, 0x3c, 0xa5, 0x47, 0xfe, 0x7b, 0x4a, 0x8f, 0xfc, 0xfc, 0x6d, 0x68, 0x82, 0x6d, 0x79, 0x77,
-	0x1d, 0xe1, 0x63, 0x12, 0xdc, 0x5a, 0x12, 0x53, 0xed, 0x2a, 0x14, 0x88, 0x8f, 0xb5, 0xc6, 0x04,
-	0xff, 0xf1, 0xb0, 0xfe, 0x3e, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xbb, 0xd8, 0xba, 0x09, 0x09, 0x00,
-	0x00,
+func (m *GetRoundDataRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	GetRoundData(ctx context.Context, in *GetRoundDataRequest, opts ...grpc.CallOption) (*GetRoundDataResponse, error)
-	LatestRoundData(ctx context.Context, in *GetLatestRoundDataRequest, opts ...grpc.CallOption) (*GetLatestRoundDataResponse, error)
-	GetAllModuleOwner(ctx context.Context, in *GetModuleOwnerRequest, opts ...grpc.CallOption) (*GetModuleOwnerResponse, error)
-	GetFeedByFeedId(ctx context.Context, in *GetFeedByIdRequest, opts ...grpc.CallOption) (*GetFeedByIdResponse, error)
-	GetAccountInfo(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
-	GetFeedRewardAvailStrategy(ctx context.Context, in *GetFeedRewardAvailStrategiesRequest, opts ...grpc.CallOption) (*GetFeedRewardAvailStrategiesResponse, error)
+type GetRoundDataResponse struct {
+	RoundData  []*RoundData        `protobuf:"bytes,1,rep,name=roundData,proto3" json:"roundData,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *GetRoundDataResponse) Reset()         { *m = GetRoundDataResponse{} }
+func (m *GetRoundDataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoundDataResponse) ProtoMessage()    {}
+func (*GetRoundDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{15}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *GetRoundDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetRoundData(ctx context.Context, in *GetRoundDataRequest, opts ...grpc.CallOption) (*GetRoundDataResponse, error) {
-	out := new(GetRoundDataResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetRoundData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetRoundDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRoundDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetRoundDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoundDataResponse.Merge(m, src)
+}
+func (m *GetRoundDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRoundDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoundDataResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LatestRoundData(ctx context.Context, in *GetLatestRoundDataRequest, opts ...grpc.CallOption) (*GetLatestRoundDataResponse, error) {
-	out := new(GetLatestRoundDataResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/LatestRoundData", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetRoundDataResponse proto.InternalMessageInfo
+
+func (m *GetRoundDataResponse) GetRoundData() []*RoundData {
+	if m != nil {
+		return m.RoundData
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetAllModuleOwner(ctx context.Context, in *GetModuleOwnerRequest, opts ...grpc.CallOption) (*GetModuleOwnerResponse, error) {
-	out := new(GetModuleOwnerResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetAllModuleOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetRoundDataResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetFeedByFeedId(ctx context.Context, in *GetFeedByIdRequest, opts ...grpc.CallOption) (*GetFeedByIdResponse, error) {
-	out := new(GetFeedByIdResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetFeedByFeedId", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// GetRoundHistoryRequest lists the rounds of a feed within [fromRound, toRound],
+// optionally restricted to the rounds updated within [startTime, endTime]
+type GetRoundHistoryRequest struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// fromRound is the first round of the range, 0 starts from the first round of the feed
+	FromRound uint64 `protobuf:"varint,2,opt,name=fromRound,proto3" json:"fromRound,omitempty"`
+	// toRound is the last round of the range, 0 ends at the latest round of the feed
+	ToRound uint64 `protobuf:"varint,3,opt,name=toRound,proto3" json:"toRound,omitempty"`
+	// startTime is the unix timestamp (seconds) the rounds are updated at or after, 0 disables the bound
+	StartTime uint64 `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// endTime is the unix timestamp (seconds) the rounds are updated at or before, 0 disables the bound
+	EndTime uint64 `protobuf:"varint,5,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// reverse lists the rounds from the newest to the oldest
+	Reverse    bool               `protobuf:"varint,6,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetRoundHistoryRequest) Reset()         { *m = GetRoundHistoryRequest{} }
+func (m *GetRoundHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoundHistoryRequest) ProtoMessage()    {}
+func (*GetRoundHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{16}
+}
+func (m *GetRoundHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetAccountInfo(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
-	out := new(GetAccountResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetAccountInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetRoundHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRoundHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetFeedRewardAvailStrategy(ctx context.Context, in *GetFeedRewardAvailStrategiesRequest, opts ...grpc.CallOption) (*GetFeedRewardAvailStrategiesResponse, error) {
-	out := new(GetFeedRewardAvailStrategiesResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetFeedRewardAvailStrategy", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetRoundHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoundHistoryRequest.Merge(m, src)
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	GetRoundData(context.Context, *GetRoundDataRequest) (*GetRoundDataResponse, error)
-	LatestRoundData(context.Context, *GetLatestRoundDataRequest) (*GetLatestRoundDataResponse, error)
-	GetAllModuleOwner(context.Context, *GetModuleOwnerRequest) (*GetModuleOwnerResponse, error)
-	GetFeedByFeedId(context.Context, *GetFeedByIdRequest) (*GetFeedByIdResponse, error)
-	GetAccountInfo(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
-	GetFeedRewardAvailStrategy(context.Context, *GetFeedRewardAvailStrategiesRequest) (*GetFeedRewardAvailStrategiesResponse, error)
+func (m *GetRoundHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *GetRoundHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoundHistoryRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) GetRoundData(ctx context.Context, req *GetRoundDataRequest) (*GetRoundDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRoundData not implemented")
-}
-func (*UnimplementedQueryServer) LatestRoundData(ctx context.Context, req *GetLatestRoundDataRequest) (*GetLatestRoundDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LatestRoundData not implemented")
-}
-func (*UnimplementedQueryServer) GetAllModuleOwner(ctx context.Context, req *GetModuleOwnerRequest) (*GetModuleOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllModuleOwner not implemented")
-}
-func (*UnimplementedQueryServer) GetFeedByFeedId(ctx context.Context, req *GetFeedByIdRequest) (*GetFeedByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFeedByFeedId not implemented")
-}
-func (*UnimplementedQueryServer) GetAccountInfo(ctx context.Context, req *GetAccountRequest) (*GetAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
-}
-func (*UnimplementedQueryServer) GetFeedRewardAvailStrategy(ctx context.Context, req *GetFeedRewardAvailStrategiesRequest) (*GetFeedRewardAvailStrategiesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFeedRewardAvailStrategy not implemented")
-}
+var xxx_messageInfo_GetRoundHistoryRequest proto.InternalMessageInfo
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *GetRoundHistoryRequest) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
 }
 
-func _Query_GetRoundData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRoundDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRoundData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Query/GetRoundData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRoundData(ctx, req.(*GetRoundDataRequest))
+func (m *GetRoundHistoryRequest) GetFromRound() uint64 {
+	if m != nil {
+		return m.FromRound
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LatestRoundData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestRoundDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LatestRoundData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Query/LatestRoundData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LatestRoundData(ctx, req.(*GetLatestRoundDataRequest))
+func (m *GetRoundHistoryRequest) GetToRound() uint64 {
+	if m != nil {
+		return m.ToRound
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetAllModuleOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetModuleOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllModuleOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Query/GetAllModuleOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllModuleOwner(ctx, req.(*GetModuleOwnerRequest))
+func (m *GetRoundHistoryRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetFeedByFeedId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeedByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetFeedByFeedId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Query/GetFeedByFeedId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetFeedByFeedId(ctx, req.(*GetFeedByIdRequest))
+func (m *GetRoundHistoryRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAccountInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Query/GetAccountInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAccountInfo(ctx, req.(*GetAccountRequest))
+func (m *GetRoundHistoryRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_GetFeedRewardAvailStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFeedRewardAvailStrategiesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetFeedRewardAvailStrategy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Query/GetFeedRewardAvailStrategy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetFeedRewardAvailStrategy(ctx, req.(*GetFeedRewardAvailStrategiesRequest))
+func (m *GetRoundHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "chainlink.v1beta.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRoundData",
-			Handler:    _Query_GetRoundData_Handler,
-		},
-		{
-			MethodName: "LatestRoundData",
-			Handler:    _Query_LatestRoundData_Handler,
-		},
-		{
-			MethodName: "GetAllModuleOwner",
-			Handler:    _Query_GetAllModuleOwner_Handler,
-		},
-		{
-			MethodName: "GetFeedByFeedId",
-			Handler:    _Query_GetFeedByFeedId_Handler,
-		},
-		{
-			MethodName: "GetAccountInfo",
-			Handler:    _Query_GetAccountInfo_Handler,
-		},
-		{
-			MethodName: "GetFeedRewardAvailStrategy",
-			Handler:    _Query_GetFeedRewardAvailStrategy_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "chainlink/v1beta/query.proto",
+type GetRoundHistoryResponse struct {
+	RoundData  []*RoundData        `protobuf:"bytes,1,rep,name=roundData,proto3" json:"roundData,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetFeedByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetRoundHistoryResponse) Reset()         { *m = GetRoundHistoryResponse{} }
+func (m *GetRoundHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoundHistoryResponse) ProtoMessage()    {}
+func (*GetRoundHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{17}
+}
+func (m *GetRoundHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRoundHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRoundHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetFeedByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetRoundHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoundHistoryResponse.Merge(m, src)
+}
+func (m *GetRoundHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRoundHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoundHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *GetFeedByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_GetRoundHistoryResponse proto.InternalMessageInfo
+
+func (m *GetRoundHistoryResponse) GetRoundData() []*RoundData {
+	if m != nil {
+		return m.RoundData
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetFeedByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetRoundHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetFeedByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetLatestRoundDataRequest struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
 }
 
-func (m *GetFeedByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Feed != nil {
-		{
-			size, err := m.Feed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *GetLatestRoundDataRequest) Reset()         { *m = GetLatestRoundDataRequest{} }
+func (m *GetLatestRoundDataRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLatestRoundDataRequest) ProtoMessage()    {}
+func (*GetLatestRoundDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{18}
+}
+func (m *GetLatestRoundDataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatestRoundDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatestRoundDataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetLatestRoundDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatestRoundDataRequest.Merge(m, src)
+}
+func (m *GetLatestRoundDataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatestRoundDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatestRoundDataRequest.DiscardUnknown(m)
 }
 
-func (m *GetModuleOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetLatestRoundDataRequest proto.InternalMessageInfo
+
+func (m *GetLatestRoundDataRequest) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetModuleOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetLatestRoundDataResponse struct {
+	RoundData []*RoundData `protobuf:"bytes,1,rep,name=roundData,proto3" json:"roundData,omitempty"`
 }
 
-func (m *GetModuleOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GetLatestRoundDataResponse) Reset()         { *m = GetLatestRoundDataResponse{} }
+func (m *GetLatestRoundDataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLatestRoundDataResponse) ProtoMessage()    {}
+func (*GetLatestRoundDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{19}
+}
+func (m *GetLatestRoundDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetLatestRoundDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetLatestRoundDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetLatestRoundDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatestRoundDataResponse.Merge(m, src)
+}
+func (m *GetLatestRoundDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetLatestRoundDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatestRoundDataResponse.DiscardUnknown(m)
 }
 
-func (m *GetModuleOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetLatestRoundDataResponse proto.InternalMessageInfo
+
+func (m *GetLatestRoundDataResponse) GetRoundData() []*RoundData {
+	if m != nil {
+		return m.RoundData
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetModuleOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RoundData follows the AggregatorV3 round data shape
+type RoundData struct {
+	FeedId   string         `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	FeedData *OCRAbiEncoded `protobuf:"bytes,2,opt,name=feedData,proto3" json:"feedData,omitempty"`
+	RoundId  uint64         `protobuf:"varint,3,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	// answer is the median of the round observations
+	Answer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=answer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"answer"`
+	// startedAt is the unix timestamp (seconds) of the block the round started in
+	StartedAt uint64 `protobuf:"varint,5,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	// updatedAt is the unix timestamp (seconds) of the block the answer got updated in
+	UpdatedAt uint64 `protobuf:"varint,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// answeredInRound is the round in which the answer was computed
+	AnsweredInRound uint64 `protobuf:"varint,7,opt,name=answeredInRound,proto3" json:"answeredInRound,omitempty"`
+	// blockHeight is the height of the block the round got persisted in
+	BlockHeight int64 `protobuf:"varint,8,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	// value is the answer of the round typed after the feed value schema
+	Value *FeedValue `protobuf:"bytes,9,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *GetModuleOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ModuleOwner) > 0 {
-		for iNdEx := len(m.ModuleOwner) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ModuleOwner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *RoundData) Reset()         { *m = RoundData{} }
+func (m *RoundData) String() string { return proto.CompactTextString(m) }
+func (*RoundData) ProtoMessage()    {}
+func (*RoundData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{20}
+}
+func (m *RoundData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoundData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoundData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RoundData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundData.Merge(m, src)
+}
+func (m *RoundData) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoundData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundData.DiscardUnknown(m)
 }
 
-func (m *GetRoundDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RoundData proto.InternalMessageInfo
+
+func (m *RoundData) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetRoundDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RoundData) GetFeedData() *OCRAbiEncoded {
+	if m != nil {
+		return m.FeedData
+	}
+	return nil
 }
 
-func (m *GetRoundDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *RoundData) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
 	}
-	if m.RoundId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RoundId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *RoundData) GetStartedAt() uint64 {
+	if m != nil {
+		return m.StartedAt
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *RoundData) GetUpdatedAt() uint64 {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetRoundDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RoundData) GetAnsweredInRound() uint64 {
+	if m != nil {
+		return m.AnsweredInRound
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetRoundDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RoundData) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *GetRoundDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *RoundData) GetValue() *FeedValue {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.RoundData) > 0 {
-		for iNdEx := len(m.RoundData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RoundData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GetLatestRoundDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type GetAccountRequest struct {
+	AccountAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=accountAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"accountAddress,omitempty"`
 }
 
-func (m *GetLatestRoundDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{21}
 }
-
-func (m *GetLatestRoundDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
 }
 
-func (m *GetLatestRoundDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetAccountAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.AccountAddress
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetLatestRoundDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetAccountResponse struct {
+	Account *MsgAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *GetLatestRoundDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RoundData) > 0 {
-		for iNdEx := len(m.RoundData) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RoundData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *GetAccountResponse) Reset()         { *m = GetAccountResponse{} }
+func (m *GetAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountResponse) ProtoMessage()    {}
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{22}
+}
+func (m *GetAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountResponse.Merge(m, src)
+}
+func (m *GetAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountResponse.DiscardUnknown(m)
 }
 
-func (m *RoundData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetAccountResponse proto.InternalMessageInfo
+
+func (m *GetAccountResponse) GetAccount() *MsgAccount {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RoundData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ListOwedPaymentsRequest lists the payments owed matching every given filter
+type ListOwedPaymentsRequest struct {
+	// oracle only lists the payments owed to this account
+	Oracle github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=oracle,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle,omitempty"`
+	// feedId only lists the payments owed by this feed
+	FeedId     string             `protobuf:"bytes,2,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *RoundData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeedData != nil {
-		{
-			size, err := m.FeedData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ListOwedPaymentsRequest) Reset()         { *m = ListOwedPaymentsRequest{} }
+func (m *ListOwedPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOwedPaymentsRequest) ProtoMessage()    {}
+func (*ListOwedPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{23}
+}
+func (m *ListOwedPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOwedPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOwedPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListOwedPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOwedPaymentsRequest.Merge(m, src)
+}
+func (m *ListOwedPaymentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListOwedPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOwedPaymentsRequest.DiscardUnknown(m)
 }
 
-func (m *GetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ListOwedPaymentsRequest proto.InternalMessageInfo
+
+func (m *ListOwedPaymentsRequest) GetOracle() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Oracle
+	}
+	return nil
 }
 
-func (m *GetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AccountAddress) > 0 {
-		i -= len(m.AccountAddress)
-		copy(dAtA[i:], m.AccountAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *ListOwedPaymentsRequest) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListOwedPaymentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListOwedPaymentsResponse struct {
+	Payments   []*OwedPayment      `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Account != nil {
-		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *ListOwedPaymentsResponse) Reset()         { *m = ListOwedPaymentsResponse{} }
+func (m *ListOwedPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOwedPaymentsResponse) ProtoMessage()    {}
+func (*ListOwedPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{24}
+}
+func (m *ListOwedPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOwedPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOwedPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListOwedPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOwedPaymentsResponse.Merge(m, src)
+}
+func (m *ListOwedPaymentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListOwedPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOwedPaymentsResponse.DiscardUnknown(m)
 }
 
-func (m *GetFeedRewardAvailStrategiesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListOwedPaymentsResponse proto.InternalMessageInfo
+
+func (m *ListOwedPaymentsResponse) GetPayments() []*OwedPayment {
+	if m != nil {
+		return m.Payments
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetFeedRewardAvailStrategiesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListOwedPaymentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *GetFeedRewardAvailStrategiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type ListOwnerProposalsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetFeedRewardAvailStrategiesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListOwnerProposalsRequest) Reset()         { *m = ListOwnerProposalsRequest{} }
+func (m *ListOwnerProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOwnerProposalsRequest) ProtoMessage()    {}
+func (*ListOwnerProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{25}
 }
-
-func (m *GetFeedRewardAvailStrategiesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListOwnerProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *GetFeedRewardAvailStrategiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AvailStrategies) > 0 {
-		for iNdEx := len(m.AvailStrategies) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AvailStrategies[iNdEx])
-			copy(dAtA[i:], m.AvailStrategies[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailStrategies[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *ListOwnerProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOwnerProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *ListOwnerProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOwnerProposalsRequest.Merge(m, src)
 }
-func (m *GetFeedByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *ListOwnerProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListOwnerProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOwnerProposalsRequest.DiscardUnknown(m)
 }
 
-func (m *GetFeedByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Feed != nil {
-		l = m.Feed.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_ListOwnerProposalsRequest proto.InternalMessageInfo
+
+func (m *ListOwnerProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *GetModuleOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// ListOwnerProposalsResponse lists the pending owner proposals in submission order
+type ListOwnerProposalsResponse struct {
+	Proposals []*OwnerProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	// threshold is the number of approvals a proposal needs to execute with the current module owners
+	Threshold  uint32              `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *GetModuleOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ModuleOwner) > 0 {
-		for _, e := range m.ModuleOwner {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *ListOwnerProposalsResponse) Reset()         { *m = ListOwnerProposalsResponse{} }
+func (m *ListOwnerProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOwnerProposalsResponse) ProtoMessage()    {}
+func (*ListOwnerProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{26}
+}
+func (m *ListOwnerProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListOwnerProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListOwnerProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ListOwnerProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOwnerProposalsResponse.Merge(m, src)
+}
+func (m *ListOwnerProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListOwnerProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOwnerProposalsResponse.DiscardUnknown(m)
 }
 
-func (m *GetRoundDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.RoundId != 0 {
-		n += 1 + sovQuery(uint64(m.RoundId))
+var xxx_messageInfo_ListOwnerProposalsResponse proto.InternalMessageInfo
+
+func (m *ListOwnerProposalsResponse) GetProposals() []*OwnerProposal {
+	if m != nil {
+		return m.Proposals
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ListOwnerProposalsResponse) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
 	}
-	return n
+	return 0
 }
 
-func (m *GetRoundDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListOwnerProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.RoundData) > 0 {
-		for _, e := range m.RoundData {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type ListModuleOwnershipTransfersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ListModuleOwnershipTransfersRequest) Reset()         { *m = ListModuleOwnershipTransfersRequest{} }
+func (m *ListModuleOwnershipTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListModuleOwnershipTransfersRequest) ProtoMessage()    {}
+func (*ListModuleOwnershipTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{27}
+}
+func (m *ListModuleOwnershipTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListModuleOwnershipTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListModuleOwnershipTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *ListModuleOwnershipTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListModuleOwnershipTransfersRequest.Merge(m, src)
+}
+func (m *ListModuleOwnershipTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListModuleOwnershipTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListModuleOwnershipTransfersRequest.DiscardUnknown(m)
 }
 
-func (m *GetLatestRoundDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_ListModuleOwnershipTransfersRequest proto.InternalMessageInfo
+
+func (m *ListModuleOwnershipTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *GetLatestRoundDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RoundData) > 0 {
-		for _, e := range m.RoundData {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+// ListModuleOwnershipTransfersResponse lists the pending module ownership transfers
+type ListModuleOwnershipTransfersResponse struct {
+	Transfers  []*OwnershipTransfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ListModuleOwnershipTransfersResponse) Reset()         { *m = ListModuleOwnershipTransfersResponse{} }
+func (m *ListModuleOwnershipTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListModuleOwnershipTransfersResponse) ProtoMessage()    {}
+func (*ListModuleOwnershipTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{28}
+}
+func (m *ListModuleOwnershipTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListModuleOwnershipTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListModuleOwnershipTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ListModuleOwnershipTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListModuleOwnershipTransfersResponse.Merge(m, src)
+}
+func (m *ListModuleOwnershipTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListModuleOwnershipTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListModuleOwnershipTransfersResponse.DiscardUnknown(m)
 }
 
-func (m *RoundData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FeedData != nil {
-		l = m.FeedData.Size()
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_ListModuleOwnershipTransfersResponse proto.InternalMessageInfo
+
+func (m *ListModuleOwnershipTransfersResponse) GetTransfers() []*OwnershipTransfer {
+	if m != nil {
+		return m.Transfers
 	}
-	return n
+	return nil
 }
 
-func (m *GetAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *ListModuleOwnershipTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *GetAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type GetFeedOwnershipTransferRequest struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+}
+
+func (m *GetFeedOwnershipTransferRequest) Reset()         { *m = GetFeedOwnershipTransferRequest{} }
+func (m *GetFeedOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeedOwnershipTransferRequest) ProtoMessage()    {}
+func (*GetFeedOwnershipTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{29}
+}
+func (m *GetFeedOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeedOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeedOwnershipTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Account != nil {
-		l = m.Account.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *GetFeedOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedOwnershipTransferRequest.Merge(m, src)
+}
+func (m *GetFeedOwnershipTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeedOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedOwnershipTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeedOwnershipTransferRequest proto.InternalMessageInfo
+
+func (m *GetFeedOwnershipTransferRequest) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return n
+	return ""
 }
 
-func (m *GetFeedRewardAvailStrategiesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// GetFeedOwnershipTransferResponse holds the pending ownership transfer of the feed, nil when there is none
+type GetFeedOwnershipTransferResponse struct {
+	Transfer *OwnershipTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (m *GetFeedOwnershipTransferResponse) Reset()         { *m = GetFeedOwnershipTransferResponse{} }
+func (m *GetFeedOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeedOwnershipTransferResponse) ProtoMessage()    {}
+func (*GetFeedOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{30}
+}
+func (m *GetFeedOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeedOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeedOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *GetFeedOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedOwnershipTransferResponse.Merge(m, src)
+}
+func (m *GetFeedOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeedOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedOwnershipTransferResponse.DiscardUnknown(m)
 }
 
-func (m *GetFeedRewardAvailStrategiesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_GetFeedOwnershipTransferResponse proto.InternalMessageInfo
+
+func (m *GetFeedOwnershipTransferResponse) GetTransfer() *OwnershipTransfer {
+	if m != nil {
+		return m.Transfer
 	}
-	var l int
-	_ = l
-	if len(m.AvailStrategies) > 0 {
-		for _, s := range m.AvailStrategies {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type ListAccountsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ListAccountsRequest) Reset()         { *m = ListAccountsRequest{} }
+func (m *ListAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsRequest) ProtoMessage()    {}
+func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{31}
+}
+func (m *ListAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ListAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsRequest.Merge(m, src)
+}
+func (m *ListAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsRequest.DiscardUnknown(m)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_ListAccountsRequest proto.InternalMessageInfo
+
+func (m *ListAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+type ListAccountsResponse struct {
+	Accounts   []*MsgAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *ListAccountsResponse) Reset()         { *m = ListAccountsResponse{} }
+func (m *ListAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsResponse) ProtoMessage()    {}
+func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{32}
+}
+func (m *ListAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsResponse.Merge(m, src)
+}
+func (m *ListAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*MsgAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *ListAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// GetAccountByChainlinkKeyRequest looks up the chainlink account by its chainlink public key or signing key
+type GetAccountByChainlinkKeyRequest struct {
+	ChainlinkKey []byte `protobuf:"bytes,1,opt,name=chainlinkKey,proto3" json:"chainlinkKey,omitempty"`
+}
+
+func (m *GetAccountByChainlinkKeyRequest) Reset()         { *m = GetAccountByChainlinkKeyRequest{} }
+func (m *GetAccountByChainlinkKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountByChainlinkKeyRequest) ProtoMessage()    {}
+func (*GetAccountByChainlinkKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{33}
+}
+func (m *GetAccountByChainlinkKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAccountByChainlinkKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAccountByChainlinkKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAccountByChainlinkKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountByChainlinkKeyRequest.Merge(m, src)
+}
+func (m *GetAccountByChainlinkKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAccountByChainlinkKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountByChainlinkKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountByChainlinkKeyRequest proto.InternalMessageInfo
+
+func (m *GetAccountByChainlinkKeyRequest) GetChainlinkKey() []byte {
+	if m != nil {
+		return m.ChainlinkKey
+	}
+	return nil
+}
+
+type GetFeedRewardAvailStrategiesRequest struct {
+}
+
+func (m *GetFeedRewardAvailStrategiesRequest) Reset()         { *m = GetFeedRewardAvailStrategiesRequest{} }
+func (m *GetFeedRewardAvailStrategiesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeedRewardAvailStrategiesRequest) ProtoMessage()    {}
+func (*GetFeedRewardAvailStrategiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{34}
+}
+func (m *GetFeedRewardAvailStrategiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeedRewardAvailStrategiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeedRewardAvailStrategiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFeedRewardAvailStrategiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedRewardAvailStrategiesRequest.Merge(m, src)
+}
+func (m *GetFeedRewardAvailStrategiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeedRewardAvailStrategiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedRewardAvailStrategiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeedRewardAvailStrategiesRequest proto.InternalMessageInfo
+
+type GetFeedRewardAvailStrategiesResponse struct {
+	AvailStrategies []string `protobuf:"bytes,1,rep,name=availStrategies,proto3" json:"availStrategies,omitempty"`
+	// strategies are the registered strategies along with their description, in name order
+	Strategies []*FeedRewardStrategyInfo `protobuf:"bytes,2,rep,name=strategies,proto3" json:"strategies,omitempty"`
+}
+
+func (m *GetFeedRewardAvailStrategiesResponse) Reset()         { *m = GetFeedRewardAvailStrategiesResponse{} }
+func (m *GetFeedRewardAvailStrategiesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeedRewardAvailStrategiesResponse) ProtoMessage()    {}
+func (*GetFeedRewardAvailStrategiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{35}
+}
+func (m *GetFeedRewardAvailStrategiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFeedRewardAvailStrategiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFeedRewardAvailStrategiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetFeedRewardAvailStrategiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeedRewardAvailStrategiesResponse.Merge(m, src)
+}
+func (m *GetFeedRewardAvailStrategiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFeedRewardAvailStrategiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeedRewardAvailStrategiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeedRewardAvailStrategiesResponse proto.InternalMessageInfo
+
+func (m *GetFeedRewardAvailStrategiesResponse) GetAvailStrategies() []string {
+	if m != nil {
+		return m.AvailStrategies
+	}
+	return nil
+}
+
+func (m *GetFeedRewardAvailStrategiesResponse) GetStrategies() []*FeedRewardStrategyInfo {
+	if m != nil {
+		return m.Strategies
+	}
+	return nil
+}
+
+// FeedRewardStrategyInfo describes how a registered reward strategy pays the rounds of a feed
+type FeedRewardStrategyInfo struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *FeedRewardStrategyInfo) Reset()         { *m = FeedRewardStrategyInfo{} }
+func (m *FeedRewardStrategyInfo) String() string { return proto.CompactTextString(m) }
+func (*FeedRewardStrategyInfo) ProtoMessage()    {}
+func (*FeedRewardStrategyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_21ba17f95e61a418, []int{36}
+}
+func (m *FeedRewardStrategyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedRewardStrategyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedRewardStrategyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedRewardStrategyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedRewardStrategyInfo.Merge(m, src)
+}
+func (m *FeedRewardStrategyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedRewardStrategyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedRewardStrategyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedRewardStrategyInfo proto.InternalMessageInfo
+
+func (m *FeedRewardStrategyInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FeedRewardStrategyInfo) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GetModuleParamsRequest)(nil), "chainlink.v1beta.GetModuleParamsRequest")
+	proto.RegisterType((*GetModuleParamsResponse)(nil), "chainlink.v1beta.GetModuleParamsResponse")
+	proto.RegisterType((*GetFeedByIdRequest)(nil), "chainlink.v1beta.GetFeedByIdRequest")
+	proto.RegisterType((*GetFeedByIdResponse)(nil), "chainlink.v1beta.GetFeedByIdResponse")
+	proto.RegisterType((*GetFeedMetadataRequest)(nil), "chainlink.v1beta.GetFeedMetadataRequest")
+	proto.RegisterType((*GetFeedMetadataResponse)(nil), "chainlink.v1beta.GetFeedMetadataResponse")
+	proto.RegisterType((*LatestConfigDetailsRequest)(nil), "chainlink.v1beta.LatestConfigDetailsRequest")
+	proto.RegisterType((*LatestConfigDetailsResponse)(nil), "chainlink.v1beta.LatestConfigDetailsResponse")
+	proto.RegisterType((*GetFeedEscrowBalanceRequest)(nil), "chainlink.v1beta.GetFeedEscrowBalanceRequest")
+	proto.RegisterType((*GetFeedEscrowBalanceResponse)(nil), "chainlink.v1beta.GetFeedEscrowBalanceResponse")
+	proto.RegisterType((*ListFeedsRequest)(nil), "chainlink.v1beta.ListFeedsRequest")
+	proto.RegisterType((*ListFeedsResponse)(nil), "chainlink.v1beta.ListFeedsResponse")
+	proto.RegisterType((*GetModuleOwnerRequest)(nil), "chainlink.v1beta.GetModuleOwnerRequest")
+	proto.RegisterType((*GetModuleOwnerResponse)(nil), "chainlink.v1beta.GetModuleOwnerResponse")
+	proto.RegisterType((*GetRoundDataRequest)(nil), "chainlink.v1beta.GetRoundDataRequest")
+	proto.RegisterType((*GetRoundDataResponse)(nil), "chainlink.v1beta.GetRoundDataResponse")
+	proto.RegisterType((*GetRoundHistoryRequest)(nil), "chainlink.v1beta.GetRoundHistoryRequest")
+	proto.RegisterType((*GetRoundHistoryResponse)(nil), "chainlink.v1beta.GetRoundHistoryResponse")
+	proto.RegisterType((*GetLatestRoundDataRequest)(nil), "chainlink.v1beta.GetLatestRoundDataRequest")
+	proto.RegisterType((*GetLatestRoundDataResponse)(nil), "chainlink.v1beta.GetLatestRoundDataResponse")
+	proto.RegisterType((*RoundData)(nil), "chainlink.v1beta.RoundData")
+	proto.RegisterType((*GetAccountRequest)(nil), "chainlink.v1beta.GetAccountRequest")
+	proto.RegisterType((*GetAccountResponse)(nil), "chainlink.v1beta.GetAccountResponse")
+	proto.RegisterType((*ListOwedPaymentsRequest)(nil), "chainlink.v1beta.ListOwedPaymentsRequest")
+	proto.RegisterType((*ListOwedPaymentsResponse)(nil), "chainlink.v1beta.ListOwedPaymentsResponse")
+	proto.RegisterType((*ListOwnerProposalsRequest)(nil), "chainlink.v1beta.ListOwnerProposalsRequest")
+	proto.RegisterType((*ListOwnerProposalsResponse)(nil), "chainlink.v1beta.ListOwnerProposalsResponse")
+	proto.RegisterType((*ListModuleOwnershipTransfersRequest)(nil), "chainlink.v1beta.ListModuleOwnershipTransfersRequest")
+	proto.RegisterType((*ListModuleOwnershipTransfersResponse)(nil), "chainlink.v1beta.ListModuleOwnershipTransfersResponse")
+	proto.RegisterType((*GetFeedOwnershipTransferRequest)(nil), "chainlink.v1beta.GetFeedOwnershipTransferRequest")
+	proto.RegisterType((*GetFeedOwnershipTransferResponse)(nil), "chainlink.v1beta.GetFeedOwnershipTransferResponse")
+	proto.RegisterType((*ListAccountsRequest)(nil), "chainlink.v1beta.ListAccountsRequest")
+	proto.RegisterType((*ListAccountsResponse)(nil), "chainlink.v1beta.ListAccountsResponse")
+	proto.RegisterType((*GetAccountByChainlinkKeyRequest)(nil), "chainlink.v1beta.GetAccountByChainlinkKeyRequest")
+	proto.RegisterType((*GetFeedRewardAvailStrategiesRequest)(nil), "chainlink.v1beta.GetFeedRewardAvailStrategiesRequest")
+	proto.RegisterType((*GetFeedRewardAvailStrategiesResponse)(nil), "chainlink.v1beta.GetFeedRewardAvailStrategiesResponse")
+	proto.RegisterType((*FeedRewardStrategyInfo)(nil), "chainlink.v1beta.FeedRewardStrategyInfo")
+}
+
+func init() { proto.RegisterFile("chainlink/v1beta/query.proto", fileDescriptor_21ba17f95e61a418) }
+
+var fileDescriptor_21ba17f95e61a418 = []byte{
+	// 2032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xfb, 0xff, 0x3c, 0x9b, 0xcd, 0x6e, 0x25, 0xd8, 0xe3, 0x8e, 0xb1, 0xbd, 0x6d, 0xc7,
+	0xb1, 0x83, 0x67, 0x3a, 0x76, 0x36, 0x61, 0x77, 0xd1, 0x82, 0x6c, 0x27, 0x71, 0xac, 0x4d, 0xd6,
+	0xde, 0xde, 0x00, 0x02, 0xc1, 0xa1, 0x67, 0xba, 0x3c, 0x6e, 0x65, 0xa6, 0x7b, 0xb6, 0xbb, 0xc6,
+	0xde, 0x51, 0x94, 0x03, 0xbb, 0x87, 0xe5, 0xb2, 0x5a, 0x24, 0x40, 0x82, 0x48, 0x70, 0x40, 0xe2,
+	0x0b, 0x2c, 0x17, 0x04, 0xe2, 0x82, 0x40, 0xda, 0xe3, 0x4a, 0x5c, 0x10, 0x87, 0x08, 0x25, 0x7c,
+	0x0a, 0x24, 0x24, 0x54, 0x55, 0xaf, 0xff, 0xcc, 0x74, 0xcd, 0xf4, 0xc4, 0xf8, 0xb0, 0x27, 0x4f,
+	0xbf, 0x7a, 0xbf, 0x7a, 0xbf, 0x7a, 0xaf, 0xea, 0xbd, 0x57, 0x65, 0x98, 0xab, 0x1e, 0xd9, 0xae,
+	0x57, 0x77, 0xbd, 0x87, 0xe6, 0xf1, 0x46, 0x85, 0x32, 0xdb, 0x7c, 0xbf, 0x45, 0x83, 0x76, 0xb9,
+	0x19, 0xf8, 0xcc, 0x27, 0x2f, 0xc7, 0xa3, 0x65, 0x39, 0xaa, 0x5f, 0xad, 0xfa, 0x61, 0xc3, 0x0f,
+	0xcd, 0x8a, 0x1d, 0x52, 0xa9, 0x8a, 0xb8, 0x0d, 0xb3, 0x69, 0xd7, 0x5c, 0xcf, 0x66, 0xae, 0xef,
+	0x49, 0xb4, 0x3e, 0x9b, 0x99, 0x9b, 0x7d, 0x80, 0x43, 0x73, 0x35, 0xdf, 0xaf, 0xd5, 0xa9, 0x69,
+	0x37, 0x5d, 0xd3, 0xf6, 0x3c, 0x9f, 0x09, 0x5c, 0x88, 0xa3, 0xf3, 0x19, 0x60, 0x8d, 0x7a, 0x34,
+	0x74, 0xa3, 0xf1, 0x8b, 0x35, 0xbf, 0xe6, 0x8b, 0x9f, 0x26, 0xff, 0x25, 0xa5, 0x46, 0x11, 0xa6,
+	0x77, 0x29, 0xbb, 0xef, 0x3b, 0xad, 0x3a, 0x3d, 0xb0, 0x03, 0xbb, 0x11, 0x5a, 0xf4, 0xfd, 0x16,
+	0x0d, 0x99, 0xf1, 0x36, 0xcc, 0x64, 0x46, 0xc2, 0xa6, 0xef, 0x85, 0x94, 0x5c, 0x83, 0xb1, 0xa6,
+	0x90, 0x14, 0xb5, 0x45, 0x6d, 0x75, 0x72, 0xb3, 0x58, 0xee, 0x5e, 0x72, 0x19, 0x11, 0xa8, 0x67,
+	0xac, 0x03, 0xd9, 0xa5, 0xec, 0x0e, 0xa5, 0xce, 0x76, 0x7b, 0xcf, 0x41, 0x13, 0x64, 0x1a, 0xc6,
+	0x0e, 0x29, 0x75, 0xf6, 0x1c, 0x31, 0x4f, 0xc1, 0xc2, 0x2f, 0xe3, 0x23, 0x0d, 0x2e, 0x74, 0xa8,
+	0xa3, 0xdd, 0x12, 0x8c, 0x70, 0x0d, 0xb4, 0x3a, 0x9b, 0xb5, 0x7a, 0x3f, 0xac, 0x71, 0x90, 0x25,
+	0xd4, 0xc8, 0x5b, 0x50, 0x60, 0x7e, 0xa3, 0x12, 0x32, 0xdf, 0xa3, 0xc5, 0x21, 0x81, 0x59, 0xc8,
+	0x62, 0x38, 0xe0, 0x41, 0xa4, 0x66, 0x25, 0x08, 0xe3, 0x9a, 0x70, 0x0d, 0x1f, 0xbe, 0x4f, 0x99,
+	0xed, 0xd8, 0xcc, 0xce, 0xe3, 0xfd, 0x37, 0x0d, 0x66, 0x32, 0x10, 0xe4, 0xde, 0x03, 0x43, 0x74,
+	0x98, 0x70, 0x68, 0xd5, 0x6d, 0xd8, 0xf5, 0x50, 0x70, 0xfc, 0x8a, 0x15, 0x7f, 0x93, 0x45, 0x98,
+	0x74, 0x68, 0x58, 0x0d, 0xdc, 0x26, 0x0f, 0x74, 0x71, 0x58, 0x00, 0xd3, 0x22, 0x52, 0x84, 0xf1,
+	0x63, 0x1a, 0x84, 0x7c, 0x74, 0x64, 0x51, 0x5b, 0x1d, 0xb1, 0xa2, 0x4f, 0xf2, 0x26, 0x4c, 0x34,
+	0x90, 0x43, 0x71, 0x54, 0xac, 0x7d, 0x5e, 0xbd, 0xf6, 0x98, 0x69, 0xac, 0x6f, 0xbc, 0x06, 0xfa,
+	0x3d, 0x9b, 0xd1, 0x90, 0xed, 0xf8, 0xde, 0xa1, 0x5b, 0xbb, 0x45, 0x99, 0xed, 0xd6, 0xc3, 0xbc,
+	0xd5, 0xff, 0x51, 0x83, 0x4b, 0x4a, 0x18, 0x7a, 0x60, 0x11, 0x26, 0xab, 0x62, 0x60, 0xc7, 0x6f,
+	0x79, 0x4c, 0x80, 0x47, 0xac, 0xb4, 0x88, 0x6b, 0x54, 0xea, 0x7e, 0xf5, 0xe1, 0x3b, 0xad, 0x46,
+	0x85, 0x06, 0xc2, 0x1d, 0xc3, 0x56, 0x5a, 0x44, 0x0c, 0x98, 0x92, 0x80, 0x5b, 0x6e, 0x8d, 0x86,
+	0x4c, 0xb8, 0x64, 0xca, 0xea, 0x90, 0x91, 0xeb, 0x30, 0x26, 0xbf, 0x85, 0x4b, 0x26, 0x37, 0x2f,
+	0x65, 0xd7, 0xbd, 0xbf, 0x63, 0x49, 0x8e, 0x16, 0xaa, 0x1a, 0x37, 0xe0, 0x12, 0x46, 0xee, 0x76,
+	0x58, 0x0d, 0xfc, 0x93, 0x6d, 0xbb, 0x6e, 0x7b, 0x55, 0x9a, 0xb7, 0xe6, 0x23, 0x98, 0x53, 0xc3,
+	0x72, 0xa2, 0x7e, 0x0d, 0xc6, 0x2b, 0x52, 0x15, 0x37, 0xe6, 0x74, 0x96, 0xe4, 0x8e, 0xef, 0x7a,
+	0x56, 0xa4, 0x66, 0x7c, 0x36, 0x04, 0x2f, 0xdf, 0x73, 0x43, 0x61, 0x2b, 0x0e, 0xc5, 0x3e, 0x14,
+	0xf8, 0x84, 0xfb, 0x27, 0x1e, 0x0d, 0x84, 0x85, 0xa9, 0xed, 0x8d, 0xff, 0x3c, 0x5d, 0x28, 0xd5,
+	0x5c, 0x76, 0xd4, 0xaa, 0x94, 0xab, 0x7e, 0xc3, 0xc4, 0xd4, 0x23, 0xff, 0x94, 0x42, 0xe7, 0xa1,
+	0xc9, 0xda, 0x4d, 0x1a, 0x96, 0xb7, 0xaa, 0xd5, 0x2d, 0xc7, 0x09, 0x68, 0x18, 0x5a, 0xc9, 0x1c,
+	0xe4, 0x3b, 0x30, 0xc5, 0x77, 0xc0, 0x41, 0xe0, 0x1f, 0xbb, 0x0e, 0x86, 0xe0, 0x54, 0x73, 0x76,
+	0x4c, 0x43, 0xca, 0x40, 0xb8, 0x0d, 0x8b, 0x9e, 0xd8, 0x81, 0xf3, 0x1e, 0x0b, 0x6c, 0x46, 0x6b,
+	0x6d, 0xdc, 0xcf, 0x8a, 0x11, 0x72, 0x07, 0x20, 0x49, 0x8c, 0x18, 0xc6, 0x95, 0xb2, 0xb4, 0x57,
+	0xe6, 0x59, 0xb4, 0x2c, 0x13, 0x2e, 0x66, 0xd1, 0xf2, 0x81, 0x5d, 0x8b, 0x42, 0x65, 0xa5, 0x90,
+	0xc6, 0x27, 0x1a, 0xbc, 0x92, 0x72, 0x1a, 0x06, 0xc5, 0x84, 0x51, 0x6e, 0x93, 0x67, 0xaf, 0xe1,
+	0xfe, 0x79, 0x44, 0xea, 0x91, 0xdd, 0x0e, 0x3a, 0x32, 0x60, 0x57, 0x72, 0xe9, 0x48, 0x6b, 0x1d,
+	0x7c, 0x66, 0xe0, 0xab, 0x71, 0x4e, 0x15, 0x0e, 0x8f, 0x92, 0xed, 0x0f, 0x61, 0xba, 0x7b, 0x00,
+	0xc9, 0x6e, 0xc3, 0x64, 0x23, 0x11, 0x23, 0xe5, 0x45, 0x25, 0xe5, 0x34, 0x3c, 0x0d, 0x32, 0x3e,
+	0x95, 0xf9, 0xd4, 0xf2, 0x5b, 0x9e, 0x73, 0x2b, 0x3f, 0x8f, 0xf1, 0xac, 0x12, 0x70, 0xdd, 0x3d,
+	0x47, 0x2c, 0x76, 0xc4, 0x8a, 0x3e, 0xbb, 0x02, 0x33, 0x7c, 0xea, 0xc0, 0x3c, 0xd1, 0xe0, 0x62,
+	0x27, 0x23, 0x5c, 0xee, 0x1b, 0x50, 0x08, 0x22, 0x21, 0x2e, 0x56, 0x71, 0x7e, 0x13, 0x5c, 0xa2,
+	0x7d, 0x76, 0x51, 0xfa, 0x70, 0x48, 0x44, 0x43, 0x18, 0xb9, 0xeb, 0x86, 0xcc, 0x0f, 0xda, 0x79,
+	0x1e, 0x9b, 0x83, 0xc2, 0x61, 0xe0, 0x37, 0x04, 0x04, 0x7d, 0x96, 0x08, 0xb8, 0x3f, 0x99, 0x2f,
+	0xc7, 0x86, 0xa5, 0x3f, 0xf1, 0x93, 0xe3, 0x42, 0x66, 0x07, 0xec, 0x81, 0xdb, 0xa0, 0x98, 0xc1,
+	0x13, 0x01, 0xc7, 0x51, 0xcf, 0x11, 0x63, 0xa3, 0x12, 0x87, 0x9f, 0x22, 0x42, 0x94, 0xa7, 0x7a,
+	0x5a, 0x1c, 0x5b, 0xd4, 0x56, 0x27, 0xac, 0xe8, 0xb3, 0x2b, 0x42, 0xe3, 0xa7, 0x8e, 0xd0, 0xaf,
+	0x65, 0x2d, 0xeb, 0x74, 0xc2, 0x97, 0x28, 0x48, 0xd7, 0x61, 0x76, 0x97, 0x32, 0x59, 0x6f, 0x06,
+	0xdd, 0xd8, 0xc6, 0xf7, 0x40, 0x57, 0x81, 0xfe, 0xef, 0x65, 0x19, 0xff, 0x1d, 0x82, 0x42, 0x3c,
+	0xd0, 0x73, 0x97, 0x7c, 0x13, 0x26, 0xf8, 0x2f, 0x31, 0x7f, 0xcf, 0x7e, 0x64, 0x7f, 0xc7, 0xda,
+	0xaa, 0xb8, 0xb7, 0xbd, 0xaa, 0xef, 0x50, 0xc7, 0x8a, 0x01, 0xe9, 0x43, 0x39, 0xdc, 0x7d, 0x28,
+	0xc7, 0x6c, 0x2f, 0x3c, 0xa1, 0x81, 0xd8, 0x41, 0x85, 0xed, 0xf2, 0xe7, 0x4f, 0x17, 0xce, 0xfd,
+	0xf3, 0xe9, 0xc2, 0xca, 0x00, 0x29, 0x7b, 0xcf, 0x63, 0x16, 0xa2, 0xe3, 0xcd, 0x48, 0x9d, 0x2d,
+	0x86, 0x1b, 0x2e, 0x11, 0xf0, 0xd1, 0x56, 0xd3, 0xb1, 0xe5, 0xe8, 0x98, 0x1c, 0x8d, 0x05, 0x64,
+	0x15, 0xce, 0xcb, 0x59, 0xa8, 0xb3, 0xe7, 0xc9, 0xad, 0x3e, 0x2e, 0x74, 0xba, 0xc5, 0x71, 0x91,
+	0xbf, 0x4b, 0xdd, 0xda, 0x11, 0x2b, 0x4e, 0xa4, 0x8a, 0xbc, 0x14, 0x91, 0x0d, 0x18, 0x3d, 0xb6,
+	0xeb, 0x2d, 0x5a, 0x2c, 0xf4, 0xaa, 0xdf, 0x3c, 0x39, 0x7f, 0x97, 0xab, 0x58, 0x52, 0xd3, 0xf0,
+	0xe0, 0x95, 0x5d, 0xca, 0xb6, 0xaa, 0x55, 0xde, 0x47, 0x44, 0xbb, 0xe0, 0xfb, 0xf0, 0x92, 0x2d,
+	0x25, 0x58, 0x95, 0x4e, 0x5f, 0x22, 0xbb, 0x26, 0x32, 0xee, 0x01, 0x49, 0xdb, 0xc3, 0x0d, 0x74,
+	0x13, 0xc6, 0x51, 0x0f, 0x5b, 0xd4, 0x39, 0x65, 0x9e, 0x8e, 0x60, 0x91, 0xb2, 0xf1, 0x57, 0x0d,
+	0x66, 0x78, 0x99, 0xda, 0x3f, 0xa1, 0xce, 0x81, 0xdd, 0x6e, 0x50, 0x8f, 0xc5, 0x25, 0x7e, 0x0f,
+	0xc6, 0xfc, 0xc0, 0xae, 0xd6, 0xe9, 0xe9, 0xc9, 0xe3, 0x04, 0xa9, 0x6d, 0x39, 0xd4, 0xb1, 0x2d,
+	0xcf, 0x2a, 0xa9, 0xff, 0x46, 0x83, 0x62, 0x76, 0x19, 0xf1, 0xe1, 0x9a, 0x68, 0xa2, 0x0c, 0xcf,
+	0xd6, 0xd7, 0x14, 0x7b, 0x3f, 0x41, 0x5a, 0xb1, 0xfa, 0xd9, 0xe5, 0x8c, 0x2a, 0xcc, 0x4a, 0x7e,
+	0x1e, 0x0d, 0x0e, 0x02, 0xbf, 0xe9, 0x87, 0x76, 0xd2, 0xd6, 0x76, 0x7a, 0x41, 0x3b, 0xb5, 0x17,
+	0xfe, 0xa2, 0x81, 0xae, 0xb2, 0x82, 0x7e, 0x78, 0x0b, 0x0a, 0xcd, 0x48, 0x88, 0x8e, 0x50, 0x25,
+	0x81, 0x34, 0xd8, 0x4a, 0x10, 0xfc, 0x14, 0xb2, 0xa3, 0x80, 0x86, 0x47, 0x7e, 0xdd, 0xc1, 0xfb,
+	0x42, 0x22, 0x20, 0xbb, 0x8a, 0x48, 0x9e, 0xca, 0x53, 0x0d, 0x58, 0xe2, 0x6b, 0x48, 0x75, 0x14,
+	0xe1, 0x91, 0xdb, 0x7c, 0x10, 0xd8, 0x5e, 0x78, 0x48, 0x83, 0x33, 0xf7, 0xd9, 0x67, 0x1a, 0x2c,
+	0xf7, 0xb7, 0x87, 0xde, 0xdb, 0x82, 0x02, 0x8b, 0x84, 0xe8, 0xbd, 0xa5, 0x1e, 0xde, 0x4b, 0x4f,
+	0x60, 0x25, 0xa8, 0xb3, 0xdb, 0x4d, 0x6f, 0xc0, 0x02, 0xf6, 0xfe, 0x59, 0x7b, 0x39, 0x75, 0xa8,
+	0x0a, 0x8b, 0xbd, 0xa1, 0xb8, 0xd4, 0x6f, 0xc3, 0x44, 0x44, 0x1a, 0x3d, 0x3b, 0xd0, 0x4a, 0x63,
+	0x90, 0xf1, 0x23, 0xb8, 0xc0, 0x7d, 0x8a, 0xd9, 0xe6, 0xcc, 0x63, 0xf6, 0x2b, 0x0d, 0x2e, 0x76,
+	0xce, 0x8f, 0xc4, 0x5f, 0x87, 0x09, 0x4c, 0x6c, 0x51, 0x88, 0xfa, 0xa7, 0xc1, 0x58, 0xfb, 0xec,
+	0x42, 0x73, 0x5b, 0x84, 0x06, 0x0d, 0x6c, 0xb7, 0x77, 0x22, 0xeb, 0x6f, 0xd3, 0xb8, 0x93, 0xe3,
+	0x37, 0xc9, 0x94, 0x58, 0x66, 0x57, 0xab, 0x43, 0x66, 0x5c, 0x86, 0x25, 0x0c, 0x93, 0xbc, 0x9f,
+	0x6c, 0x1d, 0xdb, 0x6e, 0x1d, 0x2f, 0x29, 0x2e, 0x8d, 0x5f, 0x4a, 0x9e, 0x68, 0xb0, 0xdc, 0x5f,
+	0x0f, 0x3d, 0xc3, 0x8b, 0x64, 0xe7, 0x90, 0x70, 0x50, 0xc1, 0xea, 0x16, 0x93, 0xbb, 0x00, 0x61,
+	0xa2, 0x34, 0x24, 0xbc, 0xb8, 0xaa, 0xae, 0x83, 0x9d, 0x57, 0xa7, 0x3d, 0xef, 0xd0, 0xb7, 0x52,
+	0x58, 0xe3, 0x1d, 0x98, 0x56, 0x6b, 0x11, 0x02, 0x23, 0x9e, 0xdd, 0xa0, 0xb8, 0x35, 0xc5, 0xef,
+	0xee, 0x17, 0x87, 0xa1, 0xcc, 0x8b, 0xc3, 0xe6, 0x47, 0xd3, 0x30, 0xfa, 0x2e, 0x8f, 0x02, 0xf9,
+	0xb9, 0x06, 0x53, 0xe9, 0x1e, 0x9e, 0x5c, 0xce, 0x12, 0x54, 0xdc, 0x3a, 0xf4, 0x95, 0x3c, 0x35,
+	0xe9, 0x2d, 0xe3, 0xc6, 0x87, 0x7f, 0xff, 0xf7, 0xcf, 0x86, 0x4c, 0x52, 0x32, 0x63, 0x7d, 0x93,
+	0x9f, 0x1f, 0xd3, 0xb1, 0x99, 0x6d, 0x8a, 0xe6, 0xc7, 0x7c, 0x84, 0x3d, 0xd0, 0x63, 0xf3, 0x91,
+	0x3c, 0x5a, 0x8f, 0xc9, 0x2f, 0x34, 0x38, 0xdf, 0xd5, 0xb8, 0x92, 0xd5, 0xde, 0x26, 0x3b, 0x1b,
+	0x7c, 0x7d, 0x6d, 0x00, 0x4d, 0xe4, 0x57, 0x12, 0xfc, 0xae, 0x90, 0xcb, 0x4a, 0x7e, 0x47, 0x52,
+	0x3b, 0xe1, 0xf5, 0x44, 0x83, 0xf3, 0x5d, 0x9d, 0x27, 0xf9, 0xba, 0xd2, 0x9a, 0xba, 0xa9, 0xd5,
+	0xd7, 0x07, 0x53, 0x46, 0x76, 0xeb, 0x82, 0xdd, 0x0a, 0x59, 0x56, 0xb2, 0xab, 0x0b, 0x54, 0x42,
+	0xee, 0x63, 0x4d, 0x36, 0x50, 0xf5, 0x7a, 0x2a, 0x05, 0x93, 0x2b, 0x4a, 0x8b, 0xd9, 0xeb, 0xab,
+	0xbe, 0x9a, 0xaf, 0x88, 0xb4, 0x16, 0x04, 0xad, 0x59, 0x32, 0x93, 0xa2, 0x25, 0xaf, 0xaa, 0xa6,
+	0x2f, 0x6c, 0x3e, 0xd1, 0x80, 0x64, 0xcb, 0xa7, 0xca, 0x53, 0x3d, 0x4b, 0xb9, 0xbe, 0x3e, 0x98,
+	0x32, 0x52, 0x5a, 0x13, 0x94, 0x96, 0xc8, 0xab, 0x3d, 0x28, 0x99, 0x49, 0xf5, 0xfd, 0x83, 0x06,
+	0x73, 0xfd, 0xea, 0x14, 0xb9, 0xa1, 0xb6, 0x9c, 0x53, 0x47, 0xf5, 0x9b, 0x2f, 0x0a, 0x1b, 0x94,
+	0x7a, 0x52, 0xf6, 0x3e, 0x96, 0xc7, 0x42, 0xbe, 0xa9, 0xde, 0x91, 0x8d, 0xdf, 0xb2, 0x32, 0x6c,
+	0x5d, 0xaf, 0xb4, 0xfa, 0xe5, 0x1c, 0x2d, 0xe4, 0x72, 0x45, 0x70, 0x79, 0x95, 0x2c, 0x64, 0xb9,
+	0x88, 0x7d, 0x17, 0xef, 0xb5, 0x5f, 0x26, 0x4c, 0xa2, 0xb7, 0xc7, 0x1e, 0x07, 0x54, 0xf1, 0xf6,
+	0xaa, 0xaf, 0x0d, 0xa0, 0x89, 0x8c, 0xae, 0x09, 0x46, 0x57, 0xc9, 0x6a, 0x0e, 0x23, 0x33, 0x7a,
+	0xf8, 0x24, 0x7f, 0xd6, 0xa0, 0xd8, 0xab, 0x30, 0x93, 0x8d, 0x9e, 0x96, 0x7b, 0xd5, 0x7f, 0x7d,
+	0xf3, 0x45, 0x20, 0xc8, 0xfa, 0x4d, 0xc1, 0xfa, 0x35, 0xb2, 0x99, 0xc7, 0xda, 0x8f, 0xa6, 0x28,
+	0x45, 0x51, 0x26, 0xbf, 0xd5, 0xe0, 0x82, 0xe2, 0x09, 0x96, 0xa8, 0x0e, 0x44, 0xcf, 0x07, 0x5e,
+	0xbd, 0x34, 0xa0, 0x36, 0x12, 0x2e, 0x0b, 0xc2, 0xab, 0x64, 0x25, 0x8f, 0xb0, 0x7c, 0x6a, 0x25,
+	0xbf, 0x93, 0x6f, 0x3f, 0x99, 0x47, 0x53, 0x52, 0xea, 0xe9, 0x2d, 0xd5, 0x9b, 0xac, 0x5e, 0x1e,
+	0x54, 0xfd, 0x45, 0x79, 0x52, 0x01, 0x27, 0x2d, 0x28, 0xc4, 0x6f, 0x87, 0xc4, 0x50, 0x9f, 0xd0,
+	0xf4, 0x6b, 0xac, 0xbe, 0xd4, 0x57, 0x27, 0x3f, 0x01, 0xca, 0xc7, 0xc6, 0x4f, 0x35, 0x78, 0x29,
+	0x69, 0x5e, 0x44, 0xa5, 0x5e, 0x52, 0xae, 0xb4, 0xf3, 0xb6, 0xab, 0x2f, 0xf7, 0x57, 0x42, 0xf3,
+	0x9b, 0xc2, 0xfc, 0x3a, 0xb9, 0x9a, 0x35, 0x8f, 0x6d, 0x98, 0xf9, 0xa8, 0xf3, 0xae, 0xfb, 0x98,
+	0x7c, 0xa2, 0xc9, 0xa7, 0xe7, 0xf4, 0xbd, 0x8e, 0xac, 0xf5, 0xca, 0xb1, 0x99, 0x2b, 0xac, 0x7e,
+	0x75, 0x10, 0x55, 0xe4, 0x67, 0x08, 0x7e, 0x73, 0x44, 0xcf, 0xf2, 0x8b, 0xef, 0x83, 0x3f, 0xd6,
+	0x60, 0x2a, 0xdd, 0x79, 0xaa, 0x1a, 0x0f, 0x45, 0xe7, 0xab, 0xaf, 0xe4, 0xa9, 0xe5, 0x73, 0x88,
+	0x5b, 0xd5, 0xdf, 0xcb, 0x4c, 0xa1, 0x6c, 0x31, 0x7b, 0x64, 0x8a, 0x7e, 0xed, 0xe8, 0x80, 0xd1,
+	0xfb, 0x96, 0x60, 0xf6, 0x3a, 0xb9, 0x99, 0x65, 0x16, 0x0b, 0x4a, 0x0f, 0x69, 0xdb, 0x7c, 0x94,
+	0xee, 0x63, 0x1f, 0x47, 0xb4, 0xc9, 0x9f, 0x34, 0xf1, 0x00, 0xa6, 0xee, 0x54, 0xdb, 0xaa, 0xea,
+	0x35, 0x40, 0xff, 0xab, 0xdf, 0x7c, 0x51, 0xd8, 0x80, 0x07, 0x32, 0x10, 0x68, 0x33, 0x8c, 0xe8,
+	0xfd, 0x44, 0x16, 0x8e, 0xf4, 0xbf, 0x24, 0x49, 0xbf, 0xce, 0xa3, 0xe3, 0xff, 0x99, 0xfa, 0xda,
+	0x00, 0x9a, 0x48, 0x6c, 0x51, 0x10, 0xd3, 0x49, 0x51, 0xb5, 0x09, 0xb9, 0xe6, 0xf6, 0xbb, 0x9f,
+	0x3f, 0x9b, 0xd7, 0xbe, 0x78, 0x36, 0xaf, 0xfd, 0xeb, 0xd9, 0xbc, 0xf6, 0xd3, 0xe7, 0xf3, 0xe7,
+	0xbe, 0x78, 0x3e, 0x7f, 0xee, 0x1f, 0xcf, 0xe7, 0xcf, 0xfd, 0xe0, 0x1b, 0xa9, 0xb7, 0x19, 0x11,
+	0xe8, 0xf7, 0xec, 0xc3, 0x74, 0x74, 0xf0, 0xbd, 0xe6, 0x83, 0xd4, 0xd4, 0xe2, 0xc1, 0xa6, 0x32,
+	0x26, 0xfe, 0x21, 0x7b, 0xfd, 0x7f, 0x03, 0x00, 0x31, 0x14, 0x59, 0xf5, 0x5d, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	GetRoundData(ctx context.Context, in *GetRoundDataRequest, opts ...grpc.CallOption) (*GetRoundDataResponse, error)
+	GetRoundHistory(ctx context.Context, in *GetRoundHistoryRequest, opts ...grpc.CallOption) (*GetRoundHistoryResponse, error)
+	LatestRoundData(ctx context.Context, in *GetLatestRoundDataRequest, opts ...grpc.CallOption) (*GetLatestRoundDataResponse, error)
+	GetAllModuleOwner(ctx context.Context, in *GetModuleOwnerRequest, opts ...grpc.CallOption) (*GetModuleOwnerResponse, error)
+	ListOwnerProposals(ctx context.Context, in *ListOwnerProposalsRequest, opts ...grpc.CallOption) (*ListOwnerProposalsResponse, error)
+	ListModuleOwnershipTransfers(ctx context.Context, in *ListModuleOwnershipTransfersRequest, opts ...grpc.CallOption) (*ListModuleOwnershipTransfersResponse, error)
+	GetFeedByFeedId(ctx context.Context, in *GetFeedByIdRequest, opts ...grpc.CallOption) (*GetFeedByIdResponse, error)
+	GetFeedMetadata(ctx context.Context, in *GetFeedMetadataRequest, opts ...grpc.CallOption) (*GetFeedMetadataResponse, error)
+	GetFeedOwnershipTransfer(ctx context.Context, in *GetFeedOwnershipTransferRequest, opts ...grpc.CallOption) (*GetFeedOwnershipTransferResponse, error)
+	LatestConfigDetails(ctx context.Context, in *LatestConfigDetailsRequest, opts ...grpc.CallOption) (*LatestConfigDetailsResponse, error)
+	GetFeedEscrowBalance(ctx context.Context, in *GetFeedEscrowBalanceRequest, opts ...grpc.CallOption) (*GetFeedEscrowBalanceResponse, error)
+	ListFeeds(ctx context.Context, in *ListFeedsRequest, opts ...grpc.CallOption) (*ListFeedsResponse, error)
+	GetAccountInfo(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ListOwedPayments(ctx context.Context, in *ListOwedPaymentsRequest, opts ...grpc.CallOption) (*ListOwedPaymentsResponse, error)
+	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	GetAccountByChainlinkKey(ctx context.Context, in *GetAccountByChainlinkKeyRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	GetFeedRewardAvailStrategy(ctx context.Context, in *GetFeedRewardAvailStrategiesRequest, opts ...grpc.CallOption) (*GetFeedRewardAvailStrategiesResponse, error)
+	GetModuleParams(ctx context.Context, in *GetModuleParamsRequest, opts ...grpc.CallOption) (*GetModuleParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetRoundData(ctx context.Context, in *GetRoundDataRequest, opts ...grpc.CallOption) (*GetRoundDataResponse, error) {
+	out := new(GetRoundDataResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetRoundData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRoundHistory(ctx context.Context, in *GetRoundHistoryRequest, opts ...grpc.CallOption) (*GetRoundHistoryResponse, error) {
+	out := new(GetRoundHistoryResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetRoundHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestRoundData(ctx context.Context, in *GetLatestRoundDataRequest, opts ...grpc.CallOption) (*GetLatestRoundDataResponse, error) {
+	out := new(GetLatestRoundDataResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/LatestRoundData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAllModuleOwner(ctx context.Context, in *GetModuleOwnerRequest, opts ...grpc.CallOption) (*GetModuleOwnerResponse, error) {
+	out := new(GetModuleOwnerResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetAllModuleOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListOwnerProposals(ctx context.Context, in *ListOwnerProposalsRequest, opts ...grpc.CallOption) (*ListOwnerProposalsResponse, error) {
+	out := new(ListOwnerProposalsResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/ListOwnerProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListModuleOwnershipTransfers(ctx context.Context, in *ListModuleOwnershipTransfersRequest, opts ...grpc.CallOption) (*ListModuleOwnershipTransfersResponse, error) {
+	out := new(ListModuleOwnershipTransfersResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/ListModuleOwnershipTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFeedByFeedId(ctx context.Context, in *GetFeedByIdRequest, opts ...grpc.CallOption) (*GetFeedByIdResponse, error) {
+	out := new(GetFeedByIdResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetFeedByFeedId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFeedMetadata(ctx context.Context, in *GetFeedMetadataRequest, opts ...grpc.CallOption) (*GetFeedMetadataResponse, error) {
+	out := new(GetFeedMetadataResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetFeedMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFeedOwnershipTransfer(ctx context.Context, in *GetFeedOwnershipTransferRequest, opts ...grpc.CallOption) (*GetFeedOwnershipTransferResponse, error) {
+	out := new(GetFeedOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetFeedOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LatestConfigDetails(ctx context.Context, in *LatestConfigDetailsRequest, opts ...grpc.CallOption) (*LatestConfigDetailsResponse, error) {
+	out := new(LatestConfigDetailsResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/LatestConfigDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFeedEscrowBalance(ctx context.Context, in *GetFeedEscrowBalanceRequest, opts ...grpc.CallOption) (*GetFeedEscrowBalanceResponse, error) {
+	out := new(GetFeedEscrowBalanceResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetFeedEscrowBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListFeeds(ctx context.Context, in *ListFeedsRequest, opts ...grpc.CallOption) (*ListFeedsResponse, error) {
+	out := new(ListFeedsResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/ListFeeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAccountInfo(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListOwedPayments(ctx context.Context, in *ListOwedPaymentsRequest, opts ...grpc.CallOption) (*ListOwedPaymentsResponse, error) {
+	out := new(ListOwedPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/ListOwedPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
+	out := new(ListAccountsResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAccountByChainlinkKey(ctx context.Context, in *GetAccountByChainlinkKeyRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetAccountByChainlinkKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFeedRewardAvailStrategy(ctx context.Context, in *GetFeedRewardAvailStrategiesRequest, opts ...grpc.CallOption) (*GetFeedRewardAvailStrategiesResponse, error) {
+	out := new(GetFeedRewardAvailStrategiesResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetFeedRewardAvailStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetModuleParams(ctx context.Context, in *GetModuleParamsRequest, opts ...grpc.CallOption) (*GetModuleParamsResponse, error) {
+	out := new(GetModuleParamsResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Query/GetModuleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	GetRoundData(context.Context, *GetRoundDataRequest) (*GetRoundDataResponse, error)
+	GetRoundHistory(context.Context, *GetRoundHistoryRequest) (*GetRoundHistoryResponse, error)
+	LatestRoundData(context.Context, *GetLatestRoundDataRequest) (*GetLatestRoundDataResponse, error)
+	GetAllModuleOwner(context.Context, *GetModuleOwnerRequest) (*GetModuleOwnerResponse, error)
+	ListOwnerProposals(context.Context, *ListOwnerProposalsRequest) (*ListOwnerProposalsResponse, error)
+	ListModuleOwnershipTransfers(context.Context, *ListModuleOwnershipTransfersRequest) (*ListModuleOwnershipTransfersResponse, error)
+	GetFeedByFeedId(context.Context, *GetFeedByIdRequest) (*GetFeedByIdResponse, error)
+	GetFeedMetadata(context.Context, *GetFeedMetadataRequest) (*GetFeedMetadataResponse, error)
+	GetFeedOwnershipTransfer(context.Context, *GetFeedOwnershipTransferRequest) (*GetFeedOwnershipTransferResponse, error)
+	LatestConfigDetails(context.Context, *LatestConfigDetailsRequest) (*LatestConfigDetailsResponse, error)
+	GetFeedEscrowBalance(context.Context, *GetFeedEscrowBalanceRequest) (*GetFeedEscrowBalanceResponse, error)
+	ListFeeds(context.Context, *ListFeedsRequest) (*ListFeedsResponse, error)
+	GetAccountInfo(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ListOwedPayments(context.Context, *ListOwedPaymentsRequest) (*ListOwedPaymentsResponse, error)
+	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	GetAccountByChainlinkKey(context.Context, *GetAccountByChainlinkKeyRequest) (*GetAccountResponse, error)
+	GetFeedRewardAvailStrategy(context.Context, *GetFeedRewardAvailStrategiesRequest) (*GetFeedRewardAvailStrategiesResponse, error)
+	GetModuleParams(context.Context, *GetModuleParamsRequest) (*GetModuleParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) GetRoundData(ctx context.Context, req *GetRoundDataRequest) (*GetRoundDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoundData not implemented")
+}
+func (*UnimplementedQueryServer) GetRoundHistory(ctx context.Context, req *GetRoundHistoryRequest) (*GetRoundHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoundHistory not implemented")
+}
+func (*UnimplementedQueryServer) LatestRoundData(ctx context.Context, req *GetLatestRoundDataRequest) (*GetLatestRoundDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestRoundData not implemented")
+}
+func (*UnimplementedQueryServer) GetAllModuleOwner(ctx context.Context, req *GetModuleOwnerRequest) (*GetModuleOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllModuleOwner not implemented")
+}
+func (*UnimplementedQueryServer) ListOwnerProposals(ctx context.Context, req *ListOwnerProposalsRequest) (*ListOwnerProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOwnerProposals not implemented")
+}
+func (*UnimplementedQueryServer) ListModuleOwnershipTransfers(ctx context.Context, req *ListModuleOwnershipTransfersRequest) (*ListModuleOwnershipTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListModuleOwnershipTransfers not implemented")
+}
+func (*UnimplementedQueryServer) GetFeedByFeedId(ctx context.Context, req *GetFeedByIdRequest) (*GetFeedByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedByFeedId not implemented")
+}
+func (*UnimplementedQueryServer) GetFeedMetadata(ctx context.Context, req *GetFeedMetadataRequest) (*GetFeedMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedMetadata not implemented")
+}
+func (*UnimplementedQueryServer) GetFeedOwnershipTransfer(ctx context.Context, req *GetFeedOwnershipTransferRequest) (*GetFeedOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedOwnershipTransfer not implemented")
+}
+func (*UnimplementedQueryServer) LatestConfigDetails(ctx context.Context, req *LatestConfigDetailsRequest) (*LatestConfigDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestConfigDetails not implemented")
+}
+func (*UnimplementedQueryServer) GetFeedEscrowBalance(ctx context.Context, req *GetFeedEscrowBalanceRequest) (*GetFeedEscrowBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedEscrowBalance not implemented")
+}
+func (*UnimplementedQueryServer) ListFeeds(ctx context.Context, req *ListFeedsRequest) (*ListFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeeds not implemented")
+}
+func (*UnimplementedQueryServer) GetAccountInfo(ctx context.Context, req *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (*UnimplementedQueryServer) ListOwedPayments(ctx context.Context, req *ListOwedPaymentsRequest) (*ListOwedPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOwedPayments not implemented")
+}
+func (*UnimplementedQueryServer) ListAccounts(ctx context.Context, req *ListAccountsRequest) (*ListAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
+}
+func (*UnimplementedQueryServer) GetAccountByChainlinkKey(ctx context.Context, req *GetAccountByChainlinkKeyRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountByChainlinkKey not implemented")
+}
+func (*UnimplementedQueryServer) GetFeedRewardAvailStrategy(ctx context.Context, req *GetFeedRewardAvailStrategiesRequest) (*GetFeedRewardAvailStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeedRewardAvailStrategy not implemented")
+}
+func (*UnimplementedQueryServer) GetModuleParams(ctx context.Context, req *GetModuleParamsRequest) (*GetModuleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModuleParams not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_GetRoundData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoundDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRoundData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetRoundData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRoundData(ctx, req.(*GetRoundDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRoundHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoundHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRoundHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetRoundHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRoundHistory(ctx, req.(*GetRoundHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestRoundData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestRoundDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestRoundData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/LatestRoundData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestRoundData(ctx, req.(*GetLatestRoundDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAllModuleOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllModuleOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetAllModuleOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllModuleOwner(ctx, req.(*GetModuleOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListOwnerProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOwnerProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListOwnerProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/ListOwnerProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListOwnerProposals(ctx, req.(*ListOwnerProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListModuleOwnershipTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListModuleOwnershipTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListModuleOwnershipTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/ListModuleOwnershipTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListModuleOwnershipTransfers(ctx, req.(*ListModuleOwnershipTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFeedByFeedId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFeedByFeedId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetFeedByFeedId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFeedByFeedId(ctx, req.(*GetFeedByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFeedMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFeedMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetFeedMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFeedMetadata(ctx, req.(*GetFeedMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFeedOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFeedOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetFeedOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFeedOwnershipTransfer(ctx, req.(*GetFeedOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LatestConfigDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestConfigDetailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LatestConfigDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/LatestConfigDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LatestConfigDetails(ctx, req.(*LatestConfigDetailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFeedEscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedEscrowBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFeedEscrowBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetFeedEscrowBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFeedEscrowBalance(ctx, req.(*GetFeedEscrowBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/ListFeeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListFeeds(ctx, req.(*ListFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountInfo(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListOwedPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOwedPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListOwedPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/ListOwedPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListOwedPayments(ctx, req.(*ListOwedPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAccounts(ctx, req.(*ListAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAccountByChainlinkKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountByChainlinkKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountByChainlinkKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetAccountByChainlinkKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountByChainlinkKey(ctx, req.(*GetAccountByChainlinkKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFeedRewardAvailStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedRewardAvailStrategiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFeedRewardAvailStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetFeedRewardAvailStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFeedRewardAvailStrategy(ctx, req.(*GetFeedRewardAvailStrategiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetModuleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetModuleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Query/GetModuleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetModuleParams(ctx, req.(*GetModuleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chainlink.v1beta.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRoundData",
+			Handler:    _Query_GetRoundData_Handler,
+		},
+		{
+			MethodName: "GetRoundHistory",
+			Handler:    _Query_GetRoundHistory_Handler,
+		},
+		{
+			MethodName: "LatestRoundData",
+			Handler:    _Query_LatestRoundData_Handler,
+		},
+		{
+			MethodName: "GetAllModuleOwner",
+			Handler:    _Query_GetAllModuleOwner_Handler,
+		},
+		{
+			MethodName: "ListOwnerProposals",
+			Handler:    _Query_ListOwnerProposals_Handler,
+		},
+		{
+			MethodName: "ListModuleOwnershipTransfers",
+			Handler:    _Query_ListModuleOwnershipTransfers_Handler,
+		},
+		{
+			MethodName: "GetFeedByFeedId",
+			Handler:    _Query_GetFeedByFeedId_Handler,
+		},
+		{
+			MethodName: "GetFeedMetadata",
+			Handler:    _Query_GetFeedMetadata_Handler,
+		},
+		{
+			MethodName: "GetFeedOwnershipTransfer",
+			Handler:    _Query_GetFeedOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "LatestConfigDetails",
+			Handler:    _Query_LatestConfigDetails_Handler,
+		},
+		{
+			MethodName: "GetFeedEscrowBalance",
+			Handler:    _Query_GetFeedEscrowBalance_Handler,
+		},
+		{
+			MethodName: "ListFeeds",
+			Handler:    _Query_ListFeeds_Handler,
+		},
+		{
+			MethodName: "GetAccountInfo",
+			Handler:    _Query_GetAccountInfo_Handler,
+		},
+		{
+			MethodName: "ListOwedPayments",
+			Handler:    _Query_ListOwedPayments_Handler,
+		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _Query_ListAccounts_Handler,
+		},
+		{
+			MethodName: "GetAccountByChainlinkKey",
+			Handler:    _Query_GetAccountByChainlinkKey_Handler,
+		},
+		{
+			MethodName: "GetFeedRewardAvailStrategy",
+			Handler:    _Query_GetFeedRewardAvailStrategy_Handler,
+		},
+		{
+			MethodName: "GetModuleParams",
+			Handler:    _Query_GetModuleParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chainlink/v1beta/query.proto",
+}
+
+func (m *GetModuleParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetModuleParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetModuleParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetModuleParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetModuleParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetModuleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstone != nil {
+		{
+			size, err := m.Tombstone.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Feed != nil {
+		{
+			size, err := m.Feed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestConfigDetailsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestConfigDetailsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestConfigDetailsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LatestConfigDetailsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LatestConfigDetailsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LatestConfigDetailsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConfigDigest) > 0 {
+		i -= len(m.ConfigDigest)
+		copy(dAtA[i:], m.ConfigDigest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConfigDigest)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ConfigCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConfigCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedEscrowBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedEscrowBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedEscrowBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedEscrowBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedEscrowBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedEscrowBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != nil {
+		{
+			size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFeedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFeedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFeedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FeedRewardStrategy) > 0 {
+		i -= len(m.FeedRewardStrategy)
+		copy(dAtA[i:], m.FeedRewardStrategy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedRewardStrategy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataProvider) > 0 {
+		i -= len(m.DataProvider)
+		copy(dAtA[i:], m.DataProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DataProvider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedOwner) > 0 {
+		i -= len(m.FeedOwner)
+		copy(dAtA[i:], m.FeedOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListFeedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListFeedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListFeedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Feeds) > 0 {
+		for iNdEx := len(m.Feeds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feeds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetModuleOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetModuleOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetModuleOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetModuleOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetModuleOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetModuleOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleOwner) > 0 {
+		for iNdEx := len(m.ModuleOwner) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleOwner[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRoundDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoundDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoundDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RoundId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRoundDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoundDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoundDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoundData) > 0 {
+		for iNdEx := len(m.RoundData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRoundHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoundHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoundHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Reverse {
+		i--
+		if m.Reverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ToRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToRound))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromRound))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRoundHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRoundHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRoundHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoundData) > 0 {
+		for iNdEx := len(m.RoundData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestRoundDataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestRoundDataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestRoundDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLatestRoundDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLatestRoundDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLatestRoundDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoundData) > 0 {
+		for iNdEx := len(m.RoundData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoundData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoundData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoundData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AnsweredInRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AnsweredInRound))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Answer.Size()
+		i -= size
+		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RoundId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FeedData != nil {
+		{
+			size, err := m.FeedData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccountAddress) > 0 {
+		i -= len(m.AccountAddress)
+		copy(dAtA[i:], m.AccountAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccountAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Account != nil {
+		{
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOwedPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOwedPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOwedPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOwedPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOwedPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOwedPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOwnerProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOwnerProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOwnerProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListOwnerProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListOwnerProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListOwnerProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListModuleOwnershipTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListModuleOwnershipTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListModuleOwnershipTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListModuleOwnershipTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListModuleOwnershipTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListModuleOwnershipTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		{
+			size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAccountByChainlinkKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAccountByChainlinkKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAccountByChainlinkKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainlinkKey) > 0 {
+		i -= len(m.ChainlinkKey)
+		copy(dAtA[i:], m.ChainlinkKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainlinkKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedRewardAvailStrategiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedRewardAvailStrategiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedRewardAvailStrategiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFeedRewardAvailStrategiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFeedRewardAvailStrategiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFeedRewardAvailStrategiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Strategies) > 0 {
+		for iNdEx := len(m.Strategies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strategies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AvailStrategies) > 0 {
+		for iNdEx := len(m.AvailStrategies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AvailStrategies[iNdEx])
+			copy(dAtA[i:], m.AvailStrategies[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailStrategies[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedRewardStrategyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedRewardStrategyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedRewardStrategyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetModuleParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetModuleParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Feed != nil {
+		l = m.Feed.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Tombstone != nil {
+		l = m.Tombstone.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovQuery(uint64(m.Decimals))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LatestConfigDetailsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LatestConfigDetailsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigCount != 0 {
+		n += 1 + sovQuery(uint64(m.ConfigCount))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovQuery(uint64(m.BlockNumber))
+	}
+	l = len(m.ConfigDigest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedEscrowBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedEscrowBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Balance != nil {
+		l = m.Balance.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListFeedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DataProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeedRewardStrategy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListFeedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Feeds) > 0 {
+		for _, e := range m.Feeds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetModuleOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetModuleOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ModuleOwner) > 0 {
+		for _, e := range m.ModuleOwner {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetRoundDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovQuery(uint64(m.RoundId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRoundDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoundData) > 0 {
+		for _, e := range m.RoundData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRoundHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromRound != 0 {
+		n += 1 + sovQuery(uint64(m.FromRound))
+	}
+	if m.ToRound != 0 {
+		n += 1 + sovQuery(uint64(m.ToRound))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuery(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuery(uint64(m.EndTime))
+	}
+	if m.Reverse {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRoundHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoundData) > 0 {
+		for _, e := range m.RoundData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestRoundDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLatestRoundDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoundData) > 0 {
+		for _, e := range m.RoundData {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RoundData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FeedData != nil {
+		l = m.FeedData.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovQuery(uint64(m.RoundId))
+	}
+	l = m.Answer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.StartedAt != 0 {
+		n += 1 + sovQuery(uint64(m.StartedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.UpdatedAt))
+	}
+	if m.AnsweredInRound != 0 {
+		n += 1 + sovQuery(uint64(m.AnsweredInRound))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListOwedPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListOwedPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListOwnerProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListOwnerProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListModuleOwnershipTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListModuleOwnershipTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transfer != nil {
+		l = m.Transfer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ListAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAccountByChainlinkKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainlinkKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFeedRewardAvailStrategiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetFeedRewardAvailStrategiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AvailStrategies) > 0 {
+		for _, s := range m.AvailStrategies {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Strategies) > 0 {
+		for _, e := range m.Strategies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeedRewardStrategyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetModuleParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetModuleParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetModuleParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetModuleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetModuleParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetModuleParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeedByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeedByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeedByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeedByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeedByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeedByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Feed == nil {
+				m.Feed = &MsgFeed{}
+			}
+			if err := m.Feed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tombstone == nil {
+				m.Tombstone = &FeedTombstone{}
+			}
+			if err := m.Tombstone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeedMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeedMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeedMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeedMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeedMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeedMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &FeedMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestConfigDetailsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestConfigDetailsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestConfigDetailsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LatestConfigDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LatestConfigDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LatestConfigDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
+			}
+			m.ConfigCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &OCRConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeedEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeedEscrowBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeedEscrowBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeedEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeedEscrowBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeedEscrowBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Balance == nil {
+				m.Balance = &Coin{}
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListFeedsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFeedsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFeedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeedOwner == nil {
+				m.FeedOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProvider", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataProvider = append(m.DataProvider[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataProvider == nil {
+				m.DataProvider = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedRewardStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedRewardStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListFeedsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFeedsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFeedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeds = append(m.Feeds, &MsgFeed{})
+			if err := m.Feeds[len(m.Feeds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetModuleOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetModuleOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetModuleOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetModuleOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetModuleOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetModuleOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleOwner = append(m.ModuleOwner, &MsgModuleOwner{})
+			if err := m.ModuleOwner[len(m.ModuleOwner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRoundDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRoundDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRoundDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRoundDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRoundDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRoundDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundData = append(m.RoundData, &RoundData{})
+			if err := m.RoundData[len(m.RoundData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRoundHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRoundHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRoundHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRound", wireType)
+			}
+			m.FromRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRound", wireType)
+			}
+			m.ToRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRoundHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRoundHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRoundHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundData = append(m.RoundData, &RoundData{})
+			if err := m.RoundData[len(m.RoundData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatestRoundDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestRoundDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestRoundDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLatestRoundDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLatestRoundDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLatestRoundDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundData = append(m.RoundData, &RoundData{})
+			if err := m.RoundData[len(m.RoundData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoundData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoundData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoundData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeedData == nil {
+				m.FeedData = &OCRAbiEncoded{}
+			}
+			if err := m.FeedData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			m.RoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsweredInRound", wireType)
+			}
+			m.AnsweredInRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsweredInRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &FeedValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountAddress = append(m.AccountAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountAddress == nil {
+				m.AccountAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &MsgAccount{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListOwedPaymentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListOwedPaymentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListOwedPaymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = append(m.Oracle[:0], dAtA[iNdEx:postIndex]...)
+			if m.Oracle == nil {
+				m.Oracle = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetFeedByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *ListOwedPaymentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1629,17 +7909,17 @@ func (m *GetFeedByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFeedByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOwedPaymentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFeedByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOwedPaymentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1649,23 +7929,61 @@ func (m *GetFeedByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.Payments = append(m.Payments, &OwedPayment{})
+			if err := m.Payments[len(m.Payments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1688,7 +8006,7 @@ func (m *GetFeedByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFeedByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *ListOwnerProposalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1711,15 +8029,15 @@ func (m *GetFeedByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFeedByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOwnerProposalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFeedByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOwnerProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1746,10 +8064,10 @@ func (m *GetFeedByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Feed == nil {
-				m.Feed = &MsgFeed{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Feed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1774,7 +8092,7 @@ func (m *GetFeedByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetModuleOwnerRequest) Unmarshal(dAtA []byte) error {
+func (m *ListOwnerProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1797,12 +8115,101 @@ func (m *GetModuleOwnerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetModuleOwnerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListOwnerProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetModuleOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListOwnerProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &OwnerProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1824,7 +8231,7 @@ func (m *GetModuleOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetModuleOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *ListModuleOwnershipTransfersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1847,15 +8254,15 @@ func (m *GetModuleOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetModuleOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListModuleOwnershipTransfersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetModuleOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListModuleOwnershipTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1882,8 +8289,10 @@ func (m *GetModuleOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleOwner = append(m.ModuleOwner, &MsgModuleOwner{})
-			if err := m.ModuleOwner[len(m.ModuleOwner)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1908,7 +8317,7 @@ func (m *GetModuleOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRoundDataRequest) Unmarshal(dAtA []byte) error {
+func (m *ListModuleOwnershipTransfersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1931,17 +8340,17 @@ func (m *GetRoundDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoundDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListModuleOwnershipTransfersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoundDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListModuleOwnershipTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1951,44 +8360,27 @@ func (m *GetRoundDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.Transfers = append(m.Transfers, &OwnershipTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
-			}
-			m.RoundId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RoundId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2018,7 +8410,7 @@ func (m *GetRoundDataRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2041,78 +8433,44 @@ func (m *GetRoundDataRequest) Unmarshal(dAtA []byte) error {
 	}
 
 	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRoundDataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRoundDataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRoundDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundData", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFeedOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoundData = append(m.RoundData, &RoundData{})
-			if err := m.RoundData[len(m.RoundData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFeedOwnershipTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFeedOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2122,27 +8480,23 @@ func (m *GetRoundDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2165,7 +8519,7 @@ func (m *GetRoundDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestRoundDataRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFeedOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2188,17 +8542,17 @@ func (m *GetLatestRoundDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestRoundDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFeedOwnershipTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestRoundDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFeedOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2208,23 +8562,27 @@ func (m *GetLatestRoundDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			if m.Transfer == nil {
+				m.Transfer = &OwnershipTransfer{}
+			}
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2247,7 +8605,7 @@ func (m *GetLatestRoundDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLatestRoundDataResponse) Unmarshal(dAtA []byte) error {
+func (m *ListAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,15 +8628,15 @@ func (m *GetLatestRoundDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLatestRoundDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLatestRoundDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2305,8 +8663,10 @@ func (m *GetLatestRoundDataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoundData = append(m.RoundData, &RoundData{})
-			if err := m.RoundData[len(m.RoundData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2331,7 +8691,7 @@ func (m *GetLatestRoundDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoundData) Unmarshal(dAtA []byte) error {
+func (m *ListAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2354,17 +8714,17 @@ func (m *RoundData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoundData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoundData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2374,27 +8734,29 @@ func (m *RoundData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.Accounts = append(m.Accounts, &MsgAccount{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2421,10 +8783,10 @@ func (m *RoundData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeedData == nil {
-				m.FeedData = &OCRAbiEncoded{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.FeedData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2449,7 +8811,7 @@ func (m *RoundData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *GetAccountByChainlinkKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2472,15 +8834,15 @@ func (m *GetAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetAccountByChainlinkKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetAccountByChainlinkKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainlinkKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2507,9 +8869,9 @@ func (m *GetAccountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AccountAddress = append(m.AccountAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.AccountAddress == nil {
-				m.AccountAddress = []byte{}
+			m.ChainlinkKey = append(m.ChainlinkKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainlinkKey == nil {
+				m.ChainlinkKey = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -2533,7 +8895,7 @@ func (m *GetAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *GetFeedRewardAvailStrategiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2556,48 +8918,12 @@ func (m *GetAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Account == nil {
-				m.Account = &MsgAccount{}
-			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2619,7 +8945,7 @@ func (m *GetAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFeedRewardAvailStrategiesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFeedRewardAvailStrategiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2642,12 +8968,78 @@ func (m *GetFeedRewardAvailStrategiesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailStrategies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailStrategies = append(m.AvailStrategies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategies = append(m.Strategies, &FeedRewardStrategyInfo{})
+			if err := m.Strategies[len(m.Strategies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2669,7 +9061,7 @@ func (m *GetFeedRewardAvailStrategiesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFeedRewardAvailStrategiesResponse) Unmarshal(dAtA []byte) error {
+func (m *FeedRewardStrategyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,15 +9084,15 @@ func (m *GetFeedRewardAvailStrategiesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedRewardStrategyInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFeedRewardAvailStrategiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedRewardStrategyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailStrategies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2728,7 +9120,39 @@ func (m *GetFeedRewardAvailStrategiesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailStrategies = append(m.AvailStrategies, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
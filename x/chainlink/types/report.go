@@ -0,0 +1,350 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// OCRReportContextLength is the length of the raw report context in bytes
+	OCRReportContextLength = 32
+
+	// OCRMaxOracles is the maximum number of oracles an OCR report can carry,
+	// observers are packed one byte per oracle in a 32-byte word
+	OCRMaxOracles = 31
+
+	// the raw report context is 11 bytes of padding followed by the 16-byte config digest,
+	// the 4-byte big-endian epoch and the 1-byte round
+	ocrConfigDigestOffset = 11
+	ocrEpochOffset        = 27
+	ocrRoundOffset        = 31
+
+	// ocrObservationBits is the bit size of an OCR observation (int192)
+	ocrObservationBits = 192
+
+	// ocrReportHeadLength is the length of the head of an ABI encoded report: the report context,
+	// the observers and the offset of the observations
+	ocrReportHeadLength = 3 * 32
+)
+
+// reportArguments returns the ABI layout of the report of a feed, the observations are typed after the feed value schema:
+// abi.encode(bytes32 rawReportContext, bytes32 rawObservers, T[] observations)
+// with T being int192, bytes, string or int192[] for int192, bytes, string and tuple feeds
+func reportArguments(schema *FeedValueSchema) (abi.Arguments, error) {
+	observationsType := "int192[]"
+	switch schema.ValueType() {
+	case FeedValueTypeBytes:
+		observationsType = "bytes[]"
+	case FeedValueTypeString:
+		observationsType = "string[]"
+	case FeedValueTypeTuple:
+		observationsType = "int192[][]"
+	}
+
+	bytes32Type, err := abi.NewType("bytes32", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	observationsArrType, err := abi.NewType(observationsType, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return abi.Arguments{
+		{Name: "rawReportContext", Type: bytes32Type},
+		{Name: "rawObservers", Type: bytes32Type},
+		{Name: "observations", Type: observationsArrType},
+	}, nil
+}
+
+// ValidateReportLayout checks the report is long enough and aligned like an ABI encoded report, whatever the value
+// schema of its feed, the report gets fully decoded once the feed is known
+func ValidateReportLayout(report []byte) error {
+	if len(report) == 0 {
+		return sdkerrors.Wrap(ErrInvalidOCRReport, "empty report")
+	}
+	if len(report) < ocrReportHeadLength || len(report)%32 != 0 {
+		return sdkerrors.Wrapf(ErrInvalidOCRReport, "report of %d bytes is not ABI encoded", len(report))
+	}
+	return nil
+}
+
+// DecodeOCRReport deserializes an ABI encoded OCR report of an int192 feed into OCRAbiEncoded.
+func DecodeOCRReport(report []byte) (*OCRAbiEncoded, error) {
+	return DecodeFeedReport(report, nil)
+}
+
+// DecodeFeedReport deserializes an ABI encoded report into OCRAbiEncoded, typing the observations after the feed value schema.
+// It rejects reports that can not be unpacked, carry no or too many observations, have repeated observers,
+// int192 observations that are not sorted in ascending order or tuples of another size than the schema one.
+func DecodeFeedReport(report []byte, schema *FeedValueSchema) (decoded *OCRAbiEncoded, err error) {
+	if len(report) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidOCRReport, "empty report")
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	reportArguments, err := reportArguments(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// the abi unpacker is not hardened against every malformed input, never let it take the node down
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = nil
+			err = sdkerrors.Wrapf(ErrInvalidOCRReport, "failed to unpack report: %v", r)
+		}
+	}()
+
+	values, err := reportArguments.Unpack(report)
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidOCRReport, err.Error())
+	}
+
+	rawReportContext, ok := values[0].([32]byte)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidOCRReport, "invalid report context")
+	}
+	rawObservers, ok := values[1].([32]byte)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidOCRReport, "invalid observers")
+	}
+	observations, err := decodeObservations(values[2], schema)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(observations) == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidOCRReport, "report contains no observation")
+	}
+	if len(observations) > OCRMaxOracles {
+		return nil, sdkerrors.Wrapf(ErrInvalidOCRReport, "report contains %d observations, at most %d allowed", len(observations), OCRMaxOracles)
+	}
+
+	observers := make([]byte, len(observations))
+	seen := make(map[byte]bool, len(observations))
+	for i := range observers {
+		index := rawObservers[i]
+		if index >= OCRMaxOracles {
+			return nil, sdkerrors.Wrapf(ErrInvalidOCRReport, "observer index %d out of range", index)
+		}
+		if seen[index] {
+			return nil, sdkerrors.Wrapf(ErrInvalidOCRReport, "observer index %d repeated", index)
+		}
+		seen[index] = true
+		observers[i] = index
+	}
+
+	return &OCRAbiEncoded{
+		Context:      rawReportContext[:],
+		Oracles:      observers,
+		Observations: observations,
+	}, nil
+}
+
+// decodeObservations types the unpacked observations of a report after the feed value schema
+func decodeObservations(rawObservations interface{}, schema *FeedValueSchema) ([]*Observation, error) {
+	var observations []*Observation
+
+	switch raw := rawObservations.(type) {
+	case []*big.Int:
+		for i, o := range raw {
+			if i > 0 && raw[i-1].Cmp(o) > 0 {
+				return nil, sdkerrors.Wrap(ErrInvalidOCRReport, "observations not sorted")
+			}
+			observations = append(observations, &Observation{
+				Data:      math.U256Bytes(new(big.Int).Set(o)),
+				Value:     sdk.NewIntFromBigInt(o),
+				FeedValue: NewNumericFeedValue(sdk.NewIntFromBigInt(o)),
+			})
+		}
+	case [][]byte:
+		for _, o := range raw {
+			observations = append(observations, &Observation{
+				Data:      o,
+				FeedValue: NewBytesFeedValue(o),
+			})
+		}
+	case []string:
+		for _, o := range raw {
+			observations = append(observations, &Observation{
+				Data:      []byte(o),
+				FeedValue: NewStringFeedValue(o),
+			})
+		}
+	case [][]*big.Int:
+		for _, o := range raw {
+			if uint32(len(o)) != schema.GetTupleSize() {
+				return nil, sdkerrors.Wrapf(ErrInvalidOCRReport, "tuple observation of %d components, expected %d", len(o), schema.GetTupleSize())
+			}
+			data := make([]byte, 0, 32*len(o))
+			components := make([]sdk.Int, 0, len(o))
+			for _, c := range o {
+				data = append(data, math.U256Bytes(new(big.Int).Set(c))...)
+				components = append(components, sdk.NewIntFromBigInt(c))
+			}
+			observations = append(observations, &Observation{
+				Data:      data,
+				FeedValue: NewTupleFeedValue(components...),
+			})
+		}
+	default:
+		return nil, sdkerrors.Wrap(ErrInvalidOCRReport, "invalid observations")
+	}
+
+	return observations, nil
+}
+
+// EncodeOCRReport ABI encodes the given report context, observer indices and observations into an OCR report.
+// It is the inverse of DecodeOCRReport and mostly useful for clients and tests producing reports.
+func EncodeOCRReport(reportContext []byte, observers []byte, observations []*big.Int) ([]byte, error) {
+	values := make([]*FeedValue, 0, len(observations))
+	for _, o := range observations {
+		values = append(values, NewNumericFeedValue(sdk.NewIntFromBigInt(o)))
+	}
+	return EncodeFeedReport(reportContext, observers, nil, values)
+}
+
+// EncodeFeedReport ABI encodes the given report context, observer indices and observations typed after the feed value
+// schema into a report. It is the inverse of DecodeFeedReport and mostly useful for clients and tests producing reports.
+func EncodeFeedReport(reportContext []byte, observers []byte, schema *FeedValueSchema, observations []*FeedValue) ([]byte, error) {
+	if len(reportContext) != OCRReportContextLength {
+		return nil, fmt.Errorf("report context must be %d bytes", OCRReportContextLength)
+	}
+	if len(observers) > OCRMaxOracles {
+		return nil, fmt.Errorf("at most %d observers allowed", OCRMaxOracles)
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	reportArguments, err := reportArguments(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawObservations interface{}
+	switch schema.ValueType() {
+	case FeedValueTypeBytes:
+		raw := make([][]byte, 0, len(observations))
+		for _, o := range observations {
+			raw = append(raw, o.GetBytes())
+		}
+		rawObservations = raw
+	case FeedValueTypeString:
+		raw := make([]string, 0, len(observations))
+		for _, o := range observations {
+			raw = append(raw, o.GetText())
+		}
+		rawObservations = raw
+	case FeedValueTypeTuple:
+		raw := make([][]*big.Int, 0, len(observations))
+		for _, o := range observations {
+			components, err := int192Observations(o.GetTuple())
+			if err != nil {
+				return nil, err
+			}
+			raw = append(raw, components)
+		}
+		rawObservations = raw
+	default:
+		numeric := make([]sdk.Int, 0, len(observations))
+		for _, o := range observations {
+			numeric = append(numeric, o.GetNumeric())
+		}
+		if rawObservations, err = int192Observations(numeric); err != nil {
+			return nil, err
+		}
+	}
+
+	var rawReportContext, rawObservers [32]byte
+	copy(rawReportContext[:], reportContext)
+	copy(rawObservers[:], observers)
+
+	return reportArguments.Pack(rawReportContext, rawObservers, rawObservations)
+}
+
+// int192Observations converts the observations into ABI int192 values, rejecting the overflowing ones
+func int192Observations(observations []sdk.Int) ([]*big.Int, error) {
+	values := make([]*big.Int, 0, len(observations))
+	for _, o := range observations {
+		if o.IsNil() {
+			return nil, fmt.Errorf("observation can not be empty")
+		}
+		if o.BigInt().BitLen() >= ocrObservationBits {
+			return nil, fmt.Errorf("observation %s overflows int192", o)
+		}
+		values = append(values, o.BigInt())
+	}
+	return values, nil
+}
+
+// Median returns the median of the report observations, zero for the reports of the feeds that are not int192 ones.
+// Like the OCR aggregator contract, the upper median is taken when the number of observations is even,
+// DecodeOCRReport guarantees the observations are sorted and not empty.
+func (m *OCRAbiEncoded) Median() sdk.Int {
+	observations := m.GetObservations()
+	if len(observations) == 0 || observations[len(observations)/2].Value.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return observations[len(observations)/2].Value
+}
+
+// ConfigDigest returns the config digest carried by the report context
+func (m *OCRAbiEncoded) ConfigDigest() []byte {
+	context := m.GetContext()
+	if len(context) != OCRReportContextLength {
+		return nil
+	}
+	return context[ocrConfigDigestOffset:ocrEpochOffset]
+}
+
+// Epoch returns the OCR epoch carried by the report context
+func (m *OCRAbiEncoded) Epoch() uint32 {
+	context := m.GetContext()
+	if len(context) != OCRReportContextLength {
+		return 0
+	}
+	return binary.BigEndian.Uint32(context[ocrEpochOffset:ocrRoundOffset])
+}
+
+// Round returns the OCR round carried by the report context
+func (m *OCRAbiEncoded) Round() uint8 {
+	context := m.GetContext()
+	if len(context) != OCRReportContextLength {
+		return 0
+	}
+	return context[ocrRoundOffset]
+}
+
+// EpochAndRound packs the epoch and the round into a single value ordering the reports of a feed,
+// a report is stale when its EpochAndRound is not greater than the latest accepted one
+func (m *OCRAbiEncoded) EpochAndRound() uint64 {
+	return uint64(m.Epoch())<<8 | uint64(m.Round())
+}
+
+// NewOCRReportContext builds a raw report context from the config digest, the epoch and the round
+func NewOCRReportContext(configDigest []byte, epoch uint32, round uint8) []byte {
+	context := make([]byte, OCRReportContextLength)
+	copy(context[ocrConfigDigestOffset:ocrEpochOffset], configDigest)
+	binary.BigEndian.PutUint32(context[ocrEpochOffset:ocrRoundOffset], epoch)
+	context[ocrRoundOffset] = round
+	return context
+}
+
+// ReportSigningHash returns the hash the oracles sign for a report of the feed: keccak256(keccak256(feedId) || report).
+// Like in OCR the whole report is signed, its context binds the signatures to the config digest, the epoch and the round,
+// the feedId binds them to the feed
+func ReportSigningHash(feedId string, report []byte) []byte {
+	return crypto.Keccak256(crypto.Keccak256([]byte(feedId)), report)
+}
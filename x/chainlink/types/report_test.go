@@ -0,0 +1,186 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_DecodeOCRReport(t *testing.T) {
+	reportContext := make([]byte, OCRReportContextLength)
+	reportContext[31] = 7
+
+	report, err := EncodeOCRReport(reportContext, []byte{2, 0, 1}, []*big.Int{big.NewInt(-5), big.NewInt(10), big.NewInt(200)})
+	require.NoError(t, err)
+
+	decoded, err := DecodeOCRReport(report)
+	require.NoError(t, err)
+	require.Equal(t, reportContext, decoded.GetContext())
+	require.Equal(t, []byte{2, 0, 1}, decoded.GetOracles())
+	require.Equal(t, 3, len(decoded.GetObservations()))
+	require.Equal(t, sdk.NewInt(-5), decoded.GetObservations()[0].Value)
+	require.Equal(t, sdk.NewInt(10), decoded.GetObservations()[1].Value)
+	require.Equal(t, sdk.NewInt(200), decoded.GetObservations()[2].Value)
+	require.Equal(t, 32, len(decoded.GetObservations()[0].GetData()))
+	require.Equal(t, byte(0xff), decoded.GetObservations()[0].GetData()[0])
+}
+
+func TestTypes_DecodeOCRReport_Fail(t *testing.T) {
+	validContext := make([]byte, OCRReportContextLength)
+	mustEncode := func(observers []byte, observations ...int64) []byte {
+		o := make([]*big.Int, 0, len(observations))
+		for _, v := range observations {
+			o = append(o, big.NewInt(v))
+		}
+		report, err := EncodeOCRReport(validContext, observers, o)
+		require.NoError(t, err)
+		return report
+	}
+
+	testCases := []struct {
+		name   string
+		report []byte
+	}{
+		{name: "empty report", report: nil},
+		{name: "garbage report", report: []byte("not an abi encoded report")},
+		{name: "truncated report", report: mustEncode([]byte{0, 1}, 1, 2)[:96]},
+		{name: "no observation", report: mustEncode(nil)},
+		{name: "unsorted observations", report: mustEncode([]byte{0, 1}, 2, 1)},
+		{name: "repeated observer", report: mustEncode([]byte{1, 1}, 1, 2)},
+		{name: "observer index out of range", report: mustEncode([]byte{0, OCRMaxOracles}, 1, 2)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decoded, err := DecodeOCRReport(tc.report)
+			require.ErrorIs(t, err, ErrInvalidOCRReport)
+			require.Nil(t, decoded)
+		})
+	}
+}
+
+func TestTypes_EncodeOCRReport_Fail(t *testing.T) {
+	_, err := EncodeOCRReport([]byte{1}, []byte{0}, []*big.Int{big.NewInt(1)})
+	require.Error(t, err)
+
+	overflow := new(big.Int).Lsh(big.NewInt(1), 192)
+	_, err = EncodeOCRReport(make([]byte, OCRReportContextLength), []byte{0}, []*big.Int{overflow})
+	require.Error(t, err)
+}
+
+func TestTypes_OCRAbiEncoded_Median(t *testing.T) {
+	testCases := []struct {
+		name         string
+		observations []int64
+		expected     int64
+	}{
+		{name: "single observation", observations: []int64{7}, expected: 7},
+		{name: "odd observations", observations: []int64{-3, 1, 9}, expected: 1},
+		{name: "even observations takes upper median", observations: []int64{1, 2, 3, 4}, expected: 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			observers := make([]byte, 0, len(tc.observations))
+			values := make([]*big.Int, 0, len(tc.observations))
+			for i, o := range tc.observations {
+				observers = append(observers, byte(i))
+				values = append(values, big.NewInt(o))
+			}
+			report, err := EncodeOCRReport(make([]byte, OCRReportContextLength), observers, values)
+			require.NoError(t, err)
+
+			decoded, err := DecodeOCRReport(report)
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewInt(tc.expected), decoded.Median())
+		})
+	}
+
+	require.Equal(t, sdk.ZeroInt(), (&OCRAbiEncoded{}).Median())
+}
+
+func TestTypes_OCRAbiEncoded_EpochAndRound(t *testing.T) {
+	digest := []byte("0123456789abcdef")
+	report, err := EncodeOCRReport(NewOCRReportContext(digest, 0x01020304, 0x05), []byte{0}, []*big.Int{big.NewInt(1)})
+	require.NoError(t, err)
+
+	decoded, err := DecodeOCRReport(report)
+	require.NoError(t, err)
+	require.Equal(t, digest, decoded.ConfigDigest())
+	require.Equal(t, uint32(0x01020304), decoded.Epoch())
+	require.Equal(t, uint8(0x05), decoded.Round())
+	require.Equal(t, uint64(0x0102030405), decoded.EpochAndRound())
+
+	// a later epoch always orders after any round of an earlier epoch
+	older := &OCRAbiEncoded{Context: NewOCRReportContext(digest, 1, 255)}
+	newer := &OCRAbiEncoded{Context: NewOCRReportContext(digest, 2, 0)}
+	require.Less(t, older.EpochAndRound(), newer.EpochAndRound())
+
+	require.Equal(t, uint64(0), (&OCRAbiEncoded{}).EpochAndRound())
+	require.Nil(t, (&OCRAbiEncoded{}).ConfigDigest())
+}
+
+func TestTypes_DecodeFeedReport(t *testing.T) {
+	reportContext := NewOCRReportContext(nil, 1, 1)
+
+	testCases := []struct {
+		name         string
+		schema       *FeedValueSchema
+		observations []*FeedValue
+	}{
+		{
+			name:         "int192",
+			schema:       nil,
+			observations: []*FeedValue{NewNumericFeedValue(sdk.NewInt(-1)), NewNumericFeedValue(sdk.NewInt(2))},
+		},
+		{
+			name:         "bytes",
+			schema:       &FeedValueSchema{Type: FeedValueTypeBytes},
+			observations: []*FeedValue{NewBytesFeedValue([]byte{1}), NewBytesFeedValue([]byte{0, 1, 2})},
+		},
+		{
+			name:         "string",
+			schema:       &FeedValueSchema{Type: FeedValueTypeString},
+			observations: []*FeedValue{NewStringFeedValue("reserve ok"), NewStringFeedValue("reserve ok")},
+		},
+		{
+			name:         "tuple",
+			schema:       &FeedValueSchema{Type: FeedValueTypeTuple, TupleSize: 2},
+			observations: []*FeedValue{NewTupleFeedValue(sdk.NewInt(3), sdk.NewInt(-4)), NewTupleFeedValue(sdk.NewInt(1), sdk.NewInt(2))},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			report, err := EncodeFeedReport(reportContext, []byte{1, 0}, tc.schema, tc.observations)
+			require.NoError(t, err)
+			require.NoError(t, ValidateReportLayout(report))
+
+			decoded, err := DecodeFeedReport(report, tc.schema)
+			require.NoError(t, err)
+			require.Equal(t, reportContext, decoded.GetContext())
+			require.Equal(t, []byte{1, 0}, decoded.GetOracles())
+			require.Equal(t, tc.schema.ValueType(), decoded.ValueType())
+			for i, o := range decoded.GetObservations() {
+				require.Equal(t, tc.observations[i], o.GetFeedValue())
+			}
+		})
+	}
+
+	// a report must match the schema of its feed
+	report, err := EncodeFeedReport(reportContext, []byte{0}, &FeedValueSchema{Type: FeedValueTypeTuple, TupleSize: 3},
+		[]*FeedValue{NewTupleFeedValue(sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(3))})
+	require.NoError(t, err)
+	_, err = DecodeFeedReport(report, &FeedValueSchema{Type: FeedValueTypeTuple, TupleSize: 2})
+	require.ErrorIs(t, err, ErrInvalidOCRReport)
+	_, err = DecodeFeedReport(report, &FeedValueSchema{Type: "bool"})
+	require.Error(t, err)
+
+	require.Error(t, ValidateReportLayout(nil))
+	require.Error(t, ValidateReportLayout([]byte("report")))
+}
@@ -30,7 +30,8 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// MsgModuleOwnershipTransfer is the type defined for module ownership transfer
+// MsgModuleOwnershipTransfer is the type defined for module ownership transfer, the transfer takes effect once the
+// new module owner accepts it with MsgAcceptModuleOwnership
 type MsgModuleOwnershipTransfer struct {
 	// current module owner address
 	AssignerAddress       github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=assignerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"assignerAddress,omitempty"`
@@ -92,43 +93,26 @@ func (m *MsgModuleOwnershipTransfer) GetNewModuleOwnerPubKey() []byte {
 	return nil
 }
 
-// MsgFeed is the type defined for new feed
-type MsgFeed struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// FeedOwner is the owner of the feed
-	FeedOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=feedOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"feedOwner,omitempty"`
-	// DataProviders is the init list of data provider of the feed
-	DataProviders []*DataProvider `protobuf:"bytes,3,rep,name=dataProviders,proto3" json:"dataProviders,omitempty"`
-	// The number of signatures required for a feedData submission to be valid
-	SubmissionCount uint32 `protobuf:"varint,4,opt,name=submissionCount,proto3" json:"submissionCount,omitempty"`
-	// The interval between which a new round should automatically be triggered.
-	// The given value in milliseconds will only be approximate within block intervals
-	HeartbeatTrigger uint32 `protobuf:"varint,5,opt,name=heartbeatTrigger,proto3" json:"heartbeatTrigger,omitempty"`
-	// The fraction of deviation in the feed data required to trigger a new round.
-	// For example if the price of ATOM/USD changes by 1% then a new round should occur
-	// even if the heartbeat interval has not elapsed.
-	DeviationThresholdTrigger uint32 `protobuf:"varint,6,opt,name=deviationThresholdTrigger,proto3" json:"deviationThresholdTrigger,omitempty"`
-	// Module owner who signs the add feed tx
-	ModuleOwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=moduleOwnerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"moduleOwnerAddress,omitempty"`
-	// feedReward struct describing how rewards should be paid
-	FeedReward *FeedRewardSchema `protobuf:"bytes,8,opt,name=feedReward,proto3" json:"feedReward,omitempty"`
-	// Feed description
-	Desc string `protobuf:"bytes,9,opt,name=desc,proto3" json:"desc,omitempty"`
+// MsgAcceptModuleOwnership is the type defined for the new module owner accepting a pending module ownership transfer
+type MsgAcceptModuleOwnership struct {
+	// assignerAddress is the module owner who proposed the transfer
+	AssignerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=assignerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"assignerAddress,omitempty"`
+	// Signer is the new module owner of the transfer who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgFeed) Reset()         { *m = MsgFeed{} }
-func (m *MsgFeed) String() string { return proto.CompactTextString(m) }
-func (*MsgFeed) ProtoMessage()    {}
-func (*MsgFeed) Descriptor() ([]byte, []int) {
+func (m *MsgAcceptModuleOwnership) Reset()         { *m = MsgAcceptModuleOwnership{} }
+func (m *MsgAcceptModuleOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptModuleOwnership) ProtoMessage()    {}
+func (*MsgAcceptModuleOwnership) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8e2cf97733d10959, []int{1}
 }
-func (m *MsgFeed) XXX_Unmarshal(b []byte) error {
+func (m *MsgAcceptModuleOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAcceptModuleOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFeed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAcceptModuleOwnership.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -138,102 +122,197 @@ func (m *MsgFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgFeed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFeed.Merge(m, src)
+func (m *MsgAcceptModuleOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptModuleOwnership.Merge(m, src)
 }
-func (m *MsgFeed) XXX_Size() int {
+func (m *MsgAcceptModuleOwnership) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFeed) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFeed.DiscardUnknown(m)
+func (m *MsgAcceptModuleOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptModuleOwnership.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFeed proto.InternalMessageInfo
+var xxx_messageInfo_MsgAcceptModuleOwnership proto.InternalMessageInfo
 
-func (m *MsgFeed) GetFeedId() string {
+func (m *MsgAcceptModuleOwnership) GetAssignerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.FeedId
+		return m.AssignerAddress
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgFeed) GetFeedOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgAcceptModuleOwnership) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.FeedOwner
+		return m.Signer
 	}
 	return nil
 }
 
-func (m *MsgFeed) GetDataProviders() []*DataProvider {
+// MsgCancelModuleOwnershipTransfer is the type defined for cancelling a pending module ownership transfer
+type MsgCancelModuleOwnershipTransfer struct {
+	// assignerAddress is the module owner who proposed the transfer
+	AssignerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=assignerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"assignerAddress,omitempty"`
+	// Signer is either the module owner who proposed the transfer or the new module owner declining it
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) Reset()         { *m = MsgCancelModuleOwnershipTransfer{} }
+func (m *MsgCancelModuleOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelModuleOwnershipTransfer) ProtoMessage()    {}
+func (*MsgCancelModuleOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{2}
+}
+func (m *MsgCancelModuleOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelModuleOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelModuleOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelModuleOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelModuleOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgCancelModuleOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelModuleOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelModuleOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelModuleOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelModuleOwnershipTransfer) GetAssignerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.DataProviders
+		return m.AssignerAddress
 	}
 	return nil
 }
 
-func (m *MsgFeed) GetSubmissionCount() uint32 {
+func (m *MsgCancelModuleOwnershipTransfer) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.SubmissionCount
+		return m.Signer
 	}
-	return 0
+	return nil
 }
 
-func (m *MsgFeed) GetHeartbeatTrigger() uint32 {
+// OwnershipTransfer is a feed or module ownership transfer pending the acceptance of the new owner
+type OwnershipTransfer struct {
+	// feedId is the feed whose ownership is transferred, empty for a module ownership transfer
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// owner is the current owner who proposed the transfer
+	Owner    github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"owner,omitempty"`
+	NewOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=newOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newOwner,omitempty"`
+	// newOwnerPubKey is the public key of the new module owner, empty for a feed ownership transfer
+	NewOwnerPubKey []byte `protobuf:"bytes,4,opt,name=newOwnerPubKey,proto3" json:"newOwnerPubKey,omitempty"`
+	// proposedAtHeight is the height of the block the transfer got proposed in
+	ProposedAtHeight int64 `protobuf:"varint,5,opt,name=proposedAtHeight,proto3" json:"proposedAtHeight,omitempty"`
+	// expiresAtHeight is the last height the transfer can be accepted at, 0 when it never expires
+	ExpiresAtHeight int64 `protobuf:"varint,6,opt,name=expiresAtHeight,proto3" json:"expiresAtHeight,omitempty"`
+}
+
+func (m *OwnershipTransfer) Reset()         { *m = OwnershipTransfer{} }
+func (m *OwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*OwnershipTransfer) ProtoMessage()    {}
+func (*OwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{3}
+}
+func (m *OwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnershipTransfer.Merge(m, src)
+}
+func (m *OwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnershipTransfer proto.InternalMessageInfo
+
+func (m *OwnershipTransfer) GetFeedId() string {
 	if m != nil {
-		return m.HeartbeatTrigger
+		return m.FeedId
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgFeed) GetDeviationThresholdTrigger() uint32 {
+func (m *OwnershipTransfer) GetOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.DeviationThresholdTrigger
+		return m.Owner
 	}
-	return 0
+	return nil
 }
 
-func (m *MsgFeed) GetModuleOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *OwnershipTransfer) GetNewOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.ModuleOwnerAddress
+		return m.NewOwner
 	}
 	return nil
 }
 
-func (m *MsgFeed) GetFeedReward() *FeedRewardSchema {
+func (m *OwnershipTransfer) GetNewOwnerPubKey() []byte {
 	if m != nil {
-		return m.FeedReward
+		return m.NewOwnerPubKey
 	}
 	return nil
 }
 
-func (m *MsgFeed) GetDesc() string {
+func (m *OwnershipTransfer) GetProposedAtHeight() int64 {
 	if m != nil {
-		return m.Desc
+		return m.ProposedAtHeight
 	}
-	return ""
+	return 0
 }
 
-type FeedRewardSchema struct {
-	// amount is the base value that rewarded to each valid data provider before designated strategy applied
-	// amount is not allowed to be zero
-	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// reward strategy name, must be a registered strategy
-	// this is allowed to be empty, in which case every data provider will be rewarded the same amount token
-	Strategy string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+func (m *OwnershipTransfer) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
 }
 
-func (m *FeedRewardSchema) Reset()         { *m = FeedRewardSchema{} }
-func (m *FeedRewardSchema) String() string { return proto.CompactTextString(m) }
-func (*FeedRewardSchema) ProtoMessage()    {}
-func (*FeedRewardSchema) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{2}
+// MsgRemoveModuleOwner is the type defined for removing a module owner, the last module owner can not be removed
+type MsgRemoveModuleOwner struct {
+	// address is the module owner to remove
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	// assignerAddress is the module owner who signs the tx
+	AssignerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=assignerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"assignerAddress,omitempty"`
 }
-func (m *FeedRewardSchema) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgRemoveModuleOwner) Reset()         { *m = MsgRemoveModuleOwner{} }
+func (m *MsgRemoveModuleOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveModuleOwner) ProtoMessage()    {}
+func (*MsgRemoveModuleOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{4}
+}
+func (m *MsgRemoveModuleOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FeedRewardSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveModuleOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FeedRewardSchema.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveModuleOwner.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -243,50 +322,51 @@ func (m *FeedRewardSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *FeedRewardSchema) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeedRewardSchema.Merge(m, src)
+func (m *MsgRemoveModuleOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveModuleOwner.Merge(m, src)
 }
-func (m *FeedRewardSchema) XXX_Size() int {
+func (m *MsgRemoveModuleOwner) XXX_Size() int {
 	return m.Size()
 }
-func (m *FeedRewardSchema) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeedRewardSchema.DiscardUnknown(m)
+func (m *MsgRemoveModuleOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveModuleOwner.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeedRewardSchema proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveModuleOwner proto.InternalMessageInfo
 
-func (m *FeedRewardSchema) GetAmount() uint64 {
+func (m *MsgRemoveModuleOwner) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Amount
+		return m.Address
 	}
-	return 0
+	return nil
 }
 
-func (m *FeedRewardSchema) GetStrategy() string {
+func (m *MsgRemoveModuleOwner) GetAssignerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Strategy
+		return m.AssignerAddress
 	}
-	return ""
+	return nil
 }
 
-// DataProvider is the type defined for feed data provider
-type DataProvider struct {
-	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
-	PubKey  []byte                                        `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+// MsgApproveOwnerProposal is the type defined for a module owner approving a pending owner proposal
+type MsgApproveOwnerProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	// Signer is the module owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *DataProvider) Reset()         { *m = DataProvider{} }
-func (m *DataProvider) String() string { return proto.CompactTextString(m) }
-func (*DataProvider) ProtoMessage()    {}
-func (*DataProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{3}
+func (m *MsgApproveOwnerProposal) Reset()         { *m = MsgApproveOwnerProposal{} }
+func (m *MsgApproveOwnerProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveOwnerProposal) ProtoMessage()    {}
+func (*MsgApproveOwnerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{5}
 }
-func (m *DataProvider) XXX_Unmarshal(b []byte) error {
+func (m *MsgApproveOwnerProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgApproveOwnerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DataProvider.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgApproveOwnerProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -296,54 +376,51 @@ func (m *DataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DataProvider) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DataProvider.Merge(m, src)
+func (m *MsgApproveOwnerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveOwnerProposal.Merge(m, src)
 }
-func (m *DataProvider) XXX_Size() int {
+func (m *MsgApproveOwnerProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *DataProvider) XXX_DiscardUnknown() {
-	xxx_messageInfo_DataProvider.DiscardUnknown(m)
+func (m *MsgApproveOwnerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveOwnerProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DataProvider proto.InternalMessageInfo
+var xxx_messageInfo_MsgApproveOwnerProposal proto.InternalMessageInfo
 
-func (m *DataProvider) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgApproveOwnerProposal) GetProposalId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *DataProvider) GetPubKey() []byte {
+func (m *MsgApproveOwnerProposal) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.PubKey
+		return m.Signer
 	}
 	return nil
 }
 
-// MsgAddProvider is the type defined for adding new data provider of the feed
-type MsgAddDataProvider struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// DataProvider is the new data provider of the feed to add
-	DataProvider *DataProvider `protobuf:"bytes,2,opt,name=dataProvider,proto3" json:"dataProvider,omitempty"`
-	// Signer is the feed owner who signs the add feed provided tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+// MsgCancelOwnerProposal is the type defined for the proposer of a pending owner proposal dropping it
+type MsgCancelOwnerProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposalId,proto3" json:"proposalId,omitempty"`
+	// Signer is the module owner who submitted the proposal
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgAddDataProvider) Reset()         { *m = MsgAddDataProvider{} }
-func (m *MsgAddDataProvider) String() string { return proto.CompactTextString(m) }
-func (*MsgAddDataProvider) ProtoMessage()    {}
-func (*MsgAddDataProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{4}
+func (m *MsgCancelOwnerProposal) Reset()         { *m = MsgCancelOwnerProposal{} }
+func (m *MsgCancelOwnerProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelOwnerProposal) ProtoMessage()    {}
+func (*MsgCancelOwnerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{6}
 }
-func (m *MsgAddDataProvider) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelOwnerProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddDataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelOwnerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddDataProvider.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelOwnerProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -353,61 +430,59 @@ func (m *MsgAddDataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgAddDataProvider) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddDataProvider.Merge(m, src)
+func (m *MsgCancelOwnerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelOwnerProposal.Merge(m, src)
 }
-func (m *MsgAddDataProvider) XXX_Size() int {
+func (m *MsgCancelOwnerProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddDataProvider) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddDataProvider.DiscardUnknown(m)
+func (m *MsgCancelOwnerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelOwnerProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddDataProvider proto.InternalMessageInfo
-
-func (m *MsgAddDataProvider) GetFeedId() string {
-	if m != nil {
-		return m.FeedId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgCancelOwnerProposal proto.InternalMessageInfo
 
-func (m *MsgAddDataProvider) GetDataProvider() *DataProvider {
+func (m *MsgCancelOwnerProposal) GetProposalId() uint64 {
 	if m != nil {
-		return m.DataProvider
+		return m.ProposalId
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgAddDataProvider) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgCancelOwnerProposal) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
 		return m.Signer
 	}
 	return nil
 }
 
-// MsgRemoveProvider is the type defined for removing a data provider of the feed
-type MsgRemoveDataProvider struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// Address of the data provider to remove from the feed
-	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
-	// Signer is the feed owner who signs the remove feed provided tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
-}
-
-func (m *MsgRemoveDataProvider) Reset()         { *m = MsgRemoveDataProvider{} }
-func (m *MsgRemoveDataProvider) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveDataProvider) ProtoMessage()    {}
-func (*MsgRemoveDataProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{5}
+// OwnerProposal is a module owner msg pending the approval of ownerApprovalThreshold distinct module owners,
+// exactly one of the msgs is set
+type OwnerProposal struct {
+	Id                uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Feed              *MsgFeed              `protobuf:"bytes,2,opt,name=feed,proto3" json:"feed,omitempty"`
+	ModuleOwner       *MsgModuleOwner       `protobuf:"bytes,3,opt,name=moduleOwner,proto3" json:"moduleOwner,omitempty"`
+	RemoveModuleOwner *MsgRemoveModuleOwner `protobuf:"bytes,4,opt,name=removeModuleOwner,proto3" json:"removeModuleOwner,omitempty"`
+	// approvals are the module owners who approved the proposal, starting with its proposer
+	Approvals []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,5,rep,name=approvals,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"approvals,omitempty"`
+	// submittedAtHeight is the height of the block the proposal got submitted in
+	SubmittedAtHeight int64 `protobuf:"varint,6,opt,name=submittedAtHeight,proto3" json:"submittedAtHeight,omitempty"`
+	// expiresAtHeight is the last height the proposal can be approved at, 0 when it never expires
+	ExpiresAtHeight int64 `protobuf:"varint,7,opt,name=expiresAtHeight,proto3" json:"expiresAtHeight,omitempty"`
+}
+
+func (m *OwnerProposal) Reset()         { *m = OwnerProposal{} }
+func (m *OwnerProposal) String() string { return proto.CompactTextString(m) }
+func (*OwnerProposal) ProtoMessage()    {}
+func (*OwnerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{7}
 }
-func (m *MsgRemoveDataProvider) XXX_Unmarshal(b []byte) error {
+func (m *OwnerProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveDataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OwnerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveDataProvider.Marshal(b, m, deterministic)
+		return xxx_messageInfo_OwnerProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -417,124 +492,126 @@ func (m *MsgRemoveDataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveDataProvider) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveDataProvider.Merge(m, src)
+func (m *OwnerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerProposal.Merge(m, src)
 }
-func (m *MsgRemoveDataProvider) XXX_Size() int {
+func (m *OwnerProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveDataProvider) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveDataProvider.DiscardUnknown(m)
+func (m *OwnerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveDataProvider proto.InternalMessageInfo
+var xxx_messageInfo_OwnerProposal proto.InternalMessageInfo
 
-func (m *MsgRemoveDataProvider) GetFeedId() string {
+func (m *OwnerProposal) GetId() uint64 {
 	if m != nil {
-		return m.FeedId
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRemoveDataProvider) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *OwnerProposal) GetFeed() *MsgFeed {
 	if m != nil {
-		return m.Address
+		return m.Feed
 	}
 	return nil
 }
 
-func (m *MsgRemoveDataProvider) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *OwnerProposal) GetModuleOwner() *MsgModuleOwner {
 	if m != nil {
-		return m.Signer
+		return m.ModuleOwner
 	}
 	return nil
 }
 
-type MsgSetSubmissionCount struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// The number of signatures required for a feedData submission to be valid
-	SubmissionCount uint32 `protobuf:"varint,2,opt,name=submissionCount,proto3" json:"submissionCount,omitempty"`
-	// Signer is the feed owner who signs the tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+func (m *OwnerProposal) GetRemoveModuleOwner() *MsgRemoveModuleOwner {
+	if m != nil {
+		return m.RemoveModuleOwner
+	}
+	return nil
 }
 
-func (m *MsgSetSubmissionCount) Reset()         { *m = MsgSetSubmissionCount{} }
-func (m *MsgSetSubmissionCount) String() string { return proto.CompactTextString(m) }
-func (*MsgSetSubmissionCount) ProtoMessage()    {}
-func (*MsgSetSubmissionCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{6}
-}
-func (m *MsgSetSubmissionCount) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgSetSubmissionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgSetSubmissionCount.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *MsgSetSubmissionCount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetSubmissionCount.Merge(m, src)
-}
-func (m *MsgSetSubmissionCount) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgSetSubmissionCount) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetSubmissionCount.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgSetSubmissionCount proto.InternalMessageInfo
-
-func (m *MsgSetSubmissionCount) GetFeedId() string {
+func (m *OwnerProposal) GetApprovals() []github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.FeedId
+		return m.Approvals
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgSetSubmissionCount) GetSubmissionCount() uint32 {
+func (m *OwnerProposal) GetSubmittedAtHeight() int64 {
 	if m != nil {
-		return m.SubmissionCount
+		return m.SubmittedAtHeight
 	}
 	return 0
 }
 
-func (m *MsgSetSubmissionCount) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *OwnerProposal) GetExpiresAtHeight() int64 {
 	if m != nil {
-		return m.Signer
+		return m.ExpiresAtHeight
 	}
-	return nil
+	return 0
 }
 
-type MsgSetHeartbeatTrigger struct {
+// MsgFeed is the type defined for new feed
+type MsgFeed struct {
 	// FeedId is the unique identifier of the feed
 	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// FeedOwner is the owner of the feed
+	FeedOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=feedOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"feedOwner,omitempty"`
+	// DataProviders is the init list of data provider of the feed, the data providers are the signers of the observations
+	DataProviders []*DataProvider `protobuf:"bytes,3,rep,name=dataProviders,proto3" json:"dataProviders,omitempty"`
+	// The number of signatures required for a feedData submission to be valid
+	SubmissionCount uint32 `protobuf:"varint,4,opt,name=submissionCount,proto3" json:"submissionCount,omitempty"`
 	// The interval between which a new round should automatically be triggered.
 	// The given value in milliseconds will only be approximate within block intervals
-	HeartbeatTrigger uint32 `protobuf:"varint,2,opt,name=heartbeatTrigger,proto3" json:"heartbeatTrigger,omitempty"`
-	// Signer is the feed owner who signs the tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+	HeartbeatTrigger uint32 `protobuf:"varint,5,opt,name=heartbeatTrigger,proto3" json:"heartbeatTrigger,omitempty"`
+	// The fraction of deviation in the feed data required to trigger a new round.
+	// For example if the price of ATOM/USD changes by 1% then a new round should occur
+	// even if the heartbeat interval has not elapsed.
+	DeviationThresholdTrigger uint32 `protobuf:"varint,6,opt,name=deviationThresholdTrigger,proto3" json:"deviationThresholdTrigger,omitempty"`
+	// Module owner who signs the add feed tx
+	ModuleOwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=moduleOwnerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"moduleOwnerAddress,omitempty"`
+	// feedReward struct describing how rewards should be paid
+	FeedReward *FeedRewardSchema `protobuf:"bytes,8,opt,name=feedReward,proto3" json:"feedReward,omitempty"`
+	// Feed description
+	Desc string `protobuf:"bytes,9,opt,name=desc,proto3" json:"desc,omitempty"`
+	// deviationThresholdPolicy decides what happens to a round whose answer deviates less than deviationThresholdTrigger
+	// from the previous answer before the heartbeat elapsed: "reject" (default) or "nonRewardable"
+	DeviationThresholdPolicy string `protobuf:"bytes,10,opt,name=deviationThresholdPolicy,proto3" json:"deviationThresholdPolicy,omitempty"`
+	// metadata describes how to interpret the feed answers
+	Metadata *FeedMetadata `protobuf:"bytes,11,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// paused is true when the feed does not accept new rounds, its rounds history stays available
+	Paused bool `protobuf:"varint,12,opt,name=paused,proto3" json:"paused,omitempty"`
+	// deprecated is true when the feed is read-only, it neither accepts new rounds nor parameter changes
+	// and its data providers are no longer rewarded
+	Deprecated bool `protobuf:"varint,13,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// answerBounds are the minimum and maximum answers of the feed, a feed without bounds accepts any answer
+	AnswerBounds *AnswerBounds `protobuf:"bytes,14,opt,name=answerBounds,proto3" json:"answerBounds,omitempty"`
+	// transmitters are the accounts allowed to submit the reports of the feed,
+	// the data providers transmit their own reports when the feed has no transmitter
+	Transmitters []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,15,rep,name=transmitters,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"transmitters,omitempty"`
+	// valueSchema is the type of the values observed and answered by the feed, int192 when not set
+	ValueSchema *FeedValueSchema `protobuf:"bytes,16,opt,name=valueSchema,proto3" json:"valueSchema,omitempty"`
+	// mintRewards lets the module mint the rewards and fee reimbursements the feed escrow can not cover
+	MintRewards bool `protobuf:"varint,17,opt,name=mintRewards,proto3" json:"mintRewards,omitempty"`
+	// insufficientFundsPolicy decides what happens to a round the feed escrow can not reward:
+	// "nonRewardable" (default) accepts the round without rewarding it, "reject" rejects the submission
+	InsufficientFundsPolicy string `protobuf:"bytes,18,opt,name=insufficientFundsPolicy,proto3" json:"insufficientFundsPolicy,omitempty"`
 }
 
-func (m *MsgSetHeartbeatTrigger) Reset()         { *m = MsgSetHeartbeatTrigger{} }
-func (m *MsgSetHeartbeatTrigger) String() string { return proto.CompactTextString(m) }
-func (*MsgSetHeartbeatTrigger) ProtoMessage()    {}
-func (*MsgSetHeartbeatTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{7}
+func (m *MsgFeed) Reset()         { *m = MsgFeed{} }
+func (m *MsgFeed) String() string { return proto.CompactTextString(m) }
+func (*MsgFeed) ProtoMessage()    {}
+func (*MsgFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{8}
 }
-func (m *MsgSetHeartbeatTrigger) XXX_Unmarshal(b []byte) error {
+func (m *MsgFeed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetHeartbeatTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetHeartbeatTrigger.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFeed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -544,126 +621,166 @@ func (m *MsgSetHeartbeatTrigger) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgSetHeartbeatTrigger) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetHeartbeatTrigger.Merge(m, src)
+func (m *MsgFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeed.Merge(m, src)
 }
-func (m *MsgSetHeartbeatTrigger) XXX_Size() int {
+func (m *MsgFeed) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetHeartbeatTrigger) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetHeartbeatTrigger.DiscardUnknown(m)
+func (m *MsgFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeed.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetHeartbeatTrigger proto.InternalMessageInfo
+var xxx_messageInfo_MsgFeed proto.InternalMessageInfo
 
-func (m *MsgSetHeartbeatTrigger) GetFeedId() string {
+func (m *MsgFeed) GetFeedId() string {
 	if m != nil {
 		return m.FeedId
 	}
 	return ""
 }
 
-func (m *MsgSetHeartbeatTrigger) GetHeartbeatTrigger() uint32 {
+func (m *MsgFeed) GetFeedOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FeedOwner
+	}
+	return nil
+}
+
+func (m *MsgFeed) GetDataProviders() []*DataProvider {
+	if m != nil {
+		return m.DataProviders
+	}
+	return nil
+}
+
+func (m *MsgFeed) GetSubmissionCount() uint32 {
+	if m != nil {
+		return m.SubmissionCount
+	}
+	return 0
+}
+
+func (m *MsgFeed) GetHeartbeatTrigger() uint32 {
 	if m != nil {
 		return m.HeartbeatTrigger
 	}
 	return 0
 }
 
-func (m *MsgSetHeartbeatTrigger) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgFeed) GetDeviationThresholdTrigger() uint32 {
 	if m != nil {
-		return m.Signer
+		return m.DeviationThresholdTrigger
+	}
+	return 0
+}
+
+func (m *MsgFeed) GetModuleOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.ModuleOwnerAddress
 	}
 	return nil
 }
 
-type MsgSetDeviationThresholdTrigger struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// The fraction of deviation in the feed data required to trigger a new round.
-	// For example if the price of ATOM/USD changes by 1% then a new round should occur
-	// even if the heartbeat interval has not elapsed.
-	DeviationThresholdTrigger uint32 `protobuf:"varint,2,opt,name=deviationThresholdTrigger,proto3" json:"deviationThresholdTrigger,omitempty"`
-	// Signer is the feed owner who signs the tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+func (m *MsgFeed) GetFeedReward() *FeedRewardSchema {
+	if m != nil {
+		return m.FeedReward
+	}
+	return nil
 }
 
-func (m *MsgSetDeviationThresholdTrigger) Reset()         { *m = MsgSetDeviationThresholdTrigger{} }
-func (m *MsgSetDeviationThresholdTrigger) String() string { return proto.CompactTextString(m) }
-func (*MsgSetDeviationThresholdTrigger) ProtoMessage()    {}
-func (*MsgSetDeviationThresholdTrigger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{8}
+func (m *MsgFeed) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
 }
-func (m *MsgSetDeviationThresholdTrigger) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *MsgFeed) GetDeviationThresholdPolicy() string {
+	if m != nil {
+		return m.DeviationThresholdPolicy
+	}
+	return ""
 }
-func (m *MsgSetDeviationThresholdTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgSetDeviationThresholdTrigger.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *MsgFeed) GetMetadata() *FeedMetadata {
+	if m != nil {
+		return m.Metadata
 	}
+	return nil
 }
-func (m *MsgSetDeviationThresholdTrigger) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetDeviationThresholdTrigger.Merge(m, src)
+
+func (m *MsgFeed) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
-func (m *MsgSetDeviationThresholdTrigger) XXX_Size() int {
-	return m.Size()
+
+func (m *MsgFeed) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
 }
-func (m *MsgSetDeviationThresholdTrigger) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetDeviationThresholdTrigger.DiscardUnknown(m)
+
+func (m *MsgFeed) GetAnswerBounds() *AnswerBounds {
+	if m != nil {
+		return m.AnswerBounds
+	}
+	return nil
 }
 
-var xxx_messageInfo_MsgSetDeviationThresholdTrigger proto.InternalMessageInfo
+func (m *MsgFeed) GetTransmitters() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Transmitters
+	}
+	return nil
+}
 
-func (m *MsgSetDeviationThresholdTrigger) GetFeedId() string {
+func (m *MsgFeed) GetValueSchema() *FeedValueSchema {
 	if m != nil {
-		return m.FeedId
+		return m.ValueSchema
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgSetDeviationThresholdTrigger) GetDeviationThresholdTrigger() uint32 {
+func (m *MsgFeed) GetMintRewards() bool {
 	if m != nil {
-		return m.DeviationThresholdTrigger
+		return m.MintRewards
 	}
-	return 0
+	return false
 }
 
-func (m *MsgSetDeviationThresholdTrigger) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgFeed) GetInsufficientFundsPolicy() string {
 	if m != nil {
-		return m.Signer
+		return m.InsufficientFundsPolicy
 	}
-	return nil
+	return ""
 }
 
-type MsgSetFeedReward struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// The reward distributed to the data providers in a given feed.
-	// The reward will be given as the native token in the application, denominated as "link"
-	FeedReward *FeedRewardSchema `protobuf:"bytes,2,opt,name=feedReward,proto3" json:"feedReward,omitempty"`
-	// Signer is the feed owner who signs the tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+// AnswerBounds are the bounds the aggregated answer of a round must lie within, like minAnswer and maxAnswer
+// of the OCR aggregator
+type AnswerBounds struct {
+	MinAnswer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=minAnswer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minAnswer"`
+	MaxAnswer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=maxAnswer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"maxAnswer"`
+	// mode decides what happens to a round whose answer is out of bounds: "reject" (default) rejects the round,
+	// "flag" accepts the round without rewarding it and emits a MsgFeedDataValidationFailedEvent
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func (m *MsgSetFeedReward) Reset()         { *m = MsgSetFeedReward{} }
-func (m *MsgSetFeedReward) String() string { return proto.CompactTextString(m) }
-func (*MsgSetFeedReward) ProtoMessage()    {}
-func (*MsgSetFeedReward) Descriptor() ([]byte, []int) {
+func (m *AnswerBounds) Reset()         { *m = AnswerBounds{} }
+func (m *AnswerBounds) String() string { return proto.CompactTextString(m) }
+func (*AnswerBounds) ProtoMessage()    {}
+func (*AnswerBounds) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8e2cf97733d10959, []int{9}
 }
-func (m *MsgSetFeedReward) XXX_Unmarshal(b []byte) error {
+func (m *AnswerBounds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSetFeedReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AnswerBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSetFeedReward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AnswerBounds.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -673,61 +790,45 @@ func (m *MsgSetFeedReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgSetFeedReward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSetFeedReward.Merge(m, src)
+func (m *AnswerBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnswerBounds.Merge(m, src)
 }
-func (m *MsgSetFeedReward) XXX_Size() int {
+func (m *AnswerBounds) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSetFeedReward) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSetFeedReward.DiscardUnknown(m)
+func (m *AnswerBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnswerBounds.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSetFeedReward proto.InternalMessageInfo
+var xxx_messageInfo_AnswerBounds proto.InternalMessageInfo
 
-func (m *MsgSetFeedReward) GetFeedId() string {
+func (m *AnswerBounds) GetMode() string {
 	if m != nil {
-		return m.FeedId
+		return m.Mode
 	}
 	return ""
 }
 
-func (m *MsgSetFeedReward) GetFeedReward() *FeedRewardSchema {
-	if m != nil {
-		return m.FeedReward
-	}
-	return nil
-}
-
-func (m *MsgSetFeedReward) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.Signer
-	}
-	return nil
-}
-
-// MsgFeedOwnershipTransfer is the type defined for feed ownership transfer
-type MsgFeedOwnershipTransfer struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// NewFeedOwnerAddress is the address of the new owner of the feed
-	NewFeedOwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=newFeedOwnerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newFeedOwnerAddress,omitempty"`
-	// Signer is the feed owner who signs the tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+// FeedValueSchema declares the type of the values observed and answered by a feed
+type FeedValueSchema struct {
+	// type is one of "int192" (default), "bytes", "string" or "tuple"
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// tupleSize is the number of int192 components of the values of a tuple feed
+	TupleSize uint32 `protobuf:"varint,2,opt,name=tupleSize,proto3" json:"tupleSize,omitempty"`
 }
 
-func (m *MsgFeedOwnershipTransfer) Reset()         { *m = MsgFeedOwnershipTransfer{} }
-func (m *MsgFeedOwnershipTransfer) String() string { return proto.CompactTextString(m) }
-func (*MsgFeedOwnershipTransfer) ProtoMessage()    {}
-func (*MsgFeedOwnershipTransfer) Descriptor() ([]byte, []int) {
+func (m *FeedValueSchema) Reset()         { *m = FeedValueSchema{} }
+func (m *FeedValueSchema) String() string { return proto.CompactTextString(m) }
+func (*FeedValueSchema) ProtoMessage()    {}
+func (*FeedValueSchema) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8e2cf97733d10959, []int{10}
 }
-func (m *MsgFeedOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+func (m *FeedValueSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFeedOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedValueSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFeedOwnershipTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedValueSchema.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -737,73 +838,53 @@ func (m *MsgFeedOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgFeedOwnershipTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFeedOwnershipTransfer.Merge(m, src)
+func (m *FeedValueSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedValueSchema.Merge(m, src)
 }
-func (m *MsgFeedOwnershipTransfer) XXX_Size() int {
+func (m *FeedValueSchema) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFeedOwnershipTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFeedOwnershipTransfer.DiscardUnknown(m)
+func (m *FeedValueSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedValueSchema.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFeedOwnershipTransfer proto.InternalMessageInfo
+var xxx_messageInfo_FeedValueSchema proto.InternalMessageInfo
 
-func (m *MsgFeedOwnershipTransfer) GetFeedId() string {
+func (m *FeedValueSchema) GetType() string {
 	if m != nil {
-		return m.FeedId
+		return m.Type
 	}
 	return ""
 }
 
-func (m *MsgFeedOwnershipTransfer) GetNewFeedOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.NewFeedOwnerAddress
-	}
-	return nil
-}
-
-func (m *MsgFeedOwnershipTransfer) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *FeedValueSchema) GetTupleSize() uint32 {
 	if m != nil {
-		return m.Signer
+		return m.TupleSize
 	}
-	return nil
+	return 0
 }
 
-// MsgFeedData is the type defined for the data of the feed
-// It could be an OCR report feed, or any general feed data in the future
-type MsgFeedData struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// Submitter is the leader of the current round who is calling the module to submit feed data
-	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
-	// observationFeedData is the report for price feed, could be used for general feed data
-	// From proposal: The reports generated by Chainlink nodes off-chain using the OCR protocol are ABI encoded byte arrays
-	// which means each report contains a byte array which could be deserialized to OCRAbiEncoded type
-	// this is the original observation data array from each data provider
-	ObservationFeedData [][]byte `protobuf:"bytes,3,rep,name=observationFeedData,proto3" json:"observationFeedData,omitempty"`
-	// observationFeedData is the list of the data provider observation data signature of the current round, signed by chainlink prikey. This is observation signatures
-	ObservationFeedDataSignatures [][]byte `protobuf:"bytes,4,rep,name=observationFeedDataSignatures,proto3" json:"observationFeedDataSignatures,omitempty"`
-	// isFeedDataValid is the indicator whether feed data is valid against the external validation func, it's true by default
-	IsFeedDataValid bool `protobuf:"varint,5,opt,name=isFeedDataValid,proto3" json:"isFeedDataValid,omitempty"`
-	// cosmosPubKeys is the cosmos account pubKey slice of the data providers who signed this round
-	CosmosPubKeys [][]byte `protobuf:"bytes,6,rep,name=cosmosPubKeys,proto3" json:"cosmosPubKeys,omitempty"`
-	// txFee is the tx fee of submitting feed data
-	TxFee *Coin `protobuf:"bytes,7,opt,name=txFee,proto3" json:"txFee,omitempty"`
+// FeedValue is an observation or an answer typed after the value schema of its feed, only the field of its type is set
+type FeedValue struct {
+	Type    string                                   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Numeric github_com_cosmos_cosmos_sdk_types.Int   `protobuf:"bytes,2,opt,name=numeric,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"numeric"`
+	Bytes   []byte                                   `protobuf:"bytes,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Text    string                                   `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Tuple   []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,rep,name=tuple,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tuple"`
 }
 
-func (m *MsgFeedData) Reset()         { *m = MsgFeedData{} }
-func (m *MsgFeedData) String() string { return proto.CompactTextString(m) }
-func (*MsgFeedData) ProtoMessage()    {}
-func (*MsgFeedData) Descriptor() ([]byte, []int) {
+func (m *FeedValue) Reset()         { *m = FeedValue{} }
+func (m *FeedValue) String() string { return proto.CompactTextString(m) }
+func (*FeedValue) ProtoMessage()    {}
+func (*FeedValue) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8e2cf97733d10959, []int{11}
 }
-func (m *MsgFeedData) XXX_Unmarshal(b []byte) error {
+func (m *FeedValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgFeedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgFeedData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedValue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -813,87 +894,142 @@ func (m *MsgFeedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgFeedData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgFeedData.Merge(m, src)
+func (m *FeedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedValue.Merge(m, src)
 }
-func (m *MsgFeedData) XXX_Size() int {
+func (m *FeedValue) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgFeedData) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgFeedData.DiscardUnknown(m)
+func (m *FeedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgFeedData proto.InternalMessageInfo
+var xxx_messageInfo_FeedValue proto.InternalMessageInfo
 
-func (m *MsgFeedData) GetFeedId() string {
+func (m *FeedValue) GetType() string {
 	if m != nil {
-		return m.FeedId
+		return m.Type
 	}
 	return ""
 }
 
-func (m *MsgFeedData) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *FeedValue) GetBytes() []byte {
 	if m != nil {
-		return m.Submitter
+		return m.Bytes
 	}
 	return nil
 }
 
-func (m *MsgFeedData) GetObservationFeedData() [][]byte {
+func (m *FeedValue) GetText() string {
 	if m != nil {
-		return m.ObservationFeedData
+		return m.Text
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgFeedData) GetObservationFeedDataSignatures() [][]byte {
+// FeedMetadata describes how to interpret the answers of a feed, following the AggregatorV3 interface
+type FeedMetadata struct {
+	// decimals is the number of decimals of the answers, an answer of 123456 with 2 decimals reads 1234.56
+	Decimals uint32 `protobuf:"varint,1,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// version is the version of the feed
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// baseAsset is the asset being priced, ATOM in ATOM/USD
+	BaseAsset string `protobuf:"bytes,3,opt,name=baseAsset,proto3" json:"baseAsset,omitempty"`
+	// quoteAsset is the asset the price is expressed in, USD in ATOM/USD
+	QuoteAsset string `protobuf:"bytes,4,opt,name=quoteAsset,proto3" json:"quoteAsset,omitempty"`
+	// unit is the unit of the answers when the feed is not a price pair, e.g. "%" or "celsius"
+	Unit string `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+}
+
+func (m *FeedMetadata) Reset()         { *m = FeedMetadata{} }
+func (m *FeedMetadata) String() string { return proto.CompactTextString(m) }
+func (*FeedMetadata) ProtoMessage()    {}
+func (*FeedMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{12}
+}
+func (m *FeedMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedMetadata.Merge(m, src)
+}
+func (m *FeedMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedMetadata proto.InternalMessageInfo
+
+func (m *FeedMetadata) GetDecimals() uint32 {
 	if m != nil {
-		return m.ObservationFeedDataSignatures
+		return m.Decimals
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgFeedData) GetIsFeedDataValid() bool {
+func (m *FeedMetadata) GetVersion() uint64 {
 	if m != nil {
-		return m.IsFeedDataValid
+		return m.Version
 	}
-	return false
+	return 0
 }
 
-func (m *MsgFeedData) GetCosmosPubKeys() [][]byte {
+func (m *FeedMetadata) GetBaseAsset() string {
 	if m != nil {
-		return m.CosmosPubKeys
+		return m.BaseAsset
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgFeedData) GetTxFee() *Coin {
+func (m *FeedMetadata) GetQuoteAsset() string {
 	if m != nil {
-		return m.TxFee
+		return m.QuoteAsset
 	}
-	return nil
+	return ""
 }
 
-// MsgRequestNewRound is the type defined for requesting new rounds to be triggered for a given feed
-type MsgRequestNewRound struct {
-	// FeedId is the unique identifier of the feed
-	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
-	// Signer is the feed owner who signs the tx
-	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+func (m *FeedMetadata) GetUnit() string {
+	if m != nil {
+		return m.Unit
+	}
+	return ""
 }
 
-func (m *MsgRequestNewRound) Reset()         { *m = MsgRequestNewRound{} }
-func (m *MsgRequestNewRound) String() string { return proto.CompactTextString(m) }
-func (*MsgRequestNewRound) ProtoMessage()    {}
-func (*MsgRequestNewRound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{12}
+type FeedRewardSchema struct {
+	// amount is the base value that rewarded to each valid data provider before designated strategy applied
+	// amount is not allowed to be zero
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reward strategy name, must be a registered strategy
+	// this is allowed to be empty, in which case every data provider will be rewarded the same amount token
+	Strategy string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
 }
-func (m *MsgRequestNewRound) XXX_Unmarshal(b []byte) error {
+
+func (m *FeedRewardSchema) Reset()         { *m = FeedRewardSchema{} }
+func (m *FeedRewardSchema) String() string { return proto.CompactTextString(m) }
+func (*FeedRewardSchema) ProtoMessage()    {}
+func (*FeedRewardSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{13}
+}
+func (m *FeedRewardSchema) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRequestNewRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeedRewardSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRequestNewRound.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeedRewardSchema.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -903,56 +1039,50 @@ func (m *MsgRequestNewRound) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRequestNewRound) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRequestNewRound.Merge(m, src)
+func (m *FeedRewardSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedRewardSchema.Merge(m, src)
 }
-func (m *MsgRequestNewRound) XXX_Size() int {
+func (m *FeedRewardSchema) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRequestNewRound) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRequestNewRound.DiscardUnknown(m)
+func (m *FeedRewardSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedRewardSchema.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRequestNewRound proto.InternalMessageInfo
+var xxx_messageInfo_FeedRewardSchema proto.InternalMessageInfo
 
-func (m *MsgRequestNewRound) GetFeedId() string {
+func (m *FeedRewardSchema) GetAmount() uint64 {
 	if m != nil {
-		return m.FeedId
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgRequestNewRound) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *FeedRewardSchema) GetStrategy() string {
 	if m != nil {
-		return m.Signer
+		return m.Strategy
 	}
-	return nil
+	return ""
 }
 
-// MsgAccount is the type defined for account associated with the Chainlink node
-type MsgAccount struct {
-	// submitter - associated cosmos account address
-	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
-	// chainlinkPublicKey - public key of the associated Chainlink Oracle account
-	ChainlinkPublicKey []byte `protobuf:"bytes,2,opt,name=chainlinkPublicKey,proto3" json:"chainlinkPublicKey,omitempty"`
-	// chainlinkSigningKey - signing key of the associated Chainlink Oracle account
-	ChainlinkSigningKey []byte `protobuf:"bytes,3,opt,name=chainlinkSigningKey,proto3" json:"chainlinkSigningKey,omitempty"`
-	// piggyAddress - cosmos account address receivable for reward and fee distribution
-	PiggyAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=piggyAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"piggyAddress,omitempty"`
+// DataProvider is the type defined for feed data provider
+type DataProvider struct {
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	PubKey  []byte                                        `protobuf:"bytes,2,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 }
 
-func (m *MsgAccount) Reset()         { *m = MsgAccount{} }
-func (m *MsgAccount) String() string { return proto.CompactTextString(m) }
-func (*MsgAccount) ProtoMessage()    {}
-func (*MsgAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{13}
+func (m *DataProvider) Reset()         { *m = DataProvider{} }
+func (m *DataProvider) String() string { return proto.CompactTextString(m) }
+func (*DataProvider) ProtoMessage()    {}
+func (*DataProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{14}
 }
-func (m *MsgAccount) XXX_Unmarshal(b []byte) error {
+func (m *DataProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DataProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -962,66 +1092,54 @@ func (m *MsgAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAccount.Merge(m, src)
+func (m *DataProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataProvider.Merge(m, src)
 }
-func (m *MsgAccount) XXX_Size() int {
+func (m *DataProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAccount.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgAccount proto.InternalMessageInfo
-
-func (m *MsgAccount) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
-	if m != nil {
-		return m.Submitter
-	}
-	return nil
+func (m *DataProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataProvider.DiscardUnknown(m)
 }
 
-func (m *MsgAccount) GetChainlinkPublicKey() []byte {
-	if m != nil {
-		return m.ChainlinkPublicKey
-	}
-	return nil
-}
+var xxx_messageInfo_DataProvider proto.InternalMessageInfo
 
-func (m *MsgAccount) GetChainlinkSigningKey() []byte {
+func (m *DataProvider) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.ChainlinkSigningKey
+		return m.Address
 	}
 	return nil
 }
 
-func (m *MsgAccount) GetPiggyAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *DataProvider) GetPubKey() []byte {
 	if m != nil {
-		return m.PiggyAddress
+		return m.PubKey
 	}
 	return nil
 }
 
-// MsgEditAccount is the type defined to edit a Chainlink account
-type MsgEditAccount struct {
-	// submitter - associated cosmos account address
-	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
-	// piggyAddress - cosmos account address receivable for reward and fee distribution
-	PiggyAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=piggyAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"piggyAddress,omitempty"`
+// MsgAddProvider is the type defined for adding new data provider of the feed
+type MsgAddDataProvider struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// DataProvider is the new data provider of the feed to add
+	DataProvider *DataProvider `protobuf:"bytes,2,opt,name=dataProvider,proto3" json:"dataProvider,omitempty"`
+	// Signer is the feed owner who signs the add feed provided tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgEditAccount) Reset()         { *m = MsgEditAccount{} }
-func (m *MsgEditAccount) String() string { return proto.CompactTextString(m) }
-func (*MsgEditAccount) ProtoMessage()    {}
-func (*MsgEditAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{14}
+func (m *MsgAddDataProvider) Reset()         { *m = MsgAddDataProvider{} }
+func (m *MsgAddDataProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDataProvider) ProtoMessage()    {}
+func (*MsgAddDataProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{15}
 }
-func (m *MsgEditAccount) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddDataProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgEditAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddDataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgEditAccount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddDataProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1031,49 +1149,61 @@ func (m *MsgEditAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgEditAccount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgEditAccount.Merge(m, src)
+func (m *MsgAddDataProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDataProvider.Merge(m, src)
 }
-func (m *MsgEditAccount) XXX_Size() int {
+func (m *MsgAddDataProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgEditAccount) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgEditAccount.DiscardUnknown(m)
+func (m *MsgAddDataProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDataProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgEditAccount proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddDataProvider proto.InternalMessageInfo
 
-func (m *MsgEditAccount) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgAddDataProvider) GetFeedId() string {
 	if m != nil {
-		return m.Submitter
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *MsgAddDataProvider) GetDataProvider() *DataProvider {
+	if m != nil {
+		return m.DataProvider
 	}
 	return nil
 }
 
-func (m *MsgEditAccount) GetPiggyAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+func (m *MsgAddDataProvider) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.PiggyAddress
+		return m.Signer
 	}
 	return nil
 }
 
-type MsgResponse struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	TxHash string `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
+// MsgRemoveProvider is the type defined for removing a data provider of the feed
+type MsgRemoveDataProvider struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Address of the data provider to remove from the feed
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	// Signer is the feed owner who signs the remove feed provided tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgResponse) Reset()         { *m = MsgResponse{} }
-func (m *MsgResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgResponse) ProtoMessage()    {}
-func (*MsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{15}
+func (m *MsgRemoveDataProvider) Reset()         { *m = MsgRemoveDataProvider{} }
+func (m *MsgRemoveDataProvider) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveDataProvider) ProtoMessage()    {}
+func (*MsgRemoveDataProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{16}
 }
-func (m *MsgResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveDataProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveDataProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveDataProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1083,57 +1213,61 @@ func (m *MsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgResponse.Merge(m, src)
+func (m *MsgRemoveDataProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveDataProvider.Merge(m, src)
 }
-func (m *MsgResponse) XXX_Size() int {
+func (m *MsgRemoveDataProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgResponse.DiscardUnknown(m)
+func (m *MsgRemoveDataProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveDataProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveDataProvider proto.InternalMessageInfo
 
-func (m *MsgResponse) GetHeight() uint64 {
+func (m *MsgRemoveDataProvider) GetFeedId() string {
 	if m != nil {
-		return m.Height
+		return m.FeedId
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgResponse) GetTxHash() string {
+func (m *MsgRemoveDataProvider) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.TxHash
+		return m.Address
 	}
-	return ""
+	return nil
 }
 
-// this will be the implementation used later will use pseudo OCR ABI encoded data instead
-// because the structure of how the OCR will be generalized is still unknown
-// OCRAbiEncoded implments the OCR data that is ABCI encoded. The use and form will conform to the
-// Chainlink protocol specification.
-type OCRAbiEncoded struct {
-	// Context should be a 32-byte array struct.
-	Context []byte `protobuf:"bytes,1,opt,name=Context,proto3" json:"Context,omitempty"`
-	// Oracles should be a 32-byte record of all participating oracles. Assuming this is data provider address?
-	Oracles []byte `protobuf:"bytes,2,opt,name=Oracles,proto3" json:"Oracles,omitempty"`
-	// Observations should be an array on int192 containing the providers' independent observations.
-	Observations []*Observation `protobuf:"bytes,3,rep,name=Observations,proto3" json:"Observations,omitempty"`
+func (m *MsgRemoveDataProvider) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
 
-func (m *OCRAbiEncoded) Reset()         { *m = OCRAbiEncoded{} }
-func (m *OCRAbiEncoded) String() string { return proto.CompactTextString(m) }
-func (*OCRAbiEncoded) ProtoMessage()    {}
-func (*OCRAbiEncoded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{16}
+// MsgAddTransmitter is the type defined for adding a transmitter of the feed
+type MsgAddTransmitter struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Address of the transmitter to add to the feed
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
-func (m *OCRAbiEncoded) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgAddTransmitter) Reset()         { *m = MsgAddTransmitter{} }
+func (m *MsgAddTransmitter) String() string { return proto.CompactTextString(m) }
+func (*MsgAddTransmitter) ProtoMessage()    {}
+func (*MsgAddTransmitter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{17}
+}
+func (m *MsgAddTransmitter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OCRAbiEncoded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddTransmitter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OCRAbiEncoded.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddTransmitter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1143,55 +1277,61 @@ func (m *OCRAbiEncoded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *OCRAbiEncoded) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OCRAbiEncoded.Merge(m, src)
+func (m *MsgAddTransmitter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddTransmitter.Merge(m, src)
 }
-func (m *OCRAbiEncoded) XXX_Size() int {
+func (m *MsgAddTransmitter) XXX_Size() int {
 	return m.Size()
 }
-func (m *OCRAbiEncoded) XXX_DiscardUnknown() {
-	xxx_messageInfo_OCRAbiEncoded.DiscardUnknown(m)
+func (m *MsgAddTransmitter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddTransmitter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OCRAbiEncoded proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddTransmitter proto.InternalMessageInfo
 
-func (m *OCRAbiEncoded) GetContext() []byte {
+func (m *MsgAddTransmitter) GetFeedId() string {
 	if m != nil {
-		return m.Context
+		return m.FeedId
 	}
-	return nil
+	return ""
 }
 
-func (m *OCRAbiEncoded) GetOracles() []byte {
+func (m *MsgAddTransmitter) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Oracles
+		return m.Address
 	}
 	return nil
 }
 
-func (m *OCRAbiEncoded) GetObservations() []*Observation {
+func (m *MsgAddTransmitter) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.Observations
+		return m.Signer
 	}
 	return nil
 }
 
-type Observation struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// MsgRemoveTransmitter is the type defined for removing a transmitter of the feed
+type MsgRemoveTransmitter struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Address of the transmitter to remove from the feed
+	Address github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=address,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"address,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *Observation) Reset()         { *m = Observation{} }
-func (m *Observation) String() string { return proto.CompactTextString(m) }
-func (*Observation) ProtoMessage()    {}
-func (*Observation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{17}
+func (m *MsgRemoveTransmitter) Reset()         { *m = MsgRemoveTransmitter{} }
+func (m *MsgRemoveTransmitter) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveTransmitter) ProtoMessage()    {}
+func (*MsgRemoveTransmitter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{18}
 }
-func (m *Observation) XXX_Unmarshal(b []byte) error {
+func (m *MsgRemoveTransmitter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Observation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRemoveTransmitter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Observation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRemoveTransmitter.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1201,44 +1341,60 @@ func (m *Observation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Observation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Observation.Merge(m, src)
+func (m *MsgRemoveTransmitter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveTransmitter.Merge(m, src)
 }
-func (m *Observation) XXX_Size() int {
+func (m *MsgRemoveTransmitter) XXX_Size() int {
 	return m.Size()
 }
-func (m *Observation) XXX_DiscardUnknown() {
-	xxx_messageInfo_Observation.DiscardUnknown(m)
+func (m *MsgRemoveTransmitter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveTransmitter.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Observation proto.InternalMessageInfo
+var xxx_messageInfo_MsgRemoveTransmitter proto.InternalMessageInfo
 
-func (m *Observation) GetData() []byte {
+func (m *MsgRemoveTransmitter) GetFeedId() string {
 	if m != nil {
-		return m.Data
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *MsgRemoveTransmitter) GetAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Address
 	}
 	return nil
 }
 
-// OCRFeedDataInStore defines the type for OCR report that persists into the store
-type OCRFeedDataInStore struct {
-	FeedData              *MsgFeedData   `protobuf:"bytes,1,opt,name=feedData,proto3" json:"feedData,omitempty"`
-	DeserializedOCRReport *OCRAbiEncoded `protobuf:"bytes,2,opt,name=deserializedOCRReport,proto3" json:"deserializedOCRReport,omitempty"`
-	RoundId               uint64         `protobuf:"varint,3,opt,name=RoundId,proto3" json:"RoundId,omitempty"`
+func (m *MsgRemoveTransmitter) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
 
-func (m *OCRFeedDataInStore) Reset()         { *m = OCRFeedDataInStore{} }
-func (m *OCRFeedDataInStore) String() string { return proto.CompactTextString(m) }
-func (*OCRFeedDataInStore) ProtoMessage()    {}
-func (*OCRFeedDataInStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{18}
+type MsgSetSubmissionCount struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// The number of signatures required for a feedData submission to be valid
+	SubmissionCount uint32 `protobuf:"varint,2,opt,name=submissionCount,proto3" json:"submissionCount,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
-func (m *OCRFeedDataInStore) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgSetSubmissionCount) Reset()         { *m = MsgSetSubmissionCount{} }
+func (m *MsgSetSubmissionCount) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSubmissionCount) ProtoMessage()    {}
+func (*MsgSetSubmissionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{19}
+}
+func (m *MsgSetSubmissionCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *OCRFeedDataInStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetSubmissionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_OCRFeedDataInStore.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetSubmissionCount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1248,56 +1404,61 @@ func (m *OCRFeedDataInStore) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *OCRFeedDataInStore) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OCRFeedDataInStore.Merge(m, src)
+func (m *MsgSetSubmissionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSubmissionCount.Merge(m, src)
 }
-func (m *OCRFeedDataInStore) XXX_Size() int {
+func (m *MsgSetSubmissionCount) XXX_Size() int {
 	return m.Size()
 }
-func (m *OCRFeedDataInStore) XXX_DiscardUnknown() {
-	xxx_messageInfo_OCRFeedDataInStore.DiscardUnknown(m)
+func (m *MsgSetSubmissionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSubmissionCount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OCRFeedDataInStore proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetSubmissionCount proto.InternalMessageInfo
 
-func (m *OCRFeedDataInStore) GetFeedData() *MsgFeedData {
+func (m *MsgSetSubmissionCount) GetFeedId() string {
 	if m != nil {
-		return m.FeedData
+		return m.FeedId
 	}
-	return nil
+	return ""
 }
 
-func (m *OCRFeedDataInStore) GetDeserializedOCRReport() *OCRAbiEncoded {
+func (m *MsgSetSubmissionCount) GetSubmissionCount() uint32 {
 	if m != nil {
-		return m.DeserializedOCRReport
+		return m.SubmissionCount
 	}
-	return nil
+	return 0
 }
 
-func (m *OCRFeedDataInStore) GetRoundId() uint64 {
+func (m *MsgSetSubmissionCount) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
 	if m != nil {
-		return m.RoundId
+		return m.Signer
 	}
-	return 0
+	return nil
 }
 
-type Coin struct {
-	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+type MsgSetHeartbeatTrigger struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// The interval between which a new round should automatically be triggered.
+	// The given value in milliseconds will only be approximate within block intervals
+	HeartbeatTrigger uint32 `protobuf:"varint,2,opt,name=heartbeatTrigger,proto3" json:"heartbeatTrigger,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *Coin) Reset()         { *m = Coin{} }
-func (m *Coin) String() string { return proto.CompactTextString(m) }
-func (*Coin) ProtoMessage()    {}
-func (*Coin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8e2cf97733d10959, []int{19}
+func (m *MsgSetHeartbeatTrigger) Reset()         { *m = MsgSetHeartbeatTrigger{} }
+func (m *MsgSetHeartbeatTrigger) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHeartbeatTrigger) ProtoMessage()    {}
+func (*MsgSetHeartbeatTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{20}
 }
-func (m *Coin) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetHeartbeatTrigger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Coin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetHeartbeatTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Coin.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetHeartbeatTrigger.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1307,2079 +1468,11682 @@ func (m *Coin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Coin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Coin.Merge(m, src)
+func (m *MsgSetHeartbeatTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHeartbeatTrigger.Merge(m, src)
 }
-func (m *Coin) XXX_Size() int {
+func (m *MsgSetHeartbeatTrigger) XXX_Size() int {
 	return m.Size()
 }
-func (m *Coin) XXX_DiscardUnknown() {
-	xxx_messageInfo_Coin.DiscardUnknown(m)
+func (m *MsgSetHeartbeatTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHeartbeatTrigger.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Coin proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetHeartbeatTrigger proto.InternalMessageInfo
 
-func (m *Coin) GetDenom() string {
+func (m *MsgSetHeartbeatTrigger) GetFeedId() string {
 	if m != nil {
-		return m.Denom
+		return m.FeedId
 	}
 	return ""
 }
 
-func (m *Coin) GetAmount() uint64 {
+func (m *MsgSetHeartbeatTrigger) GetHeartbeatTrigger() uint32 {
 	if m != nil {
-		return m.Amount
+		return m.HeartbeatTrigger
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MsgModuleOwnershipTransfer)(nil), "chainlink.v1beta.MsgModuleOwnershipTransfer")
-	proto.RegisterType((*MsgFeed)(nil), "chainlink.v1beta.MsgFeed")
-	proto.RegisterType((*FeedRewardSchema)(nil), "chainlink.v1beta.FeedRewardSchema")
-	proto.RegisterType((*DataProvider)(nil), "chainlink.v1beta.DataProvider")
-	proto.RegisterType((*MsgAddDataProvider)(nil), "chainlink.v1beta.MsgAddDataProvider")
-	proto.RegisterType((*MsgRemoveDataProvider)(nil), "chainlink.v1beta.MsgRemoveDataProvider")
-	proto.RegisterType((*MsgSetSubmissionCount)(nil), "chainlink.v1beta.MsgSetSubmissionCount")
-	proto.RegisterType((*MsgSetHeartbeatTrigger)(nil), "chainlink.v1beta.MsgSetHeartbeatTrigger")
-	proto.RegisterType((*MsgSetDeviationThresholdTrigger)(nil), "chainlink.v1beta.MsgSetDeviationThresholdTrigger")
-	proto.RegisterType((*MsgSetFeedReward)(nil), "chainlink.v1beta.MsgSetFeedReward")
-	proto.RegisterType((*MsgFeedOwnershipTransfer)(nil), "chainlink.v1beta.MsgFeedOwnershipTransfer")
-	proto.RegisterType((*MsgFeedData)(nil), "chainlink.v1beta.MsgFeedData")
-	proto.RegisterType((*MsgRequestNewRound)(nil), "chainlink.v1beta.MsgRequestNewRound")
-	proto.RegisterType((*MsgAccount)(nil), "chainlink.v1beta.MsgAccount")
-	proto.RegisterType((*MsgEditAccount)(nil), "chainlink.v1beta.MsgEditAccount")
-	proto.RegisterType((*MsgResponse)(nil), "chainlink.v1beta.MsgResponse")
-	proto.RegisterType((*OCRAbiEncoded)(nil), "chainlink.v1beta.OCRAbiEncoded")
-	proto.RegisterType((*Observation)(nil), "chainlink.v1beta.Observation")
-	proto.RegisterType((*OCRFeedDataInStore)(nil), "chainlink.v1beta.OCRFeedDataInStore")
-	proto.RegisterType((*Coin)(nil), "chainlink.v1beta.Coin")
+func (m *MsgSetHeartbeatTrigger) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("chainlink/v1beta/tx.proto", fileDescriptor_8e2cf97733d10959) }
-
-var fileDescriptor_8e2cf97733d10959 = []byte{
-	// 1386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xef, 0x3a, 0x6e, 0x3e, 0x5e, 0x9c, 0x36, 0x4c, 0x93, 0xe0, 0x44, 0xad, 0x13, 0x56, 0x95,
-	0x88, 0xaa, 0x36, 0xa6, 0x01, 0x09, 0x81, 0xe0, 0xe0, 0x38, 0x8d, 0x1a, 0x55, 0xc6, 0x61, 0xbc,
-	0x45, 0x08, 0x24, 0x60, 0xed, 0x79, 0x59, 0xaf, 0x6a, 0xef, 0x98, 0x9d, 0x75, 0xb2, 0xe1, 0xc8,
-	0x01, 0xae, 0x48, 0x48, 0x9c, 0x39, 0x20, 0x21, 0xf1, 0x17, 0x80, 0xb8, 0x20, 0x2e, 0x70, 0xac,
-	0xc4, 0x85, 0x53, 0x85, 0x52, 0x8e, 0x9c, 0x38, 0x72, 0x42, 0x3b, 0xbb, 0x6b, 0xaf, 0xd7, 0xbb,
-	0xd9, 0x92, 0x98, 0x53, 0x3c, 0xf3, 0xde, 0xfb, 0xbd, 0x8f, 0x79, 0x5f, 0x1b, 0x58, 0x6d, 0xb5,
-	0x75, 0xd3, 0xea, 0x98, 0xd6, 0xa3, 0xf2, 0xd1, 0xdd, 0x26, 0x3a, 0x7a, 0xd9, 0x71, 0xb7, 0x7a,
-	0x36, 0x77, 0x38, 0x59, 0x1c, 0x90, 0xb6, 0x7c, 0xd2, 0xda, 0x92, 0xc1, 0x0d, 0x2e, 0x89, 0x65,
-	0xef, 0x97, 0xcf, 0xb7, 0x76, 0xdd, 0xe0, 0xdc, 0xe8, 0x60, 0x59, 0xef, 0x99, 0x65, 0xdd, 0xb2,
-	0xb8, 0xa3, 0x3b, 0x26, 0xb7, 0x44, 0x40, 0x2d, 0x8d, 0x29, 0x30, 0xd0, 0x42, 0x61, 0x06, 0x74,
-	0xf5, 0xbb, 0x1c, 0xac, 0xd5, 0x84, 0x51, 0xe3, 0xac, 0xdf, 0xc1, 0xfa, 0xb1, 0x85, 0xb6, 0x68,
-	0x9b, 0x3d, 0xcd, 0xd6, 0x2d, 0x71, 0x88, 0x36, 0x79, 0x1f, 0xae, 0xea, 0x42, 0x98, 0x86, 0x85,
-	0x76, 0x85, 0x31, 0x1b, 0x85, 0x28, 0x2a, 0x1b, 0xca, 0x66, 0x61, 0xe7, 0xee, 0x3f, 0x4f, 0xd6,
-	0xef, 0x18, 0xa6, 0xd3, 0xee, 0x37, 0xb7, 0x5a, 0xbc, 0x5b, 0x6e, 0x71, 0xd1, 0xe5, 0x22, 0xf8,
-	0x73, 0x47, 0xb0, 0x47, 0x65, 0xe7, 0xa4, 0x87, 0x62, 0xab, 0xd2, 0x6a, 0x05, 0x82, 0x34, 0x8e,
-	0x44, 0x0c, 0x58, 0xb6, 0xf0, 0x38, 0xa2, 0x3a, 0x54, 0x91, 0x3b, 0xaf, 0x8a, 0x64, 0x3c, 0xb2,
-	0x07, 0x4b, 0xa3, 0x84, 0x83, 0x7e, 0xf3, 0x01, 0x9e, 0x14, 0xa7, 0xa4, 0x1e, 0xf2, 0xf7, 0x93,
-	0xf5, 0x2b, 0x27, 0x7a, 0xb7, 0xf3, 0xba, 0xda, 0xeb, 0x37, 0x3f, 0x7c, 0x84, 0x27, 0x2a, 0x4d,
-	0xe4, 0x57, 0x3f, 0xcf, 0xc3, 0x4c, 0x4d, 0x18, 0x7b, 0x88, 0x8c, 0xac, 0xc0, 0xf4, 0x21, 0x22,
-	0xdb, 0x67, 0x32, 0x20, 0x73, 0x34, 0x38, 0x91, 0x3a, 0xcc, 0x79, 0xbf, 0xa4, 0xd8, 0xf9, 0x1d,
-	0x19, 0x62, 0x90, 0x5d, 0x58, 0x60, 0xba, 0xa3, 0x1f, 0xd8, 0xfc, 0xc8, 0x64, 0x68, 0x8b, 0xe2,
-	0xd4, 0xc6, 0xd4, 0xe6, 0xfc, 0x76, 0x69, 0x2b, 0x9e, 0x1f, 0x5b, 0xbb, 0x11, 0x36, 0x3a, 0x2a,
-	0x44, 0x36, 0xe1, 0xaa, 0xe8, 0x37, 0xbb, 0xa6, 0x10, 0x26, 0xb7, 0xaa, 0xbc, 0x6f, 0x39, 0xc5,
-	0xfc, 0x86, 0xb2, 0xb9, 0x40, 0xe3, 0xd7, 0xe4, 0x16, 0x2c, 0xb6, 0x51, 0xb7, 0x9d, 0x26, 0xea,
-	0x8e, 0x66, 0x9b, 0x86, 0x81, 0x76, 0xf1, 0xb2, 0x64, 0x1d, 0xbb, 0x27, 0x6f, 0xc0, 0x2a, 0xc3,
-	0x23, 0x53, 0x66, 0x9c, 0xd6, 0xb6, 0x51, 0xb4, 0x79, 0x87, 0x85, 0x42, 0xd3, 0x52, 0x28, 0x9d,
-	0x81, 0xe8, 0x40, 0xba, 0xe3, 0x8f, 0x3f, 0x73, 0xde, 0x98, 0x25, 0x80, 0x91, 0x1d, 0x00, 0x2f,
-	0x92, 0x14, 0x8f, 0x75, 0x9b, 0x15, 0x67, 0x37, 0x94, 0xcd, 0xf9, 0x6d, 0x75, 0x3c, 0x72, 0x7b,
-	0x03, 0x9e, 0x46, 0xab, 0x8d, 0x5d, 0x9d, 0x46, 0xa4, 0x08, 0x81, 0x3c, 0x43, 0xd1, 0x2a, 0xce,
-	0xc9, 0x77, 0x96, 0xbf, 0xd5, 0x3d, 0x58, 0x8c, 0xcb, 0x78, 0x19, 0xa1, 0x77, 0x65, 0x64, 0xbd,
-	0x8c, 0xc8, 0xd3, 0xe0, 0x44, 0xd6, 0x60, 0x56, 0x38, 0xb6, 0xee, 0xa0, 0x71, 0x22, 0x13, 0x62,
-	0x8e, 0x0e, 0xce, 0xaa, 0x80, 0x42, 0xf4, 0xd5, 0xc8, 0x03, 0x98, 0xd1, 0x2f, 0x5a, 0x67, 0x21,
-	0x82, 0x67, 0x50, 0xcf, 0x4f, 0x74, 0x99, 0x87, 0x34, 0x38, 0xa9, 0x3f, 0x2a, 0x40, 0x6a, 0xc2,
-	0xa8, 0x30, 0x36, 0xa2, 0x3b, 0x2d, 0xa3, 0x77, 0xa0, 0x10, 0xcd, 0x25, 0x09, 0x96, 0x9d, 0x7f,
-	0x23, 0x32, 0x64, 0x1f, 0xa6, 0xfd, 0xda, 0x0f, 0x6a, 0xee, 0x1c, 0x6e, 0x05, 0x00, 0xea, 0x2f,
-	0x0a, 0x2c, 0xd7, 0x84, 0x41, 0xb1, 0xcb, 0x8f, 0xf0, 0x99, 0x1c, 0x88, 0x04, 0x35, 0x77, 0xe1,
-	0xa0, 0x4e, 0xd0, 0x93, 0x6f, 0x7c, 0x4f, 0x1a, 0xe8, 0x34, 0x62, 0x35, 0x98, 0xe6, 0x49, 0x42,
-	0x15, 0xe7, 0x92, 0xab, 0x78, 0x82, 0x66, 0x7e, 0xab, 0xc0, 0x8a, 0x6f, 0xe6, 0xfd, 0x78, 0xfd,
-	0xa7, 0xd9, 0x99, 0xd4, 0x43, 0x72, 0x29, 0x3d, 0x64, 0x82, 0x96, 0xfe, 0xac, 0xc0, 0xba, 0x6f,
-	0xe9, 0x6e, 0x6a, 0xd3, 0x49, 0x33, 0xf9, 0xcc, 0x56, 0x96, 0xcb, 0x6a, 0x65, 0x13, 0x74, 0xe2,
-	0x07, 0x05, 0x16, 0x7d, 0x27, 0x86, 0x1d, 0xe6, 0x8c, 0xda, 0x8c, 0xf6, 0xb7, 0xdc, 0xb9, 0xfa,
-	0xdb, 0x04, 0x6d, 0x3f, 0x55, 0xa0, 0x18, 0x0c, 0xc8, 0xf1, 0x5d, 0x22, 0xcd, 0x87, 0x16, 0x5c,
-	0xb3, 0xf0, 0x78, 0x20, 0x73, 0xe1, 0x25, 0x20, 0x09, 0x6d, 0x92, 0x4e, 0xfe, 0x95, 0x83, 0xf9,
-	0xc0, 0x49, 0xaf, 0xfd, 0x9c, 0xb5, 0x09, 0xc8, 0xaa, 0x74, 0x9c, 0x0b, 0x6d, 0x02, 0x03, 0x0c,
-	0xf2, 0x12, 0x5c, 0xe3, 0x4d, 0x81, 0xf6, 0x91, 0xcc, 0xc1, 0x50, 0xbf, 0xdc, 0x07, 0x0a, 0x34,
-	0x89, 0x44, 0x76, 0xe1, 0x46, 0xc2, 0x75, 0xc3, 0x34, 0x2c, 0xdd, 0xe9, 0xdb, 0x28, 0x8a, 0x79,
-	0x29, 0x7b, 0x36, 0x93, 0xd7, 0x75, 0x4c, 0x11, 0xde, 0xbf, 0xa3, 0x77, 0x4c, 0x26, 0x17, 0x82,
-	0x59, 0x1a, 0xbf, 0x26, 0x37, 0x61, 0xc1, 0xf7, 0xc5, 0x5f, 0x98, 0x44, 0x71, 0x5a, 0xe2, 0x8f,
-	0x5e, 0x92, 0xdb, 0x70, 0xd9, 0x71, 0xf7, 0x10, 0xe5, 0xa8, 0x9f, 0xdf, 0x5e, 0x19, 0xcf, 0xd7,
-	0x2a, 0x37, 0x2d, 0xea, 0x33, 0xa9, 0xc7, 0x72, 0x58, 0x51, 0xfc, 0xb8, 0x8f, 0xc2, 0x79, 0x0b,
-	0x8f, 0x29, 0xef, 0x5b, 0xe9, 0x05, 0x31, 0xc1, 0x77, 0xfe, 0x2a, 0x07, 0xe0, 0x8d, 0xc9, 0x56,
-	0x4b, 0x76, 0xd4, 0x91, 0xe7, 0x54, 0x26, 0xf0, 0x9c, 0x5b, 0x40, 0x06, 0x8e, 0x1f, 0xf4, 0x9b,
-	0x1d, 0xb3, 0x35, 0x1c, 0xd5, 0x09, 0x14, 0xef, 0xf9, 0x07, 0xb7, 0xde, 0xeb, 0x98, 0x96, 0x31,
-	0x58, 0x62, 0x69, 0x12, 0x89, 0x3c, 0x84, 0x42, 0xcf, 0x34, 0x8c, 0x93, 0xb0, 0xa4, 0xf2, 0xe7,
-	0xb5, 0x7a, 0x04, 0x46, 0xfd, 0x5e, 0x81, 0x2b, 0x35, 0x61, 0xdc, 0x63, 0xa6, 0xf3, 0xbf, 0x05,
-	0x27, 0x6e, 0x7a, 0x6e, 0x32, 0xa6, 0xbf, 0x29, 0x4b, 0x97, 0xa2, 0xe8, 0x71, 0x4b, 0xa0, 0x97,
-	0x45, 0x6d, 0x34, 0x8d, 0xf6, 0x60, 0x65, 0xf3, 0x4f, 0xde, 0xbd, 0xe3, 0xde, 0xd7, 0x45, 0x3b,
-	0x58, 0xd8, 0x82, 0x93, 0xfa, 0x99, 0x02, 0x0b, 0xf5, 0x2a, 0xad, 0x34, 0xcd, 0x7b, 0x56, 0x8b,
-	0x33, 0x64, 0xa4, 0x08, 0x33, 0x55, 0x6e, 0x39, 0xe8, 0xfa, 0x10, 0x05, 0x1a, 0x1e, 0x3d, 0x4a,
-	0xdd, 0xd6, 0x5b, 0x1d, 0x0c, 0x8c, 0xa7, 0xe1, 0x91, 0x54, 0xa0, 0x50, 0x1f, 0x16, 0x5c, 0xb8,
-	0xd0, 0xdf, 0x18, 0x2f, 0x83, 0x08, 0x17, 0x1d, 0x11, 0x51, 0x5f, 0x80, 0xf9, 0xc8, 0x59, 0xae,
-	0xa8, 0x5e, 0x2b, 0xf0, 0x4d, 0x90, 0xbf, 0xd5, 0x9f, 0x14, 0x20, 0xf5, 0x2a, 0x0d, 0x0b, 0x74,
-	0xdf, 0x6a, 0x38, 0xdc, 0x46, 0xf2, 0x1a, 0xcc, 0x1e, 0x86, 0x9d, 0x43, 0x91, 0xf5, 0x97, 0xa0,
-	0x38, 0xd2, 0xde, 0xe8, 0x80, 0x9d, 0x3c, 0x84, 0x65, 0x86, 0x02, 0x6d, 0x53, 0xef, 0x98, 0x9f,
-	0x20, 0xab, 0x57, 0x29, 0xc5, 0x1e, 0xb7, 0x9d, 0x60, 0xee, 0xac, 0x27, 0x38, 0x10, 0x8d, 0x15,
-	0x4d, 0x96, 0xf6, 0x02, 0x25, 0x6b, 0x7a, 0x9f, 0xc9, 0x5c, 0xce, 0xd3, 0xf0, 0xa8, 0xbe, 0x02,
-	0x79, 0xaf, 0x13, 0x90, 0x25, 0xb8, 0xcc, 0xd0, 0xe2, 0xdd, 0xa0, 0xd6, 0xfd, 0x43, 0x64, 0xdf,
-	0xce, 0x45, 0xf7, 0xed, 0xed, 0xaf, 0x01, 0xa6, 0x6a, 0xc2, 0x20, 0x16, 0x2c, 0xca, 0xbd, 0xca,
-	0x09, 0x5d, 0xd1, 0x5c, 0x72, 0xb6, 0xaf, 0x6b, 0xc9, 0xe4, 0x30, 0x5d, 0xd4, 0xeb, 0x9f, 0xfe,
-	0xf6, 0xe7, 0x97, 0xb9, 0x95, 0xb5, 0xa5, 0xf2, 0xf0, 0xab, 0xda, 0x8b, 0x4e, 0xd9, 0x0b, 0x38,
-	0x69, 0xc0, 0x62, 0x85, 0xb1, 0xc8, 0x57, 0xa3, 0xe6, 0x92, 0x8d, 0x44, 0xc0, 0x08, 0x4f, 0x86,
-	0x4a, 0xd2, 0x86, 0xd5, 0x94, 0x6f, 0x73, 0xcd, 0x25, 0xb7, 0xb3, 0xd0, 0xa3, 0xfc, 0x59, 0x9a,
-	0xee, 0xc1, 0x5c, 0x85, 0x31, 0x2f, 0x14, 0x9a, 0x4b, 0x56, 0x53, 0xe3, 0x94, 0x05, 0xf3, 0x2e,
-	0x3c, 0x17, 0xfb, 0xb0, 0xd0, 0x5c, 0x72, 0x33, 0x51, 0x26, 0xc6, 0x97, 0x85, 0xfc, 0x01, 0x2c,
-	0x8d, 0x2f, 0xfd, 0x9a, 0x4b, 0x5e, 0x4c, 0x11, 0x8b, 0xb3, 0x3e, 0x03, 0xfe, 0xf8, 0x2a, 0x9e,
-	0x8a, 0x3f, 0xce, 0x9a, 0x85, 0xff, 0x11, 0x2c, 0x27, 0xec, 0xd0, 0x9a, 0x4b, 0x36, 0xd3, 0x14,
-	0xc4, 0x79, 0xb3, 0x34, 0xd8, 0x50, 0x3a, 0x6b, 0xf7, 0xd5, 0x5c, 0x72, 0x37, 0x4d, 0x55, 0xaa,
-	0x50, 0x96, 0x4e, 0x0d, 0xae, 0x8e, 0xac, 0xaa, 0x9a, 0x4b, 0xd4, 0x34, 0x25, 0x43, 0xae, 0x67,
-	0xc8, 0xa2, 0xd8, 0xc4, 0x4f, 0xcd, 0xa2, 0x18, 0x5f, 0x16, 0x32, 0x83, 0xe7, 0x13, 0xd7, 0x53,
-	0xcd, 0x25, 0xb7, 0x52, 0x93, 0xfe, 0x3f, 0x17, 0xd3, 0x03, 0x28, 0x54, 0x18, 0x0b, 0xa6, 0xa3,
-	0xe6, 0x92, 0xeb, 0xc9, 0x05, 0xe0, 0xd3, 0xb3, 0xc0, 0x0e, 0x60, 0x21, 0x32, 0x6b, 0x53, 0xbb,
-	0x4a, 0x84, 0x27, 0x03, 0x71, 0xe7, 0xed, 0x5f, 0x4f, 0x4b, 0xca, 0xe3, 0xd3, 0x92, 0xf2, 0xc7,
-	0x69, 0x49, 0xf9, 0xe2, 0x69, 0xe9, 0xd2, 0xe3, 0xa7, 0xa5, 0x4b, 0xbf, 0x3f, 0x2d, 0x5d, 0x7a,
-	0xef, 0xd5, 0xc8, 0x74, 0xad, 0x7a, 0x10, 0x0d, 0xfd, 0x10, 0x87, 0xed, 0xee, 0x4e, 0x30, 0x71,
-	0xdd, 0x48, 0x07, 0x94, 0x23, 0xb7, 0x39, 0x2d, 0xff, 0x9f, 0xf8, 0xf2, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xaf, 0x18, 0x53, 0x34, 0xd2, 0x14, 0x00, 0x00,
+type MsgSetDeviationThresholdTrigger struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// The fraction of deviation in the feed data required to trigger a new round.
+	// For example if the price of ATOM/USD changes by 1% then a new round should occur
+	// even if the heartbeat interval has not elapsed.
+	DeviationThresholdTrigger uint32 `protobuf:"varint,2,opt,name=deviationThresholdTrigger,proto3" json:"deviationThresholdTrigger,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+	// deviationThresholdPolicy decides what happens to a round whose answer deviates less than deviationThresholdTrigger
+	// from the previous answer before the heartbeat elapsed: "reject" (default) or "nonRewardable"
+	DeviationThresholdPolicy string `protobuf:"bytes,4,opt,name=deviationThresholdPolicy,proto3" json:"deviationThresholdPolicy,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSetDeviationThresholdTrigger) Reset()         { *m = MsgSetDeviationThresholdTrigger{} }
+func (m *MsgSetDeviationThresholdTrigger) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDeviationThresholdTrigger) ProtoMessage()    {}
+func (*MsgSetDeviationThresholdTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{21}
+}
+func (m *MsgSetDeviationThresholdTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDeviationThresholdTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDeviationThresholdTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDeviationThresholdTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDeviationThresholdTrigger.Merge(m, src)
+}
+func (m *MsgSetDeviationThresholdTrigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDeviationThresholdTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDeviationThresholdTrigger.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetDeviationThresholdTrigger proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	SubmitFeedDataTx(ctx context.Context, in *MsgFeedData, opts ...grpc.CallOption) (*MsgResponse, error)
-	AddModuleOwnerTx(ctx context.Context, in *MsgModuleOwner, opts ...grpc.CallOption) (*MsgResponse, error)
-	ModuleOwnershipTransferTx(ctx context.Context, in *MsgModuleOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error)
-	AddFeedTx(ctx context.Context, in *MsgFeed, opts ...grpc.CallOption) (*MsgResponse, error)
-	AddDataProviderTx(ctx context.Context, in *MsgAddDataProvider, opts ...grpc.CallOption) (*MsgResponse, error)
-	RemoveDataProviderTx(ctx context.Context, in *MsgRemoveDataProvider, opts ...grpc.CallOption) (*MsgResponse, error)
-	SetSubmissionCountTx(ctx context.Context, in *MsgSetSubmissionCount, opts ...grpc.CallOption) (*MsgResponse, error)
-	SetHeartbeatTriggerTx(ctx context.Context, in *MsgSetHeartbeatTrigger, opts ...grpc.CallOption) (*MsgResponse, error)
-	SetDeviationThresholdTriggerTx(ctx context.Context, in *MsgSetDeviationThresholdTrigger, opts ...grpc.CallOption) (*MsgResponse, error)
-	SetFeedRewardTx(ctx context.Context, in *MsgSetFeedReward, opts ...grpc.CallOption) (*MsgResponse, error)
-	RequestNewRoundTx(ctx context.Context, in *MsgRequestNewRound, opts ...grpc.CallOption) (*MsgResponse, error)
-	FeedOwnershipTransferTx(ctx context.Context, in *MsgFeedOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error)
-	AddAccountTx(ctx context.Context, in *MsgAccount, opts ...grpc.CallOption) (*MsgResponse, error)
-	EditAccountTx(ctx context.Context, in *MsgEditAccount, opts ...grpc.CallOption) (*MsgResponse, error)
+func (m *MsgSetDeviationThresholdTrigger) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetDeviationThresholdTrigger) GetDeviationThresholdTrigger() uint32 {
+	if m != nil {
+		return m.DeviationThresholdTrigger
+	}
+	return 0
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetDeviationThresholdTrigger) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
 
-func (c *msgClient) SubmitFeedDataTx(ctx context.Context, in *MsgFeedData, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SubmitFeedDataTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetDeviationThresholdTrigger) GetDeviationThresholdPolicy() string {
+	if m != nil {
+		return m.DeviationThresholdPolicy
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddModuleOwnerTx(ctx context.Context, in *MsgModuleOwner, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddModuleOwnerTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgSetFeedReward struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// The reward distributed to the data providers in a given feed.
+	// The reward will be given as the native token in the application, denominated as "link"
+	FeedReward *FeedRewardSchema `protobuf:"bytes,2,opt,name=feedReward,proto3" json:"feedReward,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (c *msgClient) ModuleOwnershipTransferTx(ctx context.Context, in *MsgModuleOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/ModuleOwnershipTransferTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeedReward) Reset()         { *m = MsgSetFeedReward{} }
+func (m *MsgSetFeedReward) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeedReward) ProtoMessage()    {}
+func (*MsgSetFeedReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{22}
+}
+func (m *MsgSetFeedReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeedReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeedReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetFeedReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeedReward.Merge(m, src)
+}
+func (m *MsgSetFeedReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeedReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeedReward.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddFeedTx(ctx context.Context, in *MsgFeed, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddFeedTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFeedReward proto.InternalMessageInfo
+
+func (m *MsgSetFeedReward) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddDataProviderTx(ctx context.Context, in *MsgAddDataProvider, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddDataProviderTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeedReward) GetFeedReward() *FeedRewardSchema {
+	if m != nil {
+		return m.FeedReward
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RemoveDataProviderTx(ctx context.Context, in *MsgRemoveDataProvider, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/RemoveDataProviderTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeedReward) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SetSubmissionCountTx(ctx context.Context, in *MsgSetSubmissionCount, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetSubmissionCountTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetFeedMetadata is the type defined for updating the metadata of a feed
+type MsgSetFeedMetadata struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// metadata replaces the current metadata of the feed
+	Metadata *FeedMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (c *msgClient) SetHeartbeatTriggerTx(ctx context.Context, in *MsgSetHeartbeatTrigger, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetHeartbeatTriggerTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeedMetadata) Reset()         { *m = MsgSetFeedMetadata{} }
+func (m *MsgSetFeedMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeedMetadata) ProtoMessage()    {}
+func (*MsgSetFeedMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{23}
+}
+func (m *MsgSetFeedMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeedMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeedMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetFeedMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeedMetadata.Merge(m, src)
+}
+func (m *MsgSetFeedMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeedMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeedMetadata.DiscardUnknown(m)
 }
 
-func (c *msgClient) SetDeviationThresholdTriggerTx(ctx context.Context, in *MsgSetDeviationThresholdTrigger, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetDeviationThresholdTriggerTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFeedMetadata proto.InternalMessageInfo
+
+func (m *MsgSetFeedMetadata) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetFeedRewardTx(ctx context.Context, in *MsgSetFeedReward, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetFeedRewardTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeedMetadata) GetMetadata() *FeedMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RequestNewRoundTx(ctx context.Context, in *MsgRequestNewRound, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/RequestNewRoundTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFeedMetadata) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) FeedOwnershipTransferTx(ctx context.Context, in *MsgFeedOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/FeedOwnershipTransferTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSetAnswerBounds is the type defined for updating the answer bounds of a feed
+type MsgSetAnswerBounds struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// answerBounds replaces the current answer bounds of the feed, empty bounds remove them
+	AnswerBounds *AnswerBounds `protobuf:"bytes,2,opt,name=answerBounds,proto3" json:"answerBounds,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (c *msgClient) AddAccountTx(ctx context.Context, in *MsgAccount, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddAccountTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAnswerBounds) Reset()         { *m = MsgSetAnswerBounds{} }
+func (m *MsgSetAnswerBounds) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAnswerBounds) ProtoMessage()    {}
+func (*MsgSetAnswerBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{24}
+}
+func (m *MsgSetAnswerBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAnswerBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAnswerBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAnswerBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAnswerBounds.Merge(m, src)
+}
+func (m *MsgSetAnswerBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAnswerBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAnswerBounds.DiscardUnknown(m)
 }
 
-func (c *msgClient) EditAccountTx(ctx context.Context, in *MsgEditAccount, opts ...grpc.CallOption) (*MsgResponse, error) {
-	out := new(MsgResponse)
-	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/EditAccountTx", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAnswerBounds proto.InternalMessageInfo
+
+func (m *MsgSetAnswerBounds) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	SubmitFeedDataTx(context.Context, *MsgFeedData) (*MsgResponse, error)
-	AddModuleOwnerTx(context.Context, *MsgModuleOwner) (*MsgResponse, error)
-	ModuleOwnershipTransferTx(context.Context, *MsgModuleOwnershipTransfer) (*MsgResponse, error)
-	AddFeedTx(context.Context, *MsgFeed) (*MsgResponse, error)
-	AddDataProviderTx(context.Context, *MsgAddDataProvider) (*MsgResponse, error)
-	RemoveDataProviderTx(context.Context, *MsgRemoveDataProvider) (*MsgResponse, error)
-	SetSubmissionCountTx(context.Context, *MsgSetSubmissionCount) (*MsgResponse, error)
-	SetHeartbeatTriggerTx(context.Context, *MsgSetHeartbeatTrigger) (*MsgResponse, error)
-	SetDeviationThresholdTriggerTx(context.Context, *MsgSetDeviationThresholdTrigger) (*MsgResponse, error)
-	SetFeedRewardTx(context.Context, *MsgSetFeedReward) (*MsgResponse, error)
-	RequestNewRoundTx(context.Context, *MsgRequestNewRound) (*MsgResponse, error)
-	FeedOwnershipTransferTx(context.Context, *MsgFeedOwnershipTransfer) (*MsgResponse, error)
-	AddAccountTx(context.Context, *MsgAccount) (*MsgResponse, error)
-	EditAccountTx(context.Context, *MsgEditAccount) (*MsgResponse, error)
+func (m *MsgSetAnswerBounds) GetAnswerBounds() *AnswerBounds {
+	if m != nil {
+		return m.AnswerBounds
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetAnswerBounds) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
 
-func (*UnimplementedMsgServer) SubmitFeedDataTx(ctx context.Context, req *MsgFeedData) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedDataTx not implemented")
-}
-func (*UnimplementedMsgServer) AddModuleOwnerTx(ctx context.Context, req *MsgModuleOwner) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddModuleOwnerTx not implemented")
-}
-func (*UnimplementedMsgServer) ModuleOwnershipTransferTx(ctx context.Context, req *MsgModuleOwnershipTransfer) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModuleOwnershipTransferTx not implemented")
-}
-func (*UnimplementedMsgServer) AddFeedTx(ctx context.Context, req *MsgFeed) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddFeedTx not implemented")
-}
-func (*UnimplementedMsgServer) AddDataProviderTx(ctx context.Context, req *MsgAddDataProvider) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDataProviderTx not implemented")
-}
-func (*UnimplementedMsgServer) RemoveDataProviderTx(ctx context.Context, req *MsgRemoveDataProvider) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveDataProviderTx not implemented")
-}
-func (*UnimplementedMsgServer) SetSubmissionCountTx(ctx context.Context, req *MsgSetSubmissionCount) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetSubmissionCountTx not implemented")
-}
-func (*UnimplementedMsgServer) SetHeartbeatTriggerTx(ctx context.Context, req *MsgSetHeartbeatTrigger) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetHeartbeatTriggerTx not implemented")
+// MsgSetOCRConfig is the type defined for setting the OCR configuration of a feed, like setConfig of the OCR aggregator
+type MsgSetOCRConfig struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// signingKeys are the chainlink public keys of the oracles signing the observations, as registered in the account store
+	SigningKeys [][]byte `protobuf:"bytes,2,rep,name=signingKeys,proto3" json:"signingKeys,omitempty"`
+	// transmitters are the accounts allowed to submit the reports, one per oracle
+	Transmitters []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,rep,name=transmitters,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"transmitters,omitempty"`
+	// f is the maximum number of faulty oracles the config tolerates, a report needs at least f+1 signatures
+	F uint32 `protobuf:"varint,4,opt,name=f,proto3" json:"f,omitempty"`
+	// onchainConfig is the opaque configuration blob read on-chain
+	OnchainConfig []byte `protobuf:"bytes,5,opt,name=onchainConfig,proto3" json:"onchainConfig,omitempty"`
+	// offchainConfigVersion is the version of the offchainConfig encoding
+	OffchainConfigVersion uint64 `protobuf:"varint,6,opt,name=offchainConfigVersion,proto3" json:"offchainConfigVersion,omitempty"`
+	// offchainConfig is the opaque configuration blob read by the oracles only
+	OffchainConfig []byte `protobuf:"bytes,7,opt,name=offchainConfig,proto3" json:"offchainConfig,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,8,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
-func (*UnimplementedMsgServer) SetDeviationThresholdTriggerTx(ctx context.Context, req *MsgSetDeviationThresholdTrigger) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDeviationThresholdTriggerTx not implemented")
+
+func (m *MsgSetOCRConfig) Reset()         { *m = MsgSetOCRConfig{} }
+func (m *MsgSetOCRConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOCRConfig) ProtoMessage()    {}
+func (*MsgSetOCRConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{25}
 }
-func (*UnimplementedMsgServer) SetFeedRewardTx(ctx context.Context, req *MsgSetFeedReward) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFeedRewardTx not implemented")
+func (m *MsgSetOCRConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RequestNewRoundTx(ctx context.Context, req *MsgRequestNewRound) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestNewRoundTx not implemented")
+func (m *MsgSetOCRConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOCRConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) FeedOwnershipTransferTx(ctx context.Context, req *MsgFeedOwnershipTransfer) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeedOwnershipTransferTx not implemented")
+func (m *MsgSetOCRConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOCRConfig.Merge(m, src)
 }
-func (*UnimplementedMsgServer) AddAccountTx(ctx context.Context, req *MsgAccount) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAccountTx not implemented")
+func (m *MsgSetOCRConfig) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) EditAccountTx(ctx context.Context, req *MsgEditAccount) (*MsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditAccountTx not implemented")
+func (m *MsgSetOCRConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOCRConfig.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgSetOCRConfig proto.InternalMessageInfo
 
-func _Msg_SubmitFeedDataTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFeedData)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitFeedDataTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/SubmitFeedDataTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitFeedDataTx(ctx, req.(*MsgFeedData))
+func (m *MsgSetOCRConfig) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddModuleOwnerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModuleOwner)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetOCRConfig) GetSigningKeys() [][]byte {
+	if m != nil {
+		return m.SigningKeys
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddModuleOwnerTx(ctx, in)
+	return nil
+}
+
+func (m *MsgSetOCRConfig) GetTransmitters() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Transmitters
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/AddModuleOwnerTx",
+	return nil
+}
+
+func (m *MsgSetOCRConfig) GetF() uint32 {
+	if m != nil {
+		return m.F
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddModuleOwnerTx(ctx, req.(*MsgModuleOwner))
+	return 0
+}
+
+func (m *MsgSetOCRConfig) GetOnchainConfig() []byte {
+	if m != nil {
+		return m.OnchainConfig
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_ModuleOwnershipTransferTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgModuleOwnershipTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetOCRConfig) GetOffchainConfigVersion() uint64 {
+	if m != nil {
+		return m.OffchainConfigVersion
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ModuleOwnershipTransferTx(ctx, in)
+	return 0
+}
+
+func (m *MsgSetOCRConfig) GetOffchainConfig() []byte {
+	if m != nil {
+		return m.OffchainConfig
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/ModuleOwnershipTransferTx",
+	return nil
+}
+
+func (m *MsgSetOCRConfig) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ModuleOwnershipTransferTx(ctx, req.(*MsgModuleOwnershipTransfer))
+	return nil
+}
+
+// OCRConfig is the active OCR configuration of a feed
+type OCRConfig struct {
+	SigningKeys           [][]byte                                        `protobuf:"bytes,1,rep,name=signingKeys,proto3" json:"signingKeys,omitempty"`
+	Transmitters          []github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,rep,name=transmitters,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"transmitters,omitempty"`
+	F                     uint32                                          `protobuf:"varint,3,opt,name=f,proto3" json:"f,omitempty"`
+	OnchainConfig         []byte                                          `protobuf:"bytes,4,opt,name=onchainConfig,proto3" json:"onchainConfig,omitempty"`
+	OffchainConfigVersion uint64                                          `protobuf:"varint,5,opt,name=offchainConfigVersion,proto3" json:"offchainConfigVersion,omitempty"`
+	OffchainConfig        []byte                                          `protobuf:"bytes,6,opt,name=offchainConfig,proto3" json:"offchainConfig,omitempty"`
+	// configCount is the number of configs the feed got, this one included
+	ConfigCount uint64 `protobuf:"varint,7,opt,name=configCount,proto3" json:"configCount,omitempty"`
+	// configDigest identifies the config, the reports must carry it in their context
+	ConfigDigest []byte `protobuf:"bytes,8,opt,name=configDigest,proto3" json:"configDigest,omitempty"`
+	// blockNumber is the height of the block the config got set in
+	BlockNumber int64 `protobuf:"varint,9,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+}
+
+func (m *OCRConfig) Reset()         { *m = OCRConfig{} }
+func (m *OCRConfig) String() string { return proto.CompactTextString(m) }
+func (*OCRConfig) ProtoMessage()    {}
+func (*OCRConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{26}
+}
+func (m *OCRConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCRConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCRConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *OCRConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCRConfig.Merge(m, src)
+}
+func (m *OCRConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCRConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCRConfig.DiscardUnknown(m)
 }
 
-func _Msg_AddFeedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFeed)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_OCRConfig proto.InternalMessageInfo
+
+func (m *OCRConfig) GetSigningKeys() [][]byte {
+	if m != nil {
+		return m.SigningKeys
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddFeedTx(ctx, in)
+	return nil
+}
+
+func (m *OCRConfig) GetTransmitters() []github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Transmitters
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/AddFeedTx",
+	return nil
+}
+
+func (m *OCRConfig) GetF() uint32 {
+	if m != nil {
+		return m.F
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddFeedTx(ctx, req.(*MsgFeed))
+	return 0
+}
+
+func (m *OCRConfig) GetOnchainConfig() []byte {
+	if m != nil {
+		return m.OnchainConfig
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_AddDataProviderTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddDataProvider)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *OCRConfig) GetOffchainConfigVersion() uint64 {
+	if m != nil {
+		return m.OffchainConfigVersion
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddDataProviderTx(ctx, in)
+	return 0
+}
+
+func (m *OCRConfig) GetOffchainConfig() []byte {
+	if m != nil {
+		return m.OffchainConfig
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/AddDataProviderTx",
+	return nil
+}
+
+func (m *OCRConfig) GetConfigCount() uint64 {
+	if m != nil {
+		return m.ConfigCount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddDataProviderTx(ctx, req.(*MsgAddDataProvider))
+	return 0
+}
+
+func (m *OCRConfig) GetConfigDigest() []byte {
+	if m != nil {
+		return m.ConfigDigest
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RemoveDataProviderTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveDataProvider)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveDataProviderTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/RemoveDataProviderTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveDataProviderTx(ctx, req.(*MsgRemoveDataProvider))
+func (m *OCRConfig) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_SetSubmissionCountTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetSubmissionCount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetSubmissionCountTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/SetSubmissionCountTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetSubmissionCountTx(ctx, req.(*MsgSetSubmissionCount))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgFeedOwnershipTransfer is the type defined for feed ownership transfer
+type MsgFeedOwnershipTransfer struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// NewFeedOwnerAddress is the address of the new owner of the feed
+	NewFeedOwnerAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=newFeedOwnerAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"newFeedOwnerAddress,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func _Msg_SetHeartbeatTriggerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetHeartbeatTrigger)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetHeartbeatTriggerTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/SetHeartbeatTriggerTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetHeartbeatTriggerTx(ctx, req.(*MsgSetHeartbeatTrigger))
+func (m *MsgFeedOwnershipTransfer) Reset()         { *m = MsgFeedOwnershipTransfer{} }
+func (m *MsgFeedOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedOwnershipTransfer) ProtoMessage()    {}
+func (*MsgFeedOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{27}
+}
+func (m *MsgFeedOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgFeedOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgFeedOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedOwnershipTransfer.DiscardUnknown(m)
 }
 
-func _Msg_SetDeviationThresholdTriggerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetDeviationThresholdTrigger)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetDeviationThresholdTriggerTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/SetDeviationThresholdTriggerTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetDeviationThresholdTriggerTx(ctx, req.(*MsgSetDeviationThresholdTrigger))
+var xxx_messageInfo_MsgFeedOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgFeedOwnershipTransfer) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SetFeedRewardTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetFeedReward)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetFeedRewardTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/SetFeedRewardTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetFeedRewardTx(ctx, req.(*MsgSetFeedReward))
+func (m *MsgFeedOwnershipTransfer) GetNewFeedOwnerAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.NewFeedOwnerAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RequestNewRoundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestNewRound)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestNewRoundTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/RequestNewRoundTx",
+func (m *MsgFeedOwnershipTransfer) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestNewRoundTx(ctx, req.(*MsgRequestNewRound))
+	return nil
+}
+
+// MsgAcceptFeedOwnership is the type defined for the new feed owner accepting a pending feed ownership transfer
+type MsgAcceptFeedOwnership struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is the new feed owner of the transfer who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgAcceptFeedOwnership) Reset()         { *m = MsgAcceptFeedOwnership{} }
+func (m *MsgAcceptFeedOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptFeedOwnership) ProtoMessage()    {}
+func (*MsgAcceptFeedOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{28}
+}
+func (m *MsgAcceptFeedOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptFeedOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptFeedOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAcceptFeedOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptFeedOwnership.Merge(m, src)
+}
+func (m *MsgAcceptFeedOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptFeedOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptFeedOwnership.DiscardUnknown(m)
 }
 
-func _Msg_FeedOwnershipTransferTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFeedOwnershipTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptFeedOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptFeedOwnership) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).FeedOwnershipTransferTx(ctx, in)
+	return ""
+}
+
+func (m *MsgAcceptFeedOwnership) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/FeedOwnershipTransferTx",
+	return nil
+}
+
+// MsgCancelFeedOwnershipTransfer is the type defined for cancelling a pending feed ownership transfer
+type MsgCancelFeedOwnershipTransfer struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is either the feed owner who proposed the transfer or the new feed owner declining it
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) Reset()         { *m = MsgCancelFeedOwnershipTransfer{} }
+func (m *MsgCancelFeedOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelFeedOwnershipTransfer) ProtoMessage()    {}
+func (*MsgCancelFeedOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{29}
+}
+func (m *MsgCancelFeedOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelFeedOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelFeedOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FeedOwnershipTransferTx(ctx, req.(*MsgFeedOwnershipTransfer))
+}
+func (m *MsgCancelFeedOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelFeedOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgCancelFeedOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelFeedOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelFeedOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelFeedOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelFeedOwnershipTransfer) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddAccountTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAccount)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCancelFeedOwnershipTransfer) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddAccountTx(ctx, in)
+	return nil
+}
+
+// MsgPauseFeed is the type defined for pausing a feed, a paused feed rejects new rounds
+type MsgPauseFeed struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is the feed owner or a module owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
+}
+
+func (m *MsgPauseFeed) Reset()         { *m = MsgPauseFeed{} }
+func (m *MsgPauseFeed) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseFeed) ProtoMessage()    {}
+func (*MsgPauseFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{30}
+}
+func (m *MsgPauseFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/AddAccountTx",
+}
+func (m *MsgPauseFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseFeed.Merge(m, src)
+}
+func (m *MsgPauseFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseFeed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseFeed proto.InternalMessageInfo
+
+func (m *MsgPauseFeed) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAccountTx(ctx, req.(*MsgAccount))
+	return ""
+}
+
+func (m *MsgPauseFeed) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_EditAccountTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditAccount)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditAccountTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/chainlink.v1beta.Msg/EditAccountTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditAccountTx(ctx, req.(*MsgEditAccount))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUnpauseFeed is the type defined for unpausing a paused feed
+type MsgUnpauseFeed struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is the feed owner or a module owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "chainlink.v1beta.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SubmitFeedDataTx",
-			Handler:    _Msg_SubmitFeedDataTx_Handler,
-		},
-		{
-			MethodName: "AddModuleOwnerTx",
-			Handler:    _Msg_AddModuleOwnerTx_Handler,
-		},
-		{
-			MethodName: "ModuleOwnershipTransferTx",
-			Handler:    _Msg_ModuleOwnershipTransferTx_Handler,
-		},
-		{
-			MethodName: "AddFeedTx",
-			Handler:    _Msg_AddFeedTx_Handler,
-		},
-		{
-			MethodName: "AddDataProviderTx",
-			Handler:    _Msg_AddDataProviderTx_Handler,
-		},
-		{
-			MethodName: "RemoveDataProviderTx",
-			Handler:    _Msg_RemoveDataProviderTx_Handler,
-		},
-		{
-			MethodName: "SetSubmissionCountTx",
-			Handler:    _Msg_SetSubmissionCountTx_Handler,
-		},
-		{
-			MethodName: "SetHeartbeatTriggerTx",
-			Handler:    _Msg_SetHeartbeatTriggerTx_Handler,
-		},
-		{
-			MethodName: "SetDeviationThresholdTriggerTx",
-			Handler:    _Msg_SetDeviationThresholdTriggerTx_Handler,
-		},
-		{
-			MethodName: "SetFeedRewardTx",
-			Handler:    _Msg_SetFeedRewardTx_Handler,
-		},
-		{
-			MethodName: "RequestNewRoundTx",
-			Handler:    _Msg_RequestNewRoundTx_Handler,
-		},
-		{
-			MethodName: "FeedOwnershipTransferTx",
-			Handler:    _Msg_FeedOwnershipTransferTx_Handler,
-		},
-		{
-			MethodName: "AddAccountTx",
-			Handler:    _Msg_AddAccountTx_Handler,
-		},
-		{
-			MethodName: "EditAccountTx",
-			Handler:    _Msg_EditAccountTx_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "chainlink/v1beta/tx.proto",
+func (m *MsgUnpauseFeed) Reset()         { *m = MsgUnpauseFeed{} }
+func (m *MsgUnpauseFeed) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseFeed) ProtoMessage()    {}
+func (*MsgUnpauseFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{31}
 }
-
-func (m *MsgModuleOwnershipTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnpauseFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgModuleOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnpauseFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseFeed.Merge(m, src)
+}
+func (m *MsgUnpauseFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseFeed.DiscardUnknown(m)
 }
 
-func (m *MsgModuleOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewModuleOwnerPubKey) > 0 {
-		i -= len(m.NewModuleOwnerPubKey)
-		copy(dAtA[i:], m.NewModuleOwnerPubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewModuleOwnerPubKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewModuleOwnerAddress) > 0 {
-		i -= len(m.NewModuleOwnerAddress)
-		copy(dAtA[i:], m.NewModuleOwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewModuleOwnerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AssignerAddress) > 0 {
-		i -= len(m.AssignerAddress)
-		copy(dAtA[i:], m.AssignerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignerAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgUnpauseFeed proto.InternalMessageInfo
+
+func (m *MsgUnpauseFeed) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFeed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnpauseFeed) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgFeed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDeprecateFeed is the type defined for deprecating a feed, a deprecated feed is read-only
+type MsgDeprecateFeed struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is the feed owner or a module owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Desc) > 0 {
-		i -= len(m.Desc)
-		copy(dAtA[i:], m.Desc)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Desc)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.FeedReward != nil {
-		{
-			size, err := m.FeedReward.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ModuleOwnerAddress) > 0 {
-		i -= len(m.ModuleOwnerAddress)
-		copy(dAtA[i:], m.ModuleOwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleOwnerAddress)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.DeviationThresholdTrigger != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DeviationThresholdTrigger))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.HeartbeatTrigger != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.HeartbeatTrigger))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.SubmissionCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SubmissionCount))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.DataProviders) > 0 {
-		for iNdEx := len(m.DataProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DataProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgDeprecateFeed) Reset()         { *m = MsgDeprecateFeed{} }
+func (m *MsgDeprecateFeed) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateFeed) ProtoMessage()    {}
+func (*MsgDeprecateFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{32}
+}
+func (m *MsgDeprecateFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprecateFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprecateFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.FeedOwner) > 0 {
-		i -= len(m.FeedOwner)
-		copy(dAtA[i:], m.FeedOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedOwner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgDeprecateFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateFeed.Merge(m, src)
+}
+func (m *MsgDeprecateFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprecateFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateFeed.DiscardUnknown(m)
 }
 
-func (m *FeedRewardSchema) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeprecateFeed proto.InternalMessageInfo
+
+func (m *MsgDeprecateFeed) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *FeedRewardSchema) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDeprecateFeed) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
 
-func (m *FeedRewardSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Strategy) > 0 {
-		i -= len(m.Strategy)
-		copy(dAtA[i:], m.Strategy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Strategy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// MsgDeleteFeed is the type defined for deleting a deprecated feed along with its rounds
+type MsgDeleteFeed struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is the module owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *DataProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteFeed) Reset()         { *m = MsgDeleteFeed{} }
+func (m *MsgDeleteFeed) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteFeed) ProtoMessage()    {}
+func (*MsgDeleteFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{33}
+}
+func (m *MsgDeleteFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DataProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDeleteFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteFeed.Merge(m, src)
+}
+func (m *MsgDeleteFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteFeed.DiscardUnknown(m)
 }
 
-func (m *DataProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgDeleteFeed proto.InternalMessageInfo
+
+func (m *MsgDeleteFeed) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddDataProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDeleteFeed) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAddDataProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFundFeed is the type defined for funding the reward escrow of a feed
+type MsgFundFeed struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// amount is the amount of link moved from the signer to the feed escrow
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgAddDataProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.DataProvider != nil {
-		{
-			size, err := m.DataProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgFundFeed) Reset()         { *m = MsgFundFeed{} }
+func (m *MsgFundFeed) String() string { return proto.CompactTextString(m) }
+func (*MsgFundFeed) ProtoMessage()    {}
+func (*MsgFundFeed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{34}
+}
+func (m *MsgFundFeed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundFeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundFeed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRemoveDataProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgFundFeed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundFeed.Merge(m, src)
 }
-
-func (m *MsgRemoveDataProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFundFeed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundFeed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundFeed.DiscardUnknown(m)
 }
 
-func (m *MsgRemoveDataProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgFundFeed proto.InternalMessageInfo
+
+func (m *MsgFundFeed) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgFundFeed) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSetSubmissionCount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFundFeed) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetSubmissionCount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawFeedFunds is the type defined for withdrawing funds from the reward escrow of a feed
+type MsgWithdrawFeedFunds struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// amount is the amount of link moved from the feed escrow to the signer
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgSetSubmissionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.SubmissionCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SubmissionCount))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgWithdrawFeedFunds) Reset()         { *m = MsgWithdrawFeedFunds{} }
+func (m *MsgWithdrawFeedFunds) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeedFunds) ProtoMessage()    {}
+func (*MsgWithdrawFeedFunds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{35}
+}
+func (m *MsgWithdrawFeedFunds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeedFunds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeedFunds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgWithdrawFeedFunds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeedFunds.Merge(m, src)
+}
+func (m *MsgWithdrawFeedFunds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeedFunds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeedFunds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeedFunds proto.InternalMessageInfo
+
+func (m *MsgWithdrawFeedFunds) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetHeartbeatTrigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawFeedFunds) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSetHeartbeatTrigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawFeedFunds) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
 }
 
-func (m *MsgSetHeartbeatTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.HeartbeatTrigger != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.HeartbeatTrigger))
-		i--
-		dAtA[i] = 0x10
+// FeedTombstone is the record left in place of a deleted feed, the feedId of a deleted feed can not be re-used
+type FeedTombstone struct {
+	FeedId    string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	FeedOwner github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=feedOwner,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"feedOwner,omitempty"`
+	Desc      string                                        `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc,omitempty"`
+	Metadata  *FeedMetadata                                 `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// lastRoundId is the latest round of the feed when it got deleted
+	LastRoundId uint64 `protobuf:"varint,5,opt,name=lastRoundId,proto3" json:"lastRoundId,omitempty"`
+	// deletedAtHeight is the height of the block the feed got deleted in
+	DeletedAtHeight int64 `protobuf:"varint,6,opt,name=deletedAtHeight,proto3" json:"deletedAtHeight,omitempty"`
+	// deletedBy is the module owner who deleted the feed
+	DeletedBy github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,7,opt,name=deletedBy,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"deletedBy,omitempty"`
+}
+
+func (m *FeedTombstone) Reset()         { *m = FeedTombstone{} }
+func (m *FeedTombstone) String() string { return proto.CompactTextString(m) }
+func (*FeedTombstone) ProtoMessage()    {}
+func (*FeedTombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{36}
+}
+func (m *FeedTombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedTombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedTombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FeedTombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedTombstone.Merge(m, src)
+}
+func (m *FeedTombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedTombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedTombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedTombstone proto.InternalMessageInfo
+
+func (m *FeedTombstone) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSetDeviationThresholdTrigger) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeedTombstone) GetFeedOwner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.FeedOwner
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetDeviationThresholdTrigger) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeedTombstone) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
 }
 
-func (m *MsgSetDeviationThresholdTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func (m *FeedTombstone) GetMetadata() *FeedMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.DeviationThresholdTrigger != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DeviationThresholdTrigger))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *FeedTombstone) GetLastRoundId() uint64 {
+	if m != nil {
+		return m.LastRoundId
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *FeedTombstone) GetDeletedAtHeight() int64 {
+	if m != nil {
+		return m.DeletedAtHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSetFeedReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeedTombstone) GetDeletedBy() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.DeletedBy
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetFeedReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFeedData is the type defined for the data of the feed
+// It could be an OCR report feed, or any general feed data in the future
+type MsgFeedData struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Submitter is the leader of the current round who is calling the module to submit feed data
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
+	// observationFeedData is the report for price feed, could be used for general feed data
+	// From proposal: The reports generated by Chainlink nodes off-chain using the OCR protocol are ABI encoded byte arrays
+	// which means each report contains a byte array which could be deserialized to OCRAbiEncoded type
+	// this is the original observation data array from each data provider
+	ObservationFeedData [][]byte `protobuf:"bytes,3,rep,name=observationFeedData,proto3" json:"observationFeedData,omitempty"`
+	// observationFeedData is the list of the data provider observation data signature of the current round, signed by chainlink prikey. This is observation signatures
+	ObservationFeedDataSignatures [][]byte `protobuf:"bytes,4,rep,name=observationFeedDataSignatures,proto3" json:"observationFeedDataSignatures,omitempty"`
+	// isFeedDataValid is the indicator whether feed data is valid against the external validation func, it's true by default
+	IsFeedDataValid bool `protobuf:"varint,5,opt,name=isFeedDataValid,proto3" json:"isFeedDataValid,omitempty"`
+	// cosmosPubKeys is the cosmos account pubKey slice of the data providers who signed this round
+	CosmosPubKeys [][]byte `protobuf:"bytes,6,rep,name=cosmosPubKeys,proto3" json:"cosmosPubKeys,omitempty"`
+	// txFee is the tx fee of submitting feed data
+	TxFee *Coin `protobuf:"bytes,7,opt,name=txFee,proto3" json:"txFee,omitempty"`
+	// report is the ABI encoded OCR report generated off-chain by the OCR protocol,
+	// it is deserialized to OCRAbiEncoded type when the feed data gets persisted
+	Report []byte `protobuf:"bytes,8,opt,name=report,proto3" json:"report,omitempty"`
 }
 
-func (m *MsgSetFeedReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.FeedReward != nil {
-		{
-			size, err := m.FeedReward.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgFeedData) Reset()         { *m = MsgFeedData{} }
+func (m *MsgFeedData) String() string { return proto.CompactTextString(m) }
+func (*MsgFeedData) ProtoMessage()    {}
+func (*MsgFeedData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{37}
+}
+func (m *MsgFeedData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeedData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeedData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFeedData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeedData.Merge(m, src)
+}
+func (m *MsgFeedData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeedData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeedData.DiscardUnknown(m)
 }
 
-func (m *MsgFeedOwnershipTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFeedData proto.InternalMessageInfo
+
+func (m *MsgFeedData) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFeedOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFeedData) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
+	}
+	return nil
 }
 
-func (m *MsgFeedOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NewFeedOwnerAddress) > 0 {
-		i -= len(m.NewFeedOwnerAddress)
-		copy(dAtA[i:], m.NewFeedOwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFeedOwnerAddress)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgFeedData) GetObservationFeedData() [][]byte {
+	if m != nil {
+		return m.ObservationFeedData
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgFeedData) GetObservationFeedDataSignatures() [][]byte {
+	if m != nil {
+		return m.ObservationFeedDataSignatures
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgFeedData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFeedData) GetIsFeedDataValid() bool {
+	if m != nil {
+		return m.IsFeedDataValid
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgFeedData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFeedData) GetCosmosPubKeys() [][]byte {
+	if m != nil {
+		return m.CosmosPubKeys
+	}
+	return nil
 }
 
-func (m *MsgFeedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxFee != nil {
-		{
-			size, err := m.TxFee.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.CosmosPubKeys) > 0 {
-		for iNdEx := len(m.CosmosPubKeys) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CosmosPubKeys[iNdEx])
-			copy(dAtA[i:], m.CosmosPubKeys[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosPubKeys[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.IsFeedDataValid {
-		i--
-		if m.IsFeedDataValid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.ObservationFeedDataSignatures) > 0 {
-		for iNdEx := len(m.ObservationFeedDataSignatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ObservationFeedDataSignatures[iNdEx])
-			copy(dAtA[i:], m.ObservationFeedDataSignatures[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ObservationFeedDataSignatures[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ObservationFeedData) > 0 {
-		for iNdEx := len(m.ObservationFeedData) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ObservationFeedData[iNdEx])
-			copy(dAtA[i:], m.ObservationFeedData[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ObservationFeedData[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgFeedData) GetTxFee() *Coin {
+	if m != nil {
+		return m.TxFee
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgRequestNewRound) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFeedData) GetReport() []byte {
+	if m != nil {
+		return m.Report
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgRequestNewRound) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRequestNewRound is the type defined for requesting new rounds to be triggered for a given feed
+type MsgRequestNewRound struct {
+	// FeedId is the unique identifier of the feed
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is the feed owner who signs the tx
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *MsgRequestNewRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRequestNewRound) Reset()         { *m = MsgRequestNewRound{} }
+func (m *MsgRequestNewRound) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestNewRound) ProtoMessage()    {}
+func (*MsgRequestNewRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{38}
+}
+func (m *MsgRequestNewRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestNewRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestNewRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FeedId) > 0 {
-		i -= len(m.FeedId)
-		copy(dAtA[i:], m.FeedId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRequestNewRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestNewRound.Merge(m, src)
+}
+func (m *MsgRequestNewRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestNewRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestNewRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestNewRound proto.InternalMessageInfo
+
+func (m *MsgRequestNewRound) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRequestNewRound) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAccount is the type defined for account associated with the Chainlink node
+type MsgAccount struct {
+	// submitter - associated cosmos account address
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
+	// chainlinkPublicKey - public key of the associated Chainlink Oracle account
+	ChainlinkPublicKey []byte `protobuf:"bytes,2,opt,name=chainlinkPublicKey,proto3" json:"chainlinkPublicKey,omitempty"`
+	// chainlinkSigningKey - signing key of the associated Chainlink Oracle account
+	ChainlinkSigningKey []byte `protobuf:"bytes,3,opt,name=chainlinkSigningKey,proto3" json:"chainlinkSigningKey,omitempty"`
+	// piggyAddress - cosmos account address receivable for reward and fee distribution
+	PiggyAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,4,opt,name=piggyAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"piggyAddress,omitempty"`
 }
 
-func (m *MsgAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PiggyAddress) > 0 {
-		i -= len(m.PiggyAddress)
-		copy(dAtA[i:], m.PiggyAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PiggyAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ChainlinkSigningKey) > 0 {
-		i -= len(m.ChainlinkSigningKey)
-		copy(dAtA[i:], m.ChainlinkSigningKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainlinkSigningKey)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgAccount) Reset()         { *m = MsgAccount{} }
+func (m *MsgAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgAccount) ProtoMessage()    {}
+func (*MsgAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{39}
+}
+func (m *MsgAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ChainlinkPublicKey) > 0 {
-		i -= len(m.ChainlinkPublicKey)
-		copy(dAtA[i:], m.ChainlinkPublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainlinkPublicKey)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAccount.Merge(m, src)
+}
+func (m *MsgAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAccount proto.InternalMessageInfo
+
+func (m *MsgAccount) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgAccount) GetChainlinkPublicKey() []byte {
+	if m != nil {
+		return m.ChainlinkPublicKey
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgEditAccount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAccount) GetChainlinkSigningKey() []byte {
+	if m != nil {
+		return m.ChainlinkSigningKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgEditAccount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAccount) GetPiggyAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.PiggyAddress
+	}
+	return nil
 }
 
-func (m *MsgEditAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PiggyAddress) > 0 {
-		i -= len(m.PiggyAddress)
-		copy(dAtA[i:], m.PiggyAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PiggyAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgEditAccount is the type defined to edit a Chainlink account
+type MsgEditAccount struct {
+	// submitter - associated cosmos account address
+	Submitter github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,1,opt,name=submitter,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"submitter,omitempty"`
+	// piggyAddress - cosmos account address receivable for reward and fee distribution
+	PiggyAddress github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=piggyAddress,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"piggyAddress,omitempty"`
 }
 
-func (m *MsgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgEditAccount) Reset()         { *m = MsgEditAccount{} }
+func (m *MsgEditAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgEditAccount) ProtoMessage()    {}
+func (*MsgEditAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{40}
+}
+func (m *MsgEditAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEditAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEditAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgEditAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEditAccount.Merge(m, src)
+}
+func (m *MsgEditAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEditAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEditAccount.DiscardUnknown(m)
 }
 
-func (m *MsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgEditAccount proto.InternalMessageInfo
+
+func (m *MsgEditAccount) GetSubmitter() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Submitter
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *OCRAbiEncoded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgEditAccount) GetPiggyAddress() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.PiggyAddress
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OCRAbiEncoded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawPayment is the type defined for an oracle to claim the rewards and fee reimbursements owed to it,
+// they are paid to the piggy address of its chainlink account when set
+type MsgWithdrawPayment struct {
+	// FeedId only withdraws the payments owed by this feed, every feed when empty
+	FeedId string `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	// Signer is the oracle the payments are owed to
+	Signer github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=signer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"signer,omitempty"`
 }
 
-func (m *OCRAbiEncoded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Observations) > 0 {
-		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Observations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgWithdrawPayment) Reset()         { *m = MsgWithdrawPayment{} }
+func (m *MsgWithdrawPayment) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPayment) ProtoMessage()    {}
+func (*MsgWithdrawPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{41}
+}
+func (m *MsgWithdrawPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Oracles) > 0 {
-		i -= len(m.Oracles)
-		copy(dAtA[i:], m.Oracles)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Oracles)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Context) > 0 {
-		i -= len(m.Context)
-		copy(dAtA[i:], m.Context)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Context)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Observation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPayment.Merge(m, src)
 }
-
-func (m *Observation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPayment.DiscardUnknown(m)
 }
 
-func (m *Observation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgWithdrawPayment proto.InternalMessageInfo
+
+func (m *MsgWithdrawPayment) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *OCRFeedDataInStore) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawPayment) GetSigner() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *OCRFeedDataInStore) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// OwedPayment is the amount of link a feed owes to an oracle, credited by the rounds of the feed until withdrawn
+type OwedPayment struct {
+	FeedId string                                        `protobuf:"bytes,1,opt,name=feedId,proto3" json:"feedId,omitempty"`
+	Oracle github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=oracle,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"oracle,omitempty"`
+	Amount uint64                                        `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *OCRFeedDataInStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RoundId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RoundId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.DeserializedOCRReport != nil {
-		{
-			size, err := m.DeserializedOCRReport.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *OwedPayment) Reset()         { *m = OwedPayment{} }
+func (m *OwedPayment) String() string { return proto.CompactTextString(m) }
+func (*OwedPayment) ProtoMessage()    {}
+func (*OwedPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{42}
+}
+func (m *OwedPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwedPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwedPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.FeedData != nil {
-		{
-			size, err := m.FeedData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *OwedPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwedPayment.Merge(m, src)
+}
+func (m *OwedPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwedPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwedPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwedPayment proto.InternalMessageInfo
+
+func (m *OwedPayment) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Coin) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *OwedPayment) GetOracle() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Oracle
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Coin) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *OwedPayment) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (m *Coin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MsgResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash string `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgResponse) Reset()         { *m = MsgResponse{} }
+func (m *MsgResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResponse) ProtoMessage()    {}
+func (*MsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{43}
 }
-func (m *MsgModuleOwnershipTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AssignerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewModuleOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewModuleOwnerPubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgFeed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FeedOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.DataProviders) > 0 {
-		for _, e := range m.DataProviders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SubmissionCount != 0 {
-		n += 1 + sovTx(uint64(m.SubmissionCount))
-	}
-	if m.HeartbeatTrigger != 0 {
-		n += 1 + sovTx(uint64(m.HeartbeatTrigger))
-	}
-	if m.DeviationThresholdTrigger != 0 {
-		n += 1 + sovTx(uint64(m.DeviationThresholdTrigger))
-	}
-	l = len(m.ModuleOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FeedReward != nil {
-		l = m.FeedReward.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Desc)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *MsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResponse.Merge(m, src)
+}
+func (m *MsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResponse.DiscardUnknown(m)
 }
 
-func (m *FeedRewardSchema) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Strategy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgResponse proto.InternalMessageInfo
+
+func (m *MsgResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return n
+	return 0
 }
 
-func (m *DataProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
 	}
-	return n
+	return ""
 }
 
-func (m *MsgAddDataProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DataProvider != nil {
-		l = m.DataProvider.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// OCRAbiEncoded implements the OCR report that is ABI encoded. The use and form conform to the
+// Chainlink OCR report layout: abi.encode(bytes32 rawReportContext, bytes32 rawObservers, int192[] observations)
+type OCRAbiEncoded struct {
+	// Context is the 32-byte raw report context: 11 bytes padding, 16 bytes config digest, 4 bytes epoch and 1 byte round.
+	Context []byte `protobuf:"bytes,1,opt,name=Context,proto3" json:"Context,omitempty"`
+	// Oracles is the packed list of participating oracle indices, one byte per observation.
+	Oracles []byte `protobuf:"bytes,2,opt,name=Oracles,proto3" json:"Oracles,omitempty"`
+	// Observations is the array of the providers' independent observations, typed after the feed value schema.
+	Observations []*Observation `protobuf:"bytes,3,rep,name=Observations,proto3" json:"Observations,omitempty"`
 }
 
-func (m *MsgRemoveDataProvider) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *OCRAbiEncoded) Reset()         { *m = OCRAbiEncoded{} }
+func (m *OCRAbiEncoded) String() string { return proto.CompactTextString(m) }
+func (*OCRAbiEncoded) ProtoMessage()    {}
+func (*OCRAbiEncoded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{44}
+}
+func (m *OCRAbiEncoded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCRAbiEncoded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCRAbiEncoded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *OCRAbiEncoded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCRAbiEncoded.Merge(m, src)
+}
+func (m *OCRAbiEncoded) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCRAbiEncoded) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCRAbiEncoded.DiscardUnknown(m)
 }
 
-func (m *MsgSetSubmissionCount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.SubmissionCount != 0 {
-		n += 1 + sovTx(uint64(m.SubmissionCount))
+var xxx_messageInfo_OCRAbiEncoded proto.InternalMessageInfo
+
+func (m *OCRAbiEncoded) GetContext() []byte {
+	if m != nil {
+		return m.Context
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *OCRAbiEncoded) GetOracles() []byte {
+	if m != nil {
+		return m.Oracles
 	}
-	return n
+	return nil
 }
 
-func (m *MsgSetHeartbeatTrigger) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OCRAbiEncoded) GetObservations() []*Observation {
+	if m != nil {
+		return m.Observations
 	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.HeartbeatTrigger != 0 {
-		n += 1 + sovTx(uint64(m.HeartbeatTrigger))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return nil
 }
 
-func (m *MsgSetDeviationThresholdTrigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DeviationThresholdTrigger != 0 {
-		n += 1 + sovTx(uint64(m.DeviationThresholdTrigger))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+type Observation struct {
+	// data is the ABI encoding of the observation as it appears in the report, the 32-byte word of an int192 observation
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// value is the decoded int192 observation, only set for int192 feeds
+	Value github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+	// feedValue is the decoded observation typed after the feed value schema
+	FeedValue *FeedValue `protobuf:"bytes,3,opt,name=feedValue,proto3" json:"feedValue,omitempty"`
 }
 
-func (m *MsgSetFeedReward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FeedReward != nil {
-		l = m.FeedReward.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *Observation) Reset()         { *m = Observation{} }
+func (m *Observation) String() string { return proto.CompactTextString(m) }
+func (*Observation) ProtoMessage()    {}
+func (*Observation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{45}
+}
+func (m *Observation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Observation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Observation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Observation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Observation.Merge(m, src)
+}
+func (m *Observation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Observation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Observation.DiscardUnknown(m)
 }
 
-func (m *MsgFeedOwnershipTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewFeedOwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_Observation proto.InternalMessageInfo
+
+func (m *Observation) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return n
+	return nil
 }
 
-func (m *MsgFeedData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ObservationFeedData) > 0 {
-		for _, b := range m.ObservationFeedData {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.ObservationFeedDataSignatures) > 0 {
-		for _, b := range m.ObservationFeedDataSignatures {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.IsFeedDataValid {
-		n += 2
+func (m *Observation) GetFeedValue() *FeedValue {
+	if m != nil {
+		return m.FeedValue
 	}
-	if len(m.CosmosPubKeys) > 0 {
-		for _, b := range m.CosmosPubKeys {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// OCRFeedDataInStore defines the type for OCR report that persists into the store
+type OCRFeedDataInStore struct {
+	FeedData              *MsgFeedData   `protobuf:"bytes,1,opt,name=feedData,proto3" json:"feedData,omitempty"`
+	DeserializedOCRReport *OCRAbiEncoded `protobuf:"bytes,2,opt,name=deserializedOCRReport,proto3" json:"deserializedOCRReport,omitempty"`
+	RoundId               uint64         `protobuf:"varint,3,opt,name=RoundId,proto3" json:"RoundId,omitempty"`
+	// answer is the median of the report observations
+	Answer github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=answer,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"answer"`
+	// startedAt is the unix timestamp (seconds) of the block the round started in
+	StartedAt uint64 `protobuf:"varint,5,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	// updatedAt is the unix timestamp (seconds) of the block the answer got updated in
+	UpdatedAt uint64 `protobuf:"varint,6,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// answeredInRound is the round in which the answer was computed
+	AnsweredInRound uint64 `protobuf:"varint,7,opt,name=answeredInRound,proto3" json:"answeredInRound,omitempty"`
+	// blockHeight is the height of the block the round got persisted in
+	BlockHeight int64 `protobuf:"varint,8,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	// deviation is the deviation of the answer from the previous round answer, in thousandths of a percent
+	Deviation uint64 `protobuf:"varint,9,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// rewardable is false when the round neither met the deviation threshold nor the heartbeat
+	// and the feed deviation threshold policy is nonRewardable
+	Rewardable bool `protobuf:"varint,10,opt,name=rewardable,proto3" json:"rewardable,omitempty"`
+	// value is the answer of the round typed after the feed value schema
+	Value *FeedValue `protobuf:"bytes,11,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *OCRFeedDataInStore) Reset()         { *m = OCRFeedDataInStore{} }
+func (m *OCRFeedDataInStore) String() string { return proto.CompactTextString(m) }
+func (*OCRFeedDataInStore) ProtoMessage()    {}
+func (*OCRFeedDataInStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{46}
+}
+func (m *OCRFeedDataInStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCRFeedDataInStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCRFeedDataInStore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TxFee != nil {
-		l = m.TxFee.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+}
+func (m *OCRFeedDataInStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCRFeedDataInStore.Merge(m, src)
+}
+func (m *OCRFeedDataInStore) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCRFeedDataInStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCRFeedDataInStore.DiscardUnknown(m)
 }
 
-func (m *MsgRequestNewRound) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_OCRFeedDataInStore proto.InternalMessageInfo
+
+func (m *OCRFeedDataInStore) GetFeedData() *MsgFeedData {
+	if m != nil {
+		return m.FeedData
 	}
-	return n
+	return nil
 }
 
-func (m *MsgAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ChainlinkPublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *OCRFeedDataInStore) GetDeserializedOCRReport() *OCRAbiEncoded {
+	if m != nil {
+		return m.DeserializedOCRReport
 	}
-	l = len(m.ChainlinkSigningKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *OCRFeedDataInStore) GetRoundId() uint64 {
+	if m != nil {
+		return m.RoundId
 	}
-	l = len(m.PiggyAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *OCRFeedDataInStore) GetStartedAt() uint64 {
+	if m != nil {
+		return m.StartedAt
 	}
-	return n
+	return 0
 }
 
-func (m *MsgEditAccount) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OCRFeedDataInStore) GetUpdatedAt() uint64 {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *OCRFeedDataInStore) GetAnsweredInRound() uint64 {
+	if m != nil {
+		return m.AnsweredInRound
 	}
-	l = len(m.PiggyAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *OCRFeedDataInStore) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return n
+	return 0
 }
 
-func (m *MsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OCRFeedDataInStore) GetDeviation() uint64 {
+	if m != nil {
+		return m.Deviation
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+	return 0
+}
+
+func (m *OCRFeedDataInStore) GetRewardable() bool {
+	if m != nil {
+		return m.Rewardable
 	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return false
+}
+
+func (m *OCRFeedDataInStore) GetValue() *FeedValue {
+	if m != nil {
+		return m.Value
 	}
-	return n
+	return nil
 }
 
-func (m *OCRAbiEncoded) Size() (n int) {
-	if m == nil {
-		return 0
+type Coin struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *Coin) Reset()         { *m = Coin{} }
+func (m *Coin) String() string { return proto.CompactTextString(m) }
+func (*Coin) ProtoMessage()    {}
+func (*Coin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e2cf97733d10959, []int{47}
+}
+func (m *Coin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Coin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Coin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Context)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *Coin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Coin.Merge(m, src)
+}
+func (m *Coin) XXX_Size() int {
+	return m.Size()
+}
+func (m *Coin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Coin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Coin proto.InternalMessageInfo
+
+func (m *Coin) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Oracles)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *Coin) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Observations) > 0 {
-		for _, e := range m.Observations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgModuleOwnershipTransfer)(nil), "chainlink.v1beta.MsgModuleOwnershipTransfer")
+	proto.RegisterType((*MsgAcceptModuleOwnership)(nil), "chainlink.v1beta.MsgAcceptModuleOwnership")
+	proto.RegisterType((*MsgCancelModuleOwnershipTransfer)(nil), "chainlink.v1beta.MsgCancelModuleOwnershipTransfer")
+	proto.RegisterType((*OwnershipTransfer)(nil), "chainlink.v1beta.OwnershipTransfer")
+	proto.RegisterType((*MsgRemoveModuleOwner)(nil), "chainlink.v1beta.MsgRemoveModuleOwner")
+	proto.RegisterType((*MsgApproveOwnerProposal)(nil), "chainlink.v1beta.MsgApproveOwnerProposal")
+	proto.RegisterType((*MsgCancelOwnerProposal)(nil), "chainlink.v1beta.MsgCancelOwnerProposal")
+	proto.RegisterType((*OwnerProposal)(nil), "chainlink.v1beta.OwnerProposal")
+	proto.RegisterType((*MsgFeed)(nil), "chainlink.v1beta.MsgFeed")
+	proto.RegisterType((*AnswerBounds)(nil), "chainlink.v1beta.AnswerBounds")
+	proto.RegisterType((*FeedValueSchema)(nil), "chainlink.v1beta.FeedValueSchema")
+	proto.RegisterType((*FeedValue)(nil), "chainlink.v1beta.FeedValue")
+	proto.RegisterType((*FeedMetadata)(nil), "chainlink.v1beta.FeedMetadata")
+	proto.RegisterType((*FeedRewardSchema)(nil), "chainlink.v1beta.FeedRewardSchema")
+	proto.RegisterType((*DataProvider)(nil), "chainlink.v1beta.DataProvider")
+	proto.RegisterType((*MsgAddDataProvider)(nil), "chainlink.v1beta.MsgAddDataProvider")
+	proto.RegisterType((*MsgRemoveDataProvider)(nil), "chainlink.v1beta.MsgRemoveDataProvider")
+	proto.RegisterType((*MsgAddTransmitter)(nil), "chainlink.v1beta.MsgAddTransmitter")
+	proto.RegisterType((*MsgRemoveTransmitter)(nil), "chainlink.v1beta.MsgRemoveTransmitter")
+	proto.RegisterType((*MsgSetSubmissionCount)(nil), "chainlink.v1beta.MsgSetSubmissionCount")
+	proto.RegisterType((*MsgSetHeartbeatTrigger)(nil), "chainlink.v1beta.MsgSetHeartbeatTrigger")
+	proto.RegisterType((*MsgSetDeviationThresholdTrigger)(nil), "chainlink.v1beta.MsgSetDeviationThresholdTrigger")
+	proto.RegisterType((*MsgSetFeedReward)(nil), "chainlink.v1beta.MsgSetFeedReward")
+	proto.RegisterType((*MsgSetFeedMetadata)(nil), "chainlink.v1beta.MsgSetFeedMetadata")
+	proto.RegisterType((*MsgSetAnswerBounds)(nil), "chainlink.v1beta.MsgSetAnswerBounds")
+	proto.RegisterType((*MsgSetOCRConfig)(nil), "chainlink.v1beta.MsgSetOCRConfig")
+	proto.RegisterType((*OCRConfig)(nil), "chainlink.v1beta.OCRConfig")
+	proto.RegisterType((*MsgFeedOwnershipTransfer)(nil), "chainlink.v1beta.MsgFeedOwnershipTransfer")
+	proto.RegisterType((*MsgAcceptFeedOwnership)(nil), "chainlink.v1beta.MsgAcceptFeedOwnership")
+	proto.RegisterType((*MsgCancelFeedOwnershipTransfer)(nil), "chainlink.v1beta.MsgCancelFeedOwnershipTransfer")
+	proto.RegisterType((*MsgPauseFeed)(nil), "chainlink.v1beta.MsgPauseFeed")
+	proto.RegisterType((*MsgUnpauseFeed)(nil), "chainlink.v1beta.MsgUnpauseFeed")
+	proto.RegisterType((*MsgDeprecateFeed)(nil), "chainlink.v1beta.MsgDeprecateFeed")
+	proto.RegisterType((*MsgDeleteFeed)(nil), "chainlink.v1beta.MsgDeleteFeed")
+	proto.RegisterType((*MsgFundFeed)(nil), "chainlink.v1beta.MsgFundFeed")
+	proto.RegisterType((*MsgWithdrawFeedFunds)(nil), "chainlink.v1beta.MsgWithdrawFeedFunds")
+	proto.RegisterType((*FeedTombstone)(nil), "chainlink.v1beta.FeedTombstone")
+	proto.RegisterType((*MsgFeedData)(nil), "chainlink.v1beta.MsgFeedData")
+	proto.RegisterType((*MsgRequestNewRound)(nil), "chainlink.v1beta.MsgRequestNewRound")
+	proto.RegisterType((*MsgAccount)(nil), "chainlink.v1beta.MsgAccount")
+	proto.RegisterType((*MsgEditAccount)(nil), "chainlink.v1beta.MsgEditAccount")
+	proto.RegisterType((*MsgWithdrawPayment)(nil), "chainlink.v1beta.MsgWithdrawPayment")
+	proto.RegisterType((*OwedPayment)(nil), "chainlink.v1beta.OwedPayment")
+	proto.RegisterType((*MsgResponse)(nil), "chainlink.v1beta.MsgResponse")
+	proto.RegisterType((*OCRAbiEncoded)(nil), "chainlink.v1beta.OCRAbiEncoded")
+	proto.RegisterType((*Observation)(nil), "chainlink.v1beta.Observation")
+	proto.RegisterType((*OCRFeedDataInStore)(nil), "chainlink.v1beta.OCRFeedDataInStore")
+	proto.RegisterType((*Coin)(nil), "chainlink.v1beta.Coin")
+}
+
+func init() { proto.RegisterFile("chainlink/v1beta/tx.proto", fileDescriptor_8e2cf97733d10959) }
+
+var fileDescriptor_8e2cf97733d10959 = []byte{
+	// 2826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0xdd, 0x6f, 0x23, 0x57,
+	0xf5, 0x3b, 0xb6, 0xf3, 0x75, 0x6c, 0xe7, 0xe3, 0x36, 0x9b, 0xf5, 0xe6, 0xb7, 0xeb, 0xb8, 0xf3,
+	0xab, 0x4a, 0xa8, 0xba, 0x49, 0x37, 0x54, 0x02, 0x2a, 0x78, 0x48, 0x9c, 0x86, 0x0d, 0x5b, 0x37,
+	0xe1, 0x66, 0xba, 0x20, 0x40, 0x85, 0xb1, 0xe7, 0x66, 0x3c, 0x5a, 0x7b, 0xc6, 0x9d, 0x3b, 0x4e,
+	0x26, 0xe5, 0x09, 0xa8, 0x10, 0x8f, 0x54, 0x48, 0xfd, 0x03, 0x10, 0x12, 0x12, 0xaf, 0xbc, 0x80,
+	0x2a, 0x21, 0x3e, 0x24, 0xa8, 0x78, 0xaa, 0xc4, 0x0b, 0xf0, 0xb0, 0x42, 0x5b, 0xf8, 0x07, 0xe0,
+	0x0d, 0x21, 0x40, 0xf7, 0xde, 0xf9, 0xf6, 0x8c, 0xed, 0x38, 0xee, 0x56, 0x7d, 0x8a, 0xef, 0xb9,
+	0xe7, 0x9e, 0x73, 0xee, 0xb9, 0xe7, 0xeb, 0x9e, 0x3b, 0x81, 0x9b, 0xad, 0xb6, 0x6a, 0x98, 0x1d,
+	0xc3, 0x7c, 0xb8, 0x7d, 0x76, 0xb7, 0x49, 0x1c, 0x75, 0xdb, 0x71, 0xb7, 0x7a, 0xb6, 0xe5, 0x58,
+	0x68, 0x39, 0x98, 0xda, 0x12, 0x53, 0xeb, 0xab, 0xba, 0xa5, 0x5b, 0x7c, 0x72, 0x9b, 0xfd, 0x12,
+	0x78, 0xeb, 0xb7, 0x74, 0xcb, 0xd2, 0x3b, 0x64, 0x5b, 0xed, 0x19, 0xdb, 0xaa, 0x69, 0x5a, 0x8e,
+	0xea, 0x18, 0x96, 0x49, 0xbd, 0xd9, 0xea, 0x00, 0x03, 0x9d, 0x98, 0x84, 0x1a, 0xde, 0xbc, 0xfc,
+	0xd3, 0x1c, 0xac, 0x37, 0xa8, 0xde, 0xb0, 0xb4, 0x7e, 0x87, 0x1c, 0x9d, 0x9b, 0xc4, 0xa6, 0x6d,
+	0xa3, 0xa7, 0xd8, 0xaa, 0x49, 0x4f, 0x89, 0x8d, 0xbe, 0x06, 0x4b, 0x2a, 0xa5, 0x86, 0x6e, 0x12,
+	0x7b, 0x57, 0xd3, 0x6c, 0x42, 0x69, 0x45, 0xaa, 0x49, 0x9b, 0xa5, 0xbd, 0xbb, 0xff, 0x7a, 0xb4,
+	0x71, 0x47, 0x37, 0x9c, 0x76, 0xbf, 0xb9, 0xd5, 0xb2, 0xba, 0xdb, 0x2d, 0x8b, 0x76, 0x2d, 0xea,
+	0xfd, 0xb9, 0x43, 0xb5, 0x87, 0xdb, 0xce, 0x45, 0x8f, 0xd0, 0xad, 0xdd, 0x56, 0xcb, 0x5b, 0x88,
+	0x93, 0x94, 0x90, 0x0e, 0xd7, 0x4d, 0x72, 0x1e, 0x61, 0xed, 0xb3, 0xc8, 0x4d, 0xca, 0x22, 0x9d,
+	0x1e, 0x3a, 0x80, 0xd5, 0xf8, 0xc4, 0x71, 0xbf, 0x79, 0x9f, 0x5c, 0x54, 0xf2, 0x9c, 0x0f, 0xfa,
+	0xc7, 0xa3, 0x8d, 0xc5, 0x0b, 0xb5, 0xdb, 0x79, 0x49, 0xee, 0xf5, 0x9b, 0xdf, 0x78, 0x48, 0x2e,
+	0x64, 0x9c, 0x8a, 0x2f, 0xff, 0x46, 0x82, 0x4a, 0x83, 0xea, 0xbb, 0xad, 0x16, 0xe9, 0x39, 0x09,
+	0x95, 0x7d, 0xb8, 0xaa, 0x3a, 0x84, 0x59, 0x01, 0x98, 0x5c, 0x37, 0x1e, 0x01, 0xf9, 0x0f, 0x12,
+	0xd4, 0x1a, 0x54, 0xaf, 0xab, 0x66, 0x8b, 0x74, 0x3e, 0x92, 0x73, 0x9f, 0xe2, 0x66, 0x7e, 0x9d,
+	0x83, 0x95, 0x41, 0xe9, 0xd7, 0x60, 0xf6, 0x94, 0x10, 0xed, 0x50, 0xe3, 0x42, 0x2f, 0x60, 0x6f,
+	0x84, 0xbe, 0x00, 0x33, 0xd6, 0xf9, 0x95, 0xf8, 0x8a, 0xf5, 0xa8, 0x01, 0xf3, 0x26, 0x39, 0xe7,
+	0x8c, 0x2b, 0xf9, 0x49, 0x69, 0x05, 0x24, 0xd0, 0xb3, 0xb0, 0xe8, 0xff, 0xf6, 0x2c, 0xb3, 0xc0,
+	0x88, 0xe2, 0x04, 0x14, 0x3d, 0x07, 0xcb, 0x3d, 0xdb, 0xea, 0x59, 0x94, 0x68, 0xbb, 0xce, 0x3d,
+	0x62, 0xe8, 0x6d, 0xa7, 0x32, 0x53, 0x93, 0x36, 0xf3, 0x78, 0x00, 0x8e, 0x36, 0x61, 0x89, 0xb8,
+	0x3d, 0xc3, 0x26, 0x34, 0x40, 0x9d, 0xe5, 0xa8, 0x49, 0xb0, 0xfc, 0x2b, 0x09, 0x56, 0x1b, 0x54,
+	0xc7, 0xa4, 0x6b, 0x9d, 0x91, 0x88, 0x41, 0xa0, 0xfb, 0x30, 0xa7, 0x5e, 0xf5, 0xf0, 0x7d, 0x0a,
+	0x69, 0x16, 0x95, 0x9b, 0x96, 0x45, 0xc9, 0x6f, 0x49, 0x70, 0x83, 0x39, 0x66, 0xaf, 0x67, 0x5b,
+	0x67, 0x9e, 0xcb, 0x72, 0x85, 0xa8, 0x1d, 0x54, 0x05, 0xe8, 0x79, 0xbf, 0x3d, 0x83, 0x28, 0xe0,
+	0x08, 0x64, 0x9a, 0xd6, 0xf8, 0x5d, 0x09, 0xd6, 0x02, 0xd7, 0xfa, 0xc8, 0xa4, 0x78, 0x3b, 0x0f,
+	0xe5, 0x38, 0xf3, 0x45, 0xc8, 0x19, 0x3e, 0xd3, 0x9c, 0xa1, 0xa1, 0x3b, 0x50, 0x60, 0x1e, 0xc1,
+	0x59, 0x15, 0x77, 0x6e, 0x6e, 0x25, 0x33, 0xcd, 0x56, 0x83, 0xea, 0x07, 0x84, 0x68, 0x98, 0xa3,
+	0xa1, 0x3d, 0x28, 0x76, 0x43, 0xb3, 0xe0, 0x06, 0x5f, 0xdc, 0xa9, 0xa5, 0xae, 0x8a, 0x98, 0x0f,
+	0x8e, 0x2e, 0x42, 0x0a, 0xac, 0xd8, 0x49, 0x03, 0xe3, 0x56, 0x5e, 0xdc, 0x79, 0x36, 0x95, 0xd2,
+	0x80, 0x39, 0xe2, 0x41, 0x02, 0xe8, 0x08, 0x16, 0x54, 0x7e, 0xe6, 0x6a, 0x87, 0x56, 0x66, 0x6a,
+	0xf9, 0xc9, 0x14, 0x17, 0xd2, 0x40, 0xcf, 0xc3, 0x0a, 0xed, 0x37, 0xbb, 0x86, 0xe3, 0x10, 0x2d,
+	0xe1, 0x37, 0x83, 0x13, 0x69, 0x3e, 0x36, 0x97, 0xee, 0x63, 0xff, 0x9c, 0x83, 0x39, 0x4f, 0xa9,
+	0x99, 0xd1, 0xe9, 0x08, 0x16, 0xd8, 0xaf, 0xa3, 0xab, 0x45, 0xa8, 0x90, 0x06, 0xda, 0x87, 0xb2,
+	0xa6, 0x3a, 0xea, 0xb1, 0x6d, 0x9d, 0x19, 0x1a, 0xb1, 0x69, 0x25, 0x5f, 0xcb, 0x6f, 0x16, 0x77,
+	0xaa, 0x83, 0xfa, 0xde, 0x8f, 0xa0, 0xe1, 0xf8, 0x22, 0xb6, 0x49, 0xbe, 0x73, 0x4a, 0x0d, 0xcb,
+	0xac, 0x5b, 0x7d, 0xd3, 0xe1, 0xe7, 0x56, 0xc6, 0x49, 0x30, 0x0b, 0x4f, 0x6d, 0xa2, 0xda, 0x4e,
+	0x93, 0xa8, 0x8e, 0x62, 0x1b, 0xba, 0x4e, 0x6c, 0x1e, 0x9e, 0xca, 0x78, 0x00, 0x8e, 0x3e, 0x07,
+	0x37, 0x35, 0x72, 0x66, 0xf0, 0x5a, 0x45, 0x69, 0xdb, 0x84, 0xb6, 0xad, 0x8e, 0xe6, 0x2f, 0x9a,
+	0xe5, 0x8b, 0xb2, 0x11, 0x90, 0x0a, 0xa8, 0x3b, 0x58, 0x36, 0xcc, 0x4d, 0xaa, 0xb3, 0x14, 0x62,
+	0x68, 0x0f, 0x80, 0x69, 0x12, 0x93, 0x73, 0xd5, 0xd6, 0x2a, 0xf3, 0xdc, 0x52, 0xe5, 0x41, 0xcd,
+	0x1d, 0x04, 0x38, 0x27, 0xad, 0x36, 0xe9, 0xaa, 0x38, 0xb2, 0x0a, 0x21, 0x28, 0x68, 0x84, 0xb6,
+	0x2a, 0x0b, 0xfc, 0x9c, 0xf9, 0x6f, 0xf4, 0x12, 0x54, 0x06, 0xf7, 0x75, 0x6c, 0x75, 0x8c, 0xd6,
+	0x45, 0x05, 0x38, 0x5e, 0xe6, 0x3c, 0x7a, 0x09, 0xe6, 0xbb, 0xc4, 0x51, 0xd9, 0xf9, 0x54, 0x8a,
+	0x35, 0x29, 0xfd, 0x2c, 0x99, 0x44, 0x0d, 0x0f, 0x0b, 0x07, 0xf8, 0xcc, 0xea, 0x7a, 0x6a, 0x9f,
+	0x12, 0xad, 0x52, 0xaa, 0x49, 0x9b, 0xf3, 0xd8, 0x1b, 0xb1, 0xc0, 0xa4, 0x91, 0x9e, 0x4d, 0x5a,
+	0xaa, 0x43, 0xb4, 0x4a, 0x99, 0xcf, 0x45, 0x20, 0x68, 0x0f, 0x4a, 0xaa, 0x49, 0xcf, 0x89, 0xbd,
+	0x67, 0xf5, 0x4d, 0x8d, 0x56, 0x16, 0xb3, 0xf8, 0xee, 0x46, 0xb0, 0x70, 0x6c, 0x0d, 0x7a, 0x0d,
+	0x4a, 0x0e, 0xcb, 0xcd, 0xdc, 0x7d, 0x6c, 0x5a, 0x59, 0x9a, 0xd4, 0x53, 0x63, 0x64, 0x50, 0x1d,
+	0x8a, 0x67, 0x6a, 0xa7, 0x4f, 0x84, 0xe6, 0x2b, 0xcb, 0x5c, 0xb2, 0xa7, 0xd3, 0x35, 0xf2, 0x20,
+	0x44, 0xc4, 0xd1, 0x55, 0xa8, 0x06, 0xc5, 0xae, 0x61, 0x3a, 0xe2, 0xc4, 0x68, 0x65, 0x85, 0x2b,
+	0x20, 0x0a, 0x42, 0x9f, 0x81, 0x1b, 0x86, 0x49, 0xfb, 0xa7, 0xa7, 0x46, 0xcb, 0x20, 0xa6, 0x73,
+	0xc0, 0xb6, 0xe4, 0x1d, 0x18, 0xe2, 0x07, 0x96, 0x35, 0x2d, 0xff, 0x52, 0x82, 0x52, 0x54, 0x2d,
+	0xe8, 0x15, 0x58, 0xe8, 0x1a, 0xa6, 0x00, 0x09, 0xef, 0xdf, 0xdb, 0x7a, 0xef, 0xd1, 0xc6, 0xb5,
+	0xbf, 0x3c, 0xda, 0x78, 0x76, 0x0c, 0x4d, 0x1c, 0x9a, 0x0e, 0x0e, 0x09, 0x70, 0x6a, 0xaa, 0xeb,
+	0x51, 0xcb, 0x4d, 0x48, 0xcd, 0x27, 0xc0, 0x8c, 0xb5, 0x6b, 0x69, 0x84, 0x87, 0xf7, 0x05, 0xcc,
+	0x7f, 0xcb, 0x75, 0x58, 0x4a, 0x28, 0x8f, 0xa1, 0xb1, 0xe5, 0x5e, 0xec, 0xe2, 0xbf, 0xd1, 0x2d,
+	0x58, 0x70, 0xfa, 0xbd, 0x0e, 0x39, 0x31, 0xde, 0x24, 0x5c, 0x90, 0x32, 0x0e, 0x01, 0xf2, 0x9f,
+	0x25, 0x58, 0x08, 0xa8, 0xa4, 0xae, 0xbf, 0x07, 0x73, 0x66, 0xbf, 0x4b, 0x6c, 0xa3, 0x35, 0xe1,
+	0x36, 0xfc, 0xe5, 0x68, 0x15, 0x66, 0x9a, 0x17, 0x0e, 0xa1, 0xa2, 0x2a, 0xc3, 0x62, 0xc0, 0x79,
+	0x12, 0x57, 0xc4, 0x2d, 0xc6, 0x93, 0xb8, 0x0e, 0xda, 0x87, 0x19, 0x2e, 0x22, 0x4f, 0x1b, 0x97,
+	0xe7, 0x28, 0x16, 0xcb, 0xef, 0x48, 0x50, 0x8a, 0x3a, 0x1c, 0x5a, 0x87, 0x79, 0x8d, 0xb4, 0x8c,
+	0x2e, 0x4b, 0x48, 0x12, 0xd7, 0x44, 0x30, 0x46, 0x15, 0x98, 0x3b, 0x23, 0x36, 0x8b, 0x97, 0x7c,
+	0x9b, 0x05, 0xec, 0x0f, 0x99, 0x02, 0x9b, 0x2a, 0x25, 0xbb, 0x94, 0x12, 0xc7, 0x3b, 0x80, 0x10,
+	0xc0, 0x5c, 0xf4, 0x8d, 0xbe, 0xe5, 0x78, 0xd3, 0x62, 0x13, 0x11, 0x08, 0xdb, 0x5e, 0xdf, 0x34,
+	0x44, 0x29, 0xb8, 0x80, 0xf9, 0x6f, 0xf9, 0x00, 0x96, 0x93, 0xa1, 0x89, 0x85, 0x00, 0xb5, 0xcb,
+	0x03, 0xb8, 0x28, 0x05, 0xbc, 0x11, 0x93, 0x99, 0x3a, 0xb6, 0xea, 0x10, 0xfd, 0x42, 0xe8, 0x1f,
+	0x07, 0x63, 0x99, 0x42, 0x29, 0x9a, 0x1c, 0xa6, 0x5b, 0x13, 0xb2, 0x98, 0x24, 0xea, 0x5d, 0x9e,
+	0xee, 0xb0, 0x37, 0x92, 0xdf, 0x95, 0x00, 0xb1, 0x72, 0x4e, 0xd3, 0x62, 0xbc, 0xb3, 0x12, 0xe7,
+	0x1e, 0x94, 0xa2, 0x29, 0xab, 0x92, 0xcb, 0x0a, 0x51, 0xb1, 0x34, 0x17, 0x5b, 0x13, 0xa9, 0xbf,
+	0xf2, 0x57, 0xad, 0xbf, 0x7e, 0x2f, 0xc1, 0xf5, 0xa0, 0x80, 0x19, 0x6b, 0x03, 0x11, 0xa5, 0xe6,
+	0xae, 0xac, 0xd4, 0x29, 0xee, 0xe4, 0xb7, 0x12, 0xac, 0x88, 0x73, 0x50, 0xc2, 0xb8, 0xfb, 0xb1,
+	0xdb, 0xc5, 0xef, 0xa2, 0xf7, 0x9b, 0x8f, 0xf3, 0x46, 0x7e, 0x2c, 0x0c, 0xeb, 0x84, 0x38, 0x27,
+	0x89, 0xca, 0x2b, 0x6b, 0x27, 0x29, 0xb5, 0x5b, 0x2e, 0xbd, 0x76, 0x9b, 0xa2, 0x98, 0x3f, 0x11,
+	0xb7, 0xa0, 0x13, 0xe2, 0xdc, 0x4b, 0x56, 0x7d, 0x59, 0x72, 0xa6, 0x55, 0x8e, 0xb9, 0x8c, 0xca,
+	0x71, 0x8a, 0x92, 0xfe, 0x47, 0x82, 0x0d, 0x21, 0xe9, 0x7e, 0x66, 0xa9, 0x99, 0x25, 0xf2, 0xd0,
+	0x02, 0x36, 0x37, 0xaa, 0x80, 0x9d, 0xde, 0x26, 0x86, 0x16, 0x94, 0x85, 0xe1, 0x05, 0xa5, 0xfc,
+	0x0b, 0x09, 0x96, 0x85, 0x02, 0xc2, 0x64, 0x31, 0x24, 0xcc, 0x46, 0x2b, 0xe2, 0xdc, 0x44, 0x15,
+	0xf1, 0x14, 0x0f, 0xef, 0x67, 0x22, 0x49, 0x78, 0xb2, 0x37, 0x22, 0x75, 0x6e, 0xaa, 0xf4, 0xd1,
+	0xda, 0x39, 0x77, 0xc9, 0xda, 0x79, 0x8a, 0x52, 0xbf, 0x1b, 0x48, 0x1d, 0x2b, 0x0c, 0x87, 0xa4,
+	0xb6, 0x58, 0xf5, 0x9d, 0x9b, 0xa0, 0xfa, 0x9e, 0xa2, 0xf4, 0xff, 0xcd, 0xc1, 0x92, 0x90, 0xfe,
+	0xa8, 0x8e, 0xeb, 0x96, 0x79, 0x6a, 0xe8, 0x99, 0xa2, 0xd7, 0xa0, 0xc8, 0x56, 0x19, 0xa6, 0x7e,
+	0x9f, 0x5c, 0x30, 0xc9, 0xf3, 0x9b, 0x25, 0x1c, 0x05, 0x0d, 0x5c, 0x0b, 0xf2, 0xd3, 0xb9, 0x16,
+	0x94, 0x40, 0x3a, 0xf5, 0xae, 0xa8, 0xd2, 0x29, 0x7a, 0x06, 0xca, 0x96, 0xc9, 0xd5, 0x25, 0xe4,
+	0xe5, 0x55, 0x52, 0x09, 0xc7, 0x81, 0xe8, 0x45, 0xb8, 0x6e, 0x9d, 0x9e, 0x46, 0x20, 0x0f, 0xbc,
+	0x42, 0x6d, 0x96, 0x57, 0x4a, 0xe9, 0x93, 0xac, 0x6f, 0x17, 0x9f, 0x10, 0x57, 0x50, 0x9c, 0x80,
+	0x46, 0x4e, 0x60, 0xfe, 0xca, 0x21, 0x2b, 0x07, 0x0b, 0xa1, 0xee, 0x13, 0x3a, 0x96, 0x46, 0xeb,
+	0x38, 0x37, 0x45, 0x1d, 0xe7, 0x33, 0x75, 0x5c, 0xb8, 0x94, 0x8e, 0x67, 0x2e, 0xa7, 0xe3, 0xd9,
+	0x54, 0x1d, 0xd7, 0xa0, 0xd8, 0xe2, 0xbf, 0x44, 0x9a, 0x9b, 0xe3, 0x34, 0xa3, 0x20, 0x24, 0x43,
+	0x49, 0x0c, 0xf7, 0x0d, 0x9d, 0x50, 0x47, 0x9c, 0x05, 0x8e, 0xc1, 0x18, 0x95, 0x66, 0xc7, 0x6a,
+	0x3d, 0x7c, 0xb5, 0xdf, 0x6d, 0x12, 0x9b, 0x5f, 0xdc, 0xf3, 0x38, 0x0a, 0x92, 0x1f, 0x8b, 0x37,
+	0x80, 0x03, 0xbf, 0xcb, 0x32, 0x56, 0xe3, 0xb9, 0x05, 0x4f, 0x99, 0xe4, 0x3c, 0x58, 0x73, 0xe5,
+	0x06, 0x68, 0x1a, 0xb5, 0x69, 0xfa, 0xf9, 0xb7, 0x60, 0x2d, 0x78, 0xe7, 0x88, 0xed, 0x34, 0x73,
+	0x87, 0xd3, 0xed, 0xa2, 0x56, 0x83, 0x2e, 0xea, 0xe5, 0xf4, 0x3c, 0x45, 0x29, 0xde, 0x80, 0x52,
+	0x83, 0xea, 0xc7, 0xac, 0x49, 0x32, 0xb4, 0x6b, 0x37, 0x45, 0x96, 0x14, 0x16, 0x1b, 0x54, 0x7f,
+	0xcd, 0xec, 0x3d, 0x49, 0xa6, 0x7d, 0x5e, 0x01, 0xec, 0xfb, 0x0d, 0x9f, 0x27, 0xc5, 0xd6, 0x86,
+	0x32, 0x67, 0xdb, 0x21, 0x4f, 0x8e, 0xe7, 0xf7, 0x25, 0x28, 0x32, 0xd7, 0xed, 0x9b, 0xda, 0x50,
+	0x96, 0xe1, 0x3d, 0x39, 0x17, 0xbb, 0x27, 0x4f, 0xd1, 0xc1, 0xde, 0x16, 0x77, 0x92, 0x2f, 0x1b,
+	0x4e, 0x5b, 0xb3, 0x55, 0xee, 0xcb, 0x07, 0x43, 0x0b, 0x81, 0x27, 0x20, 0xd3, 0xdf, 0x73, 0x50,
+	0x66, 0x82, 0x28, 0x56, 0xb7, 0x49, 0x1d, 0xcb, 0x24, 0x4f, 0xae, 0x53, 0xed, 0x37, 0x4a, 0xf3,
+	0xb1, 0x46, 0x69, 0x58, 0xb0, 0x15, 0x2e, 0x59, 0xb0, 0xd5, 0xa0, 0xd8, 0x51, 0xa9, 0x83, 0x59,
+	0x01, 0x74, 0xa8, 0x79, 0x09, 0x26, 0x0a, 0x62, 0x37, 0x23, 0x8d, 0x1b, 0x5f, 0xb2, 0xcd, 0x9f,
+	0x04, 0xb3, 0xcd, 0x7a, 0xa0, 0xbd, 0x8b, 0xc9, 0x5b, 0xcc, 0x21, 0x0d, 0xf9, 0xad, 0xbc, 0x30,
+	0x43, 0x42, 0x78, 0x7b, 0x63, 0x98, 0x96, 0xfd, 0x27, 0x87, 0xab, 0x68, 0x39, 0xa0, 0x81, 0x5e,
+	0x80, 0xa7, 0xac, 0x26, 0x25, 0xf6, 0x19, 0xbf, 0x0b, 0xf8, 0xfc, 0x45, 0xd9, 0x85, 0xd3, 0xa6,
+	0xd0, 0x3e, 0xdc, 0x4e, 0x01, 0x9f, 0x18, 0xba, 0xa9, 0x3a, 0x7d, 0x9b, 0xd0, 0x4a, 0x81, 0xaf,
+	0x1d, 0x8e, 0xc4, 0x74, 0x6d, 0x50, 0x1f, 0xfe, 0x40, 0xed, 0x18, 0xe2, 0x44, 0xe6, 0x71, 0x12,
+	0xcc, 0x0a, 0x09, 0xb1, 0x17, 0xf1, 0xe0, 0x49, 0x2b, 0xb3, 0x9c, 0x7e, 0x1c, 0x88, 0x9e, 0x87,
+	0x19, 0xc7, 0x3d, 0x20, 0x84, 0x9f, 0x46, 0x71, 0x67, 0x6d, 0xd0, 0x2c, 0xea, 0x96, 0x61, 0x62,
+	0x81, 0xc4, 0xd4, 0x6b, 0x93, 0x9e, 0x65, 0xfb, 0x09, 0xdf, 0x1b, 0xc9, 0xe7, 0xbc, 0x10, 0xc7,
+	0xe4, 0x8d, 0x3e, 0xa1, 0xce, 0xab, 0xe4, 0x9c, 0x5b, 0xc6, 0x18, 0x61, 0xe8, 0xca, 0x7e, 0xf6,
+	0x4e, 0x0e, 0x40, 0x64, 0x57, 0xee, 0xc1, 0xb1, 0x63, 0x96, 0xa6, 0x70, 0xcc, 0x5b, 0x80, 0x02,
+	0x85, 0x1c, 0xf7, 0x9b, 0x1d, 0xa3, 0x15, 0x76, 0xd8, 0x52, 0x66, 0x98, 0x59, 0x04, 0xd0, 0x93,
+	0xa0, 0x74, 0xf4, 0x3a, 0xa8, 0x69, 0x53, 0xac, 0xa8, 0xec, 0x19, 0xba, 0x7e, 0xe1, 0xd7, 0x31,
+	0x85, 0x49, 0xa5, 0x8e, 0x91, 0x91, 0x7f, 0x2e, 0xf1, 0x04, 0xf8, 0xb2, 0x66, 0x38, 0x1f, 0x9a,
+	0x72, 0x92, 0xa2, 0xe7, 0xa6, 0x23, 0xba, 0x30, 0x26, 0x3f, 0x9c, 0x1f, 0xab, 0x17, 0x5d, 0x62,
+	0x3a, 0x63, 0x18, 0xd3, 0x54, 0x72, 0xda, 0xd1, 0x39, 0xd1, 0xc6, 0x60, 0x69, 0xd9, 0x6a, 0xab,
+	0x43, 0xae, 0xc0, 0x52, 0x10, 0x88, 0xa4, 0xa2, 0x7c, 0x34, 0x15, 0xc9, 0x9f, 0xe7, 0x61, 0x0d,
+	0x13, 0xda, 0xb3, 0x4c, 0xca, 0xd1, 0xda, 0x22, 0xb0, 0x7a, 0xdd, 0x66, 0x31, 0x62, 0x70, 0xc7,
+	0xbd, 0xa7, 0xd2, 0xb6, 0xd7, 0x6b, 0xf6, 0x46, 0xf2, 0xf7, 0x24, 0x28, 0x1f, 0xd5, 0xf1, 0x6e,
+	0xd3, 0x78, 0xd9, 0x6c, 0x59, 0x1a, 0xd1, 0x58, 0xbf, 0xbc, 0x6e, 0x99, 0xbc, 0x73, 0xcf, 0x8f,
+	0x1e, 0xfb, 0x43, 0x36, 0x73, 0xc4, 0x85, 0xf1, 0x0e, 0x10, 0xfb, 0x43, 0xb4, 0x0b, 0xa5, 0xa3,
+	0x30, 0x18, 0xf9, 0x4f, 0x9e, 0xb7, 0x07, 0x43, 0x44, 0x04, 0x0b, 0xc7, 0x96, 0xc8, 0x3f, 0x62,
+	0x2a, 0x0d, 0x01, 0x3c, 0x39, 0xb1, 0x38, 0x29, 0x64, 0xe0, 0xbf, 0xd9, 0xeb, 0x01, 0x7f, 0x44,
+	0x9a, 0xf0, 0xbd, 0x42, 0x2c, 0x46, 0x9f, 0x15, 0x79, 0x94, 0x3f, 0x8c, 0x78, 0xcf, 0xea, 0xff,
+	0x37, 0xe4, 0xf9, 0x0a, 0x87, 0xd8, 0xf2, 0xb7, 0x0b, 0x80, 0x8e, 0xea, 0xd8, 0x0f, 0x9f, 0x87,
+	0xe6, 0x89, 0x63, 0xd9, 0x8c, 0xe2, 0xfc, 0xa9, 0x07, 0xe2, 0xf2, 0xa6, 0x6e, 0x3d, 0x92, 0x7c,
+	0x70, 0x80, 0x8e, 0x5e, 0x83, 0xeb, 0x1a, 0xa1, 0xc4, 0x36, 0xd4, 0x8e, 0xf1, 0x26, 0xd1, 0x8e,
+	0xea, 0x18, 0x8b, 0xb0, 0x29, 0x7a, 0x0e, 0x1b, 0x29, 0x2a, 0x8c, 0x9e, 0x16, 0x4e, 0x5f, 0xcd,
+	0x8e, 0xca, 0x4f, 0xc3, 0xc2, 0x5c, 0xfc, 0x21, 0x3a, 0x80, 0x59, 0xd1, 0xa7, 0xa8, 0x14, 0x26,
+	0x52, 0xa2, 0xb7, 0x9a, 0x3d, 0x9e, 0x50, 0x47, 0xb5, 0x79, 0xce, 0xf6, 0x52, 0x7d, 0x08, 0x60,
+	0xb3, 0xfd, 0x9e, 0xa6, 0x8a, 0x59, 0x71, 0x9b, 0x0f, 0x01, 0x2c, 0x35, 0x09, 0x2a, 0x44, 0x3b,
+	0x34, 0xb9, 0x60, 0xde, 0xcd, 0x31, 0x09, 0x0e, 0x6e, 0x86, 0x5e, 0xb1, 0x30, 0x1f, 0xb9, 0x19,
+	0x0a, 0x10, 0xe3, 0x14, 0x34, 0xda, 0xf8, 0xcd, 0xb1, 0x80, 0x43, 0x00, 0x7b, 0xc4, 0xb1, 0x79,
+	0x0f, 0x4c, 0x6d, 0x76, 0x08, 0x7f, 0xe9, 0x9d, 0xc7, 0x11, 0x08, 0xba, 0xeb, 0x5b, 0x54, 0x71,
+	0xb4, 0x1d, 0x08, 0x4c, 0xf9, 0x45, 0x28, 0xb0, 0x44, 0xc7, 0x1e, 0xbd, 0x34, 0x62, 0x5a, 0x5d,
+	0xcf, 0xe5, 0xc5, 0x20, 0xab, 0x62, 0xdc, 0xf9, 0xf7, 0x0d, 0xc8, 0x37, 0xa8, 0x8e, 0x4c, 0x58,
+	0xe6, 0x6d, 0x64, 0xc7, 0xb7, 0x05, 0xc5, 0x45, 0xc3, 0x8d, 0x65, 0xfd, 0x76, 0xc6, 0x97, 0x1a,
+	0xc2, 0xe3, 0xe5, 0x5b, 0xdf, 0xf9, 0xe3, 0xdf, 0x7e, 0x98, 0x5b, 0x5b, 0x5f, 0xdd, 0x0e, 0xd0,
+	0xb6, 0x99, 0x79, 0x6d, 0x73, 0x97, 0x39, 0x81, 0xe5, 0x5d, 0x4d, 0x8b, 0x7c, 0xbd, 0xa1, 0xb8,
+	0x68, 0xe4, 0x47, 0x24, 0x23, 0x58, 0xa2, 0x36, 0xdc, 0xcc, 0xf8, 0x84, 0x4d, 0x71, 0xd1, 0xf3,
+	0xa3, 0xa8, 0x47, 0xf1, 0x47, 0x71, 0xd2, 0xe0, 0x46, 0xea, 0x77, 0x7f, 0x8a, 0x8b, 0x9e, 0x4b,
+	0x5d, 0x99, 0x8a, 0x3d, 0x8a, 0x8b, 0x03, 0x1b, 0x43, 0x3f, 0xcc, 0x53, 0x5c, 0xb4, 0x93, 0x4a,
+	0x61, 0xe8, 0xaa, 0x51, 0x5c, 0xbf, 0x0e, 0x4f, 0x0d, 0x7c, 0x6e, 0xa3, 0xb8, 0x68, 0xcc, 0x0f,
+	0x73, 0x46, 0x51, 0x6f, 0xc2, 0x5a, 0xda, 0x87, 0x59, 0x8a, 0x8b, 0x3e, 0x99, 0xae, 0xb8, 0x14,
+	0xe4, 0x51, 0x3c, 0xbe, 0x09, 0xd7, 0x53, 0xbe, 0xba, 0x52, 0x5c, 0xb4, 0x39, 0x44, 0x5b, 0x97,
+	0xe2, 0xf0, 0x32, 0x2c, 0xec, 0x6a, 0xfc, 0xde, 0xa8, 0xb8, 0x28, 0xfb, 0x93, 0xa9, 0x51, 0x64,
+	0xbe, 0x02, 0x2b, 0x89, 0x67, 0x4d, 0xc5, 0x45, 0xcf, 0xa4, 0xeb, 0x21, 0x8e, 0x37, 0x8a, 0xf2,
+	0xeb, 0xb0, 0x3a, 0xf8, 0xe4, 0xa8, 0xb8, 0xe8, 0x13, 0x43, 0x4e, 0xf1, 0x32, 0xf4, 0x1f, 0x70,
+	0xff, 0x8d, 0xbc, 0x9f, 0x29, 0x2e, 0xfa, 0xff, 0x2c, 0xc1, 0x23, 0x68, 0x63, 0x1b, 0x5f, 0x9c,
+	0xf4, 0x30, 0xe3, 0xbb, 0x04, 0xf5, 0xd7, 0x61, 0x75, 0xf0, 0xbd, 0x2c, 0x53, 0x2b, 0x83, 0xa8,
+	0x63, 0x18, 0x5e, 0xca, 0x43, 0x57, 0xa6, 0xe1, 0xa5, 0xe0, 0x8e, 0xe2, 0x60, 0x43, 0x75, 0xd8,
+	0x03, 0x95, 0xe2, 0xa2, 0xbb, 0x59, 0xac, 0x32, 0x17, 0x8d, 0xe2, 0xa9, 0xc0, 0x52, 0xec, 0x4d,
+	0x48, 0x71, 0x91, 0x9c, 0xc5, 0x24, 0xc4, 0x1a, 0xc3, 0xf6, 0x13, 0xaf, 0x35, 0x99, 0xb6, 0x9f,
+	0xc0, 0x1b, 0x8f, 0x72, 0xf4, 0x0d, 0x64, 0x18, 0xe5, 0x28, 0xde, 0x28, 0xca, 0x18, 0x16, 0xa3,
+	0xaf, 0x1d, 0x8a, 0x8b, 0x9e, 0xce, 0x22, 0x1b, 0x20, 0x8d, 0x21, 0x6d, 0xe2, 0xda, 0x99, 0x29,
+	0x6d, 0x02, 0x6f, 0x8c, 0x24, 0x95, 0xda, 0x30, 0xcd, 0x4c, 0x52, 0xa9, 0xd8, 0x63, 0xd8, 0x7c,
+	0x4a, 0x6b, 0x38, 0xd3, 0xe6, 0x53, 0x70, 0x47, 0x71, 0xe8, 0xc1, 0xed, 0x21, 0xed, 0x5f, 0xc5,
+	0x45, 0x2f, 0x0c, 0x09, 0xeb, 0x13, 0xed, 0xe9, 0x15, 0x28, 0x06, 0xbd, 0x5e, 0xc5, 0x45, 0xd5,
+	0x54, 0xec, 0x00, 0x63, 0x14, 0xb5, 0x63, 0x28, 0x47, 0xda, 0xb8, 0x99, 0x85, 0x4e, 0x04, 0x67,
+	0x0c, 0x8f, 0x8c, 0xf5, 0x68, 0x33, 0x3d, 0x32, 0x86, 0x35, 0x8a, 0xea, 0xab, 0x50, 0x0a, 0x5b,
+	0xb0, 0x8a, 0x8b, 0x36, 0x32, 0x48, 0x76, 0xc8, 0x78, 0xf4, 0xbe, 0x08, 0xe0, 0x77, 0x57, 0xb3,
+	0xab, 0x49, 0x0f, 0x61, 0x8c, 0xbc, 0x30, 0xd0, 0x1e, 0xcd, 0xcc, 0x0b, 0x03, 0x98, 0x63, 0xf8,
+	0x60, 0xe2, 0xb6, 0x9e, 0xe9, 0x83, 0x09, 0xbc, 0x51, 0x94, 0xef, 0x43, 0x69, 0x57, 0xd3, 0xbc,
+	0x06, 0x86, 0xe2, 0xa2, 0x5b, 0x59, 0x4e, 0x31, 0x4e, 0x7a, 0x39, 0x86, 0x72, 0xa4, 0x1d, 0x92,
+	0x69, 0x48, 0x11, 0x9c, 0x11, 0x14, 0xf7, 0xbe, 0xf4, 0xde, 0xe3, 0xaa, 0xf4, 0xfe, 0xe3, 0xaa,
+	0xf4, 0xd7, 0xc7, 0x55, 0xe9, 0x07, 0x1f, 0x54, 0xaf, 0xbd, 0xff, 0x41, 0xf5, 0xda, 0x9f, 0x3e,
+	0xa8, 0x5e, 0xfb, 0xea, 0xa7, 0x23, 0xf7, 0xae, 0x3a, 0x23, 0x71, 0xa2, 0x9e, 0x92, 0xb0, 0x94,
+	0xbf, 0xe3, 0xdd, 0xc5, 0xdc, 0x10, 0x24, 0x2e, 0x63, 0xcd, 0x59, 0xfe, 0xaf, 0x44, 0x9f, 0xfa,
+	0xdf, 0x00, 0x79, 0x08, 0x6e, 0xd5, 0xcd, 0x34, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SubmitFeedDataTx(ctx context.Context, in *MsgFeedData, opts ...grpc.CallOption) (*MsgResponse, error)
+	AddModuleOwnerTx(ctx context.Context, in *MsgModuleOwner, opts ...grpc.CallOption) (*MsgResponse, error)
+	ModuleOwnershipTransferTx(ctx context.Context, in *MsgModuleOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error)
+	AcceptModuleOwnershipTx(ctx context.Context, in *MsgAcceptModuleOwnership, opts ...grpc.CallOption) (*MsgResponse, error)
+	CancelModuleOwnershipTransferTx(ctx context.Context, in *MsgCancelModuleOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error)
+	RemoveModuleOwnerTx(ctx context.Context, in *MsgRemoveModuleOwner, opts ...grpc.CallOption) (*MsgResponse, error)
+	ApproveOwnerProposalTx(ctx context.Context, in *MsgApproveOwnerProposal, opts ...grpc.CallOption) (*MsgResponse, error)
+	CancelOwnerProposalTx(ctx context.Context, in *MsgCancelOwnerProposal, opts ...grpc.CallOption) (*MsgResponse, error)
+	AddFeedTx(ctx context.Context, in *MsgFeed, opts ...grpc.CallOption) (*MsgResponse, error)
+	AddDataProviderTx(ctx context.Context, in *MsgAddDataProvider, opts ...grpc.CallOption) (*MsgResponse, error)
+	RemoveDataProviderTx(ctx context.Context, in *MsgRemoveDataProvider, opts ...grpc.CallOption) (*MsgResponse, error)
+	AddTransmitterTx(ctx context.Context, in *MsgAddTransmitter, opts ...grpc.CallOption) (*MsgResponse, error)
+	RemoveTransmitterTx(ctx context.Context, in *MsgRemoveTransmitter, opts ...grpc.CallOption) (*MsgResponse, error)
+	SetSubmissionCountTx(ctx context.Context, in *MsgSetSubmissionCount, opts ...grpc.CallOption) (*MsgResponse, error)
+	SetHeartbeatTriggerTx(ctx context.Context, in *MsgSetHeartbeatTrigger, opts ...grpc.CallOption) (*MsgResponse, error)
+	SetDeviationThresholdTriggerTx(ctx context.Context, in *MsgSetDeviationThresholdTrigger, opts ...grpc.CallOption) (*MsgResponse, error)
+	SetFeedRewardTx(ctx context.Context, in *MsgSetFeedReward, opts ...grpc.CallOption) (*MsgResponse, error)
+	SetFeedMetadataTx(ctx context.Context, in *MsgSetFeedMetadata, opts ...grpc.CallOption) (*MsgResponse, error)
+	SetAnswerBoundsTx(ctx context.Context, in *MsgSetAnswerBounds, opts ...grpc.CallOption) (*MsgResponse, error)
+	SetOCRConfigTx(ctx context.Context, in *MsgSetOCRConfig, opts ...grpc.CallOption) (*MsgResponse, error)
+	RequestNewRoundTx(ctx context.Context, in *MsgRequestNewRound, opts ...grpc.CallOption) (*MsgResponse, error)
+	FeedOwnershipTransferTx(ctx context.Context, in *MsgFeedOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error)
+	AcceptFeedOwnershipTx(ctx context.Context, in *MsgAcceptFeedOwnership, opts ...grpc.CallOption) (*MsgResponse, error)
+	CancelFeedOwnershipTransferTx(ctx context.Context, in *MsgCancelFeedOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error)
+	PauseFeedTx(ctx context.Context, in *MsgPauseFeed, opts ...grpc.CallOption) (*MsgResponse, error)
+	UnpauseFeedTx(ctx context.Context, in *MsgUnpauseFeed, opts ...grpc.CallOption) (*MsgResponse, error)
+	DeprecateFeedTx(ctx context.Context, in *MsgDeprecateFeed, opts ...grpc.CallOption) (*MsgResponse, error)
+	DeleteFeedTx(ctx context.Context, in *MsgDeleteFeed, opts ...grpc.CallOption) (*MsgResponse, error)
+	FundFeedTx(ctx context.Context, in *MsgFundFeed, opts ...grpc.CallOption) (*MsgResponse, error)
+	WithdrawFeedFundsTx(ctx context.Context, in *MsgWithdrawFeedFunds, opts ...grpc.CallOption) (*MsgResponse, error)
+	WithdrawPaymentTx(ctx context.Context, in *MsgWithdrawPayment, opts ...grpc.CallOption) (*MsgResponse, error)
+	AddAccountTx(ctx context.Context, in *MsgAccount, opts ...grpc.CallOption) (*MsgResponse, error)
+	EditAccountTx(ctx context.Context, in *MsgEditAccount, opts ...grpc.CallOption) (*MsgResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SubmitFeedDataTx(ctx context.Context, in *MsgFeedData, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SubmitFeedDataTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddModuleOwnerTx(ctx context.Context, in *MsgModuleOwner, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddModuleOwnerTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ModuleOwnershipTransferTx(ctx context.Context, in *MsgModuleOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/ModuleOwnershipTransferTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptModuleOwnershipTx(ctx context.Context, in *MsgAcceptModuleOwnership, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AcceptModuleOwnershipTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelModuleOwnershipTransferTx(ctx context.Context, in *MsgCancelModuleOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/CancelModuleOwnershipTransferTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveModuleOwnerTx(ctx context.Context, in *MsgRemoveModuleOwner, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/RemoveModuleOwnerTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveOwnerProposalTx(ctx context.Context, in *MsgApproveOwnerProposal, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/ApproveOwnerProposalTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelOwnerProposalTx(ctx context.Context, in *MsgCancelOwnerProposal, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/CancelOwnerProposalTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddFeedTx(ctx context.Context, in *MsgFeed, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddFeedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddDataProviderTx(ctx context.Context, in *MsgAddDataProvider, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddDataProviderTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveDataProviderTx(ctx context.Context, in *MsgRemoveDataProvider, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/RemoveDataProviderTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddTransmitterTx(ctx context.Context, in *MsgAddTransmitter, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddTransmitterTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveTransmitterTx(ctx context.Context, in *MsgRemoveTransmitter, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/RemoveTransmitterTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSubmissionCountTx(ctx context.Context, in *MsgSetSubmissionCount, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetSubmissionCountTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetHeartbeatTriggerTx(ctx context.Context, in *MsgSetHeartbeatTrigger, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetHeartbeatTriggerTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDeviationThresholdTriggerTx(ctx context.Context, in *MsgSetDeviationThresholdTrigger, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetDeviationThresholdTriggerTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeedRewardTx(ctx context.Context, in *MsgSetFeedReward, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetFeedRewardTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeedMetadataTx(ctx context.Context, in *MsgSetFeedMetadata, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetFeedMetadataTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAnswerBoundsTx(ctx context.Context, in *MsgSetAnswerBounds, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetAnswerBoundsTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetOCRConfigTx(ctx context.Context, in *MsgSetOCRConfig, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/SetOCRConfigTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestNewRoundTx(ctx context.Context, in *MsgRequestNewRound, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/RequestNewRoundTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FeedOwnershipTransferTx(ctx context.Context, in *MsgFeedOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/FeedOwnershipTransferTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptFeedOwnershipTx(ctx context.Context, in *MsgAcceptFeedOwnership, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AcceptFeedOwnershipTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelFeedOwnershipTransferTx(ctx context.Context, in *MsgCancelFeedOwnershipTransfer, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/CancelFeedOwnershipTransferTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseFeedTx(ctx context.Context, in *MsgPauseFeed, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/PauseFeedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpauseFeedTx(ctx context.Context, in *MsgUnpauseFeed, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/UnpauseFeedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeprecateFeedTx(ctx context.Context, in *MsgDeprecateFeed, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/DeprecateFeedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteFeedTx(ctx context.Context, in *MsgDeleteFeed, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/DeleteFeedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundFeedTx(ctx context.Context, in *MsgFundFeed, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/FundFeedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFeedFundsTx(ctx context.Context, in *MsgWithdrawFeedFunds, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/WithdrawFeedFundsTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawPaymentTx(ctx context.Context, in *MsgWithdrawPayment, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/WithdrawPaymentTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAccountTx(ctx context.Context, in *MsgAccount, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/AddAccountTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EditAccountTx(ctx context.Context, in *MsgEditAccount, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/chainlink.v1beta.Msg/EditAccountTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SubmitFeedDataTx(context.Context, *MsgFeedData) (*MsgResponse, error)
+	AddModuleOwnerTx(context.Context, *MsgModuleOwner) (*MsgResponse, error)
+	ModuleOwnershipTransferTx(context.Context, *MsgModuleOwnershipTransfer) (*MsgResponse, error)
+	AcceptModuleOwnershipTx(context.Context, *MsgAcceptModuleOwnership) (*MsgResponse, error)
+	CancelModuleOwnershipTransferTx(context.Context, *MsgCancelModuleOwnershipTransfer) (*MsgResponse, error)
+	RemoveModuleOwnerTx(context.Context, *MsgRemoveModuleOwner) (*MsgResponse, error)
+	ApproveOwnerProposalTx(context.Context, *MsgApproveOwnerProposal) (*MsgResponse, error)
+	CancelOwnerProposalTx(context.Context, *MsgCancelOwnerProposal) (*MsgResponse, error)
+	AddFeedTx(context.Context, *MsgFeed) (*MsgResponse, error)
+	AddDataProviderTx(context.Context, *MsgAddDataProvider) (*MsgResponse, error)
+	RemoveDataProviderTx(context.Context, *MsgRemoveDataProvider) (*MsgResponse, error)
+	AddTransmitterTx(context.Context, *MsgAddTransmitter) (*MsgResponse, error)
+	RemoveTransmitterTx(context.Context, *MsgRemoveTransmitter) (*MsgResponse, error)
+	SetSubmissionCountTx(context.Context, *MsgSetSubmissionCount) (*MsgResponse, error)
+	SetHeartbeatTriggerTx(context.Context, *MsgSetHeartbeatTrigger) (*MsgResponse, error)
+	SetDeviationThresholdTriggerTx(context.Context, *MsgSetDeviationThresholdTrigger) (*MsgResponse, error)
+	SetFeedRewardTx(context.Context, *MsgSetFeedReward) (*MsgResponse, error)
+	SetFeedMetadataTx(context.Context, *MsgSetFeedMetadata) (*MsgResponse, error)
+	SetAnswerBoundsTx(context.Context, *MsgSetAnswerBounds) (*MsgResponse, error)
+	SetOCRConfigTx(context.Context, *MsgSetOCRConfig) (*MsgResponse, error)
+	RequestNewRoundTx(context.Context, *MsgRequestNewRound) (*MsgResponse, error)
+	FeedOwnershipTransferTx(context.Context, *MsgFeedOwnershipTransfer) (*MsgResponse, error)
+	AcceptFeedOwnershipTx(context.Context, *MsgAcceptFeedOwnership) (*MsgResponse, error)
+	CancelFeedOwnershipTransferTx(context.Context, *MsgCancelFeedOwnershipTransfer) (*MsgResponse, error)
+	PauseFeedTx(context.Context, *MsgPauseFeed) (*MsgResponse, error)
+	UnpauseFeedTx(context.Context, *MsgUnpauseFeed) (*MsgResponse, error)
+	DeprecateFeedTx(context.Context, *MsgDeprecateFeed) (*MsgResponse, error)
+	DeleteFeedTx(context.Context, *MsgDeleteFeed) (*MsgResponse, error)
+	FundFeedTx(context.Context, *MsgFundFeed) (*MsgResponse, error)
+	WithdrawFeedFundsTx(context.Context, *MsgWithdrawFeedFunds) (*MsgResponse, error)
+	WithdrawPaymentTx(context.Context, *MsgWithdrawPayment) (*MsgResponse, error)
+	AddAccountTx(context.Context, *MsgAccount) (*MsgResponse, error)
+	EditAccountTx(context.Context, *MsgEditAccount) (*MsgResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SubmitFeedDataTx(ctx context.Context, req *MsgFeedData) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedDataTx not implemented")
+}
+func (*UnimplementedMsgServer) AddModuleOwnerTx(ctx context.Context, req *MsgModuleOwner) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddModuleOwnerTx not implemented")
+}
+func (*UnimplementedMsgServer) ModuleOwnershipTransferTx(ctx context.Context, req *MsgModuleOwnershipTransfer) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleOwnershipTransferTx not implemented")
+}
+func (*UnimplementedMsgServer) AcceptModuleOwnershipTx(ctx context.Context, req *MsgAcceptModuleOwnership) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptModuleOwnershipTx not implemented")
+}
+func (*UnimplementedMsgServer) CancelModuleOwnershipTransferTx(ctx context.Context, req *MsgCancelModuleOwnershipTransfer) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelModuleOwnershipTransferTx not implemented")
+}
+func (*UnimplementedMsgServer) RemoveModuleOwnerTx(ctx context.Context, req *MsgRemoveModuleOwner) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveModuleOwnerTx not implemented")
+}
+func (*UnimplementedMsgServer) ApproveOwnerProposalTx(ctx context.Context, req *MsgApproveOwnerProposal) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOwnerProposalTx not implemented")
+}
+func (*UnimplementedMsgServer) CancelOwnerProposalTx(ctx context.Context, req *MsgCancelOwnerProposal) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOwnerProposalTx not implemented")
+}
+func (*UnimplementedMsgServer) AddFeedTx(ctx context.Context, req *MsgFeed) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFeedTx not implemented")
+}
+func (*UnimplementedMsgServer) AddDataProviderTx(ctx context.Context, req *MsgAddDataProvider) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDataProviderTx not implemented")
+}
+func (*UnimplementedMsgServer) RemoveDataProviderTx(ctx context.Context, req *MsgRemoveDataProvider) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDataProviderTx not implemented")
+}
+func (*UnimplementedMsgServer) AddTransmitterTx(ctx context.Context, req *MsgAddTransmitter) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTransmitterTx not implemented")
+}
+func (*UnimplementedMsgServer) RemoveTransmitterTx(ctx context.Context, req *MsgRemoveTransmitter) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransmitterTx not implemented")
+}
+func (*UnimplementedMsgServer) SetSubmissionCountTx(ctx context.Context, req *MsgSetSubmissionCount) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubmissionCountTx not implemented")
+}
+func (*UnimplementedMsgServer) SetHeartbeatTriggerTx(ctx context.Context, req *MsgSetHeartbeatTrigger) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHeartbeatTriggerTx not implemented")
+}
+func (*UnimplementedMsgServer) SetDeviationThresholdTriggerTx(ctx context.Context, req *MsgSetDeviationThresholdTrigger) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeviationThresholdTriggerTx not implemented")
+}
+func (*UnimplementedMsgServer) SetFeedRewardTx(ctx context.Context, req *MsgSetFeedReward) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeedRewardTx not implemented")
+}
+func (*UnimplementedMsgServer) SetFeedMetadataTx(ctx context.Context, req *MsgSetFeedMetadata) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeedMetadataTx not implemented")
+}
+func (*UnimplementedMsgServer) SetAnswerBoundsTx(ctx context.Context, req *MsgSetAnswerBounds) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnswerBoundsTx not implemented")
+}
+func (*UnimplementedMsgServer) SetOCRConfigTx(ctx context.Context, req *MsgSetOCRConfig) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOCRConfigTx not implemented")
+}
+func (*UnimplementedMsgServer) RequestNewRoundTx(ctx context.Context, req *MsgRequestNewRound) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestNewRoundTx not implemented")
+}
+func (*UnimplementedMsgServer) FeedOwnershipTransferTx(ctx context.Context, req *MsgFeedOwnershipTransfer) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedOwnershipTransferTx not implemented")
+}
+func (*UnimplementedMsgServer) AcceptFeedOwnershipTx(ctx context.Context, req *MsgAcceptFeedOwnership) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFeedOwnershipTx not implemented")
+}
+func (*UnimplementedMsgServer) CancelFeedOwnershipTransferTx(ctx context.Context, req *MsgCancelFeedOwnershipTransfer) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFeedOwnershipTransferTx not implemented")
+}
+func (*UnimplementedMsgServer) PauseFeedTx(ctx context.Context, req *MsgPauseFeed) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseFeedTx not implemented")
+}
+func (*UnimplementedMsgServer) UnpauseFeedTx(ctx context.Context, req *MsgUnpauseFeed) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpauseFeedTx not implemented")
+}
+func (*UnimplementedMsgServer) DeprecateFeedTx(ctx context.Context, req *MsgDeprecateFeed) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateFeedTx not implemented")
+}
+func (*UnimplementedMsgServer) DeleteFeedTx(ctx context.Context, req *MsgDeleteFeed) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeedTx not implemented")
+}
+func (*UnimplementedMsgServer) FundFeedTx(ctx context.Context, req *MsgFundFeed) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundFeedTx not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFeedFundsTx(ctx context.Context, req *MsgWithdrawFeedFunds) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeedFundsTx not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawPaymentTx(ctx context.Context, req *MsgWithdrawPayment) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPaymentTx not implemented")
+}
+func (*UnimplementedMsgServer) AddAccountTx(ctx context.Context, req *MsgAccount) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAccountTx not implemented")
+}
+func (*UnimplementedMsgServer) EditAccountTx(ctx context.Context, req *MsgEditAccount) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAccountTx not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SubmitFeedDataTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFeedData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFeedDataTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SubmitFeedDataTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFeedDataTx(ctx, req.(*MsgFeedData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddModuleOwnerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModuleOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddModuleOwnerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/AddModuleOwnerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddModuleOwnerTx(ctx, req.(*MsgModuleOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ModuleOwnershipTransferTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgModuleOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModuleOwnershipTransferTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/ModuleOwnershipTransferTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModuleOwnershipTransferTx(ctx, req.(*MsgModuleOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptModuleOwnershipTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptModuleOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptModuleOwnershipTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/AcceptModuleOwnershipTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptModuleOwnershipTx(ctx, req.(*MsgAcceptModuleOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelModuleOwnershipTransferTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelModuleOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelModuleOwnershipTransferTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/CancelModuleOwnershipTransferTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelModuleOwnershipTransferTx(ctx, req.(*MsgCancelModuleOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveModuleOwnerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveModuleOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveModuleOwnerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/RemoveModuleOwnerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveModuleOwnerTx(ctx, req.(*MsgRemoveModuleOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveOwnerProposalTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveOwnerProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveOwnerProposalTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/ApproveOwnerProposalTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveOwnerProposalTx(ctx, req.(*MsgApproveOwnerProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelOwnerProposalTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelOwnerProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelOwnerProposalTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/CancelOwnerProposalTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelOwnerProposalTx(ctx, req.(*MsgCancelOwnerProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddFeedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFeedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/AddFeedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFeedTx(ctx, req.(*MsgFeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddDataProviderTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDataProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddDataProviderTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/AddDataProviderTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDataProviderTx(ctx, req.(*MsgAddDataProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveDataProviderTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveDataProvider)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveDataProviderTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/RemoveDataProviderTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveDataProviderTx(ctx, req.(*MsgRemoveDataProvider))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddTransmitterTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddTransmitter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddTransmitterTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/AddTransmitterTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddTransmitterTx(ctx, req.(*MsgAddTransmitter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveTransmitterTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveTransmitter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveTransmitterTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/RemoveTransmitterTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveTransmitterTx(ctx, req.(*MsgRemoveTransmitter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSubmissionCountTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSubmissionCount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSubmissionCountTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SetSubmissionCountTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSubmissionCountTx(ctx, req.(*MsgSetSubmissionCount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetHeartbeatTriggerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHeartbeatTrigger)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHeartbeatTriggerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SetHeartbeatTriggerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHeartbeatTriggerTx(ctx, req.(*MsgSetHeartbeatTrigger))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDeviationThresholdTriggerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDeviationThresholdTrigger)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDeviationThresholdTriggerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SetDeviationThresholdTriggerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDeviationThresholdTriggerTx(ctx, req.(*MsgSetDeviationThresholdTrigger))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeedRewardTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeedReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeedRewardTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SetFeedRewardTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeedRewardTx(ctx, req.(*MsgSetFeedReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeedMetadataTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeedMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeedMetadataTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SetFeedMetadataTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeedMetadataTx(ctx, req.(*MsgSetFeedMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAnswerBoundsTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAnswerBounds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAnswerBoundsTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SetAnswerBoundsTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAnswerBoundsTx(ctx, req.(*MsgSetAnswerBounds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetOCRConfigTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOCRConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOCRConfigTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/SetOCRConfigTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOCRConfigTx(ctx, req.(*MsgSetOCRConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestNewRoundTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestNewRound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestNewRoundTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/RequestNewRoundTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestNewRoundTx(ctx, req.(*MsgRequestNewRound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FeedOwnershipTransferTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFeedOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FeedOwnershipTransferTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/FeedOwnershipTransferTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FeedOwnershipTransferTx(ctx, req.(*MsgFeedOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptFeedOwnershipTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptFeedOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptFeedOwnershipTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/AcceptFeedOwnershipTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptFeedOwnershipTx(ctx, req.(*MsgAcceptFeedOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelFeedOwnershipTransferTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelFeedOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelFeedOwnershipTransferTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/CancelFeedOwnershipTransferTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelFeedOwnershipTransferTx(ctx, req.(*MsgCancelFeedOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseFeedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseFeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseFeedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/PauseFeedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseFeedTx(ctx, req.(*MsgPauseFeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpauseFeedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpauseFeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpauseFeedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/UnpauseFeedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpauseFeedTx(ctx, req.(*MsgUnpauseFeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeprecateFeedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprecateFeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateFeedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/DeprecateFeedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateFeedTx(ctx, req.(*MsgDeprecateFeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteFeedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteFeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteFeedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/DeleteFeedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteFeedTx(ctx, req.(*MsgDeleteFeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundFeedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundFeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundFeedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/FundFeedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundFeedTx(ctx, req.(*MsgFundFeed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFeedFundsTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeedFunds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeedFundsTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/WithdrawFeedFundsTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeedFundsTx(ctx, req.(*MsgWithdrawFeedFunds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawPaymentTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawPaymentTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/WithdrawPaymentTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawPaymentTx(ctx, req.(*MsgWithdrawPayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAccountTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAccountTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/AddAccountTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAccountTx(ctx, req.(*MsgAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EditAccountTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EditAccountTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainlink.v1beta.Msg/EditAccountTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditAccountTx(ctx, req.(*MsgEditAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "chainlink.v1beta.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitFeedDataTx",
+			Handler:    _Msg_SubmitFeedDataTx_Handler,
+		},
+		{
+			MethodName: "AddModuleOwnerTx",
+			Handler:    _Msg_AddModuleOwnerTx_Handler,
+		},
+		{
+			MethodName: "ModuleOwnershipTransferTx",
+			Handler:    _Msg_ModuleOwnershipTransferTx_Handler,
+		},
+		{
+			MethodName: "AcceptModuleOwnershipTx",
+			Handler:    _Msg_AcceptModuleOwnershipTx_Handler,
+		},
+		{
+			MethodName: "CancelModuleOwnershipTransferTx",
+			Handler:    _Msg_CancelModuleOwnershipTransferTx_Handler,
+		},
+		{
+			MethodName: "RemoveModuleOwnerTx",
+			Handler:    _Msg_RemoveModuleOwnerTx_Handler,
+		},
+		{
+			MethodName: "ApproveOwnerProposalTx",
+			Handler:    _Msg_ApproveOwnerProposalTx_Handler,
+		},
+		{
+			MethodName: "CancelOwnerProposalTx",
+			Handler:    _Msg_CancelOwnerProposalTx_Handler,
+		},
+		{
+			MethodName: "AddFeedTx",
+			Handler:    _Msg_AddFeedTx_Handler,
+		},
+		{
+			MethodName: "AddDataProviderTx",
+			Handler:    _Msg_AddDataProviderTx_Handler,
+		},
+		{
+			MethodName: "RemoveDataProviderTx",
+			Handler:    _Msg_RemoveDataProviderTx_Handler,
+		},
+		{
+			MethodName: "AddTransmitterTx",
+			Handler:    _Msg_AddTransmitterTx_Handler,
+		},
+		{
+			MethodName: "RemoveTransmitterTx",
+			Handler:    _Msg_RemoveTransmitterTx_Handler,
+		},
+		{
+			MethodName: "SetSubmissionCountTx",
+			Handler:    _Msg_SetSubmissionCountTx_Handler,
+		},
+		{
+			MethodName: "SetHeartbeatTriggerTx",
+			Handler:    _Msg_SetHeartbeatTriggerTx_Handler,
+		},
+		{
+			MethodName: "SetDeviationThresholdTriggerTx",
+			Handler:    _Msg_SetDeviationThresholdTriggerTx_Handler,
+		},
+		{
+			MethodName: "SetFeedRewardTx",
+			Handler:    _Msg_SetFeedRewardTx_Handler,
+		},
+		{
+			MethodName: "SetFeedMetadataTx",
+			Handler:    _Msg_SetFeedMetadataTx_Handler,
+		},
+		{
+			MethodName: "SetAnswerBoundsTx",
+			Handler:    _Msg_SetAnswerBoundsTx_Handler,
+		},
+		{
+			MethodName: "SetOCRConfigTx",
+			Handler:    _Msg_SetOCRConfigTx_Handler,
+		},
+		{
+			MethodName: "RequestNewRoundTx",
+			Handler:    _Msg_RequestNewRoundTx_Handler,
+		},
+		{
+			MethodName: "FeedOwnershipTransferTx",
+			Handler:    _Msg_FeedOwnershipTransferTx_Handler,
+		},
+		{
+			MethodName: "AcceptFeedOwnershipTx",
+			Handler:    _Msg_AcceptFeedOwnershipTx_Handler,
+		},
+		{
+			MethodName: "CancelFeedOwnershipTransferTx",
+			Handler:    _Msg_CancelFeedOwnershipTransferTx_Handler,
+		},
+		{
+			MethodName: "PauseFeedTx",
+			Handler:    _Msg_PauseFeedTx_Handler,
+		},
+		{
+			MethodName: "UnpauseFeedTx",
+			Handler:    _Msg_UnpauseFeedTx_Handler,
+		},
+		{
+			MethodName: "DeprecateFeedTx",
+			Handler:    _Msg_DeprecateFeedTx_Handler,
+		},
+		{
+			MethodName: "DeleteFeedTx",
+			Handler:    _Msg_DeleteFeedTx_Handler,
+		},
+		{
+			MethodName: "FundFeedTx",
+			Handler:    _Msg_FundFeedTx_Handler,
+		},
+		{
+			MethodName: "WithdrawFeedFundsTx",
+			Handler:    _Msg_WithdrawFeedFundsTx_Handler,
+		},
+		{
+			MethodName: "WithdrawPaymentTx",
+			Handler:    _Msg_WithdrawPaymentTx_Handler,
+		},
+		{
+			MethodName: "AddAccountTx",
+			Handler:    _Msg_AddAccountTx_Handler,
+		},
+		{
+			MethodName: "EditAccountTx",
+			Handler:    _Msg_EditAccountTx_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chainlink/v1beta/tx.proto",
+}
+
+func (m *MsgModuleOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgModuleOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgModuleOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewModuleOwnerPubKey) > 0 {
+		i -= len(m.NewModuleOwnerPubKey)
+		copy(dAtA[i:], m.NewModuleOwnerPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewModuleOwnerPubKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewModuleOwnerAddress) > 0 {
+		i -= len(m.NewModuleOwnerAddress)
+		copy(dAtA[i:], m.NewModuleOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewModuleOwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssignerAddress) > 0 {
+		i -= len(m.AssignerAddress)
+		copy(dAtA[i:], m.AssignerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptModuleOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptModuleOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptModuleOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssignerAddress) > 0 {
+		i -= len(m.AssignerAddress)
+		copy(dAtA[i:], m.AssignerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssignerAddress) > 0 {
+		i -= len(m.AssignerAddress)
+		copy(dAtA[i:], m.AssignerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ProposedAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposedAtHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewOwnerPubKey) > 0 {
+		i -= len(m.NewOwnerPubKey)
+		copy(dAtA[i:], m.NewOwnerPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwnerPubKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveModuleOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveModuleOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveModuleOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssignerAddress) > 0 {
+		i -= len(m.AssignerAddress)
+		copy(dAtA[i:], m.AssignerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveOwnerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveOwnerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveOwnerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelOwnerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelOwnerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelOwnerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SubmittedAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubmittedAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RemoveModuleOwner != nil {
+		{
+			size, err := m.RemoveModuleOwner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ModuleOwner != nil {
+		{
+			size, err := m.ModuleOwner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Feed != nil {
+		{
+			size, err := m.Feed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InsufficientFundsPolicy) > 0 {
+		i -= len(m.InsufficientFundsPolicy)
+		copy(dAtA[i:], m.InsufficientFundsPolicy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InsufficientFundsPolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MintRewards {
+		i--
+		if m.MintRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ValueSchema != nil {
+		{
+			size, err := m.ValueSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Transmitters) > 0 {
+		for iNdEx := len(m.Transmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transmitters[iNdEx])
+			copy(dAtA[i:], m.Transmitters[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Transmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.AnswerBounds != nil {
+		{
+			size, err := m.AnswerBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.DeviationThresholdPolicy) > 0 {
+		i -= len(m.DeviationThresholdPolicy)
+		copy(dAtA[i:], m.DeviationThresholdPolicy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeviationThresholdPolicy)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Desc) > 0 {
+		i -= len(m.Desc)
+		copy(dAtA[i:], m.Desc)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Desc)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.FeedReward != nil {
+		{
+			size, err := m.FeedReward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ModuleOwnerAddress) > 0 {
+		i -= len(m.ModuleOwnerAddress)
+		copy(dAtA[i:], m.ModuleOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleOwnerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DeviationThresholdTrigger != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeviationThresholdTrigger))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HeartbeatTrigger != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HeartbeatTrigger))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SubmissionCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubmissionCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DataProviders) > 0 {
+		for iNdEx := len(m.DataProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeedOwner) > 0 {
+		i -= len(m.FeedOwner)
+		copy(dAtA[i:], m.FeedOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AnswerBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AnswerBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AnswerBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.MaxAnswer.Size()
+		i -= size
+		if _, err := m.MaxAnswer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinAnswer.Size()
+		i -= size
+		if _, err := m.MinAnswer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedValueSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedValueSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedValueSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TupleSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TupleSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tuple) > 0 {
+		for iNdEx := len(m.Tuple) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Tuple[iNdEx].Size()
+				i -= size
+				if _, err := m.Tuple[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Bytes) > 0 {
+		i -= len(m.Bytes)
+		copy(dAtA[i:], m.Bytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Bytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Numeric.Size()
+		i -= size
+		if _, err := m.Numeric.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.QuoteAsset) > 0 {
+		i -= len(m.QuoteAsset)
+		copy(dAtA[i:], m.QuoteAsset)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.QuoteAsset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseAsset) > 0 {
+		i -= len(m.BaseAsset)
+		copy(dAtA[i:], m.BaseAsset)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BaseAsset)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedRewardSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedRewardSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedRewardSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDataProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDataProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDataProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DataProvider != nil {
+		{
+			size, err := m.DataProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveDataProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveDataProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveDataProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddTransmitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddTransmitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddTransmitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveTransmitter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveTransmitter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveTransmitter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSubmissionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSubmissionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSubmissionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SubmissionCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SubmissionCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetHeartbeatTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetHeartbeatTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHeartbeatTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HeartbeatTrigger != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HeartbeatTrigger))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDeviationThresholdTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDeviationThresholdTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDeviationThresholdTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviationThresholdPolicy) > 0 {
+		i -= len(m.DeviationThresholdPolicy)
+		copy(dAtA[i:], m.DeviationThresholdPolicy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeviationThresholdPolicy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DeviationThresholdTrigger != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeviationThresholdTrigger))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeedReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeedReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeedReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FeedReward != nil {
+		{
+			size, err := m.FeedReward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeedMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeedMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeedMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAnswerBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAnswerBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAnswerBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AnswerBounds != nil {
+		{
+			size, err := m.AnswerBounds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOCRConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOCRConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOCRConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.OffchainConfig) > 0 {
+		i -= len(m.OffchainConfig)
+		copy(dAtA[i:], m.OffchainConfig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OffchainConfig)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OffchainConfigVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OffchainConfigVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OnchainConfig) > 0 {
+		i -= len(m.OnchainConfig)
+		copy(dAtA[i:], m.OnchainConfig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OnchainConfig)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.F != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.F))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Transmitters) > 0 {
+		for iNdEx := len(m.Transmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transmitters[iNdEx])
+			copy(dAtA[i:], m.Transmitters[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Transmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningKeys) > 0 {
+		for iNdEx := len(m.SigningKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigningKeys[iNdEx])
+			copy(dAtA[i:], m.SigningKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SigningKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCRConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCRConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCRConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.ConfigDigest) > 0 {
+		i -= len(m.ConfigDigest)
+		copy(dAtA[i:], m.ConfigDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConfigDigest)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ConfigCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ConfigCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.OffchainConfig) > 0 {
+		i -= len(m.OffchainConfig)
+		copy(dAtA[i:], m.OffchainConfig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OffchainConfig)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.OffchainConfigVersion != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OffchainConfigVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OnchainConfig) > 0 {
+		i -= len(m.OnchainConfig)
+		copy(dAtA[i:], m.OnchainConfig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OnchainConfig)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.F != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.F))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Transmitters) > 0 {
+		for iNdEx := len(m.Transmitters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Transmitters[iNdEx])
+			copy(dAtA[i:], m.Transmitters[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Transmitters[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SigningKeys) > 0 {
+		for iNdEx := len(m.SigningKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SigningKeys[iNdEx])
+			copy(dAtA[i:], m.SigningKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.SigningKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NewFeedOwnerAddress) > 0 {
+		i -= len(m.NewFeedOwnerAddress)
+		copy(dAtA[i:], m.NewFeedOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFeedOwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptFeedOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptFeedOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptFeedOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundFeed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundFeed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundFeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFeedFunds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeedFunds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeedFunds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedTombstone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedTombstone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedTombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DeletedAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DeletedAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastRoundId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LastRoundId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Desc) > 0 {
+		i -= len(m.Desc)
+		copy(dAtA[i:], m.Desc)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Desc)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeedOwner) > 0 {
+		i -= len(m.FeedOwner)
+		copy(dAtA[i:], m.FeedOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeedData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeedData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeedData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Report) > 0 {
+		i -= len(m.Report)
+		copy(dAtA[i:], m.Report)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Report)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TxFee != nil {
+		{
+			size, err := m.TxFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CosmosPubKeys) > 0 {
+		for iNdEx := len(m.CosmosPubKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CosmosPubKeys[iNdEx])
+			copy(dAtA[i:], m.CosmosPubKeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CosmosPubKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.IsFeedDataValid {
+		i--
+		if m.IsFeedDataValid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ObservationFeedDataSignatures) > 0 {
+		for iNdEx := len(m.ObservationFeedDataSignatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObservationFeedDataSignatures[iNdEx])
+			copy(dAtA[i:], m.ObservationFeedDataSignatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ObservationFeedDataSignatures[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ObservationFeedData) > 0 {
+		for iNdEx := len(m.ObservationFeedData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ObservationFeedData[iNdEx])
+			copy(dAtA[i:], m.ObservationFeedData[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ObservationFeedData[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestNewRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestNewRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestNewRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PiggyAddress) > 0 {
+		i -= len(m.PiggyAddress)
+		copy(dAtA[i:], m.PiggyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PiggyAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChainlinkSigningKey) > 0 {
+		i -= len(m.ChainlinkSigningKey)
+		copy(dAtA[i:], m.ChainlinkSigningKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainlinkSigningKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainlinkPublicKey) > 0 {
+		i -= len(m.ChainlinkPublicKey)
+		copy(dAtA[i:], m.ChainlinkPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainlinkPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEditAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PiggyAddress) > 0 {
+		i -= len(m.PiggyAddress)
+		copy(dAtA[i:], m.PiggyAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PiggyAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwedPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwedPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwedPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Oracle) > 0 {
+		i -= len(m.Oracle)
+		copy(dAtA[i:], m.Oracle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Oracle)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCRAbiEncoded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCRAbiEncoded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCRAbiEncoded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Observations) > 0 {
+		for iNdEx := len(m.Observations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Observations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Oracles) > 0 {
+		i -= len(m.Oracles)
+		copy(dAtA[i:], m.Oracles)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Oracles)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Observation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Observation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Observation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeedValue != nil {
+		{
+			size, err := m.FeedValue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCRFeedDataInStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCRFeedDataInStore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCRFeedDataInStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Rewardable {
+		i--
+		if m.Rewardable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Deviation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Deviation))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.AnsweredInRound != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AnsweredInRound))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Answer.Size()
+		i -= size
+		if _, err := m.Answer.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.RoundId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RoundId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DeserializedOCRReport != nil {
+		{
+			size, err := m.DeserializedOCRReport.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FeedData != nil {
+		{
+			size, err := m.FeedData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Coin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Coin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Coin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgModuleOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssignerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewModuleOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewModuleOwnerPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptModuleOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssignerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelModuleOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssignerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwnerPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposedAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ProposedAtHeight))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *MsgRemoveModuleOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AssignerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveOwnerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelOwnerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	if m.Feed != nil {
+		l = m.Feed.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ModuleOwner != nil {
+		l = m.ModuleOwner.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RemoveModuleOwner != nil {
+		l = m.RemoveModuleOwner.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, b := range m.Approvals {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SubmittedAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.SubmittedAtHeight))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *MsgFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeedOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.DataProviders) > 0 {
+		for _, e := range m.DataProviders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SubmissionCount != 0 {
+		n += 1 + sovTx(uint64(m.SubmissionCount))
+	}
+	if m.HeartbeatTrigger != 0 {
+		n += 1 + sovTx(uint64(m.HeartbeatTrigger))
+	}
+	if m.DeviationThresholdTrigger != 0 {
+		n += 1 + sovTx(uint64(m.DeviationThresholdTrigger))
+	}
+	l = len(m.ModuleOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeedReward != nil {
+		l = m.FeedReward.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeviationThresholdPolicy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	if m.AnswerBounds != nil {
+		l = m.AnswerBounds.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Transmitters) > 0 {
+		for _, b := range m.Transmitters {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ValueSchema != nil {
+		l = m.ValueSchema.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.MintRewards {
+		n += 3
+	}
+	l = len(m.InsufficientFundsPolicy)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *AnswerBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinAnswer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAnswer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedValueSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TupleSize != 0 {
+		n += 1 + sovTx(uint64(m.TupleSize))
+	}
+	return n
+}
+
+func (m *FeedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Numeric.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Bytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tuple) > 0 {
+		for _, e := range m.Tuple {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeedMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	l = len(m.BaseAsset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteAsset)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedRewardSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DataProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddDataProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DataProvider != nil {
+		l = m.DataProvider.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveDataProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddTransmitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveTransmitter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSubmissionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SubmissionCount != 0 {
+		n += 1 + sovTx(uint64(m.SubmissionCount))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetHeartbeatTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HeartbeatTrigger != 0 {
+		n += 1 + sovTx(uint64(m.HeartbeatTrigger))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDeviationThresholdTrigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeviationThresholdTrigger != 0 {
+		n += 1 + sovTx(uint64(m.DeviationThresholdTrigger))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeviationThresholdPolicy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeedReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeedReward != nil {
+		l = m.FeedReward.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFeedMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAnswerBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AnswerBounds != nil {
+		l = m.AnswerBounds.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetOCRConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SigningKeys) > 0 {
+		for _, b := range m.SigningKeys {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Transmitters) > 0 {
+		for _, b := range m.Transmitters {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.F != 0 {
+		n += 1 + sovTx(uint64(m.F))
+	}
+	l = len(m.OnchainConfig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OffchainConfigVersion != 0 {
+		n += 1 + sovTx(uint64(m.OffchainConfigVersion))
+	}
+	l = len(m.OffchainConfig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OCRConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SigningKeys) > 0 {
+		for _, b := range m.SigningKeys {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Transmitters) > 0 {
+		for _, b := range m.Transmitters {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.F != 0 {
+		n += 1 + sovTx(uint64(m.F))
+	}
+	l = len(m.OnchainConfig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OffchainConfigVersion != 0 {
+		n += 1 + sovTx(uint64(m.OffchainConfigVersion))
+	}
+	l = len(m.OffchainConfig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConfigCount != 0 {
+		n += 1 + sovTx(uint64(m.ConfigCount))
+	}
+	l = len(m.ConfigDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumber))
+	}
+	return n
+}
+
+func (m *MsgFeedOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFeedOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptFeedOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelFeedOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeprecateFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundFeed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeedFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedTombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeedOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Desc)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LastRoundId != 0 {
+		n += 1 + sovTx(uint64(m.LastRoundId))
+	}
+	if m.DeletedAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.DeletedAtHeight))
+	}
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFeedData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ObservationFeedData) > 0 {
+		for _, b := range m.ObservationFeedData {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ObservationFeedDataSignatures) > 0 {
+		for _, b := range m.ObservationFeedDataSignatures {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.IsFeedDataValid {
+		n += 2
+	}
+	if len(m.CosmosPubKeys) > 0 {
+		for _, b := range m.CosmosPubKeys {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.TxFee != nil {
+		l = m.TxFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Report)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestNewRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainlinkPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainlinkSigningKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PiggyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PiggyAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OwedPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Oracle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OCRAbiEncoded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Oracles)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Observations) > 0 {
+		for _, e := range m.Observations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Observation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FeedValue != nil {
+		l = m.FeedValue.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *OCRFeedDataInStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeedData != nil {
+		l = m.FeedData.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DeserializedOCRReport != nil {
+		l = m.DeserializedOCRReport.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RoundId != 0 {
+		n += 1 + sovTx(uint64(m.RoundId))
+	}
+	l = m.Answer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.StartedAt != 0 {
+		n += 1 + sovTx(uint64(m.StartedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTx(uint64(m.UpdatedAt))
+	}
+	if m.AnsweredInRound != 0 {
+		n += 1 + sovTx(uint64(m.AnsweredInRound))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.Deviation != 0 {
+		n += 1 + sovTx(uint64(m.Deviation))
+	}
+	if m.Rewardable {
+		n += 2
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *Coin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgModuleOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgModuleOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignerAddress = append(m.AssignerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssignerAddress == nil {
+				m.AssignerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewModuleOwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewModuleOwnerAddress = append(m.NewModuleOwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewModuleOwnerAddress == nil {
+				m.NewModuleOwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewModuleOwnerPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewModuleOwnerPubKey = append(m.NewModuleOwnerPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewModuleOwnerPubKey == nil {
+				m.NewModuleOwnerPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptModuleOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptModuleOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptModuleOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignerAddress = append(m.AssignerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssignerAddress == nil {
+				m.AssignerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelModuleOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelModuleOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignerAddress = append(m.AssignerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssignerAddress == nil {
+				m.AssignerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = append(m.NewOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwner == nil {
+				m.NewOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerPubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwnerPubKey = append(m.NewOwnerPubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewOwnerPubKey == nil {
+				m.NewOwnerPubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedAtHeight", wireType)
+			}
+			m.ProposedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveModuleOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveModuleOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveModuleOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignerAddress = append(m.AssignerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssignerAddress == nil {
+				m.AssignerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveOwnerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveOwnerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveOwnerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelOwnerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelOwnerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelOwnerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Feed == nil {
+				m.Feed = &MsgFeed{}
+			}
+			if err := m.Feed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModuleOwner == nil {
+				m.ModuleOwner = &MsgModuleOwner{}
+			}
+			if err := m.ModuleOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveModuleOwner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemoveModuleOwner == nil {
+				m.RemoveModuleOwner = &MsgRemoveModuleOwner{}
+			}
+			if err := m.RemoveModuleOwner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, make([]byte, postIndex-iNdEx))
+			copy(m.Approvals[len(m.Approvals)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmittedAtHeight", wireType)
+			}
+			m.SubmittedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmittedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeedOwner == nil {
+				m.FeedOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataProviders = append(m.DataProviders, &DataProvider{})
+			if err := m.DataProviders[len(m.DataProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionCount", wireType)
+			}
+			m.SubmissionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTrigger", wireType)
+			}
+			m.HeartbeatTrigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatTrigger |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThresholdTrigger", wireType)
+			}
+			m.DeviationThresholdTrigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationThresholdTrigger |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleOwnerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleOwnerAddress = append(m.ModuleOwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ModuleOwnerAddress == nil {
+				m.ModuleOwnerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeedReward == nil {
+				m.FeedReward = &FeedRewardSchema{}
+			}
+			if err := m.FeedReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThresholdPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviationThresholdPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &FeedMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnswerBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AnswerBounds == nil {
+				m.AnswerBounds = &AnswerBounds{}
+			}
+			if err := m.AnswerBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitters = append(m.Transmitters, make([]byte, postIndex-iNdEx))
+			copy(m.Transmitters[len(m.Transmitters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueSchema == nil {
+				m.ValueSchema = &FeedValueSchema{}
+			}
+			if err := m.ValueSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintRewards = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsufficientFundsPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsufficientFundsPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnswerBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnswerBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnswerBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAnswer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnswer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedValueSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedValueSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedValueSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TupleSize", wireType)
+			}
+			m.TupleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TupleSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numeric", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Numeric.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tuple", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Tuple = append(m.Tuple, v)
+			if err := m.Tuple[len(m.Tuple)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteAsset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteAsset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedRewardSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedRewardSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedRewardSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDataProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDataProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDataProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataProvider == nil {
+				m.DataProvider = &DataProvider{}
+			}
+			if err := m.DataProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveDataProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveDataProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveDataProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddTransmitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddTransmitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddTransmitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveTransmitter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveTransmitter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveTransmitter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSubmissionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSubmissionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSubmissionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionCount", wireType)
+			}
+			m.SubmissionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHeartbeatTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHeartbeatTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHeartbeatTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTrigger", wireType)
+			}
+			m.HeartbeatTrigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeartbeatTrigger |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDeviationThresholdTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDeviationThresholdTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDeviationThresholdTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThresholdTrigger", wireType)
+			}
+			m.DeviationThresholdTrigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeviationThresholdTrigger |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThresholdPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviationThresholdPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeedReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeedReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeedReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeedReward == nil {
+				m.FeedReward = &FeedRewardSchema{}
+			}
+			if err := m.FeedReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeedMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeedMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeedMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &FeedMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAnswerBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAnswerBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAnswerBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnswerBounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AnswerBounds == nil {
+				m.AnswerBounds = &AnswerBounds{}
+			}
+			if err := m.AnswerBounds.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOCRConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOCRConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOCRConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeys = append(m.SigningKeys, make([]byte, postIndex-iNdEx))
+			copy(m.SigningKeys[len(m.SigningKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitters = append(m.Transmitters, make([]byte, postIndex-iNdEx))
+			copy(m.Transmitters[len(m.Transmitters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OnchainConfig == nil {
+				m.OnchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
+			}
+			m.OffchainConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OffchainConfig == nil {
+				m.OffchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCRConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCRConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCRConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningKeys = append(m.SigningKeys, make([]byte, postIndex-iNdEx))
+			copy(m.SigningKeys[len(m.SigningKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitters = append(m.Transmitters, make([]byte, postIndex-iNdEx))
+			copy(m.Transmitters[len(m.Transmitters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OnchainConfig == nil {
+				m.OnchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
+			}
+			m.OffchainConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OffchainConfig == nil {
+				m.OffchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
+			}
+			m.ConfigCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *Observation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *OCRFeedDataInStore) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FeedData != nil {
-		l = m.FeedData.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DeserializedOCRReport != nil {
-		l = m.DeserializedOCRReport.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RoundId != 0 {
-		n += 1 + sovTx(uint64(m.RoundId))
-	}
-	return n
-}
 
-func (m *Coin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3402,17 +13166,17 @@ func (m *MsgModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgModuleOwnershipTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeedOwnershipTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgModuleOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeedOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3422,29 +13186,27 @@ func (m *MsgModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignerAddress = append(m.AssignerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.AssignerAddress == nil {
-				m.AssignerAddress = []byte{}
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewModuleOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFeedOwnerAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3471,14 +13233,14 @@ func (m *MsgModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewModuleOwnerAddress = append(m.NewModuleOwnerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewModuleOwnerAddress == nil {
-				m.NewModuleOwnerAddress = []byte{}
+			m.NewFeedOwnerAddress = append(m.NewFeedOwnerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewFeedOwnerAddress == nil {
+				m.NewFeedOwnerAddress = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewModuleOwnerPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3505,9 +13267,9 @@ func (m *MsgModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewModuleOwnerPubKey = append(m.NewModuleOwnerPubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewModuleOwnerPubKey == nil {
-				m.NewModuleOwnerPubKey = []byte{}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3531,7 +13293,7 @@ func (m *MsgModuleOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFeed) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptFeedOwnership) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3554,10 +13316,10 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptFeedOwnership: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptFeedOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3594,7 +13356,7 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3621,69 +13383,66 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
-			if m.FeedOwner == nil {
-				m.FeedOwner = []byte{}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProviders", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataProviders = append(m.DataProviders, &DataProvider{})
-			if err := m.DataProviders[len(m.DataProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeedOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionCount", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SubmissionCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SubmissionCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTrigger", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeedOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeedOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			m.HeartbeatTrigger = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3693,33 +13452,27 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeartbeatTrigger |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThresholdTrigger", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.DeviationThresholdTrigger = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DeviationThresholdTrigger |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleOwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3746,16 +13499,66 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleOwnerAddress = append(m.ModuleOwnerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.ModuleOwnerAddress == nil {
-				m.ModuleOwnerAddress = []byte{}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseFeed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseFeed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseFeed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3765,33 +13568,29 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeedReward == nil {
-				m.FeedReward = &FeedRewardSchema{}
-			}
-			if err := m.FeedReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3801,23 +13600,25 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Desc = string(dAtA[iNdEx:postIndex])
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3840,7 +13641,7 @@ func (m *MsgFeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedRewardSchema) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseFeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3863,17 +13664,17 @@ func (m *FeedRewardSchema) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedRewardSchema: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseFeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedRewardSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseFeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			m.Amount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3883,16 +13684,29 @@ func (m *FeedRewardSchema) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3902,23 +13716,25 @@ func (m *FeedRewardSchema) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = string(dAtA[iNdEx:postIndex])
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3941,7 +13757,7 @@ func (m *FeedRewardSchema) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgDeprecateFeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3964,17 +13780,17 @@ func (m *DataProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeprecateFeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeprecateFeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3984,29 +13800,27 @@ func (m *DataProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4033,9 +13847,9 @@ func (m *DataProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4059,7 +13873,7 @@ func (m *DataProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddDataProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteFeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4082,10 +13896,10 @@ func (m *MsgAddDataProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddDataProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteFeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddDataProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteFeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4121,42 +13935,6 @@ func (m *MsgAddDataProvider) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProvider", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DataProvider == nil {
-				m.DataProvider = &DataProvider{}
-			}
-			if err := m.DataProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
@@ -4211,7 +13989,7 @@ func (m *MsgAddDataProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveDataProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgFundFeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4234,10 +14012,10 @@ func (m *MsgRemoveDataProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveDataProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundFeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveDataProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundFeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4273,10 +14051,10 @@ func (m *MsgRemoveDataProvider) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4286,26 +14064,11 @@ func (m *MsgRemoveDataProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -4361,7 +14124,7 @@ func (m *MsgRemoveDataProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetSubmissionCount) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFeedFunds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4384,10 +14147,10 @@ func (m *MsgSetSubmissionCount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetSubmissionCount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFeedFunds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetSubmissionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFeedFunds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4424,9 +14187,9 @@ func (m *MsgSetSubmissionCount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.SubmissionCount = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4436,7 +14199,7 @@ func (m *MsgSetSubmissionCount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmissionCount |= uint32(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4496,7 +14259,7 @@ func (m *MsgSetSubmissionCount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetHeartbeatTrigger) Unmarshal(dAtA []byte) error {
+func (m *FeedTombstone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4519,10 +14282,10 @@ func (m *MsgSetHeartbeatTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetHeartbeatTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedTombstone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetHeartbeatTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedTombstone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4558,10 +14321,131 @@ func (m *MsgSetHeartbeatTrigger) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedOwner", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedOwner = append(m.FeedOwner[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeedOwner == nil {
+				m.FeedOwner = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Desc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Desc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &FeedMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRoundId", wireType)
+			}
+			m.LastRoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRoundId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatTrigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAtHeight", wireType)
 			}
-			m.HeartbeatTrigger = 0
+			m.DeletedAtHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4571,14 +14455,14 @@ func (m *MsgSetHeartbeatTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HeartbeatTrigger |= uint32(b&0x7F) << shift
+				m.DeletedAtHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4605,9 +14489,9 @@ func (m *MsgSetHeartbeatTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signer == nil {
-				m.Signer = []byte{}
+			m.DeletedBy = append(m.DeletedBy[:0], dAtA[iNdEx:postIndex]...)
+			if m.DeletedBy == nil {
+				m.DeletedBy = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4631,7 +14515,7 @@ func (m *MsgSetHeartbeatTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetDeviationThresholdTrigger) Unmarshal(dAtA []byte) error {
+func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4654,10 +14538,10 @@ func (m *MsgSetDeviationThresholdTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetDeviationThresholdTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFeedData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetDeviationThresholdTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFeedData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4693,10 +14577,10 @@ func (m *MsgSetDeviationThresholdTrigger) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThresholdTrigger", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
-			m.DeviationThresholdTrigger = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4706,14 +14590,29 @@ func (m *MsgSetDeviationThresholdTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DeviationThresholdTrigger |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationFeedData", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4740,66 +14639,66 @@ func (m *MsgSetDeviationThresholdTrigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signer == nil {
-				m.Signer = []byte{}
-			}
+			m.ObservationFeedData = append(m.ObservationFeedData, make([]byte, postIndex-iNdEx))
+			copy(m.ObservationFeedData[len(m.ObservationFeedData)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationFeedDataSignatures", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetFeedReward) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ObservationFeedDataSignatures = append(m.ObservationFeedDataSignatures, make([]byte, postIndex-iNdEx))
+			copy(m.ObservationFeedDataSignatures[len(m.ObservationFeedDataSignatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsFeedDataValid", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetFeedReward: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetFeedReward: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsFeedDataValid = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosPubKeys", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4809,27 +14708,27 @@ func (m *MsgSetFeedReward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.CosmosPubKeys = append(m.CosmosPubKeys, make([]byte, postIndex-iNdEx))
+			copy(m.CosmosPubKeys[len(m.CosmosPubKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedReward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4856,16 +14755,16 @@ func (m *MsgSetFeedReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeedReward == nil {
-				m.FeedReward = &FeedRewardSchema{}
+			if m.TxFee == nil {
+				m.TxFee = &Coin{}
 			}
-			if err := m.FeedReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4892,9 +14791,9 @@ func (m *MsgSetFeedReward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signer == nil {
-				m.Signer = []byte{}
+			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
+			if m.Report == nil {
+				m.Report = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4918,7 +14817,7 @@ func (m *MsgSetFeedReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFeedOwnershipTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestNewRound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4941,10 +14840,10 @@ func (m *MsgFeedOwnershipTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFeedOwnershipTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestNewRound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFeedOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestNewRound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4979,40 +14878,6 @@ func (m *MsgFeedOwnershipTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFeedOwnerAddress", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewFeedOwnerAddress = append(m.NewFeedOwnerAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewFeedOwnerAddress == nil {
-				m.NewFeedOwnerAddress = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
@@ -5068,7 +14933,7 @@ func (m *MsgFeedOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
+func (m *MsgAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5085,51 +14950,19 @@ func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFeedData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFeedData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
@@ -5163,9 +14996,9 @@ func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
 				m.Submitter = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationFeedData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainlinkPublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5192,12 +15025,14 @@ func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationFeedData = append(m.ObservationFeedData, make([]byte, postIndex-iNdEx))
-			copy(m.ObservationFeedData[len(m.ObservationFeedData)-1], dAtA[iNdEx:postIndex])
+			m.ChainlinkPublicKey = append(m.ChainlinkPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainlinkPublicKey == nil {
+				m.ChainlinkPublicKey = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationFeedDataSignatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainlinkSigningKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5224,32 +15059,14 @@ func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationFeedDataSignatures = append(m.ObservationFeedDataSignatures, make([]byte, postIndex-iNdEx))
-			copy(m.ObservationFeedDataSignatures[len(m.ObservationFeedDataSignatures)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsFeedDataValid", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ChainlinkSigningKey = append(m.ChainlinkSigningKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ChainlinkSigningKey == nil {
+				m.ChainlinkSigningKey = []byte{}
 			}
-			m.IsFeedDataValid = bool(v != 0)
-		case 6:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosPubKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PiggyAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5276,43 +15093,9 @@ func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosPubKeys = append(m.CosmosPubKeys, make([]byte, postIndex-iNdEx))
-			copy(m.CosmosPubKeys[len(m.CosmosPubKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TxFee == nil {
-				m.TxFee = &Coin{}
-			}
-			if err := m.TxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PiggyAddress = append(m.PiggyAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.PiggyAddress == nil {
+				m.PiggyAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5336,7 +15119,7 @@ func (m *MsgFeedData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestNewRound) Unmarshal(dAtA []byte) error {
+func (m *MsgEditAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5359,17 +15142,17 @@ func (m *MsgRequestNewRound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestNewRound: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEditAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestNewRound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEditAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5379,27 +15162,29 @@ func (m *MsgRequestNewRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Submitter == nil {
+				m.Submitter = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PiggyAddress", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5426,9 +15211,9 @@ func (m *MsgRequestNewRound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signer == nil {
-				m.Signer = []byte{}
+			m.PiggyAddress = append(m.PiggyAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.PiggyAddress == nil {
+				m.PiggyAddress = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5452,7 +15237,7 @@ func (m *MsgRequestNewRound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAccount) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawPayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5475,17 +15260,17 @@ func (m *MsgAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawPayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawPayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5495,97 +15280,27 @@ func (m *MsgAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainlinkPublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainlinkPublicKey = append(m.ChainlinkPublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChainlinkPublicKey == nil {
-				m.ChainlinkPublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainlinkSigningKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainlinkSigningKey = append(m.ChainlinkSigningKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChainlinkSigningKey == nil {
-				m.ChainlinkSigningKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PiggyAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5612,9 +15327,9 @@ func (m *MsgAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PiggyAddress = append(m.PiggyAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.PiggyAddress == nil {
-				m.PiggyAddress = []byte{}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5638,7 +15353,7 @@ func (m *MsgAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditAccount) Unmarshal(dAtA []byte) error {
+func (m *OwedPayment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5661,15 +15376,47 @@ func (m *MsgEditAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwedPayment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwedPayment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5696,16 +15443,16 @@ func (m *MsgEditAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = append(m.Submitter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Submitter == nil {
-				m.Submitter = []byte{}
+			m.Oracle = append(m.Oracle[:0], dAtA[iNdEx:postIndex]...)
+			if m.Oracle == nil {
+				m.Oracle = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PiggyAddress", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5715,26 +15462,11 @@ func (m *MsgEditAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PiggyAddress = append(m.PiggyAddress[:0], dAtA[iNdEx:postIndex]...)
-			if m.PiggyAddress == nil {
-				m.PiggyAddress = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6072,6 +15804,76 @@ func (m *Observation) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeedValue == nil {
+				m.FeedValue = &FeedValue{}
+			}
+			if err := m.FeedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6213,6 +16015,191 @@ func (m *OCRFeedDataInStore) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnsweredInRound", wireType)
+			}
+			m.AnsweredInRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnsweredInRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			m.Deviation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deviation |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewardable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rewardable = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &FeedValue{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5,7 +5,13 @@ package types
 
 import (
 	"bytes"
+	"encoding/hex"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 type MsgModuleOwners []*MsgModuleOwner
@@ -51,6 +57,38 @@ func (dp DataProviders) Remove(addr sdk.Address) DataProviders {
 	return s
 }
 
+type Transmitters []sdk.AccAddress
+
+// Contains returns true if the given address exists in a slice of Transmitters.
+func (t Transmitters) Contains(addr sdk.Address) bool {
+	for _, acc := range t {
+		if acc.Equals(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (t Transmitters) Remove(addr sdk.Address) Transmitters {
+	s := make([]sdk.AccAddress, 0, len(t))
+	for _, acc := range t {
+		if !acc.Equals(addr) {
+			s = append(s, acc)
+		}
+	}
+	return s
+}
+
+// IsTransmitter returns true if the given address is allowed to submit the reports of the feed,
+// the data providers of a feed without transmitter transmit their own reports
+func (m *MsgFeed) IsTransmitter(addr sdk.AccAddress) bool {
+	if len(m.GetTransmitters()) == 0 {
+		return (DataProviders)(m.GetDataProviders()).Contains(addr)
+	}
+	return (Transmitters)(m.GetTransmitters()).Contains(addr)
+}
+
 // DeriveCosmosAddrFromPubKey derives the cosmos address from Bech32 cosmos pubkey
 func DeriveCosmosAddrFromPubKey(pubKey string) (sdk.AccAddress, error) {
 	bech32PubKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeAccPub, pubKey)
@@ -60,3 +98,33 @@ func DeriveCosmosAddrFromPubKey(pubKey string) (sdk.AccAddress, error) {
 	}
 	return cosmosAddr, nil
 }
+
+// ChainlinkPubKeyToAddress converts a chainlink public key, registered in the account store or set as signing key of an
+// OCR config, into the ethereum style address of the oracle signing key.
+// The key can either be raw bytes or a hex string (with or without 0x prefix) of a 20-byte address,
+// a 33-byte compressed or a 65-byte uncompressed secp256k1 public key.
+func ChainlinkPubKeyToAddress(chainlinkPubKey []byte) (common.Address, error) {
+	key := chainlinkPubKey
+	if decoded, err := hex.DecodeString(strings.TrimPrefix(string(chainlinkPubKey), "0x")); err == nil {
+		key = decoded
+	}
+
+	switch len(key) {
+	case common.AddressLength:
+		return common.BytesToAddress(key), nil
+	case 33:
+		pubKey, err := crypto.DecompressPubkey(key)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return crypto.PubkeyToAddress(*pubKey), nil
+	case 65:
+		pubKey, err := crypto.UnmarshalPubkey(key)
+		if err != nil {
+			return common.Address{}, err
+		}
+		return crypto.PubkeyToAddress(*pubKey), nil
+	default:
+		return common.Address{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidPubKey, "unsupported chainlink pubKey length %d", len(key))
+	}
+}
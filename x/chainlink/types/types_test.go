@@ -4,8 +4,11 @@
 package types
 
 import (
-	"github.com/stretchr/testify/require"
+	"encoding/hex"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 )
 
 func TestTypes_MsgModuleOwners_Contains(t *testing.T) {
@@ -143,3 +146,29 @@ func TestTypes_DeriveCosmosAddrFromPubKey(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expAddr2, addr2)
 }
+
+func TestTypes_ChainlinkPubKeyToAddress(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	compressed := crypto.CompressPubkey(&key.PublicKey)
+
+	// the encodings of the same key map to the same address
+	encodings := [][]byte{
+		compressed,
+		[]byte(hex.EncodeToString(compressed)),
+		[]byte("0x" + hex.EncodeToString(compressed)),
+		crypto.FromECDSAPub(&key.PublicKey),
+		[]byte(hex.EncodeToString(crypto.FromECDSAPub(&key.PublicKey))),
+		address.Bytes(),
+		[]byte(address.Hex()),
+	}
+	for i, encoding := range encodings {
+		signer, err := ChainlinkPubKeyToAddress(encoding)
+		require.NoError(t, err, "encoding %d", i)
+		require.Equal(t, address, signer, "encoding %d", i)
+	}
+
+	_, err = ChainlinkPubKeyToAddress([]byte("chainlinkPubKey"))
+	require.Error(t, err)
+}
@@ -0,0 +1,201 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ChainSafe/chainlink-cosmos/x/chainlink/exported"
+)
+
+var (
+	_ exported.FeedValueI   = &FeedValue{}
+	_ exported.ObservationI = &Observation{}
+	_ exported.ReportI      = &OCRAbiEncoded{}
+	_ exported.RoundDataI   = &RoundData{}
+)
+
+const (
+	// FeedValueTypeInt192 is the type of the numeric feeds, like the price feeds of the OCR aggregator
+	FeedValueTypeInt192 = "int192"
+	// FeedValueTypeBytes is the type of the feeds observing opaque bytes
+	FeedValueTypeBytes = "bytes"
+	// FeedValueTypeString is the type of the feeds observing text
+	FeedValueTypeString = "string"
+	// FeedValueTypeTuple is the type of the feeds observing a fixed size tuple of int192 numbers
+	FeedValueTypeTuple = "tuple"
+
+	// FeedValueMaxTupleSize is the maximum number of components of a tuple value
+	FeedValueMaxTupleSize = 16
+)
+
+// ValueType returns the type of the values of the schema, a feed without schema is an int192 feed
+func (m *FeedValueSchema) ValueType() string {
+	if m.GetType() == "" {
+		return FeedValueTypeInt192
+	}
+	return m.GetType()
+}
+
+// IsNumeric tells whether the feed answers a single int192 number, only numeric feeds have an answer the
+// deviation threshold trigger and the answer bounds apply to
+func (m *FeedValueSchema) IsNumeric() bool {
+	return m.ValueType() == FeedValueTypeInt192
+}
+
+// Validate checks the type is a known one and only tuples have a tuple size, nil is a valid int192 schema
+func (m *FeedValueSchema) Validate() error {
+	switch m.ValueType() {
+	case FeedValueTypeInt192, FeedValueTypeBytes, FeedValueTypeString:
+		if m.GetTupleSize() != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tupleSize can not be set for %s values", m.ValueType())
+		}
+	case FeedValueTypeTuple:
+		if m.GetTupleSize() == 0 || m.GetTupleSize() > FeedValueMaxTupleSize {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tupleSize must be between 1 and %d", FeedValueMaxTupleSize)
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid feed value type %s", m.GetType())
+	}
+	return nil
+}
+
+// NewNumericFeedValue returns an int192 feed value, the numeric field of the other feed values is zero
+func NewNumericFeedValue(value sdk.Int) *FeedValue {
+	return &FeedValue{Type: FeedValueTypeInt192, Numeric: value}
+}
+
+func NewBytesFeedValue(value []byte) *FeedValue {
+	return &FeedValue{Type: FeedValueTypeBytes, Numeric: sdk.ZeroInt(), Bytes: value}
+}
+
+func NewStringFeedValue(value string) *FeedValue {
+	return &FeedValue{Type: FeedValueTypeString, Numeric: sdk.ZeroInt(), Text: value}
+}
+
+func NewTupleFeedValue(values ...sdk.Int) *FeedValue {
+	return &FeedValue{Type: FeedValueTypeTuple, Numeric: sdk.ZeroInt(), Tuple: values}
+}
+
+// GetNumeric returns the value of an int192 feed value, zero for the other types
+func (m *FeedValue) GetNumeric() sdk.Int {
+	if m == nil || m.Numeric.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return m.Numeric
+}
+
+// GetTuple returns the components of a tuple feed value, nil for the other types
+func (m *FeedValue) GetTuple() []sdk.Int {
+	if m != nil {
+		return m.Tuple
+	}
+	return nil
+}
+
+// ObservedValue returns the observation typed after the feed value schema
+func (m *Observation) ObservedValue() exported.FeedValueI {
+	return m.feedValue()
+}
+
+// feedValue returns the typed observation, the observations of the rounds persisted before the feeds got a value
+// schema are int192 ones
+func (m *Observation) feedValue() *FeedValue {
+	if m.GetFeedValue() != nil {
+		return m.GetFeedValue()
+	}
+	return NewNumericFeedValue(m.Value)
+}
+
+// Aggregate returns the answer of the report typed after the feed value schema: the median of int192 observations,
+// the median of every component of tuple observations and the value observed by the most oracles for bytes and
+// string observations, the first one in the report on a tie
+func (m *OCRAbiEncoded) Aggregate() *FeedValue {
+	observations := m.GetObservations()
+	if len(observations) == 0 {
+		return nil
+	}
+
+	switch m.ValueType() {
+	case FeedValueTypeBytes, FeedValueTypeString:
+		var answer *FeedValue
+		answerCount := 0
+		for i, o := range observations {
+			count := 0
+			for _, other := range observations[i:] {
+				if feedValueEqual(o.feedValue(), other.feedValue()) {
+					count++
+				}
+			}
+			if count > answerCount {
+				answer, answerCount = o.feedValue(), count
+			}
+		}
+		return answer
+	case FeedValueTypeTuple:
+		size := len(observations[0].feedValue().GetTuple())
+		answer := make([]sdk.Int, 0, size)
+		for c := 0; c < size; c++ {
+			component := make([]sdk.Int, 0, len(observations))
+			for _, o := range observations {
+				component = append(component, o.feedValue().GetTuple()[c])
+			}
+			sort.SliceStable(component, func(i, j int) bool { return component[i].LT(component[j]) })
+			answer = append(answer, component[len(component)/2])
+		}
+		return NewTupleFeedValue(answer...)
+	default:
+		return NewNumericFeedValue(m.Median())
+	}
+}
+
+// ObservationList returns the observations of the report
+func (m *OCRAbiEncoded) ObservationList() []exported.ObservationI {
+	observations := make([]exported.ObservationI, 0, len(m.GetObservations()))
+	for _, o := range m.GetObservations() {
+		observations = append(observations, o)
+	}
+	return observations
+}
+
+// AnswerValue returns the aggregated answer of the report, nil when the report has no observation
+func (m *OCRAbiEncoded) AnswerValue() exported.FeedValueI {
+	if answer := m.Aggregate(); answer != nil {
+		return answer
+	}
+	return nil
+}
+
+// ValueType returns the type of the report observations, the reports persisted before the feeds got a value schema
+// are int192 ones
+func (m *OCRAbiEncoded) ValueType() string {
+	if len(m.GetObservations()) == 0 {
+		return FeedValueTypeInt192
+	}
+	return m.GetObservations()[0].feedValue().GetType()
+}
+
+func feedValueEqual(a, b *FeedValue) bool {
+	return a.GetType() == b.GetType() && bytes.Equal(a.GetBytes(), b.GetBytes()) && a.GetText() == b.GetText()
+}
+
+// Report returns the report the round got answered with
+func (m *RoundData) Report() exported.ReportI {
+	if m.GetFeedData() != nil {
+		return m.GetFeedData()
+	}
+	return nil
+}
+
+// AnswerValue returns the answer of the round typed after the feed value schema
+func (m *RoundData) AnswerValue() exported.FeedValueI {
+	if m.GetValue() != nil {
+		return m.GetValue()
+	}
+	return nil
+}
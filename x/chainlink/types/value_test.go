@@ -0,0 +1,60 @@
+// Copyright 2021 ChainSafe Systems
+// SPDX-License-Identifier: MIT
+
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypes_FeedValueSchema_Validate(t *testing.T) {
+	var noSchema *FeedValueSchema
+	require.NoError(t, noSchema.Validate())
+	require.Equal(t, FeedValueTypeInt192, noSchema.ValueType())
+	require.True(t, noSchema.IsNumeric())
+
+	require.NoError(t, (&FeedValueSchema{Type: FeedValueTypeBytes}).Validate())
+	require.NoError(t, (&FeedValueSchema{Type: FeedValueTypeString}).Validate())
+	require.NoError(t, (&FeedValueSchema{Type: FeedValueTypeTuple, TupleSize: 2}).Validate())
+	require.False(t, (&FeedValueSchema{Type: FeedValueTypeTuple, TupleSize: 2}).IsNumeric())
+
+	require.Error(t, (&FeedValueSchema{Type: "bool"}).Validate())
+	require.Error(t, (&FeedValueSchema{Type: FeedValueTypeBytes, TupleSize: 2}).Validate())
+	require.Error(t, (&FeedValueSchema{Type: FeedValueTypeTuple}).Validate())
+	require.Error(t, (&FeedValueSchema{Type: FeedValueTypeTuple, TupleSize: FeedValueMaxTupleSize + 1}).Validate())
+}
+
+func TestTypes_OCRAbiEncoded_Aggregate(t *testing.T) {
+	report := func(values ...*FeedValue) *OCRAbiEncoded {
+		observations := make([]*Observation, 0, len(values))
+		for _, v := range values {
+			observations = append(observations, &Observation{FeedValue: v})
+		}
+		return &OCRAbiEncoded{Observations: observations}
+	}
+
+	// int192 reports are answered with the median, including the ones persisted without typed observations
+	legacy := &OCRAbiEncoded{Observations: []*Observation{{Value: sdk.NewInt(1)}, {Value: sdk.NewInt(2)}, {Value: sdk.NewInt(3)}}}
+	require.Equal(t, FeedValueTypeInt192, legacy.ValueType())
+	require.Equal(t, NewNumericFeedValue(sdk.NewInt(2)), legacy.Aggregate())
+
+	// bytes and string reports are answered with the value observed by the most oracles, the first one on a tie
+	require.Equal(t, NewBytesFeedValue([]byte{1}), report(NewBytesFeedValue([]byte{0}), NewBytesFeedValue([]byte{1}), NewBytesFeedValue([]byte{1})).Aggregate())
+	require.Equal(t, NewStringFeedValue("up"), report(NewStringFeedValue("up"), NewStringFeedValue("down")).Aggregate())
+	require.Equal(t, FeedValueTypeString, report(NewStringFeedValue("up")).ValueType())
+
+	// tuple reports are answered with the median of every component
+	tuples := report(
+		NewTupleFeedValue(sdk.NewInt(30), sdk.NewInt(1)),
+		NewTupleFeedValue(sdk.NewInt(10), sdk.NewInt(3)),
+		NewTupleFeedValue(sdk.NewInt(20), sdk.NewInt(2)),
+	)
+	require.Equal(t, NewTupleFeedValue(sdk.NewInt(20), sdk.NewInt(2)), tuples.Aggregate())
+	require.Equal(t, []sdk.Int{sdk.NewInt(20), sdk.NewInt(2)}, tuples.AnswerValue().GetTuple())
+
+	require.Nil(t, (&OCRAbiEncoded{}).Aggregate())
+	require.Nil(t, (&OCRAbiEncoded{}).AnswerValue())
+}